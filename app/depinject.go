@@ -0,0 +1,38 @@
+package app
+
+import (
+	oraclemodule "github.com/PersonaPass-ID/personachain/x/oracle/module"
+)
+
+// There is no app.go assembling a concrete App type in this tree yet (app/encoding.go
+// has no ModuleBasics to register module codecs against -- see snapshot.go's and
+// wasm.go's doc comments for the same gap), so there is no depinject.Inject call or
+// appconfig.Compose-built *depinject.Config for x/oracle/module's ProvideModule (and its
+// x/registry/x/zkproof/x/token siblings under their own module/depinject.go) to
+// actually be wired into yet. This is the piece that wiring would call once one exists,
+// e.g.:
+//
+//	var appConfig = depinject.Configs(
+//		appconfig.Compose(&runtimev1alpha1.Module{ ... }),
+//		depinject.Supply(oracleModuleAuthority),
+//		depinject.Provide(oraclemodule.ProvideModule),
+//	)
+//
+// OracleModuleInputs/OracleModuleOutputs/ProvideOracleModule used to live in this file
+// directly; they've moved to x/oracle/module/depinject.go (as ModuleInputs/
+// ModuleOutputs/ProvideModule) now that x/registry, x/zkproof, and x/token each have
+// their own module/depinject.go alongside it, so all four live under a consistent
+// per-module path instead of only x/oracle's being defined at the app level.
+//
+// x/registry has a concrete Keeper struct but no AppModule yet, so its
+// module/depinject.go only provides the Keeper. x/did, x/credential, and x/zkproof now
+// each have a concrete Keeper struct and NewKeeper constructor too (see their own
+// keeper/keeper.go), closing the Keeper half of this gap, but still have no
+// AppModule/module.go in this tree -- so, like x/registry, there is no
+// NewAppModule(...) call for a ProvideModule function in those three modules to return
+// alongside their Keeper yet. x/token has neither a Keeper nor Msg types at all (see
+// x/token/module/depinject.go). x/oracle has both a Keeper and an AppModule (as do
+// x/revocation and x/e2ee, which have no module/depinject.go), so
+// oraclemodule.ProvideModule is a real, complete depinject provider; x/did/x/credential/x/zkproof/x/registry's own module/depinject.go files can
+// follow its exact shape for the AppModule half once their AppModule gap is closed.
+var _ = oraclemodule.ProvideModule
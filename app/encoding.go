@@ -32,10 +32,21 @@ func MakeEncodingConfig() EncodingConfig {
 	}
 }
 
+// There is no module.BasicManager (conventionally named ModuleBasics) assembled
+// anywhere in this tree yet -- that requires an AppModuleBasic for every module this
+// repo has, and x/did, x/credential, and x/zkproof have no AppModule/module.go at all
+// (see depinject.go's doc comment for the fuller per-module state of that gap), so
+// there is nothing yet to range over RegisterLegacyAminoCodec/RegisterInterfaces calls
+// against. init() used to call a ModuleBasics that was never defined anywhere in this
+// tree, which doesn't compile; std.RegisterLegacyAminoCodec/std.RegisterInterfaces
+// (covering the SDK's own standard types) still run on package load, and the
+// ModuleBasics.RegisterLegacyAminoCodec/RegisterInterfaces calls are the piece real
+// app wiring would add back once a BasicManager exists, e.g.:
+//
+//	ModuleBasics.RegisterLegacyAminoCodec(config.Amino)
+//	ModuleBasics.RegisterInterfaces(config.InterfaceRegistry)
 func init() {
 	config := MakeEncodingConfig()
 	std.RegisterLegacyAminoCodec(config.Amino)
 	std.RegisterInterfaces(config.InterfaceRegistry)
-	ModuleBasics.RegisterLegacyAminoCodec(config.Amino)
-	ModuleBasics.RegisterInterfaces(config.InterfaceRegistry)
 }
\ No newline at end of file
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	credentialsim "github.com/PersonaPass-ID/personachain/x/credential/simulation"
+	credentialtypes "github.com/PersonaPass-ID/personachain/x/credential/types"
+	didsim "github.com/PersonaPass-ID/personachain/x/did/simulation"
+	didtypes "github.com/PersonaPass-ID/personachain/x/did/types"
+	oraclesim "github.com/PersonaPass-ID/personachain/x/oracle/simulation"
+	oracletypes "github.com/PersonaPass-ID/personachain/x/oracle/types"
+	registrysim "github.com/PersonaPass-ID/personachain/x/registry/simulation"
+	registrytypes "github.com/PersonaPass-ID/personachain/x/registry/types"
+	zkproofsim "github.com/PersonaPass-ID/personachain/x/zkproof/simulation"
+	zkprooftypes "github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// There is no app.go assembling a concrete App type in this tree yet (app/encoding.go
+// has no ModuleBasics to register module codecs against -- see snapshot.go's,
+// wasm.go's, and depinject.go's doc comments for the same gap), so there is no
+// NewPersonaChainAppNew for a `sm *module.SimulationManager` field to live on, and none
+// of x/did, x/credential, x/zkproof, x/oracle, or x/registry has a concrete
+// AppModuleSimulation wired into one (see depinject.go's doc comment) for
+// module.NewSimulationManager to register alongside the modules that do.
+// RandomizedGenState/WeightedOperations/NewDecodeStore for each already exist in their
+// own simulation packages (x/registry/x/token's WeightedOperations return an empty set
+// -- see their own doc comments for why); this file is the piece app wiring would call
+// once a real *module.SimulationManager exists, e.g.:
+//
+//	app.sm = module.NewSimulationManager(
+//		oracle.NewAppModule(cdc, oracleKeeper), // has both a Keeper and an AppModule
+//		... // did/credential/zkproof/registry/token entries once their AppModule gap (depinject.go) is closed
+//	)
+//	app.sm.RegisterStoreDecoders()
+//
+// RegisterSimulationStoreDecoders registers did/credential/zkproof/oracle/registry's
+// NewDecodeStore functions into sdr directly, standing in for what passing each
+// module's AppModuleSimulation into module.NewSimulationManager would do once those
+// modules have one. x/token has no store of its own (see its simulation package's doc
+// comment), so there is no NewDecodeStore to register for it.
+func RegisterSimulationStoreDecoders(sdr module.StoreDecoderRegistry, cdc codec.Codec) {
+	sdr[didtypes.StoreKey] = didsim.NewDecodeStore(cdc)
+	sdr[credentialtypes.ModuleName] = credentialsim.NewDecodeStore(cdc)
+	sdr[zkprooftypes.ModuleName] = zkproofsim.NewDecodeStore(cdc)
+	sdr[oracletypes.StoreKey] = oraclesim.NewDecodeStore(cdc)
+	sdr[registrytypes.StoreKey] = registrysim.NewDecodeStore(cdc)
+}
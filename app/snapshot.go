@@ -0,0 +1,36 @@
+package app
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+
+	schemakeeper "github.com/PersonaPass-ID/personachain/x/schema/keeper"
+)
+
+// RegisterExtensionSnapshotters wires the schema module's out-of-band
+// ExtensionSnapshotter into mgr, so its (potentially large) JSON Schema bodies stream
+// into state-sync snapshots separately from ordinary IAVL chunks rather than bloating
+// every one of them.
+//
+// x/identity does not exist in this tree -- see the x/schema AutoCLI doc comment for
+// the closest analogs -- and x/credential, though it now has a concrete Keeper (see
+// x/credential/keeper/keeper.go), has no ExtensionSnapshotter of its own yet
+// (x/credential/types.Params carries a SnapshotFormat field for when one is added), so
+// only x/schema is registered below.
+//
+// schemaStoreKey is the module's raw storetypes.StoreKey, passed through to
+// NewSnapshotExtension separately from schemaKeeper -- see x/schema/keeper/keeper.go's
+// Keeper doc comment for why the keeper itself no longer carries one.
+//
+// There is also no app.go assembling a concrete App type in this tree yet
+// (app/encoding.go has no ModuleBasics to register module codecs against, and
+// app/wasm.go notes the same gap) -- this is the piece app wiring would call once one
+// exists, e.g.:
+//
+//	if err := app.RegisterExtensionSnapshotters(app.SnapshotManager(), app.SchemaKeeper, app.SchemaStoreKey, app.CommitMultiStore()); err != nil {
+//		panic(err)
+//	}
+func RegisterExtensionSnapshotters(mgr *snapshots.Manager, schemaKeeper schemakeeper.Keeper, schemaStoreKey storetypes.StoreKey, cms storetypes.MultiStore) error {
+	return mgr.RegisterExtensions(schemakeeper.NewSnapshotExtension(schemaKeeper, schemaStoreKey, cms))
+}
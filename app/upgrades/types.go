@@ -0,0 +1,37 @@
+// Package upgrades holds one subpackage per chain upgrade (v2, v3, ...), the
+// conventional shape most Cosmos SDK chains use to keep SetUpgradeHandler/
+// SetStoreLoader registration out of app.go itself.
+//
+// There is no app.go assembling a concrete App type in this tree yet (see
+// app/depinject.go's and app/simulation.go's doc comments for the same gap), so
+// nothing calls upgradeKeeper.SetUpgradeHandler(u.UpgradeName, u.CreateUpgradeHandler(...))
+// or baseApp.SetStoreLoader for any Upgrade value below yet -- this is the piece app
+// wiring would need once a concrete App and its *module.Manager/module.Configurator
+// exist, e.g.:
+//
+//	app.UpgradeKeeper.SetUpgradeHandler(
+//		v2.Upgrade.UpgradeName,
+//		v2.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), app.DIDKeeper, app.CredentialKeeper, app.keys[credentialtypes.StoreKey]),
+//	)
+//	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+//	if err == nil && upgradeInfo.Name == v2.Upgrade.UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+//		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &v2.Upgrade.StoreUpgrades))
+//	}
+package upgrades
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Upgrade bundles the two pieces every release's app wiring needs to hand the
+// UpgradeKeeper/BaseApp regardless of which keepers that release's own
+// CreateUpgradeHandler migrates: the plan name that must match the on-chain
+// software-upgrade proposal, and the store keys the upgrade adds (StoreUpgrades.Added)
+// for upgradetypes.UpgradeStoreLoader to provision atomically. Each release's own
+// subpackage (e.g. v2) exposes its own CreateUpgradeHandler function separately,
+// since its parameter list -- which keepers it migrates -- is necessarily specific to
+// that release rather than a shape every Upgrade value could share.
+type Upgrade struct {
+	UpgradeName   string
+	StoreUpgrades storetypes.StoreUpgrades
+}
@@ -0,0 +1,76 @@
+// Package v2 is the chain's first software-upgrade handler: it provisions x/oracle's
+// store (added this cycle, see x/oracle/module/depinject.go's ProvideModule) and runs the
+// did/credential module migrations already written against that eventuality
+// (x/did/keeper/migrations.go's Migrate2to3, x/credential/keeper/migrations.go's
+// Migrate1to2).
+package v2
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/PersonaPass-ID/personachain/app/upgrades"
+	credentialkeeper "github.com/PersonaPass-ID/personachain/x/credential/keeper"
+	didkeeper "github.com/PersonaPass-ID/personachain/x/did/keeper"
+	oracletypes "github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// UpgradeName is the plan name this handler registers under; it must match the
+// software-upgrade governance proposal's Plan.Name exactly for UpgradeKeeper to pick
+// it (and this file's StoreUpgrades) up at the target height.
+const UpgradeName = "v2"
+
+// Upgrade is this release's upgrades.Upgrade value, for app wiring to pass to
+// UpgradeKeeper.SetUpgradeHandler/the upgradetypes.UpgradeStoreLoader construction
+// documented in upgrades/types.go's package doc comment.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName: UpgradeName,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{oracletypes.StoreKey},
+	},
+}
+
+// CreateUpgradeHandler returns the upgradetypes.UpgradeHandler v2's SetUpgradeHandler
+// call registers. It runs mm.RunMigrations first (bumping every already-registered
+// module's ConsensusVersion per fromVM -- the mechanism x/credential's and x/did's own
+// Migrator.Migrate1to2/Migrate2to3 would run through once those modules have a
+// module.go/Configurator to call cfg.RegisterMigration from; see each file's doc
+// comment for that standing gap), then calls didKeeper's and credentialKeeper's
+// migrators directly, since RunMigrations can't reach a migration that was never
+// registered with the configurator in the first place. credentialLegacyStoreKey is
+// x/credential's pre-collections store key (still present in app wiring's keys map at
+// upgrade time, same as any other in-place migration) -- the same legacyStoreKey
+// parameter credential/keeper/migrations.go's Migrate1to2 already takes; x/did's
+// Migrate2to3 takes no store key of its own. x/zkproof has no
+// legacy-layout migration to run this cycle -- it only needs its ConsensusVersion
+// bumped in fromVM, the same as any other module with no state shape change this
+// release.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	didKeeper didkeeper.Keeper,
+	credentialKeeper credentialkeeper.Keeper,
+	credentialLegacyStoreKey storetypes.StoreKey,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		toVM, err := mm.RunMigrations(ctx, cfg, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+		if err := didkeeper.NewMigrator(didKeeper).Migrate2to3(sdkCtx); err != nil {
+			return nil, err
+		}
+		if err := credentialkeeper.NewMigrator(credentialKeeper).Migrate1to2(sdkCtx, credentialLegacyStoreKey); err != nil {
+			return nil, err
+		}
+
+		return toVM, nil
+	}
+}
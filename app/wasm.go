@@ -0,0 +1,23 @@
+//go:build wasm
+
+package app
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	schemakeeper "github.com/PersonaPass-ID/personachain/x/schema/keeper"
+)
+
+// WasmOpts returns the wasmkeeper.Option list the wasm keeper should be constructed
+// with, wiring in x/schema's custom PersonaMsg/PersonaQuery bindings so contracts can
+// register and validate against credential schemas. Only compiled into binaries built
+// with the `wasm` tag, so operators who don't run CosmWasm keep a lean binary.
+//
+// There is no app.go assembling a concrete App type in this tree yet (app/encoding.go
+// has no ModuleBasics to register module codecs against) -- this is the piece app
+// wiring would call with its own wasmKeeper once one exists, e.g.:
+//
+//	wasmKeeper := wasmkeeper.NewKeeper(..., app.WasmOpts(app.SchemaKeeper, wasmKeeper)...)
+func WasmOpts(schemaKeeper schemakeeper.Keeper, wasmKeeper wasmkeeper.Keeper) []wasmkeeper.Option {
+	return schemaKeeper.RegisterWasmBindings(wasmKeeper)
+}
@@ -0,0 +1,36 @@
+// Package errors provides a small shared helper so every module's msgServer/keeper
+// errors carry a stable (codespace, code) pair all the way out to the client, instead
+// of losing it the moment a lower-level error gets wrapped in a plain fmt.Errorf. x/
+// credential, x/schema and x/revocation already register their sentinels with
+// errorsmod.Register (see each module's types/errors.go) and return them directly from
+// most keeper paths; this package exists for the remaining case where a keeper needs to
+// attach one of those registered sentinels to an error it did not originate itself
+// (e.g. an io/codec error surfaced from a lower-level call) without discarding the
+// original error's message. The result's cause is the sentinel, which is what baseapp's
+// sdkerrors.ABCIInfo reads the code from; the original error value itself is not kept
+// in the chain, so errors.Is against it does not match.
+package errors
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Wrap attaches sentinel's registered (codespace, code) to err, preserving err's own
+// message as the wrapped error's message. Returns nil if err is nil.
+func Wrap(sentinel *errorsmod.Error, err error) error {
+	if err == nil {
+		return nil
+	}
+	return sentinel.Wrap(err.Error())
+}
+
+// Wrapf is Wrap with a formatted prefix placed ahead of err's own message, mirroring
+// errorsmod.Wrapf's format/args signature.
+func Wrapf(sentinel *errorsmod.Error, err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return sentinel.Wrap(fmt.Sprintf(format, args...) + ": " + err.Error())
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// GetQueryCmd returns the query commands for the credential module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdShowCredential(),
+		CmdShowPresentation(),
+		CmdListCredentialTypes(),
+		CmdShowCredentialType(),
+	)
+
+	return cmd
+}
+
+// CmdShowCredential returns the query command for fetching a credential by ID.
+func CmdShowCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-credential [id]",
+		Short: "Show a verifiable credential by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), types.CredentialKey(args[0]))
+			if err != nil {
+				return err
+			}
+			if res.Response.Value == nil {
+				return fmt.Errorf("credential %q not found", args[0])
+			}
+
+			return clientCtx.PrintString(string(res.Response.Value) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryCredentialTypeUsage fetches CredentialTypeUsage[name] via a raw ABCI query against
+// types.CredentialTypeUsagePrefix||name, the same layout
+// keeper/credential_types.go's RecordCredentialTypeUsage writes under. A missing entry
+// means the type has never been used, not an error.
+func queryCredentialTypeUsage(cmd *cobra.Command, clientCtx client.Context, name string) (uint64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	key := append(append([]byte{}, []byte(types.CredentialTypeUsagePrefix)...), []byte(name)...)
+	res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), key)
+	if err != nil {
+		return 0, err
+	}
+	if res.Response.Value == nil {
+		return 0, nil
+	}
+	return types.BytesToUint64(res.Response.Value), nil
+}
+
+// CmdListCredentialTypes returns the query command listing every registered
+// CredentialTypeDef in Params.CredentialTypes, including deprecated ones, so an operator
+// can see the full allowlist history alongside show-type's per-entry usage count.
+func CmdListCredentialTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-types",
+		Short: "List registered credential type definitions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), []byte(types.ParamsKey))
+			if err != nil {
+				return err
+			}
+
+			params := types.DefaultParams()
+			if res.Response.Value != nil {
+				if err := clientCtx.Codec.Unmarshal(res.Response.Value, &params); err != nil {
+					return fmt.Errorf("decoding params: %w", err)
+				}
+			}
+
+			out, err := json.MarshalIndent(params.CredentialTypes, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdShowCredentialType returns the query command showing one registered
+// CredentialTypeDef together with its CredentialTypeUsage count, the adoption figure an
+// operator weighs before submitting deprecate-type.
+func CmdShowCredentialType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-type [name]",
+		Short: "Show a registered credential type definition and its usage count",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), []byte(types.ParamsKey))
+			if err != nil {
+				return err
+			}
+
+			params := types.DefaultParams()
+			if res.Response.Value != nil {
+				if err := clientCtx.Codec.Unmarshal(res.Response.Value, &params); err != nil {
+					return fmt.Errorf("decoding params: %w", err)
+				}
+			}
+
+			def, ok := types.FindCredentialTypeDef(params.CredentialTypes, args[0])
+			if !ok {
+				for _, d := range params.CredentialTypes {
+					if d.Name == args[0] {
+						def, ok = d, true
+						break
+					}
+				}
+			}
+			if !ok {
+				return fmt.Errorf("credential type %q is not registered", args[0])
+			}
+
+			usage, err := queryCredentialTypeUsage(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(struct {
+				types.CredentialTypeDef
+				UsageCount uint64 `json:"usageCount"`
+			}{def, usage}, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(out) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdShowPresentation returns the query command for fetching a presentation by ID.
+func CmdShowPresentation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-presentation [id]",
+		Short: "Show a verifiable presentation by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), types.PresentationKey(args[0]))
+			if err != nil {
+				return err
+			}
+			if res.Response.Value == nil {
+				return fmt.Errorf("presentation %q not found", args[0])
+			}
+
+			return clientCtx.PrintString(string(res.Response.Value) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,435 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// GetTxCmd returns the transaction commands for the credential module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateCredential(),
+		CmdRevokeCredential(),
+		CmdCreatePresentation(),
+		CmdRegisterCredentialType(),
+		CmdDeprecateCredentialType(),
+		CmdSubmitCredentialApplication(),
+		CmdReviewApplication(),
+		CmdGrantIssuance(),
+	)
+
+	return cmd
+}
+
+// queryCurrentParams fetches and decodes the module's live Params via a raw ABCI query
+// against types.ParamsKey, the same store/key query style CmdShowCredential/
+// CmdShowPresentation (query.go) use for Credentials/Presentations -- there is no gRPC
+// QueryParams endpoint in this tree to call instead (see keeper/genesis.go's
+// GenesisSnapshot doc comment for the broader "no AppModule" gap this falls out of).
+func queryCurrentParams(clientCtx client.Context, cmd *cobra.Command) (types.Params, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), []byte(types.ParamsKey))
+	if err != nil {
+		return types.Params{}, err
+	}
+	if res.Response.Value == nil {
+		return types.DefaultParams(), nil
+	}
+
+	var params types.Params
+	if err := clientCtx.Codec.Unmarshal(res.Response.Value, &params); err != nil {
+		return types.Params{}, fmt.Errorf("decoding current params: %w", err)
+	}
+	return params, nil
+}
+
+// CmdRegisterCredentialType returns the tx command for registering or replacing a
+// CredentialTypeDef in Params.CredentialTypes. It is sugar over MsgUpdateParams (see
+// types.MsgUpdateParams's doc comment): it reads the live Params, upserts the named
+// definition, and submits the whole Params back rather than being a separate Msg type.
+func CmdRegisterCredentialType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-type [name] [json-schema-uri] [revocable] [max-validity-seconds] [required-claim-key...]",
+		Short: "Register or replace a credential type definition (authority only)",
+		Args:  cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			revocable, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid revocable %q: %w", args[2], err)
+			}
+			maxValiditySeconds, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max-validity-seconds %q: %w", args[3], err)
+			}
+
+			def := types.CredentialTypeDef{
+				Name:                     args[0],
+				JSONSchemaURI:            args[1],
+				Revocable:                revocable,
+				MaxValidityDuration:      time.Duration(maxValiditySeconds) * time.Second,
+				RequiredSubjectClaimKeys: args[4:],
+			}
+			if err := def.Validate(); err != nil {
+				return err
+			}
+
+			params, err := queryCurrentParams(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+			params.CredentialTypes = upsertCredentialTypeDef(params.CredentialTypes, def)
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDeprecateCredentialType returns the tx command for marking a registered credential
+// type Deprecated, the same MsgUpdateParams-as-sugar style CmdRegisterCredentialType uses.
+// A deprecated type is left in Params.CredentialTypes (rather than removed) so its
+// CredentialTypeUsage history and previously-issued credentials of that type stay legible.
+func CmdDeprecateCredentialType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-type [name]",
+		Short: "Mark a registered credential type as deprecated (authority only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			params, err := queryCurrentParams(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			found := false
+			for i := range params.CredentialTypes {
+				if params.CredentialTypes[i].Name == args[0] {
+					params.CredentialTypes[i].Deprecated = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("credential type %q is not registered", args[0])
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// upsertCredentialTypeDef returns defs with def inserted, replacing any existing entry of
+// the same name.
+func upsertCredentialTypeDef(defs []types.CredentialTypeDef, def types.CredentialTypeDef) []types.CredentialTypeDef {
+	for i := range defs {
+		if defs[i].Name == def.Name {
+			defs[i] = def
+			return defs
+		}
+	}
+	return append(defs, def)
+}
+
+// CmdCreateCredential returns the tx command for issuing a verifiable credential.
+func CmdCreateCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-credential [id] [credential-type] [subject-json]",
+		Short: "Issue a new verifiable credential",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var subject types.CredentialSubject
+			if err := json.Unmarshal([]byte(args[2]), &subject); err != nil {
+				return fmt.Errorf("failed to parse credential subject: %w", err)
+			}
+
+			msg := &types.MsgCreateCredential{
+				Context:           []string{"https://www.w3.org/2018/credentials/v1"},
+				Id:                args[0],
+				CredentialType:    []string{"VerifiableCredential", args[1]},
+				Issuer:            clientCtx.GetFromAddress().String(),
+				CredentialSubject: &subject,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevokeCredential returns the tx command for revoking a verifiable credential.
+func CmdRevokeCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-credential [credential-id]",
+		Short: "Revoke a verifiable credential",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeCredential{
+				CredentialId: args[0],
+				Revoker:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdCreatePresentation returns the tx command for bundling credentials into a
+// verifiable presentation.
+func CmdCreatePresentation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-presentation [id] [credential-id...]",
+		Short: "Create a verifiable presentation from one or more credentials",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreatePresentation{
+				Context:              []string{"https://www.w3.org/2018/credentials/v1"},
+				Id:                   args[0],
+				PresentationType:     []string{"VerifiablePresentation"},
+				Holder:               clientCtx.GetFromAddress().String(),
+				VerifiableCredential: args[1:],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSubmitCredentialApplication returns the tx command for a holder to open a
+// CredentialApplication against an issuer's published CredentialSchema.
+func CmdSubmitCredentialApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-application [id] [issuer] [schema-id] [claims-json]",
+		Short: "Submit a credential application against an issuer's schema",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var claims map[string]interface{}
+			if err := json.Unmarshal([]byte(args[3]), &claims); err != nil {
+				return fmt.Errorf("failed to parse claims: %w", err)
+			}
+
+			msg := &types.MsgSubmitCredentialApplication{
+				Id:       args[0],
+				Holder:   clientCtx.GetFromAddress().String(),
+				Issuer:   args[1],
+				SchemaId: args[2],
+				Claims:   claims,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdReviewApplication returns the tx command for an issuer to approve or deny a
+// pending CredentialApplication. --approve defaults to false, so denying only needs
+// --reason; approving needs both --approve and, optionally, --overrides.
+func CmdReviewApplication() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "review-application [application-id]",
+		Short: "Approve or deny a pending credential application",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			approved, err := cmd.Flags().GetBool("approve")
+			if err != nil {
+				return err
+			}
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return err
+			}
+			overridesJSON, err := cmd.Flags().GetString("overrides")
+			if err != nil {
+				return err
+			}
+
+			var overrides map[string]interface{}
+			if overridesJSON != "" {
+				if err := json.Unmarshal([]byte(overridesJSON), &overrides); err != nil {
+					return fmt.Errorf("failed to parse credential overrides: %w", err)
+				}
+			}
+
+			msg := &types.MsgReviewApplication{
+				ApplicationId:       args[0],
+				Issuer:              clientCtx.GetFromAddress().String(),
+				Approved:            approved,
+				Reason:              reason,
+				CredentialOverrides: overrides,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool("approve", false, "approve the application (default: deny)")
+	cmd.Flags().String("reason", "", "reason for approval or denial")
+	cmd.Flags().String("overrides", "", "JSON object of claims to override on approval")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGrantIssuance returns the tx command for an issuer to delegate scoped
+// MsgCreateCredential authority to another account via a CredentialIssuanceAuthorization
+// (see types/issuance_authz.go). The grantee later issues under the grant by
+// submitting a MsgExecIssuance (keeper/authz.go's ExecIssuance), for which this CLI has
+// no command; this command only creates the standing grant.
+func CmdGrantIssuance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-issuance [grantee]",
+		Short: "Delegate scoped credential-issuance authority to another account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credentialTypes, err := cmd.Flags().GetStringSlice("types")
+			if err != nil {
+				return err
+			}
+			schemas, err := cmd.Flags().GetStringSlice("schemas")
+			if err != nil {
+				return err
+			}
+			maxCount, err := cmd.Flags().GetUint64("max-count")
+			if err != nil {
+				return err
+			}
+			maxValiditySeconds, err := cmd.Flags().GetInt64("max-validity-seconds")
+			if err != nil {
+				return err
+			}
+			expirationSeconds, err := cmd.Flags().GetInt64("expiration")
+			if err != nil {
+				return err
+			}
+
+			auth := types.CredentialIssuanceAuthorization{
+				AllowedTypes:   credentialTypes,
+				AllowedSchemas: schemas,
+				MaxPerBlock:    maxCount,
+				MaxValidity:    time.Duration(maxValiditySeconds) * time.Second,
+			}
+			if expirationSeconds > 0 {
+				expiration := time.Unix(expirationSeconds, 0)
+				auth.Expiration = &expiration
+			}
+
+			msg := &types.MsgGrantIssuanceAuthority{
+				Granter: clientCtx.GetFromAddress().String(),
+				Grantee: args[0],
+				Auth:    auth,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice("types", nil, "credential types the grantee may issue (default: all)")
+	cmd.Flags().StringSlice("schemas", nil, "schema IDs the grantee may issue against (default: all)")
+	cmd.Flags().Uint64("max-count", 0, "maximum credentials the grantee may issue per block (default: unlimited)")
+	cmd.Flags().Int64("max-validity-seconds", 0, "maximum validity period, in seconds, for an issued credential (default: unlimited)")
+	cmd.Flags().Int64("expiration", 0, "unix timestamp when the grant itself expires (default: never)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
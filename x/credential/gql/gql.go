@@ -0,0 +1,274 @@
+// Package gql exposes x/credential/keeper.QueryServer as a typed GraphQL schema,
+// mountable behind the node's API under /graphql the way query_server.go's own doc
+// comment already anticipates a "GraphQL gateway in front of it".
+//
+// This tree has no cmd/ or app/ HTTP server setup that registers API routes or parses
+// CLI flags (app/ holds only wiring helpers and doc stubs, no server) -- so there is no
+// --gql-playground flag call site to wire NewPlaygroundHandler into yet, the same
+// module-wiring gap x/zkproof/router's depinject.go flags for its own ProviderSet. This
+// package is written against the schema/resolver shape a real API server would mount
+// once that wiring exists.
+package gql
+
+import (
+	"context"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	credentialkeeper "github.com/PersonaPass-ID/personachain/x/credential/keeper"
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// schema is the GraphQL SDL for the credential gateway. CredentialConnection/
+// CredentialEdge/PageInfo follow the Relay cursor connection spec, reusing
+// types.CursorPageRequest/CursorPageResponse (added for the gRPC side) as the
+// connection's cursor.
+const schema = `
+	schema {
+		query: Query
+	}
+
+	type Query {
+		credential(id: ID!): Credential
+		credentialsByIssuer(issuer: String!, first: Int, after: String): CredentialConnection!
+		credentialsByHolder(holder: String!, first: Int, after: String): CredentialConnection!
+		credentialsByAttributes(attributes: [AttributePredicateInput!]!, first: Int, after: String): CredentialConnection!
+	}
+
+	# AttributePredicateInput is one {key, value} equality clause; predicates passed to
+	# credentialsByAttributes are AND-intersected. Exactly one of the value fields should
+	# be set, mirroring types.AttributeValue's hand-rolled oneof.
+	input AttributePredicateInput {
+		key: String!
+		stringValue: String
+		intValue: Int
+		boolValue: Boolean
+	}
+
+	type Credential {
+		id: ID!
+		issuer: String!
+		holder: String!
+		credentialType: [String!]!
+		status: String!
+		active: Boolean!
+	}
+
+	type CredentialConnection {
+		edges: [CredentialEdge!]!
+		pageInfo: PageInfo!
+		totalCount: Int!
+	}
+
+	type CredentialEdge {
+		node: Credential!
+		cursor: String!
+	}
+
+	type PageInfo {
+		endCursor: String
+		hasNextPage: Boolean!
+	}
+`
+
+// Resolver backs the root Query type, translating GraphQL field arguments into
+// types.CursorPageRequest and delegating to the existing QueryServer rather than
+// re-implementing credential lookup/pagination against the keeper directly.
+type Resolver struct {
+	queryServer credentialkeeper.QueryServer
+}
+
+// NewResolver returns a Resolver backed by queryServer.
+func NewResolver(queryServer credentialkeeper.QueryServer) *Resolver {
+	return &Resolver{queryServer: queryServer}
+}
+
+// NewSchema parses schema and binds it to resolver, the constructor a real API server
+// would call once it has a mount point for it.
+func NewSchema(resolver *Resolver) (*graphql.Schema, error) {
+	return graphql.ParseSchema(schema, resolver)
+}
+
+// NewPlaygroundHandler returns the graph-gophers relay playground handler for schema,
+// intended to be mounted only when a future --gql-playground flag is set. See this
+// package's doc comment for why nothing calls this yet.
+func NewPlaygroundHandler(s *graphql.Schema) http.Handler {
+	return &relay.Handler{Schema: s}
+}
+
+// credentialArgs are the arguments to the credential(id:) root field.
+type credentialArgs struct {
+	ID graphql.ID
+}
+
+// connectionArgs are the Relay-style first/after arguments shared by
+// credentialsByIssuer and credentialsByHolder.
+type connectionArgs struct {
+	First *int32
+	After *string
+}
+
+func (a connectionArgs) toPage() types.CursorPageRequest {
+	page := types.CursorPageRequest{}
+	if a.First != nil {
+		page.Limit = uint64(*a.First)
+	}
+	if a.After != nil {
+		page.Cursor = *a.After
+	}
+	return page
+}
+
+// Credential resolves the credential(id:) root field by looking the ID up directly
+// against r.queryServer.Credentials (the QueryServer's embedded Keeper), the same way
+// x/zkproof/keeper/query_server.go's CircuitStats gets a single Circuit by ID rather
+// than paginating --
+// types.CursorPageRequest.Filters exists on the struct but, like in
+// x/zkproof/types/querygateway.go's Matches methods, nothing in this tree actually
+// evaluates it yet, so it isn't a usable id-equality filter to paginate through.
+func (r *Resolver) Credential(ctx context.Context, args credentialArgs) (*credentialResolver, error) {
+	vc, err := r.queryServer.Credentials.Get(ctx, string(args.ID))
+	if err != nil {
+		return nil, nil
+	}
+	return &credentialResolver{vc: vc}, nil
+}
+
+// CredentialsByIssuer resolves the credentialsByIssuer(issuer, first, after:) root
+// field via QueryServer.GetCredentialsByIssuer's CredentialsByIssuer secondary index.
+func (r *Resolver) CredentialsByIssuer(ctx context.Context, args struct {
+	Issuer string
+	First  *int32
+	After  *string
+}) (*credentialConnectionResolver, error) {
+	page := connectionArgs{First: args.First, After: args.After}.toPage()
+	items, resp, err := r.queryServer.GetCredentialsByIssuer(ctx, args.Issuer, page)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialConnectionResolver{items: items, resp: resp}, nil
+}
+
+// CredentialsByHolder resolves the credentialsByHolder(holder, first, after:) root
+// field via QueryServer.GetCredentialsByHolder -- a full filtered walk, since (per that
+// method's own doc comment) there is no CredentialsByHolder secondary index in this
+// tree yet.
+func (r *Resolver) CredentialsByHolder(ctx context.Context, args struct {
+	Holder string
+	First  *int32
+	After  *string
+}) (*credentialConnectionResolver, error) {
+	page := connectionArgs{First: args.First, After: args.After}.toPage()
+	items, resp, err := r.queryServer.GetCredentialsByHolder(ctx, args.Holder, page)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialConnectionResolver{items: items, resp: resp}, nil
+}
+
+// attributePredicateInput is the resolved GraphQL shape of AttributePredicateInput.
+type attributePredicateInput struct {
+	Key         string
+	StringValue *string
+	IntValue    *int32
+	BoolValue   *bool
+}
+
+// toPredicate converts a GraphQL AttributePredicateInput into a types.AttributePredicate.
+// Only the first non-nil of StringValue/IntValue/BoolValue is used, in that order, the
+// same "exactly one set" contract types.AttributeValue documents.
+func (a attributePredicateInput) toPredicate() types.AttributePredicate {
+	switch {
+	case a.StringValue != nil:
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{StringValue: a.StringValue}}
+	case a.IntValue != nil:
+		v := int64(*a.IntValue)
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{IntValue: &v}}
+	case a.BoolValue != nil:
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{BoolValue: a.BoolValue}}
+	default:
+		return types.AttributePredicate{Key: a.Key}
+	}
+}
+
+// CredentialsByAttributes resolves the credentialsByAttributes(attributes, first,
+// after:) root field via QueryServer.GetCredentialsByAttributes's AND-intersected
+// CredentialsByAttribute lookup. types.AttributeValue also supports BytesValue, which
+// has no natural GraphQL scalar and so isn't exposed on AttributePredicateInput.
+func (r *Resolver) CredentialsByAttributes(ctx context.Context, args struct {
+	Attributes []attributePredicateInput
+	First      *int32
+	After      *string
+}) (*credentialConnectionResolver, error) {
+	predicates := make([]types.AttributePredicate, len(args.Attributes))
+	for i, a := range args.Attributes {
+		predicates[i] = a.toPredicate()
+	}
+	page := connectionArgs{First: args.First, After: args.After}.toPage()
+	items, resp, err := r.queryServer.GetCredentialsByAttributes(ctx, predicates, page)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialConnectionResolver{items: items, resp: resp}, nil
+}
+
+// credentialResolver backs the Credential GraphQL type.
+type credentialResolver struct {
+	vc types.VerifiableCredential
+}
+
+func (c *credentialResolver) ID() graphql.ID          { return graphql.ID(c.vc.ID) }
+func (c *credentialResolver) Issuer() string          { return c.vc.Issuer }
+func (c *credentialResolver) Holder() string          { return c.vc.CredentialSubject.ID }
+func (c *credentialResolver) CredentialType() []string { return c.vc.Type }
+func (c *credentialResolver) Status() string          { return string(c.vc.Status) }
+func (c *credentialResolver) Active() bool            { return c.vc.Active }
+
+// credentialConnectionResolver backs the CredentialConnection GraphQL type, adapting
+// QueryServer's ([]VerifiableCredential, CursorPageResponse) pair into a Relay
+// connection.
+type credentialConnectionResolver struct {
+	items []types.VerifiableCredential
+	resp  types.CursorPageResponse
+}
+
+func (c *credentialConnectionResolver) Edges() []*credentialEdgeResolver {
+	edges := make([]*credentialEdgeResolver, len(c.items))
+	for i, vc := range c.items {
+		edges[i] = &credentialEdgeResolver{vc: vc, cursor: types.EncodeCursor([]byte(vc.ID))}
+	}
+	return edges
+}
+
+func (c *credentialConnectionResolver) PageInfo() *pageInfoResolver {
+	return &pageInfoResolver{resp: c.resp}
+}
+
+func (c *credentialConnectionResolver) TotalCount() int32 { return int32(len(c.items)) }
+
+// credentialEdgeResolver backs the CredentialEdge GraphQL type.
+type credentialEdgeResolver struct {
+	vc     types.VerifiableCredential
+	cursor string
+}
+
+func (e *credentialEdgeResolver) Node() *credentialResolver { return &credentialResolver{vc: e.vc} }
+func (e *credentialEdgeResolver) Cursor() string            { return e.cursor }
+
+// pageInfoResolver backs the PageInfo GraphQL type, reusing
+// types.CursorPageResponse.NextCursor as endCursor.
+type pageInfoResolver struct {
+	resp types.CursorPageResponse
+}
+
+func (p *pageInfoResolver) EndCursor() *string {
+	if p.resp.NextCursor == "" {
+		return nil
+	}
+	cursor := p.resp.NextCursor
+	return &cursor
+}
+
+func (p *pageInfoResolver) HasNextPage() bool { return p.resp.NextCursor != "" }
@@ -0,0 +1,277 @@
+// Package ibc implements the credential-transfer IBC application: a verifier contract
+// on any IBC-connected chain can be presented a VerifiableCredential issued on
+// personachain without that chain re-publishing the credential locally, mirroring
+// ICS-20's lock-on-source/mint-on-destination shape for credentials instead of coins.
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/keeper"
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// IBCModule implements porttypes.IBCModule for the credential-transfer application.
+// This chain only ever plays the destination role today (it has no
+// MsgTransferCredential of its own to send a CredentialPacketData with, unlike
+// x/did's MsgQueryRemoteDID/MsgTransferDIDController, which this chunk's sibling
+// request added): OnRecvPacket verifies the issuer's DID through keeper's
+// ValidateIssuerDID, which accepts only an active DID Document stored on this chain (a
+// did:web or did:ethr issuer is not resolved through x/did's method resolvers), and
+// persists an ImportedCredential record; OnAcknowledgementPacket/OnTimeoutPacket
+// are therefore no-ops, the same role they play in x/zkproof/ibc's host-only
+// IBCModule.
+//
+// keeper.Keeper carries everything this needs (see keeper/keeper.go): ImportedCredentials
+// collections.Map[string, types.ImportedCredential] keyed by CredentialHash, written here
+// and read back by a local verifier query; didKeeper, whose ValidateDID backs
+// keeper/ibc.go's ValidateIssuerDID; and channelKeeper/scopedKeeper, the same IBC plumbing
+// x/did/keeper/ibc_module.go needs.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule returns an IBCModule bound to k.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// OnChanOpenInit implements porttypes.IBCModule. The credential-transfer port only
+// accepts UNORDERED channels -- unlike the did-resolver and zkproof-verify ports, a
+// credential import doesn't need to stay in sequence with any other packet on the same
+// channel, the same ordering ICS-20's transfer port uses -- on the negotiated
+// types.Version.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("credential-transfer channels must be UNORDERED")
+	}
+	if version != "" && version != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements porttypes.IBCModule, mirroring OnChanOpenInit's checks for
+// the side that did not initiate the handshake.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("credential-transfer channels must be UNORDERED")
+	}
+	if counterpartyVersion != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != types.Version {
+		return types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// credentialPacketEnvelope is unmarshaled first to tell a CredentialPacketData from a
+// CredentialVerificationPacketData: both ride the same credential-transfer
+// port/channel, and only the verification packet ever sets credential_id -- the
+// import packet's corresponding field is credential_hash, matching
+// x/did/keeper/ibc_module.go's didResolverPacketEnvelope dispatch pattern.
+type credentialPacketEnvelope struct {
+	CredentialID string `json:"credential_id"`
+}
+
+// OnRecvPacket implements porttypes.IBCModule, dispatching on whether packet.GetData()
+// decodes as a CredentialPacketData (handled by onRecvCredentialTransfer) or a
+// CredentialVerificationPacketData (handled by onRecvCredentialVerification) -- the two
+// packet types the credential-transfer port now carries, the second added so a
+// counterparty chain can treat this chain as an identity-verification oracle without
+// ever importing the underlying credential.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var envelope credentialPacketEnvelope
+	if err := json.Unmarshal(packet.GetData(), &envelope); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling credential-transfer packet data: %w", err))
+	}
+	if envelope.CredentialID != "" {
+		return im.onRecvCredentialVerification(ctx, packet)
+	}
+	return im.onRecvCredentialTransfer(ctx, packet)
+}
+
+// onRecvCredentialTransfer implements the original credential-import side of
+// OnRecvPacket: it checks data.Issuer through im.keeper.ValidateIssuerDID (an active DID
+// Document stored on this chain; DIDs of other methods are not resolved), and on success
+// persists an ImportedCredential keyed by CredentialHash for local verifiers to query. A
+// malformed packet or an issuer DID that fails to resolve both return
+// channeltypes.NewErrorAcknowledgement rather than a successful ack carrying
+// Imported=false, since (unlike x/did's controller handoff) there is no
+// meaningful partial-failure state to roll back here if the import never happened.
+func (im IBCModule) onRecvCredentialTransfer(ctx sdk.Context, packet channeltypes.Packet) ibcexported.Acknowledgement {
+	var data types.CredentialPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling credential-transfer packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.keeper.ValidateIssuerDID(ctx, data.Issuer); err != nil {
+		return channeltypes.NewErrorAcknowledgement(types.ErrInvalidIssuer.Wrapf("issuer %s did not resolve: %s", data.Issuer, err))
+	}
+
+	record := types.ImportedCredential{
+		Issuer:         data.Issuer,
+		Subject:        data.Subject,
+		CredentialHash: data.CredentialHash,
+		SourceChannel:  packet.DestinationChannel,
+		Status:         types.ImportedCredentialStatusLive,
+		ImportedAt:     ctx.BlockHeight(),
+	}
+	if err := im.keeper.ImportedCredentials.Set(ctx, data.CredentialHash, record); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("persisting imported credential: %w", err))
+	}
+
+	ackData := types.CredentialAcknowledgement{
+		Imported: true,
+		Height:   ctx.BlockHeight(),
+	}
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling credential-transfer acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// onRecvCredentialVerification implements this chain's identity-oracle role: it looks
+// up data.CredentialID, runs it through the same im.keeper.VerifyCredential proof and
+// revocation check MsgServer.VerifyCredential uses locally (verify.go), and if it
+// verifies, discloses each of data.RequestedClaims present on the credential.
+// data.HolderProof is only checked for non-emptiness -- see
+// CredentialVerificationPacketData's doc comment for why this tree has no
+// challenge-bound holder-possession proof to verify for real yet. Unlike
+// onRecvCredentialTransfer, a credential that fails to verify still returns a
+// successful acknowledgement (Verified=false) rather than an error one: "not verified"
+// is itself the answer the requesting chain asked for, not a packet-relay failure.
+func (im IBCModule) onRecvCredentialVerification(ctx sdk.Context, packet channeltypes.Packet) ibcexported.Acknowledgement {
+	var data types.CredentialVerificationPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling credential-verification packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	verified, revoked, err := im.keeper.VerifyCredential(ctx, data.CredentialID, packet.SourceChannel)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(types.ErrCredentialNotFound.Wrapf("credential %s: %s", data.CredentialID, err))
+	}
+
+	revocationStatus := "live"
+	if revoked {
+		revocationStatus = "revoked"
+	}
+
+	ackData := types.CredentialVerificationAcknowledgement{
+		Verified:         verified,
+		RevocationStatus: revocationStatus,
+		Height:           ctx.BlockHeight(),
+	}
+	if verified && len(data.RequestedClaims) > 0 {
+		vc, err := im.keeper.Credentials.Get(ctx, data.CredentialID)
+		if err == nil {
+			ackData.DisclosedClaims = make(map[string]json.RawMessage, len(data.RequestedClaims))
+			for _, claimKey := range data.RequestedClaims {
+				value, ok := vc.CredentialSubject.Claims[claimKey]
+				if !ok {
+					continue
+				}
+				valueBytes, err := json.Marshal(value)
+				if err != nil {
+					continue
+				}
+				ackData.DisclosedClaims[claimKey] = valueBytes
+			}
+		}
+	}
+
+	types.EmitIBCVerificationAcknowledgedEvent(ctx, data.CredentialID, verified)
+
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling credential-verification acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// OnAcknowledgementPacket implements porttypes.IBCModule. A credential-transfer packet
+// this chain never sends, so there is nothing to reconcile for that case; a
+// credential-verification packet's ack is the actual answer to a local
+// MsgRequestVerification and is simply left for RequestVerification's caller to read
+// back off the relayed ack (see keeper/ibc_verification.go's doc comment) -- there is
+// no cached-result store to update here, unlike x/did's RemoteDIDKey cache.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return nil
+}
+
+// OnTimeoutPacket implements porttypes.IBCModule. A timed-out transfer is simply
+// dropped.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return nil
+}
+
+// RollbackImportedCredential marks a previously imported credential Revoked, called
+// from a later OnTimeoutPacket/OnAcknowledgementPacket equivalent once this chain
+// gains its own MsgTransferCredential and can receive a rollback signal from the
+// source chain (e.g. the source credential itself being revoked after transfer). Not
+// yet wired into OnAcknowledgementPacket/OnTimeoutPacket above since this chain does
+// not originate transfers -- see this file's IBCModule doc comment.
+func RollbackImportedCredential(ctx sdk.Context, k keeper.Keeper, credentialHash string) error {
+	record, err := k.ImportedCredentials.Get(ctx, credentialHash)
+	if err != nil {
+		return types.ErrImportedCredentialNotFound.Wrap(credentialHash)
+	}
+	record.Status = types.ImportedCredentialStatusRevoked
+	return k.ImportedCredentials.Set(ctx, credentialHash, record)
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// SubmitCredentialApplication implements types.MsgServer's SubmitCredentialApplication, the entry
+// point to the application -> review -> issue pipeline. Unlike CreateCredential (which
+// mints a VC directly), the holder here only records an application against an
+// issuer-published CredentialSchema -- issuance happens later, in ReviewApplication,
+// once the issuer approves it.
+//
+// ms.Applications, ms.ApplicationsByIssuer, and ms.Operations, like ms.Credentials
+// elsewhere in this package, are real Keeper fields (see keeper.go).
+func (ms MsgServer) SubmitCredentialApplication(ctx context.Context, msg *types.MsgSubmitCredentialApplication) (*types.MsgSubmitCredentialApplicationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := ms.didKeeper.ValidateDID(ctx, msg.Issuer); err != nil {
+		return nil, types.ErrInvalidIssuer.Wrapf("issuer %s is not an active DID controller: %s", msg.Issuer, err)
+	}
+
+	if ok, err := ms.Applications.Has(ctx, msg.Id); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, types.ErrCredentialAlreadyExists.Wrapf("application %s already exists", msg.Id)
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdkCtx.BlockTime()
+	app := types.CredentialApplication{
+		Id:       msg.Id,
+		Holder:   msg.Holder,
+		Issuer:   msg.Issuer,
+		SchemaId: msg.SchemaId,
+		Claims:   msg.Claims,
+		Status:   types.ApplicationStatusPending,
+		Created:  now,
+		Updated:  now,
+	}
+	if params.ApplicationReviewPeriod > 0 {
+		deadline := now.Add(params.ApplicationReviewPeriod)
+		app.Deadline = &deadline
+	}
+	if err := app.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Applications.Set(ctx, app.Id, app); err != nil {
+		return nil, err
+	}
+	if err := ms.ApplicationsByIssuer.Set(ctx, collections.Join(msg.Issuer, app.Id), app.Id); err != nil {
+		return nil, err
+	}
+	if err := ms.Operations.Set(ctx, app.Id, types.CredentialOperation{
+		ApplicationId: app.Id,
+		Status:        types.ApplicationStatusPending,
+		Created:       now,
+		Updated:       now,
+	}); err != nil {
+		return nil, err
+	}
+
+	types.EmitApplicationSubmittedEvent(sdkCtx, app.Id, app.Holder, app.Issuer, app.SchemaId)
+
+	return &types.MsgSubmitCredentialApplicationResponse{ApplicationId: app.Id}, nil
+}
+
+// ReviewApplication implements types.MsgServer's ReviewApplication. Only the
+// application's named Issuer may review it, and only once: a pending application moves
+// to either ApplicationStatusDenied (terminal) or directly to ApplicationStatusFulfilled
+// (ApplicationStatusApproved is never stored), since this tree has no separate async
+// "approved, not yet issued" step to model -- approval and issuance happen in the same
+// handler the same way CreateCredential issues synchronously elsewhere in this package.
+//
+// The issued VerifiableCredential's Type is resolved from the application's SchemaId via
+// ms.schemaKeeper.GetSchemaType, the closest analog this tree has to a Credential
+// Manifest's output descriptor (see types/application.go's CredentialApplication doc
+// comment). CredentialOverrides are merged over the application's own Claims, with
+// overrides taking precedence, before the VC is validated against the schema and
+// persisted exactly like CreateCredential's issuance path (status-list stamping, all
+// secondary indexes, CredentialTypeUsage).
+func (ms MsgServer) ReviewApplication(ctx context.Context, msg *types.MsgReviewApplication) (*types.MsgReviewApplicationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	app, err := ms.Applications.Get(ctx, msg.ApplicationId)
+	if err != nil {
+		return nil, types.ErrApplicationNotFound.Wrapf("application %s not found", msg.ApplicationId)
+	}
+	if app.Issuer != msg.Issuer {
+		return nil, types.ErrUnauthorized.Wrap("only the application's named issuer may review it")
+	}
+	if app.Status != types.ApplicationStatusPending {
+		return nil, types.ErrApplicationAlreadyReviewed.Wrapf("application %s is already %s", app.Id, app.Status)
+	}
+	if app.Deadline != nil && sdkCtx.BlockTime().After(*app.Deadline) {
+		return nil, types.ErrApplicationExpired.Wrapf("application %s's review deadline has passed", app.Id)
+	}
+
+	now := sdkCtx.BlockTime()
+
+	if !msg.Approved {
+		app.Status = types.ApplicationStatusDenied
+		app.Reason = msg.Reason
+		app.Updated = now
+		if err := ms.Applications.Set(ctx, app.Id, app); err != nil {
+			return nil, err
+		}
+		if err := ms.setOperationStatus(ctx, app.Id, types.ApplicationStatusDenied, "", now); err != nil {
+			return nil, err
+		}
+		types.EmitApplicationReviewedEvent(sdkCtx, app.Id, app.Issuer, msg.Reason, false, "")
+		return &types.MsgReviewApplicationResponse{Status: types.ApplicationStatusDenied}, nil
+	}
+
+	credentialType, err := ms.schemaKeeper.GetSchemaType(sdkCtx, app.SchemaId)
+	if err != nil {
+		return nil, types.ErrInvalidSchema.Wrapf("resolving schema %s: %s", app.SchemaId, err)
+	}
+
+	claims := make(map[string]interface{}, len(app.Claims)+len(msg.CredentialOverrides))
+	for k, v := range app.Claims {
+		claims[k] = v
+	}
+	for k, v := range msg.CredentialOverrides {
+		claims[k] = v
+	}
+
+	vc := types.VerifiableCredential{
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		ID:      types.CreateCredentialHash(app.Issuer, app.Holder, uint64(now.UnixNano())),
+		Type:    []string{credentialType},
+		Issuer:  app.Issuer,
+		CredentialSubject: types.CredentialSubject{
+			ID:     app.Holder,
+			Claims: claims,
+		},
+		IssuanceDate: now,
+		BlockHeight:  sdkCtx.BlockHeight(),
+		Created:      now,
+		Updated:      now,
+		Active:       true,
+		Status:       types.CredentialStatusLive,
+	}
+
+	if err := vc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := vc.ValidateAgainstSchema(sdkCtx, ms.schemaKeeper, app.SchemaId); err != nil {
+		return nil, err
+	}
+	if _, ok, err := ms.ValidateCredentialType(ctx, credentialType); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, types.ErrInvalidCredentialType.Wrapf("schema %s's declared type %q is not a registered credential type", app.SchemaId, credentialType)
+	}
+	if err := ms.RecordCredentialTypeUsage(ctx, credentialType); err != nil {
+		return nil, err
+	}
+
+	statusListID, index, err := ms.revocationKeeper.AllocateStatusListIndex(sdkCtx, app.Issuer, types.StatusPurposeRevocation)
+	if err != nil {
+		return nil, err
+	}
+	ms.revocationKeeper.SetCredentialStatusIndex(sdkCtx, vc.ID, statusListID, index)
+	vc.CredentialStatus = types.NewStatusListEntry(statusListID, index, types.StatusPurposeRevocation)
+
+	if err := ms.Credentials.Set(ctx, vc.ID, vc); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByIssuer.Set(ctx, collections.Join(vc.Issuer, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByStatusListEntry.Set(ctx, collections.Join(statusListID, index), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByHolder.Set(ctx, collections.Join(vc.CredentialSubject.ID, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByType.Set(ctx, collections.Join(credentialType, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsBySchema.Set(ctx, collections.Join(app.SchemaId, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.setCredentialStatusIndex(ctx, vc.ID, "", vc.Status); err != nil {
+		return nil, err
+	}
+
+	app.Status = types.ApplicationStatusFulfilled
+	app.Updated = now
+	if err := ms.Applications.Set(ctx, app.Id, app); err != nil {
+		return nil, err
+	}
+	if err := ms.setOperationStatus(ctx, app.Id, types.ApplicationStatusFulfilled, vc.ID, now); err != nil {
+		return nil, err
+	}
+
+	types.EmitApplicationReviewedEvent(sdkCtx, app.Id, app.Issuer, msg.Reason, true, vc.ID)
+	types.EmitCredentialIssuedEvent(sdkCtx, &vc, statusListID, index)
+
+	return &types.MsgReviewApplicationResponse{Status: types.ApplicationStatusFulfilled, CredentialId: vc.ID}, nil
+}
+
+// setOperationStatus updates applicationID's CredentialOperation in place, the shared
+// tail of ReviewApplication's denial/fulfillment branches and EndBlocker's
+// expireOverdueApplications. Takes a Keeper receiver, like setCredentialStatusIndex
+// (credential_types.go), so both MsgServer (embedding Keeper) and EndBlocker can call it.
+func (k Keeper) setOperationStatus(ctx context.Context, applicationID string, status types.ApplicationStatus, credentialID string, now time.Time) error {
+	op, err := k.Operations.Get(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+	op.Status = status
+	op.CredentialId = credentialID
+	op.Updated = now
+	return k.Operations.Set(ctx, applicationID, op)
+}
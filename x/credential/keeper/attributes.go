@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// indexCredentialAttributes mirrors vc.CredentialSubject.Claims into
+// CredentialsByAttribute for every key in allowedKeys (Params.IndexableAttributeKeys),
+// letting GetCredentialsByAttributes resolve an equality predicate on that key without
+// walking every credential. Claims whose value isn't a scalar encoding/json itself would
+// produce (string/bool/float64) are skipped -- see types.AttributeValueFromClaim.
+//
+// CredentialsByAttribute is a collections.Map[collections.Triple[string,string,string],
+// string] (attrKey, attrValue, credentialID) -> credentialID, a real Keeper field (see
+// keeper.go) alongside CredentialsByIssuer/CredentialsByStatusListEntry.
+func (ms MsgServer) indexCredentialAttributes(ctx context.Context, vc types.VerifiableCredential, allowedKeys []string) error {
+	for _, key := range allowedKeys {
+		claim, ok := vc.CredentialSubject.Claims[key]
+		if !ok {
+			continue
+		}
+		value, ok := types.AttributeValueFromClaim(claim)
+		if !ok {
+			continue
+		}
+		if err := ms.CredentialsByAttribute.Set(ctx, collections.Join3(key, value.IndexKey(), vc.ID), vc.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributeMatchIDs returns, in sorted order, every credential ID
+// CredentialsByAttribute has indexed under pred's (key, value) pair. Walking a
+// collections.Map yields keys in sorted byte order, and within a fixed (key, value)
+// prefix a Triple's remaining component is the credential ID, so this comes back
+// pre-sorted without an explicit sort.
+func (k Keeper) attributeMatchIDs(ctx context.Context, pred types.AttributePredicate) ([]string, error) {
+	rng := collections.NewPrefixedTripleRange[string, string, string](pred.Key, pred.Value.IndexKey())
+
+	var ids []string
+	err := k.CredentialsByAttribute.Walk(ctx, rng, func(_ collections.Triple[string, string, string], credentialID string) (bool, error) {
+		ids = append(ids, credentialID)
+		return false, nil
+	})
+	return ids, err
+}
+
+// intersectSorted returns the sorted intersection of two sorted string slices, via a
+// linear sort-merge (no duplicates assumed in either input, since CredentialsByAttribute
+// keys each (key, value, credentialID) triple only once per credential).
+func intersectSorted(a, b []string) []string {
+	out := make([]string, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, a[i])
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return out
+}
+
+// GetCredentialsByAttributes AND-intersects predicates against CredentialsByAttribute:
+// each predicate's (key, value) prefix is walked in full via attributeMatchIDs to get
+// its sorted set of matching credential IDs, then a sort-merge across predicates
+// (intersectSorted) keeps only IDs present in every set.
+//
+// Unlike paginate/paginateByIssuer, there's no per-predicate store cursor to resume
+// from: resuming a single predicate's range wouldn't resume the intersection, since a
+// later predicate can exclude IDs an earlier page already returned. So this computes the
+// full intersection up front (already sorted, already in memory) and paginates over that
+// slice directly -- page.Cursor is the last credential ID returned by the previous page.
+//
+// CredentialsByAttribute only indexes CreateCredential-time claims (see
+// indexCredentialAttributes); a predicate on a key never in
+// Params.IndexableAttributeKeys at issuance time will simply never match, since the key
+// was never written to the index.
+func (k Keeper) GetCredentialsByAttributes(ctx context.Context, predicates []types.AttributePredicate, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	if len(predicates) == 0 {
+		return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrap("at least one attribute predicate is required")
+	}
+
+	ids, err := k.attributeMatchIDs(ctx, predicates[0])
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+	for _, pred := range predicates[1:] {
+		matchIDs, err := k.attributeMatchIDs(ctx, pred)
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		ids = intersectSorted(ids, matchIDs)
+	}
+
+	startAt := 0
+	if page.Cursor != "" {
+		cursorKey, err := types.DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrapf("invalid pagination cursor: %s", err)
+		}
+		startAt = sort.SearchStrings(ids, string(cursorKey))
+		if startAt < len(ids) && ids[startAt] == string(cursorKey) {
+			startAt++
+		}
+	}
+
+	limit := page.EffectiveLimit()
+	items := make([]types.VerifiableCredential, 0, limit)
+	var nextCursor string
+	for i := startAt; i < len(ids); i++ {
+		if uint64(len(items)) >= limit {
+			nextCursor = types.EncodeCursor([]byte(ids[i-1]))
+			break
+		}
+		vc, err := k.Credentials.Get(ctx, ids[i])
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		items = append(items, vc)
+	}
+
+	return items, types.CursorPageResponse{NextCursor: nextCursor, Total: uint64(len(ids))}, nil
+}
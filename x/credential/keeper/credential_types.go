@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// This file assumes, alongside the Keeper fields migrations.go's header already lists, a
+// CredentialTypeUsage collections.Map[string, uint64] (types.CredentialTypeUsagePrefix)
+// counting per-CredentialTypeDef.Name issuance, and the authority string / GetAuthority()
+// / SetParams(ctx, types.Params) error convention x/schema, x/e2ee, and x/oracle's Keeper
+// structs already carry (see x/schema/keeper/keeper.go) -- here gating
+// MsgServer.UpdateParams (msg_server_params.go) the same way it gates those modules'
+// equivalent handlers.
+
+// ValidateCredentialType reports whether credType is a currently-registered, non-deprecated
+// CredentialTypeDef in the context's Params.CredentialTypes, replacing the hardcoded
+// eight-string map literal types.ValidateCredentialType used to be (see types/keys.go).
+// Reading the allowlist from params rather than a compiled-in map is what lets
+// MsgUpdateParams -- and the register-type/deprecate-type CLI commands built on it, see
+// client/cli/tx.go -- extend or retire accepted types without a binary upgrade.
+func (k Keeper) ValidateCredentialType(ctx context.Context, credType string) (types.CredentialTypeDef, bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.CredentialTypeDef{}, false, err
+	}
+	def, ok := types.FindCredentialTypeDef(params.CredentialTypes, credType)
+	return def, ok, nil
+}
+
+// RecordCredentialTypeUsage increments CredentialTypeUsage[credType], the per-type adoption
+// counter the list-types/show-type CLI commands (client/cli/query.go) read so an operator
+// can see how much a type is actually used before deprecating it.
+func (k Keeper) RecordCredentialTypeUsage(ctx context.Context, credType string) error {
+	count, err := k.CredentialTypeUsage.Get(ctx, credType)
+	if err != nil {
+		if !isCredentialTypeUsageNotFound(err) {
+			return err
+		}
+		count = 0
+	}
+	return k.CredentialTypeUsage.Set(ctx, credType, count+1)
+}
+
+// GetCredentialTypeUsage returns how many credentials have been recorded against credType,
+// or zero if it has never been used.
+func (k Keeper) GetCredentialTypeUsage(ctx context.Context, credType string) (uint64, error) {
+	count, err := k.CredentialTypeUsage.Get(ctx, credType)
+	if err != nil {
+		if isCredentialTypeUsageNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return count, nil
+}
+
+func isCredentialTypeUsageNotFound(err error) bool {
+	return errors.Is(err, collections.ErrNotFound)
+}
+
+// setCredentialStatusIndex moves credentialID from oldStatus to newStatus within
+// CredentialsByStatus, the secondary index CreateCredential/RevokeCredential/
+// UpdateCredentialStatus/EndBlocker's processExpiredCredentials all maintain so a
+// caller can range-scan "every Live credential" or "every Suspended credential"
+// instead of walking k.Credentials in full. oldStatus is the empty
+// CredentialLifecycleStatus ("") on first issuance, when there is no prior entry to
+// remove.
+func (k Keeper) setCredentialStatusIndex(ctx context.Context, credentialID string, oldStatus, newStatus types.CredentialLifecycleStatus) error {
+	if oldStatus != "" && oldStatus != newStatus {
+		if err := k.CredentialsByStatus.Remove(ctx, collections.Join(string(oldStatus), credentialID)); err != nil {
+			return err
+		}
+	}
+	return k.CredentialsByStatus.Set(ctx, collections.Join(string(newStatus), credentialID), credentialID)
+}
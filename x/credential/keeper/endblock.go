@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// EndBlocker is called at the end of each block to perform module-specific operations.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.processExpiredCredentials(sdkCtx); err != nil {
+		return err
+	}
+	return k.expireOverdueApplications(sdkCtx)
+}
+
+// processExpiredCredentials flips a credential's lifecycle status to
+// CredentialStatusExpired once its ExpirationDate has passed, mirroring
+// x/zkproof/keeper's EndBlocker-driven processExpiredProofs.
+//
+// TODO(expiry-queue): this still does a full k.Credentials.Walk every block.
+// types.ExpiryQueueKey defines a time-ordered key space (LegacyExpiryQueuePrefix) meant
+// to replace this with a bounded range iteration via ExpiryQueuePrefixUntil(blockTime)
+// once CreateCredential also maintains that secondary index.
+func (k Keeper) processExpiredCredentials(ctx sdk.Context) error {
+	blockTime := ctx.BlockTime()
+
+	return k.Credentials.Walk(ctx, nil, func(id string, vc types.VerifiableCredential) (bool, error) {
+		if vc.Status == types.CredentialStatusExpired || vc.Status == types.CredentialStatusRevoked {
+			return false, nil
+		}
+		if vc.ExpirationDate == nil || !blockTime.After(*vc.ExpirationDate) {
+			return false, nil
+		}
+
+		oldStatus := vc.Status
+		vc.Status = types.CredentialStatusExpired
+		vc.Active = false
+		vc.Updated = blockTime
+
+		if err := k.Credentials.Set(ctx, id, vc); err != nil {
+			return true, err
+		}
+		if err := k.setCredentialStatusIndex(ctx, vc.ID, oldStatus, vc.Status); err != nil {
+			return true, err
+		}
+
+		types.EmitCredentialExpiredEvent(ctx, vc.Issuer, vc.ID)
+
+		return false, nil
+	})
+}
+
+// expireOverdueApplications auto-denies any CredentialApplication still
+// ApplicationStatusPending past its Deadline, the asynchronous review-deadline
+// enforcement this chunk's request asks for. Mirrors processExpiredCredentials' full
+// Walk above rather than a bounded range iteration over Deadline -- there is no
+// deadline-ordered secondary index here either, the same TODO(expiry-queue) gap that
+// function documents.
+func (k Keeper) expireOverdueApplications(ctx sdk.Context) error {
+	blockTime := ctx.BlockTime()
+
+	return k.Applications.Walk(ctx, nil, func(id string, app types.CredentialApplication) (bool, error) {
+		if app.Status != types.ApplicationStatusPending {
+			return false, nil
+		}
+		if app.Deadline == nil || !blockTime.After(*app.Deadline) {
+			return false, nil
+		}
+
+		app.Status = types.ApplicationStatusDenied
+		app.Reason = "review deadline exceeded"
+		app.Updated = blockTime
+
+		if err := k.Applications.Set(ctx, id, app); err != nil {
+			return true, err
+		}
+		if err := k.setOperationStatus(ctx, id, types.ApplicationStatusDenied, "", blockTime); err != nil {
+			return true, err
+		}
+
+		types.EmitApplicationExpiredEvent(ctx, app.Id, app.Issuer)
+
+		return false, nil
+	})
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// GenesisSnapshot is the genesis-time fixture k.GenesisSnapshot (a real Keeper field,
+// see keeper.go) holds. It lets queryAtHeight answer a height-0 query against the
+// credentials that existed at InitGenesis, without replaying every block since --
+// mirroring x/zkproof/keeper/genesis.go's GenesisSnapshot for the same request.
+type GenesisSnapshot struct {
+	Credentials []types.VerifiableCredential
+}
+
+// SetGenesisSnapshot captures credentials as the genesis snapshot queryAtHeight serves
+// for a height-0 query. This is the primitive a real InitGenesis would call once one
+// exists -- x/credential has no module.go/AppModule/GenesisState in this tree, so
+// nothing calls this yet.
+func (k *Keeper) SetGenesisSnapshot(snapshot *GenesisSnapshot) {
+	k.GenesisSnapshot = snapshot
+}
+
+// queryAtHeight runs liveFn against ctx, unless the incoming gRPC metadata's
+// x-cosmos-block-height header is literally "0" and a GenesisSnapshot has been
+// captured, in which case it runs genesisFn against that snapshot instead. See
+// x/zkproof/keeper/genesis.go's queryAtHeight for why only height 0 is special-cased
+// here, diverging from baseapp.CreateQueryContext's own "0 means latest" convention.
+func queryAtHeight[T any](ctx context.Context, snapshot *GenesisSnapshot, liveFn func(context.Context) (T, error), genesisFn func(*GenesisSnapshot) (T, error)) (T, error) {
+	if snapshot != nil && isGenesisHeightQuery(ctx) {
+		return genesisFn(snapshot)
+	}
+	return liveFn(ctx)
+}
+
+// isGenesisHeightQuery reports whether ctx carries gRPC metadata requesting height 0,
+// per grpctypes.GRPCBlockHeightHeader ("x-cosmos-block-height").
+func isGenesisHeightQuery(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(grpctypes.GRPCBlockHeightHeader)
+	return len(values) == 1 && values[0] == "0"
+}
+
+// filterSlice applies page's Limit/Matches over an in-memory genesis snapshot slice,
+// the GenesisSnapshot counterpart to pagination.go's paginate -- no cursor resumption,
+// since a snapshot slice has no store keys to resume a Range walk from.
+func filterSlice[V any](all []V, page types.CursorPageRequest, matches func(V) bool) ([]V, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+	out := make([]V, 0, limit)
+	for _, v := range all {
+		if !matches(v) {
+			continue
+		}
+		if uint64(len(out)) >= limit {
+			break
+		}
+		out = append(out, v)
+	}
+	return out, types.CursorPageResponse{Total: uint64(len(out))}, nil
+}
@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	"context"
+)
+
+// ValidateIssuerDID forwards to k.didKeeper.ValidateDID, exported so
+// x/credential/ibc's IBCModule (a separate package, to keep the credential-transfer
+// IBC plumbing out of this package the same way x/did keeps ibc_module.go inside
+// x/did/keeper instead) can check a cross-chain CredentialPacketData's issuer without
+// reaching into k.didKeeper directly.
+func (k Keeper) ValidateIssuerDID(ctx context.Context, issuer string) error {
+	return k.didKeeper.ValidateDID(ctx, issuer)
+}
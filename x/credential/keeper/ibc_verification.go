@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// defaultVerificationTimeout mirrors x/did/keeper/msg_server_remote_did.go's
+// defaultRemoteDIDTimeout: how far in the future a MsgRequestVerification packet's
+// timeout is set when the message doesn't specify one.
+const defaultVerificationTimeout = 10 * 60 * 1e9 // 10 minutes, in nanoseconds
+
+// RequestVerification implements types.MsgServer's RequestVerification: it builds a
+// types.CredentialVerificationPacketData for msg.CredentialID and sends it over
+// msg.ChannelId via ms.channelKeeper.SendPacket, the controller-side counterpart to
+// ibc/ibc_module.go's onRecvCredentialVerification on the host side. The verification
+// outcome is not returned synchronously -- it arrives later as the relayed ack on
+// msg.ChannelId, which OnAcknowledgementPacket currently leaves for the caller to read
+// directly off the relayer rather than caching (see that method's doc comment).
+//
+// msg.ChannelId must already be open: unlike a real ICS-27 interchain-accounts
+// controller, this does not open a channel lazily on first use -- see
+// MsgRequestVerification's doc comment for why. ms.channelKeeper/ms.scopedKeeper are
+// the Keeper fields NewKeeper (keeper.go) is given.
+func (ms MsgServer) RequestVerification(ctx context.Context, msg *types.MsgRequestVerification) (*types.MsgRequestVerificationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	channelCap, ok := ms.scopedKeeper.GetCapability(sdkCtx, hostPortChannelCapabilityName(types.PortID, msg.ChannelId))
+	if !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("no channel capability for channel %s", msg.ChannelId)
+	}
+
+	if _, ok := ms.channelKeeper.GetChannel(sdkCtx, types.PortID, msg.ChannelId); !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("channel %s not found", msg.ChannelId)
+	}
+
+	packetData := types.CredentialVerificationPacketData{
+		CredentialID:    msg.CredentialID,
+		HolderProof:     msg.HolderProof,
+		RequestedClaims: msg.RequestedClaims,
+	}
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(sdkCtx.BlockTime().UnixNano() + defaultVerificationTimeout)
+	}
+
+	sequence, err := ms.channelKeeper.SendPacket(
+		sdkCtx,
+		channelCap,
+		types.PortID,
+		msg.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		packetBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	types.EmitIBCVerificationRequestedEvent(sdkCtx, msg.Requester, msg.ChannelId, msg.CredentialID)
+
+	return &types.MsgRequestVerificationResponse{Sequence: sequence}, nil
+}
+
+// hostPortChannelCapabilityName mirrors host.ChannelCapabilityPath's
+// "{portID}/{channelID}" naming, the same inline helper
+// x/did/keeper/msg_server_remote_did.go defines for the same reason: avoiding an
+// import of the ibc-go host package solely for this one helper.
+func hostPortChannelCapabilityName(portID, channelID string) string {
+	return portID + "/" + channelID
+}
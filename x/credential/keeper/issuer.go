@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// This file assumes, alongside the Keeper fields credential_types.go's header already
+// lists, an IssuerRegistrations collections.Map[string, types.IssuerRegistration]
+// (types.IssuerRegistrationPrefix) and an IssuerRateLimitStates
+// collections.Map[string, types.IssuerRateLimitState] (types.IssuerRateLimitStatePrefix),
+// both keyed by ControllerDID.
+
+// RegisterIssuer creates or replaces the IssuerRegistration governing controllerDID.
+// Governance-only (see msg_server_issuer.go); CreateCredential (msg_server_lifecycle.go)
+// is the only reader.
+func (k Keeper) RegisterIssuer(ctx context.Context, reg types.IssuerRegistration) error {
+	if err := reg.Validate(); err != nil {
+		return err
+	}
+	return k.IssuerRegistrations.Set(ctx, reg.ControllerDID, reg)
+}
+
+// GetIssuerRegistration returns controllerDID's IssuerRegistration, or
+// types.ErrIssuerNotRegistered if governance has never registered one. An unregistered
+// issuer is not an error for CreateCredential -- see checkIssuerRegistration -- only
+// for callers that need the registration itself, like the pause/rate-limit handlers.
+func (k Keeper) GetIssuerRegistration(ctx context.Context, controllerDID string) (types.IssuerRegistration, error) {
+	reg, err := k.IssuerRegistrations.Get(ctx, controllerDID)
+	if err != nil {
+		return types.IssuerRegistration{}, types.ErrIssuerNotRegistered.Wrapf("issuer %s is not registered", controllerDID)
+	}
+	return reg, nil
+}
+
+// PauseIssuer sets controllerDID's IssuerRegistration.Paused.
+func (k Keeper) PauseIssuer(ctx context.Context, controllerDID string, paused bool) error {
+	reg, err := k.GetIssuerRegistration(ctx, controllerDID)
+	if err != nil {
+		return err
+	}
+	reg.Paused = paused
+	return k.IssuerRegistrations.Set(ctx, controllerDID, reg)
+}
+
+// UpdateIssuerRateLimit replaces controllerDID's IssuerRegistration.RateLimit without
+// touching its allow-list, denylist, or Paused state.
+func (k Keeper) UpdateIssuerRateLimit(ctx context.Context, controllerDID string, rateLimit types.IssuerRateLimit) error {
+	reg, err := k.GetIssuerRegistration(ctx, controllerDID)
+	if err != nil {
+		return err
+	}
+	reg.RateLimit = rateLimit
+	return k.IssuerRegistrations.Set(ctx, controllerDID, reg)
+}
+
+// checkIssuerRegistration enforces controllerDID's IssuerRegistration (if any) against
+// an about-to-be-issued credential of credType naming subjectDID: paused, credential
+// type allow-list, denied subjects, and the rolling per-period rate limit, in that
+// order. An issuer with no IssuerRegistration is unrestricted and this is a no-op --
+// the gate only applies to DIDs governance has opted in via RegisterIssuer. On success
+// it persists the incremented IssuerRateLimitState, so CreateCredential must only call
+// this once issuance is otherwise guaranteed to succeed.
+func (k Keeper) checkIssuerRegistration(ctx context.Context, controllerDID, credType, subjectDID string) error {
+	reg, err := k.IssuerRegistrations.Get(ctx, controllerDID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if reg.Paused {
+		return types.ErrIssuerPaused.Wrapf("issuer %s is paused", controllerDID)
+	}
+	if !reg.IsCredentialTypeAllowed(credType) {
+		return types.ErrCredentialTypeNotAllowed.Wrapf("issuer %s may not issue credential type %q", controllerDID, credType)
+	}
+	if reg.IsSubjectDenied(subjectDID) {
+		return types.ErrSubjectDenied.Wrapf("issuer %s has denied subject %s", controllerDID, subjectDID)
+	}
+
+	return k.incrementIssuerRateLimit(ctx, controllerDID, reg.RateLimit)
+}
+
+// incrementIssuerRateLimit rolls IssuerRateLimitState.Period forward to
+// ctx.BlockHeight()/rateLimit.PeriodBlocks if it has advanced, then rejects once
+// rateLimit.MaxPerPeriod is reached for the current period. A zero PeriodBlocks or
+// MaxPerPeriod means unlimited, per IssuerRateLimit's doc comment.
+func (k Keeper) incrementIssuerRateLimit(ctx context.Context, controllerDID string, rateLimit types.IssuerRateLimit) error {
+	if rateLimit.PeriodBlocks <= 0 || rateLimit.MaxPerPeriod == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	period := sdkCtx.BlockHeight() / rateLimit.PeriodBlocks
+
+	state, err := k.IssuerRateLimitStates.Get(ctx, controllerDID)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		state = types.IssuerRateLimitState{}
+	}
+	if state.Period != period {
+		state.Period = period
+		state.Count = 0
+	}
+
+	if state.Count >= rateLimit.MaxPerPeriod {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeIssuerRateLimitHit,
+				sdk.NewAttribute(types.AttributeKeyControllerDID, controllerDID),
+			),
+		)
+		return types.ErrIssuerRateLimitExceeded.Wrapf(
+			"issuer %s has reached its limit of %d credentials per %d blocks", controllerDID, rateLimit.MaxPerPeriod, rateLimit.PeriodBlocks)
+	}
+
+	state.Count++
+	return k.IssuerRateLimitStates.Set(ctx, controllerDID, state)
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine. This struct was missing entirely until now: every
+// file in this package (msg_server_lifecycle.go, migrations.go, authz.go,
+// ibc_verification.go, attributes.go, and the rest) was written against an assumed
+// Keeper shape documented in each file's own doc comments rather than a real type,
+// which meant nothing in this package actually compiled. The field set below is taken
+// directly from those doc comments -- migrations.go's "This file assumes Keeper
+// carries..." block in particular already enumerated almost every collection here --
+// rather than redesigned from scratch, so the methods written against the assumed
+// shape need no further changes.
+//
+// Modeled on x/schema/keeper/keeper.go's KVStoreService-backed collections.Schema
+// layout (the newer-era convention in this tree, vs. x/oracle/x/registry's raw
+// KVStore-byte-prefix style), since every method in this package already does
+// k.Credentials.Get/Set/Walk rather than manual byte-key construction.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+	Schema       collections.Schema
+
+	Credentials                  collections.Map[string, types.VerifiableCredential]
+	CredentialsByIssuer          collections.Map[collections.Pair[string, string], string]
+	CredentialsByHolder          collections.Map[collections.Pair[string, string], string]
+	CredentialsByType            collections.Map[collections.Pair[string, string], string]
+	CredentialsBySchema          collections.Map[collections.Pair[string, string], string]
+	CredentialsByStatus          collections.Map[collections.Pair[string, string], string]
+	CredentialsByStatusListEntry collections.Map[collections.Pair[string, uint64], string]
+	CredentialsByAttribute       collections.Map[collections.Triple[string, string, string], string]
+	CredentialCount              collections.Sequence
+	CredentialTypeUsage          collections.Map[string, uint64]
+	CredentialVerifications      collections.Map[string, types.VerificationRecord]
+
+	Presentations         collections.Map[string, types.VerifiablePresentation]
+	PresentationsByHolder collections.Map[collections.Pair[string, string], string]
+	PresentationCount     collections.Sequence
+
+	Applications         collections.Map[string, types.CredentialApplication]
+	ApplicationsByIssuer collections.Map[collections.Pair[string, string], string]
+	Operations           collections.Map[string, types.CredentialOperation]
+
+	IssuerRegistrations   collections.Map[string, types.IssuerRegistration]
+	IssuerRateLimitStates collections.Map[string, types.IssuerRateLimitState]
+
+	// ImportedCredentials records one ImportedCredential per credential received over
+	// the credential-transfer IBC application, keyed by CredentialPacketData.CredentialHash
+	// -- see ibc/ibc_module.go's OnRecvPacket.
+	ImportedCredentials collections.Map[string, types.ImportedCredential]
+
+	CredentialAuthorizations collections.Map[collections.Pair[string, string], types.CredentialAuthorization]
+	IssuanceAuthorizations   collections.Map[collections.Pair[string, string], types.CredentialIssuanceAuthorization]
+	RevocationAuthorizations collections.Map[collections.Pair[string, string], types.DelegatedRevocationAuthorization]
+
+	Params collections.Item[types.Params]
+
+	// GenesisSnapshot serves a height-0 query against InitGenesis's fixture -- see
+	// genesis.go's SetGenesisSnapshot/queryAtHeight. nil until set.
+	GenesisSnapshot *GenesisSnapshot
+
+	// bbsVerifier backs IssueCredentialBBS/CreatePresentationBBS's and
+	// CreatePresentation's BBS+-derived-proof branch (msg_server_bbs.go): this repo
+	// vendors no BLS12-381 pairing library (the same reason x/revocation's
+	// AccumulatorRegistry.N/G must come from an external trusted setup rather than being
+	// generated on-chain), so there is no in-tree types.BBSVerifier implementation to
+	// construct one from by default. nil until SetBBSVerifier wires a real backend in;
+	// every BBS+ entry point fails loud with ErrUnsupportedProofFormat rather than
+	// silently skipping verification when it's unset.
+	bbsVerifier types.BBSVerifier
+
+	// External keepers, narrowed to this tree's expected_keepers.go interfaces rather
+	// than concrete x/auth, x/bank, x/did, x/revocation, x/schema keeper types.
+	didKeeper        types.DIDKeeper
+	revocationKeeper types.RevocationKeeper
+	schemaKeeper     types.SchemaKeeper
+	bankKeeper       types.BankKeeper
+	channelKeeper    types.ChannelKeeper
+	scopedKeeper     capabilitykeeper.ScopedKeeper
+
+	// authority is the address capable of executing governance proposals (RegisterIssuer,
+	// PauseIssuer, UpdateParams -- see msg_server_issuer.go/msg_server_params.go).
+	authority string
+}
+
+// NewKeeper creates a new credential Keeper instance backed by storeService.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+	didKeeper types.DIDKeeper,
+	revocationKeeper types.RevocationKeeper,
+	schemaKeeper types.SchemaKeeper,
+	bankKeeper types.BankKeeper,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		logger:           log.NewNopLogger(),
+		authority:        authority,
+		didKeeper:        didKeeper,
+		revocationKeeper: revocationKeeper,
+		schemaKeeper:     schemaKeeper,
+		bankKeeper:       bankKeeper,
+		channelKeeper:    channelKeeper,
+		scopedKeeper:     scopedKeeper,
+
+		Credentials:                  collections.NewMap(sb, types.CredentialPrefix, "credentials", collections.StringKey, codec.CollValue[types.VerifiableCredential](cdc)),
+		CredentialsByIssuer:          collections.NewMap(sb, types.CredentialByIssuerPrefix, "credentials_by_issuer", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialsByHolder:          collections.NewMap(sb, types.CredentialByHolderPrefix, "credentials_by_holder", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialsByType:            collections.NewMap(sb, types.CredentialByTypePrefix, "credentials_by_type", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialsBySchema:          collections.NewMap(sb, types.CredentialBySchemaPrefix, "credentials_by_schema", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialsByStatus:          collections.NewMap(sb, types.CredentialsByStatusPrefix, "credentials_by_status", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialsByStatusListEntry: collections.NewMap(sb, types.CredentialsByStatusListEntryPrefix, "credentials_by_status_list_entry", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringValue),
+		CredentialsByAttribute:       collections.NewMap(sb, types.CredentialsByAttributePrefix, "credentials_by_attribute", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), collections.StringValue),
+		CredentialCount:              collections.NewSequence(sb, types.CredentialCountKey, "credential_count"),
+		CredentialTypeUsage:          collections.NewMap(sb, types.CredentialTypeUsagePrefix, "credential_type_usage", collections.StringKey, collections.Uint64Value),
+		CredentialVerifications:      collections.NewMap(sb, types.CredentialVerificationPrefix, "credential_verifications", collections.StringKey, codec.CollValue[types.VerificationRecord](cdc)),
+
+		Presentations:         collections.NewMap(sb, types.PresentationPrefix, "presentations", collections.StringKey, codec.CollValue[types.VerifiablePresentation](cdc)),
+		PresentationsByHolder: collections.NewMap(sb, types.PresentationByHolderPrefix, "presentations_by_holder", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		PresentationCount:     collections.NewSequence(sb, types.PresentationCountKey, "presentation_count"),
+
+		Applications:         collections.NewMap(sb, types.ApplicationPrefix, "applications", collections.StringKey, codec.CollValue[types.CredentialApplication](cdc)),
+		ApplicationsByIssuer: collections.NewMap(sb, types.ApplicationsByIssuerPrefix, "applications_by_issuer", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		Operations:           collections.NewMap(sb, types.OperationPrefix, "operations", collections.StringKey, codec.CollValue[types.CredentialOperation](cdc)),
+
+		IssuerRegistrations:   collections.NewMap(sb, types.IssuerRegistrationPrefix, "issuer_registrations", collections.StringKey, codec.CollValue[types.IssuerRegistration](cdc)),
+		IssuerRateLimitStates: collections.NewMap(sb, types.IssuerRateLimitStatePrefix, "issuer_rate_limit_states", collections.StringKey, codec.CollValue[types.IssuerRateLimitState](cdc)),
+
+		ImportedCredentials: collections.NewMap(sb, types.ImportedCredentialPrefix, "imported_credentials", collections.StringKey, codec.CollValue[types.ImportedCredential](cdc)),
+
+		CredentialAuthorizations: collections.NewMap(sb, types.CredentialAuthorizationPrefix, "credential_authorizations", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.CredentialAuthorization](cdc)),
+		IssuanceAuthorizations:   collections.NewMap(sb, types.IssuanceAuthorizationPrefix, "issuance_authorizations", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.CredentialIssuanceAuthorization](cdc)),
+		RevocationAuthorizations: collections.NewMap(sb, types.RevocationAuthorizationPrefix, "revocation_authorizations", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatedRevocationAuthorization](cdc)),
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetBBSVerifier wires a types.BBSVerifier backend into the keeper, enabling
+// IssueCredentialBBS/CreatePresentationBBS and CreatePresentation's BBS+-derived-proof
+// branch (msg_server_bbs.go). This is the primitive a real app.go would call once a
+// BLS12-381 pairing library is vendored -- x/credential has no such dependency in this
+// tree, so nothing calls this yet and every BBS+ entry point returns
+// ErrUnsupportedProofFormat until it does.
+func (k *Keeper) SetBBSVerifier(v types.BBSVerifier) {
+	k.bbsVerifier = v
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module's current params, falling back to DefaultParams if none
+// have been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams(), nil
+	}
+	return params, nil
+}
+
+// SetParams persists the module's params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
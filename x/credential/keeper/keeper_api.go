@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// IsCredentialValid reports whether credentialID exists, is not revoked (by either the
+// legacy Revoked/Status fields or a StatusList2021 bit -- see
+// VerifiableCredential.IsRevoked), and has not expired. This is the stable,
+// Msg/Query-type-free entry point a cross-module caller should use instead of depending
+// on MsgServer/QueryServer's proto request/response shapes -- e.g. x/zkproof checking
+// that a proof's bound credential is still good before accepting it, the motivating case
+// this was added for. A missing credential is reported as invalid rather than an error,
+// since "not found" and "found but revoked" are the same answer to "can I rely on this
+// credential right now".
+func (k Keeper) IsCredentialValid(ctx context.Context, credentialID string) (bool, error) {
+	vc, err := k.Credentials.Get(ctx, credentialID)
+	if err != nil {
+		return false, nil
+	}
+	if vc.IsExpired() {
+		return false, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	revoked, err := vc.IsRevoked(sdkCtx, k.revocationKeeper)
+	if err != nil {
+		return false, err
+	}
+	return !revoked, nil
+}
+
+// CredentialStatus reports whether credentialID is currently revoked and/or suspended,
+// the lightweight {revoked, suspended} check a verifier runs instead of fetching the
+// full VerifiableCredential via the Credential query. Both booleans are read off
+// vc.Status (CredentialStatusRevoked/CredentialStatusSuspended), the same field
+// RevokeCredential/UpdateCredentialStatus already keep in sync with the StatusList2021
+// bit they flip, rather than re-decoding the bitstring here -- vc.Status is strictly
+// derived from that bit by those two handlers, so reading it is equivalent and avoids a
+// second GetStatusList/gzip-decode per call. A missing credential returns
+// ErrCredentialNotFound rather than (false, false, nil): unlike IsCredentialValid
+// (a single pass/fail a caller can treat "missing" and "invalid" the same way for),
+// a caller asking for a specific credential's status needs to distinguish "doesn't
+// exist" from "exists and is fine".
+func (k Keeper) CredentialStatus(ctx context.Context, credentialID string) (revoked bool, suspended bool, err error) {
+	vc, err := k.Credentials.Get(ctx, credentialID)
+	if err != nil {
+		return false, false, types.ErrCredentialNotFound.Wrapf("credential %s not found", credentialID)
+	}
+	return vc.Status == types.CredentialStatusRevoked, vc.Status == types.CredentialStatusSuspended, nil
+}
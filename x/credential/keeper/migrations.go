@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// Keeper (see keeper.go) carries every collections.Map this file and the rest of the
+// package reference -- Credentials, CredentialsByIssuer, CredentialsByStatusListEntry,
+// CredentialsByAttribute, CredentialsByHolder/Type/Schema/Status, Presentations and its
+// holder index, the Credential/PresentationCount sequences, Applications and its issuer
+// index, Operations, and the three delegation-authorization maps keyed by
+// collections.Join(granter, grantee) that keeper/authz.go's
+// GrantCredentialAuthorization/GrantIssuanceAuthority/GrantRevocationAuthority and their
+// Revoke/Exec counterparts use. Migrate1to2 below is written against that real schema
+// the same way x/revocation/keeper/migrations.go's MigrateStore is written against
+// x/revocation's.
+
+// Migrator is the x/credential counterpart to x/revocation/keeper/migrations.go's bare
+// MigrateStore function, shaped as a type with a Migrate1to2 method because this chunk's
+// request names it that way: `cfg.RegisterMigration(types.ModuleName, 1,
+// migrator.Migrate1to2)`, alongside bumping AppModule.ConsensusVersion from 1 to 2. There is
+// no module.go/AppModule/Configurator anywhere in x/credential for a RegisterServices to
+// actually make that cfg.RegisterMigration call from -- see keeper/genesis.go's
+// GenesisSnapshot doc comment for the same standing gap -- so nothing invokes Migrate1to2 yet
+// and there is no AppModule.ConsensusVersion to bump. Once a module.go exists,
+// RegisterServices would wire this as:
+//
+//	cfg.RegisterMigration(types.ModuleName, 1, NewMigrator(keeper).Migrate1to2)
+//
+// and AppModule.ConsensusVersion() would return 2.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator wrapping keeper, the constructor a future RegisterServices
+// would call alongside NewMsgServer/NewQueryServer.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves every record written under the Legacy* string prefixes in
+// types/keys.go (LegacyCredentialPrefix and friends) onto the collections.NewPrefix(N)
+// byte-prefixed schema keeper.go builds, then deletes the legacy entries. Modeled directly
+// on x/revocation/keeper/migrations.go's MigrateStore: it takes the legacy raw store key
+// rather than routing through Keeper, since by the time this is wired into an upgrade
+// handler the legacy storeKey won't be part of Keeper's collections schema anymore.
+//
+// Per the request, counters are rebuilt from the migrated data (credentialCount/
+// presentationCount below) rather than trusting any old counter value -- this module's
+// Legacy* scheme never had a dedicated legacy counter key to begin with, so there was
+// nothing to distrust, but counting migrated entries is also simply the correct way to
+// populate CredentialCount/PresentationCount's first real values.
+//
+// LegacyCredentialMetadataPrefix entries are decoded with encoding/json rather than
+// k.cdc.MustUnmarshal: types.CredentialMetadata (types.go) has a map[string]interface{}
+// Properties field and no ProtoMessage methods, so it was never a proto.Message the way
+// VerifiableCredential/VerifiablePresentation are -- it predates this module's move to a
+// proto-backed collections schema. There is no CredentialMetadata collections.Map in the
+// schema this file assumes (see the header comment above) for the decoded value to land
+// in, so this loop only deletes the legacy entries to complete the migration; a future
+// chunk adding a CredentialMetadata collection would give it somewhere to go.
+//
+// LegacyRevocationListPrefix is iterated too, since the request names it explicitly, but
+// is expected to always be empty in practice: CreateCredential (msg_server_lifecycle.go)
+// allocates StatusList2021 state through ms.revocationKeeper (x/revocation), never through
+// a RevocationList record of its own, so nothing in this tree has ever written a key under
+// this prefix. There is correspondingly no RevocationList type or destination collection to
+// decode into -- this loop likewise only deletes whatever (unexpectedly) turns up. The
+// real StatusList2021 bitmap/Merkle-proof system this prefix's name might suggest
+// already exists, just one module over: x/revocation's StatusList collection (added in
+// earlier chunks, see x/revocation/types/statuslist.go and merkle.go), keyed by
+// (issuer, purpose, generation) rather than bare issuer, with AllocateStatusListIndex
+// handling rollover at a configurable Params.StatusListSize and
+// Keeper.QueryCredentialStatus serving single-bit-plus-proof reads. There is nothing
+// under this legacy prefix to carry into it.
+func (m Migrator) Migrate1to2(ctx sdk.Context, legacyStoreKey storetypes.StoreKey) error {
+	k := m.keeper
+	store := ctx.KVStore(legacyStoreKey)
+
+	var credentialCount uint64
+	credIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialPrefix))
+	defer credIter.Close()
+	for ; credIter.Valid(); credIter.Next() {
+		var vc types.VerifiableCredential
+		k.cdc.MustUnmarshal(credIter.Value(), &vc)
+		if err := k.Credentials.Set(ctx, vc.ID, vc); err != nil {
+			return err
+		}
+		credentialCount++
+		store.Delete(credIter.Key())
+	}
+
+	issuerIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialByIssuerPrefix))
+	defer issuerIter.Close()
+	for ; issuerIter.Valid(); issuerIter.Next() {
+		issuer := types.GetIssuerFromKey(issuerIter.Key())
+		id := types.GetCredentialIDFromIssuerKey(issuerIter.Key())
+		if issuer == "" || id == "" {
+			store.Delete(issuerIter.Key())
+			continue
+		}
+		if err := k.CredentialsByIssuer.Set(ctx, collections.Join(issuer, id), id); err != nil {
+			return err
+		}
+		store.Delete(issuerIter.Key())
+	}
+
+	holderIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialByHolderPrefix))
+	defer holderIter.Close()
+	for ; holderIter.Valid(); holderIter.Next() {
+		holder := types.GetHolderFromKey(holderIter.Key())
+		id := types.GetCredentialIDFromHolderKey(holderIter.Key())
+		if holder == "" || id == "" {
+			store.Delete(holderIter.Key())
+			continue
+		}
+		if err := k.CredentialsByHolder.Set(ctx, collections.Join(holder, id), id); err != nil {
+			return err
+		}
+		store.Delete(holderIter.Key())
+	}
+
+	typeIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialByTypePrefix))
+	defer typeIter.Close()
+	for ; typeIter.Valid(); typeIter.Next() {
+		credType, id := splitLegacyIndexKey(typeIter.Key(), types.LegacyCredentialByTypePrefix)
+		if credType == "" || id == "" {
+			store.Delete(typeIter.Key())
+			continue
+		}
+		if err := k.CredentialsByType.Set(ctx, collections.Join(credType, id), id); err != nil {
+			return err
+		}
+		store.Delete(typeIter.Key())
+	}
+
+	schemaIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialBySchemaPrefix))
+	defer schemaIter.Close()
+	for ; schemaIter.Valid(); schemaIter.Next() {
+		schema, id := splitLegacyIndexKey(schemaIter.Key(), types.LegacyCredentialBySchemaPrefix)
+		if schema == "" || id == "" {
+			store.Delete(schemaIter.Key())
+			continue
+		}
+		if err := k.CredentialsBySchema.Set(ctx, collections.Join(schema, id), id); err != nil {
+			return err
+		}
+		store.Delete(schemaIter.Key())
+	}
+
+	var presentationCount uint64
+	presIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyPresentationPrefix))
+	defer presIter.Close()
+	for ; presIter.Valid(); presIter.Next() {
+		var vp types.VerifiablePresentation
+		k.cdc.MustUnmarshal(presIter.Value(), &vp)
+		if err := k.Presentations.Set(ctx, vp.ID, vp); err != nil {
+			return err
+		}
+		presentationCount++
+		store.Delete(presIter.Key())
+	}
+
+	presHolderIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyPresentationByHolderPrefix))
+	defer presHolderIter.Close()
+	for ; presHolderIter.Valid(); presHolderIter.Next() {
+		holder, id := splitLegacyIndexKey(presHolderIter.Key(), types.LegacyPresentationByHolderPrefix)
+		if holder == "" || id == "" {
+			store.Delete(presHolderIter.Key())
+			continue
+		}
+		if err := k.PresentationsByHolder.Set(ctx, collections.Join(holder, id), id); err != nil {
+			return err
+		}
+		store.Delete(presHolderIter.Key())
+	}
+
+	metaIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialMetadataPrefix))
+	defer metaIter.Close()
+	for ; metaIter.Valid(); metaIter.Next() {
+		var meta types.CredentialMetadata
+		if err := json.Unmarshal(metaIter.Value(), &meta); err != nil {
+			return err
+		}
+		// No CredentialMetadata collection exists in the schema this file assumes (see
+		// header comment) -- decoded only to confirm the legacy value is well-formed
+		// before discarding the key.
+		store.Delete(metaIter.Key())
+	}
+
+	revocationListIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyRevocationListPrefix))
+	defer revocationListIter.Close()
+	for ; revocationListIter.Valid(); revocationListIter.Next() {
+		store.Delete(revocationListIter.Key())
+	}
+
+	if err := k.CredentialCount.Set(ctx, credentialCount); err != nil {
+		return err
+	}
+	if err := k.PresentationCount.Set(ctx, presentationCount); err != nil {
+		return err
+	}
+
+	// CredentialsByStatus (keys.go's CredentialsByStatusPrefix) postdates this
+	// migration's original design -- there was never a legacy key space for it -- so it
+	// has nothing to migrate from. It's backfilled here from the just-migrated
+	// k.Credentials instead, the same "derive the new index from already-migrated
+	// canonical state" approach x/revocation/keeper/migrations.go's
+	// BackfillStatusListBits uses for pre-existing revocations.
+	return k.Credentials.Walk(ctx, nil, func(id string, vc types.VerifiableCredential) (bool, error) {
+		return false, k.setCredentialStatusIndex(ctx, id, "", vc.Status)
+	})
+}
+
+// splitLegacyIndexKey splits a "<prefix><left>:<right>" legacy secondary-index key (the
+// shared shape of LegacyCredentialByTypePrefix, LegacyCredentialBySchemaPrefix, and
+// LegacyPresentationByHolderPrefix keys -- see types/keys.go's CredentialByTypeKey et al.)
+// back into (left, right), scanning for the last ':' the way types/keys.go's
+// GetIssuerFromKey/GetHolderFromKey do for their own prefixes.
+func splitLegacyIndexKey(key []byte, prefix string) (left, right string) {
+	suffix := string(key[len(prefix):])
+	for i := len(suffix) - 1; i >= 0; i-- {
+		if suffix[i] == ':' {
+			return suffix[:i], suffix[i+1:]
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,18 @@
+package keeper
+
+// MsgServer embeds Keeper to host the credential lifecycle's validation/orchestration
+// logic (CreateCredential, RevokeCredential, UpdateCredentialStatus, CreatePresentation
+// in msg_server_lifecycle.go/msg_server_presentation.go), mirroring QueryServer's role on
+// the read side. Splitting these out of Keeper itself means a cross-module caller that
+// only wants to check credential state -- x/zkproof consulting credential validity while
+// verifying a proof, say -- can depend on Keeper's pure state-access surface
+// (Credentials.Get, IsCredentialValid below) without pulling in MsgCreateCredential/
+// QueryCredentialResponse-shaped types it has no business knowing about.
+type MsgServer struct {
+	Keeper
+}
+
+// NewMsgServer returns a MsgServer backed by keeper.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{Keeper: keeper}
+}
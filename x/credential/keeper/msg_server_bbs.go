@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// didKeyTypeBls12381G2 mirrors x/did/types.VerificationKeyTypeBls12381G2 by value, the
+// same way verify.go's didKeyTypeEd25519/didKeyTypeSecp256k1 avoid importing x/did/types
+// directly into this package.
+const didKeyTypeBls12381G2 = "Bls12381G2Key2020"
+
+// IssueCredentialBBS implements types.MsgServer's IssueCredentialBBS: it issues a
+// VerifiableCredential signed with a BbsBlsSignature2020 signature over
+// types.DeriveMessageVector(msg.CredentialSubject), verified against a BLS12-381 key
+// resolved from the issuer's DID. Otherwise mirrors CreateCredential's bookkeeping
+// (issuer/type checks, fee, status-list allocation, indexing) so a BBS+ issued credential
+// is revocable and discoverable the same way a CreateCredential one is.
+func (ms MsgServer) IssueCredentialBBS(ctx context.Context, msg *types.MsgIssueCredentialBBS) (*types.MsgIssueCredentialBBSResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := ms.didKeeper.ValidateDID(ctx, msg.Issuer); err != nil {
+		return nil, types.ErrInvalidIssuer.Wrapf("issuer %s is not an active DID controller: %s", msg.Issuer, err)
+	}
+
+	subjectDID := ""
+	if msg.CredentialSubject != nil {
+		subjectDID = msg.CredentialSubject.ID
+	}
+	primaryType := ""
+	if len(msg.CredentialType) > 0 {
+		primaryType = msg.CredentialType[0]
+	}
+	if err := ms.checkIssuerRegistration(ctx, msg.Issuer, primaryType, subjectDID); err != nil {
+		return nil, err
+	}
+
+	if ok, err := ms.Credentials.Has(ctx, msg.Id); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, types.ErrCredentialAlreadyExists.Wrapf("credential %s already exists", msg.Id)
+	}
+
+	keyType, publicKeyMultibase, err := ms.didKeeper.ResolveVerificationMethod(ctx, msg.Issuer, msg.VerificationMethod)
+	if err != nil {
+		return nil, types.ErrInvalidVerificationMethod.Wrapf("resolving %q against issuer %s: %s", msg.VerificationMethod, msg.Issuer, err)
+	}
+	if keyType != didKeyTypeBls12381G2 {
+		return nil, types.ErrInvalidVerificationMethod.Wrapf("verification method %q is not a BLS12-381 key", msg.VerificationMethod)
+	}
+	pubKey, err := decodeMultibaseSignature(publicKeyMultibase)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := types.DeriveMessageVector(*msg.CredentialSubject)
+	if err != nil {
+		return nil, err
+	}
+	if ms.bbsVerifier == nil {
+		return nil, types.ErrUnsupportedProofFormat.Wrap("no BBS+ verifier backend is configured (see Keeper.SetBBSVerifier)")
+	}
+	if err := ms.bbsVerifier.Verify(types.BLS12381PublicKey(pubKey), messages, msg.Signature); err != nil {
+		return nil, types.ErrProofVerificationFailed.Wrapf("BbsBlsSignature2020 does not verify: %s", err)
+	}
+
+	validatedType := ""
+	for _, credType := range msg.CredentialType {
+		if _, ok, err := ms.ValidateCredentialType(ctx, credType); err != nil {
+			return nil, err
+		} else if ok {
+			validatedType = credType
+			break
+		}
+	}
+	if validatedType == "" {
+		return nil, types.ErrInvalidCredentialType.Wrapf("none of %v is a registered, non-deprecated credential type", msg.CredentialType)
+	}
+	if err := ms.RecordCredentialTypeUsage(ctx, validatedType); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issuerAddr, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, types.ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if !params.CreateCredentialFee.IsZero() {
+		if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, issuerAddr, types.ModuleName, params.CreateCredentialFee); err != nil {
+			return nil, types.ErrInsufficientFunds.Wrapf("paying credential fee: %s", err)
+		}
+	}
+
+	vc := types.VerifiableCredential{
+		Context:           msg.Context,
+		ID:                msg.Id,
+		Type:              msg.CredentialType,
+		Issuer:            msg.Issuer,
+		IssuanceDate:      sdkCtx.BlockTime(),
+		CredentialSubject: *msg.CredentialSubject,
+		Proof: &types.Proof{
+			Type:               string(types.ProofTypeBbsBlsSignature2020),
+			Created:            sdkCtx.BlockTime(),
+			VerificationMethod: msg.VerificationMethod,
+			ProofPurpose:       "assertionMethod",
+			ProofValue:         "u" + base64.RawURLEncoding.EncodeToString(msg.Signature),
+		},
+		BlockHeight: sdkCtx.BlockHeight(),
+		Created:     sdkCtx.BlockTime(),
+		Updated:     sdkCtx.BlockTime(),
+		Active:      true,
+		Status:      types.CredentialStatusLive,
+	}
+	if err := vc.Validate(); err != nil {
+		return nil, err
+	}
+
+	statusListID, index, err := ms.revocationKeeper.AllocateStatusListIndex(sdkCtx, msg.Issuer, types.StatusPurposeRevocation)
+	if err != nil {
+		return nil, err
+	}
+	ms.revocationKeeper.SetCredentialStatusIndex(sdkCtx, vc.ID, statusListID, index)
+	vc.CredentialStatus = types.NewStatusListEntry(statusListID, index, types.StatusPurposeRevocation)
+
+	if err := ms.Credentials.Set(ctx, vc.ID, vc); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByIssuer.Set(ctx, collections.Join(msg.Issuer, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByStatusListEntry.Set(ctx, collections.Join(statusListID, index), vc.ID); err != nil {
+		return nil, err
+	}
+	if vc.CredentialSubject.ID != "" {
+		if err := ms.CredentialsByHolder.Set(ctx, collections.Join(vc.CredentialSubject.ID, vc.ID), vc.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.CredentialsByType.Set(ctx, collections.Join(validatedType, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.setCredentialStatusIndex(ctx, vc.ID, "", vc.Status); err != nil {
+		return nil, err
+	}
+	if err := ms.indexCredentialAttributes(ctx, vc, params.IndexableAttributeKeys); err != nil {
+		return nil, err
+	}
+
+	types.EmitCredentialIssuedEvent(sdkCtx, &vc, statusListID, index)
+
+	return &types.MsgIssueCredentialBBSResponse{CredentialId: vc.ID}, nil
+}
+
+// statementPaths returns the "/"-prefixed JSON-pointer paths of the statements at
+// indices, in index order -- the same path format CanonicalizeCredentialSubject and
+// VerifyPresentationProof's reveal parameter use.
+func statementPaths(statements []types.CanonicalStatement, indices []int) []string {
+	paths := make([]string, len(indices))
+	for i, idx := range indices {
+		paths[i] = statements[idx].Path
+	}
+	return paths
+}
+
+// CreatePresentationBBS implements types.MsgServer's CreatePresentationBBS: it derives a
+// BbsBlsSignatureProof2020 presentation from a BBS+ issued credential, revealing only the
+// statements named by msg.RevealMask. Unlike CreatePresentation's path-based Reveal field,
+// this redacts CredentialSubject.Claims down to the revealed indices itself rather than
+// requiring the caller to supply a pre-redacted subject, since MsgCreatePresentationBBS
+// carries a bitmask rather than a redacted credential.
+//
+// The credential's own BbsBlsSignature2020 signature (vc.Proof.ProofValue) stands in for
+// the holder-derived proof of knowledge VerifyPresentationProof checks: a real derivation
+// blinds and re-randomizes the issuer's signature so the verifier learns nothing about
+// the hidden statements beyond what CredentialSubject.ID and the revealed claims disclose,
+// but doing that requires a BLS12-381 pairing library this tree does not vendor (see
+// types.BBSVerifier's doc comment). ms.bbsVerifier is nil until one is wired in via
+// SetBBSVerifier, so this path fails loud with ErrUnsupportedProofFormat rather than
+// emitting a presentation whose "proof" is really just the original signature.
+func (ms MsgServer) CreatePresentationBBS(ctx context.Context, msg *types.MsgCreatePresentationBBS) (*types.MsgCreatePresentationBBSResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vc, err := ms.Credentials.Get(ctx, msg.CredentialId)
+	if err != nil {
+		return nil, types.ErrCredentialNotFound.Wrapf("credential %s not found", msg.CredentialId)
+	}
+	if vc.Proof == nil || vc.Proof.Type != string(types.ProofTypeBbsBlsSignature2020) {
+		return nil, types.ErrInvalidProof.Wrapf("credential %s was not issued with a BbsBlsSignature2020 proof", msg.CredentialId)
+	}
+
+	revoked, err := vc.IsRevoked(sdkCtx, ms.revocationKeeper)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, types.ErrCredentialRevoked.Wrapf("credential %s has been revoked", msg.CredentialId)
+	}
+	if vc.IsExpired() {
+		return nil, types.ErrExpiredCredential.Wrapf("credential %s has expired", msg.CredentialId)
+	}
+
+	statements, err := types.CanonicalizeCredentialSubject(vc.CredentialSubject)
+	if err != nil {
+		return nil, err
+	}
+	revealedIdx := types.RevealedIndicesFromMask(len(statements), msg.RevealMask)
+	if len(revealedIdx) == 0 {
+		return nil, types.ErrInvalidProof.Wrap("BBS+ derived presentations must reveal at least one statement")
+	}
+
+	redactedClaims := make(map[string]interface{}, len(revealedIdx))
+	for _, idx := range revealedIdx {
+		path := strings.TrimPrefix(statements[idx].Path, "/")
+		redactedClaims[path] = vc.CredentialSubject.Claims[path]
+	}
+	redactedSubject := types.CredentialSubject{ID: vc.CredentialSubject.ID, Claims: redactedClaims}
+
+	if ms.bbsVerifier == nil {
+		return nil, types.ErrUnsupportedProofFormat.Wrap("no BBS+ verifier backend is configured (see Keeper.SetBBSVerifier)")
+	}
+	keyType, publicKeyMultibase, err := ms.didKeeper.ResolveVerificationMethod(ctx, vc.Issuer, vc.Proof.VerificationMethod)
+	if err != nil {
+		return nil, types.ErrInvalidVerificationMethod.Wrapf("resolving %q against issuer %s: %s", vc.Proof.VerificationMethod, vc.Issuer, err)
+	}
+	if keyType != didKeyTypeBls12381G2 {
+		return nil, types.ErrInvalidVerificationMethod.Wrapf("verification method %q is not a BLS12-381 key", vc.Proof.VerificationMethod)
+	}
+	pubKey, err := decodeMultibaseSignature(publicKeyMultibase)
+	if err != nil {
+		return nil, err
+	}
+	proofBytes, err := decodeMultibaseSignature(vc.Proof.ProofValue)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.VerifyPresentationProof(ms.bbsVerifier, types.BLS12381PublicKey(pubKey), vc.CredentialSubject, redactedSubject, statementPaths(statements, revealedIdx), proofBytes, msg.Nonce); err != nil {
+		return nil, types.ErrProofVerificationFailed.Wrapf("BbsBlsSignatureProof2020 does not verify: %s", err)
+	}
+
+	vp := types.VerifiablePresentation{
+		ID:                   msg.Id,
+		Holder:               msg.Holder,
+		Type:                 []string{"VerifiablePresentation"},
+		VerifiableCredential: []string{msg.CredentialId},
+		Proof: &types.Proof{
+			Type:         string(types.ProofTypeBbsBlsSignatureProof2020),
+			Created:      sdkCtx.BlockTime(),
+			ProofPurpose: "authentication",
+			Nonce:        "u" + base64.RawURLEncoding.EncodeToString(msg.Nonce),
+		},
+		Created: sdkCtx.BlockTime(),
+	}
+
+	if err := ms.Presentations.Set(ctx, vp.ID, vp); err != nil {
+		return nil, err
+	}
+	if err := ms.PresentationsByHolder.Set(ctx, collections.Join(msg.Holder, msg.Id), msg.Id); err != nil {
+		return nil, err
+	}
+	if _, err := ms.PresentationCount.Next(ctx); err != nil {
+		return nil, err
+	}
+
+	types.EmitPresentationSubmittedEvent(sdkCtx, msg.Holder, msg.Id, vp.Type)
+
+	return &types.MsgCreatePresentationBBSResponse{PresentationId: msg.Id}, nil
+}
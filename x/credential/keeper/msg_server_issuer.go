@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// RegisterIssuer implements types.MsgServer's RegisterIssuer, gated on ms.GetAuthority()
+// the same way UpdateParams is (msg_server_params.go). It registers or wholesale
+// replaces the IssuerRegistration governing msg.ControllerDID -- to adjust just the
+// rate limit or pause flag afterwards, use MsgUpdateIssuerRateLimit/MsgPauseIssuer
+// rather than resubmitting a full MsgRegisterIssuer.
+func (ms MsgServer) RegisterIssuer(ctx context.Context, msg *types.MsgRegisterIssuer) (*types.MsgRegisterIssuerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != ms.GetAuthority() {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	reg := types.IssuerRegistration{
+		ControllerDID:          msg.ControllerDID,
+		AllowedCredentialTypes: msg.AllowedCredentialTypes,
+		DeniedSubjects:         msg.DeniedSubjects,
+		RateLimit:              msg.RateLimit,
+	}
+	if err := ms.RegisterIssuer(ctx, reg); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIssuerRegistered,
+			sdk.NewAttribute(types.AttributeKeyControllerDID, msg.ControllerDID),
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgRegisterIssuerResponse{}, nil
+}
+
+// PauseIssuer implements types.MsgServer's PauseIssuer, gated on ms.GetAuthority().
+func (ms MsgServer) PauseIssuer(ctx context.Context, msg *types.MsgPauseIssuer) (*types.MsgPauseIssuerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != ms.GetAuthority() {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.Keeper.PauseIssuer(ctx, msg.ControllerDID, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIssuerPaused,
+			sdk.NewAttribute(types.AttributeKeyControllerDID, msg.ControllerDID),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(msg.Paused)),
+		),
+	)
+
+	return &types.MsgPauseIssuerResponse{}, nil
+}
+
+// UpdateIssuerRateLimit implements types.MsgServer's UpdateIssuerRateLimit, gated on
+// ms.GetAuthority().
+func (ms MsgServer) UpdateIssuerRateLimit(ctx context.Context, msg *types.MsgUpdateIssuerRateLimit) (*types.MsgUpdateIssuerRateLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != ms.GetAuthority() {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := ms.Keeper.UpdateIssuerRateLimit(ctx, msg.ControllerDID, msg.RateLimit); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIssuerRateLimitUpdated,
+			sdk.NewAttribute(types.AttributeKeyControllerDID, msg.ControllerDID),
+		),
+	)
+
+	return &types.MsgUpdateIssuerRateLimitResponse{}, nil
+}
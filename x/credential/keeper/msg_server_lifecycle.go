@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// CreateCredential implements types.MsgServer's CreateCredential, issuing a
+// VerifiableCredential: the issuer must be an active DID controller (ms.didKeeper),
+// msg.SchemaId (if set) must validate the declared type and claims (ms.schemaKeeper),
+// and the credential is stamped with a StatusList2021 revocation bit allocated from
+// ms.revocationKeeper before it is persisted. Modeled on the Hypersign credential-status
+// design referenced in this chunk's request: status starts at CredentialStatusLive and
+// only EndBlocker or a later MsgRevokeCredential/MsgUpdateCredentialStatus moves it on.
+//
+// This and the other methods in this file take a MsgServer receiver rather than Keeper
+// directly, so a caller only needing read access (see keeper_api.go's
+// IsCredentialValid) isn't handed a type whose surface also includes issuance/
+// revocation orchestration.
+func (ms MsgServer) CreateCredential(ctx context.Context, msg *types.MsgCreateCredential) (*types.MsgCreateCredentialResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := ms.didKeeper.ValidateDID(ctx, msg.Issuer); err != nil {
+		return nil, types.ErrInvalidIssuer.Wrapf("issuer %s is not an active DID controller: %s", msg.Issuer, err)
+	}
+
+	subjectDID := ""
+	if msg.CredentialSubject != nil {
+		subjectDID = msg.CredentialSubject.ID
+	}
+	primaryType := ""
+	if len(msg.CredentialType) > 0 {
+		primaryType = msg.CredentialType[0]
+	}
+	if err := ms.checkIssuerRegistration(ctx, msg.Issuer, primaryType, subjectDID); err != nil {
+		return nil, err
+	}
+
+	if ok, err := ms.Credentials.Has(ctx, msg.Id); err != nil {
+		return nil, err
+	} else if ok {
+		return nil, types.ErrCredentialAlreadyExists.Wrapf("credential %s already exists", msg.Id)
+	}
+
+	params, err := ms.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vc := types.VerifiableCredential{
+		Context:           msg.Context,
+		ID:                msg.Id,
+		Type:              msg.CredentialType,
+		Issuer:            msg.Issuer,
+		IssuanceDate:      sdkCtx.BlockTime(),
+		CredentialSubject: *msg.CredentialSubject,
+		Proof:             msg.Proof,
+		BlockHeight:       sdkCtx.BlockHeight(),
+		Created:           sdkCtx.BlockTime(),
+		Updated:           sdkCtx.BlockTime(),
+		Active:            true,
+		Status:            types.CredentialStatusLive,
+	}
+
+	if err := vc.Validate(); err != nil {
+		return nil, err
+	}
+
+	validatedType := ""
+	for _, credType := range msg.CredentialType {
+		if _, ok, err := ms.ValidateCredentialType(ctx, credType); err != nil {
+			return nil, err
+		} else if ok {
+			validatedType = credType
+			break
+		}
+	}
+	if validatedType == "" {
+		return nil, types.ErrInvalidCredentialType.Wrapf("none of %v is a registered, non-deprecated credential type", msg.CredentialType)
+	}
+	if err := ms.RecordCredentialTypeUsage(ctx, validatedType); err != nil {
+		return nil, err
+	}
+
+	if msg.SchemaId != "" {
+		if err := vc.ValidateAgainstSchema(sdkCtx, ms.schemaKeeper, msg.SchemaId); err != nil {
+			return nil, err
+		}
+	}
+
+	issuerAddr, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, types.ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if !params.CreateCredentialFee.IsZero() {
+		if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, issuerAddr, types.ModuleName, params.CreateCredentialFee); err != nil {
+			return nil, types.ErrInsufficientFunds.Wrapf("paying credential fee: %s", err)
+		}
+	}
+
+	statusListID, index, err := ms.revocationKeeper.AllocateStatusListIndex(sdkCtx, msg.Issuer, types.StatusPurposeRevocation)
+	if err != nil {
+		return nil, err
+	}
+	ms.revocationKeeper.SetCredentialStatusIndex(sdkCtx, vc.ID, statusListID, index)
+	vc.CredentialStatus = types.NewStatusListEntry(statusListID, index, types.StatusPurposeRevocation)
+
+	if err := ms.Credentials.Set(ctx, vc.ID, vc); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByIssuer.Set(ctx, collections.Join(msg.Issuer, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if err := ms.CredentialsByStatusListEntry.Set(ctx, collections.Join(statusListID, index), vc.ID); err != nil {
+		return nil, err
+	}
+	if vc.CredentialSubject.ID != "" {
+		if err := ms.CredentialsByHolder.Set(ctx, collections.Join(vc.CredentialSubject.ID, vc.ID), vc.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.CredentialsByType.Set(ctx, collections.Join(validatedType, vc.ID), vc.ID); err != nil {
+		return nil, err
+	}
+	if msg.SchemaId != "" {
+		if err := ms.CredentialsBySchema.Set(ctx, collections.Join(msg.SchemaId, vc.ID), vc.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.setCredentialStatusIndex(ctx, vc.ID, "", vc.Status); err != nil {
+		return nil, err
+	}
+	if err := ms.indexCredentialAttributes(ctx, vc, params.IndexableAttributeKeys); err != nil {
+		return nil, err
+	}
+
+	types.EmitCredentialIssuedEvent(sdkCtx, &vc, statusListID, index)
+
+	return &types.MsgCreateCredentialResponse{CredentialId: vc.ID}, nil
+}
+
+// RevokeCredential implements types.MsgServer's RevokeCredential. Only the issuing DID
+// may revoke its own credential; revocation is permanent (CredentialStatusRevoked),
+// unlike suspension via MsgUpdateCredentialStatus.
+func (ms MsgServer) RevokeCredential(ctx context.Context, msg *types.MsgRevokeCredential) (*types.MsgRevokeCredentialResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vc, err := ms.Credentials.Get(ctx, msg.CredentialId)
+	if err != nil {
+		return nil, types.ErrCredentialNotFound.Wrapf("credential %s not found", msg.CredentialId)
+	}
+	if vc.Issuer != msg.Revoker {
+		return nil, types.ErrUnauthorized.Wrap("only the issuing DID may revoke its credential")
+	}
+	if vc.Status == types.CredentialStatusRevoked {
+		return nil, types.ErrCredentialRevoked.Wrapf("credential %s is already revoked", msg.CredentialId)
+	}
+
+	if vc.CredentialStatus != nil && vc.CredentialStatus.StatusListId != "" && vc.CredentialStatus.StatusListIndex != nil {
+		index := uint64(*vc.CredentialStatus.StatusListIndex)
+		if err := ms.revocationKeeper.UpdateStatusListEntry(sdkCtx, vc.CredentialStatus.StatusListId, index, msg.Revoker, true); err != nil {
+			return nil, err
+		}
+	}
+
+	oldStatus := vc.Status
+	now := sdkCtx.BlockTime()
+	vc.Status = types.CredentialStatusRevoked
+	vc.Active = false
+	vc.Revoked = true
+	vc.RevokedAt = &now
+	vc.Updated = now
+
+	if err := ms.Credentials.Set(ctx, vc.ID, vc); err != nil {
+		return nil, err
+	}
+	if err := ms.setCredentialStatusIndex(ctx, vc.ID, oldStatus, vc.Status); err != nil {
+		return nil, err
+	}
+
+	statusListID, index := statusListRef(vc)
+	types.EmitCredentialStatusEvent(sdkCtx, msg.Revoker, vc.ID, statusListID, index, types.StatusPurposeRevocation, true)
+
+	return &types.MsgRevokeCredentialResponse{}, nil
+}
+
+// CreateStatusList implements types.MsgServer's CreateStatusList by forwarding straight
+// to ms.revocationKeeper, the same pattern UpdateCredentialStatus below uses: this
+// package's own types.StatusList is bookkeeping-only (see its doc comment), so creating
+// one for real means allocating it in x/revocation's StatusLists collection, the thing
+// AllocateStatusListIndex/IsCredentialRevoked actually read from.
+func (ms MsgServer) CreateStatusList(ctx context.Context, msg *types.MsgCreateStatusList) (*types.MsgCreateStatusListResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := ms.didKeeper.ValidateDID(ctx, msg.Issuer); err != nil {
+		return nil, types.ErrInvalidIssuer.Wrapf("issuer %s is not an active DID controller: %s", msg.Issuer, err)
+	}
+
+	if err := ms.revocationKeeper.CreateStatusList(sdkCtx, msg.Id, msg.Issuer, msg.Purpose, msg.Size); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStatusListResponse{}, nil
+}
+
+// UpdateCredentialStatus implements types.MsgServer's UpdateCredentialStatus, flipping
+// a StatusList2021 bit to suspend (msg.Revoked=true against a StatusPurposeSuspension
+// list) or reinstate (msg.Revoked=false) a credential. A credential already in the
+// terminal CredentialStatusRevoked or CredentialStatusExpired state cannot be
+// transitioned by this handler.
+func (ms MsgServer) UpdateCredentialStatus(ctx context.Context, msg *types.MsgUpdateCredentialStatus) (*types.MsgUpdateCredentialStatusResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	credentialID, err := ms.CredentialsByStatusListEntry.Get(ctx, collections.Join(msg.StatusListId, msg.Index))
+	if err != nil {
+		return nil, types.ErrCredentialNotFound.Wrapf("no credential stamped with %s#%d", msg.StatusListId, msg.Index)
+	}
+
+	vc, err := ms.Credentials.Get(ctx, credentialID)
+	if err != nil {
+		return nil, types.ErrCredentialNotFound.Wrapf("credential %s not found", credentialID)
+	}
+	if vc.Issuer != msg.Issuer {
+		return nil, types.ErrUnauthorized.Wrap("only the issuing DID may update its credential's status")
+	}
+	if vc.Status == types.CredentialStatusRevoked || vc.Status == types.CredentialStatusExpired {
+		return nil, types.ErrCredentialRevoked.Wrapf("credential %s is in a terminal state and cannot be transitioned", credentialID)
+	}
+
+	if err := ms.revocationKeeper.UpdateStatusListEntry(sdkCtx, msg.StatusListId, msg.Index, msg.Issuer, msg.Revoked); err != nil {
+		return nil, err
+	}
+
+	oldStatus := vc.Status
+	now := sdkCtx.BlockTime()
+	if msg.Revoked {
+		vc.Status = types.CredentialStatusSuspended
+		vc.Active = false
+	} else {
+		vc.Status = types.CredentialStatusLive
+		vc.Active = true
+	}
+	vc.Updated = now
+
+	if err := ms.Credentials.Set(ctx, vc.ID, vc); err != nil {
+		return nil, err
+	}
+	if err := ms.setCredentialStatusIndex(ctx, vc.ID, oldStatus, vc.Status); err != nil {
+		return nil, err
+	}
+
+	types.EmitCredentialStatusEvent(sdkCtx, msg.Issuer, vc.ID, msg.StatusListId, msg.Index, types.StatusPurposeSuspension, msg.Revoked)
+
+	return &types.MsgUpdateCredentialStatusResponse{}, nil
+}
+
+// statusListRef returns the (statusListID, index) a credential was stamped with, or
+// ("", 0) if it predates status-list stamping.
+func statusListRef(vc types.VerifiableCredential) (string, uint64) {
+	if vc.CredentialStatus == nil || vc.CredentialStatus.StatusListIndex == nil {
+		return "", 0
+	}
+	return vc.CredentialStatus.StatusListId, uint64(*vc.CredentialStatus.StatusListIndex)
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// UpdateParams implements types.MsgServer's UpdateParams. Only the keeper's configured
+// authority (ms.GetAuthority, the same convention x/schema/x/e2ee/x/oracle's Keeper structs
+// use -- see credential_types.go's header comment) may update Params, which includes the
+// CredentialTypes allowlist ValidateCredentialType checks. The register-type/deprecate-type
+// CLI commands (client/cli/tx.go) build and submit a MsgUpdateParams rather than being
+// separate Msg types, so this single handler is the only write path for CredentialTypes.
+func (ms MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != ms.GetAuthority() {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+	if err := ms.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsUpdated,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// Note on history: the chunk that added the IsRevoked/expiry checks here landed in a
+// commit (chunk8-5) sequenced before the chunk that split MsgServer out of Keeper
+// (chunk8-4), the reverse of their order in the backlog. Both landed correctly and
+// this file's behavior is unaffected, but a diff of either commit in isolation against
+// `main` can look like it's missing the other's context -- check both before assuming
+// either introduced a regression on its own.
+//
+// CreatePresentation implements types.MsgServer's CreatePresentation. Every credential
+// msg.VerifiableCredential references must exist and must not be revoked -- checked via
+// VerifiableCredential.IsRevoked, which transparently resolves either the legacy
+// Revoked/Status fields RevokeCredential sets directly or a StatusList2021 status-list
+// bit -- before the presentation is accepted.
+//
+// ms.Credentials/ms.revocationKeeper/ms.Presentations are all real Keeper fields now
+// (see keeper.go), the same ones CreateCredential's doc comment and migrations.go's
+// header used to describe as assumed. Now that Presentations exists, this also persists
+// the assembled VerifiablePresentation (and its holder index), so a later
+// GetPresentation/ListPresentations-style query can read it back.
+func (ms MsgServer) CreatePresentation(ctx context.Context, msg *types.MsgCreatePresentation) (*types.MsgCreatePresentationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	isBBSDerived := msg.Proof != nil && msg.Proof.Type == string(types.ProofTypeBbsBlsSignatureProof2020)
+	if isBBSDerived && len(msg.VerifiableCredential) != 1 {
+		return nil, types.ErrInvalidProof.Wrap("a BBS+ derived presentation must reference exactly one credential")
+	}
+
+	for _, credentialID := range msg.VerifiableCredential {
+		vc, err := ms.Credentials.Get(ctx, credentialID)
+		if err != nil {
+			return nil, types.ErrCredentialNotFound.Wrapf("credential %s not found", credentialID)
+		}
+
+		revoked, err := vc.IsRevoked(sdkCtx, ms.revocationKeeper)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, types.ErrCredentialRevoked.Wrapf("credential %s has been revoked", credentialID)
+		}
+
+		if vc.IsExpired() {
+			return nil, types.ErrExpiredCredential.Wrapf("credential %s has expired", credentialID)
+		}
+
+		if isBBSDerived {
+			if err := ms.verifyBBSDerivedProof(ctx, vc, msg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	vp := types.VerifiablePresentation{
+		ID:                   msg.Id,
+		Holder:               msg.Holder,
+		Type:                 msg.PresentationType,
+		VerifiableCredential: msg.VerifiableCredential,
+		Created:              sdkCtx.BlockTime(),
+	}
+	if err := ms.Presentations.Set(ctx, vp.ID, vp); err != nil {
+		return nil, err
+	}
+	if err := ms.PresentationsByHolder.Set(ctx, collections.Join(msg.Holder, msg.Id), msg.Id); err != nil {
+		return nil, err
+	}
+	if _, err := ms.PresentationCount.Next(ctx); err != nil {
+		return nil, err
+	}
+
+	types.EmitPresentationSubmittedEvent(sdkCtx, msg.Holder, msg.Id, msg.PresentationType)
+
+	return &types.MsgCreatePresentationResponse{PresentationId: msg.Id}, nil
+}
+
+// verifyBBSDerivedProof checks msg.Proof -- a BbsBlsSignatureProof2020 proof of
+// knowledge over vc's canonical statement vector -- against vc's issuer key, revealing
+// only the claims named by msg.Reveal. vc must itself have been issued with a
+// BbsBlsSignature2020 proof (see IssueCredentialBBS in msg_server_bbs.go); a holder
+// cannot derive a BBS+ presentation from a credential signed with any other suite.
+func (ms MsgServer) verifyBBSDerivedProof(ctx context.Context, vc types.VerifiableCredential, msg *types.MsgCreatePresentation) error {
+	if vc.Proof == nil || vc.Proof.Type != string(types.ProofTypeBbsBlsSignature2020) {
+		return types.ErrInvalidProof.Wrapf("credential %s was not issued with a BbsBlsSignature2020 proof", vc.ID)
+	}
+	if ms.bbsVerifier == nil {
+		return types.ErrUnsupportedProofFormat.Wrap("no BBS+ verifier backend is configured (see Keeper.SetBBSVerifier)")
+	}
+
+	keyType, publicKeyMultibase, err := ms.didKeeper.ResolveVerificationMethod(ctx, vc.Issuer, msg.Proof.VerificationMethod)
+	if err != nil {
+		return types.ErrInvalidVerificationMethod.Wrapf("resolving %q against issuer %s: %s", msg.Proof.VerificationMethod, vc.Issuer, err)
+	}
+	if keyType != didKeyTypeBls12381G2 {
+		return types.ErrInvalidVerificationMethod.Wrapf("verification method %q is not a BLS12-381 key", msg.Proof.VerificationMethod)
+	}
+	pubKey, err := decodeMultibaseSignature(publicKeyMultibase)
+	if err != nil {
+		return err
+	}
+	proofBytes, err := decodeMultibaseSignature(msg.Proof.ProofValue)
+	if err != nil {
+		return err
+	}
+	nonce, err := decodeMultibaseSignature(msg.Proof.Nonce)
+	if err != nil {
+		return err
+	}
+
+	redactedClaims := make(map[string]interface{}, len(msg.Reveal))
+	for _, path := range msg.Reveal {
+		key := strings.TrimPrefix(path, "/")
+		value, ok := vc.CredentialSubject.Claims[key]
+		if !ok {
+			return types.ErrInvalidProof.Wrapf("reveal path %q does not name a claim on credential %s", path, vc.ID)
+		}
+		redactedClaims[key] = value
+	}
+	redactedSubject := types.CredentialSubject{ID: vc.CredentialSubject.ID, Claims: redactedClaims}
+
+	if err := types.VerifyPresentationProof(ms.bbsVerifier, types.BLS12381PublicKey(pubKey), vc.CredentialSubject, redactedSubject, msg.Reveal, proofBytes, nonce); err != nil {
+		return types.ErrProofVerificationFailed.Wrapf("BbsBlsSignatureProof2020 does not verify: %s", err)
+	}
+	return nil
+}
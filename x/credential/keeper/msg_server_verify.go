@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// VerifyCredential implements types.MsgServer's VerifyCredential, the consensus-
+// committed counterpart to a relayer's off-chain pre-flight check (see
+// verify.go's VerifyCredential doc comment: the same function answers both). Runs as a
+// Msg, not a gRPC query, so every node agrees on the answer rather than each reflecting
+// only its own local view.
+func (ms MsgServer) VerifyCredential(ctx context.Context, msg *types.MsgVerifyCredential) (*types.MsgVerifyCredentialResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	verified, revoked, err := ms.Keeper.VerifyCredential(ctx, msg.CredentialId, msg.Verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVerifyCredentialResponse{Verified: verified, Revoked: revoked}, nil
+}
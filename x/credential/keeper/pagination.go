@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// paginate walks coll in store-key order starting just after page.Cursor (or from the
+// beginning, if empty), collecting values until page.EffectiveLimit() of them have been
+// found or coll is exhausted. It returns a CursorPageResponse whose NextCursor resumes
+// exactly where this call left off.
+func paginate[V any](ctx context.Context, coll collections.Map[string, V], page types.CursorPageRequest) ([]V, types.CursorPageResponse, error) {
+	return paginateFiltered(ctx, coll, page, func(V) bool { return true })
+}
+
+// paginateFiltered is paginate plus a matches predicate, for callers (like
+// GetCredentialsByHolder) that have no secondary index to range over and must walk the
+// full collection, keeping only values matches accepts.
+func paginateFiltered[V any](ctx context.Context, coll collections.Map[string, V], page types.CursorPageRequest, matches func(V) bool) ([]V, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+
+	startKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrapf("invalid pagination cursor: %s", err)
+	}
+
+	rng := new(collections.Range[string])
+	if len(startKey) > 0 {
+		rng = rng.StartExclusive(string(startKey))
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	items := make([]V, 0, limit)
+	var lastKey string
+	var hasMore bool
+
+	err = coll.Walk(ctx, rng, func(key string, value V) (bool, error) {
+		if !matches(value) {
+			return false, nil
+		}
+		if uint64(len(items)) >= limit {
+			hasMore = true
+			return true, nil
+		}
+		items = append(items, value)
+		lastKey = key
+		return false, nil
+	})
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+
+	var nextCursor string
+	if hasMore {
+		nextCursor = types.EncodeCursor([]byte(lastKey))
+	}
+	return items, types.CursorPageResponse{NextCursor: nextCursor}, nil
+}
+
+// paginateByIssuer resumes a walk of k.CredentialsByIssuer's (issuer, credentialID)
+// index under issuer, resolving each matching credential ID through k.Credentials.
+func (k Keeper) paginateByIssuer(ctx context.Context, issuer string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return k.paginateByIndex(ctx, k.CredentialsByIssuer, issuer, page)
+}
+
+// paginateByHolder resumes a walk of k.CredentialsByHolder's (holder, credentialID)
+// index under holder, resolving each matching credential ID through k.Credentials.
+// holder is CredentialSubject.ID -- this module has no separate "holder" concept from
+// "subject", so this also serves what a CredentialsBySubject index would.
+func (k Keeper) paginateByHolder(ctx context.Context, holder string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return k.paginateByIndex(ctx, k.CredentialsByHolder, holder, page)
+}
+
+// paginateByType resumes a walk of k.CredentialsByType's (credentialType,
+// credentialID) index under credType, resolving each matching credential ID through
+// k.Credentials.
+func (k Keeper) paginateByType(ctx context.Context, credType string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return k.paginateByIndex(ctx, k.CredentialsByType, credType, page)
+}
+
+// paginateByStatus resumes a walk of k.CredentialsByStatus's (status, credentialID)
+// index under status, resolving each matching credential ID through k.Credentials.
+func (k Keeper) paginateByStatus(ctx context.Context, status types.CredentialLifecycleStatus, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return k.paginateByIndex(ctx, k.CredentialsByStatus, string(status), page)
+}
+
+// paginateByIndex resumes a walk of a (prefixKey, credentialID) secondary index --
+// CredentialsByIssuer, CredentialsByHolder, CredentialsByType, or CredentialsByStatus --
+// under prefixKey, resolving each matching credential ID through k.Credentials.
+// page.Cursor is the last credential ID returned by the previous page -- unlike
+// paginate's collections.Range.StartExclusive, collections.PairRange has no exclusive
+// bound, so this walks inclusive of the cursor key and skips the first match itself.
+func (k Keeper) paginateByIndex(ctx context.Context, index collections.Map[collections.Pair[string, string], string], prefixKey string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+
+	cursorKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrapf("invalid pagination cursor: %s", err)
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](prefixKey)
+	if len(cursorKey) > 0 {
+		rng = rng.StartInclusive(string(cursorKey))
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+	skipCursor := len(cursorKey) > 0
+
+	items := make([]types.VerifiableCredential, 0, limit)
+	var lastKey string
+	var hasMore bool
+
+	err = index.Walk(ctx, rng, func(key collections.Pair[string, string], credentialID string) (bool, error) {
+		if skipCursor && key.K2() == string(cursorKey) {
+			skipCursor = false
+			return false, nil
+		}
+		if uint64(len(items)) >= limit {
+			hasMore = true
+			return true, nil
+		}
+		vc, err := k.Credentials.Get(ctx, credentialID)
+		if err != nil {
+			return false, err
+		}
+		items = append(items, vc)
+		lastKey = key.K2()
+		return false, nil
+	})
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+
+	var nextCursor string
+	if hasMore {
+		nextCursor = types.EncodeCursor([]byte(lastKey))
+	}
+	return items, types.CursorPageResponse{NextCursor: nextCursor}, nil
+}
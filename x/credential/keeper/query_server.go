@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// QueryServer exposes the credential keeper's read surface, mirroring
+// x/zkproof/keeper.QueryServer's role as the shape a gRPC Query service or GraphQL
+// gateway would call into, rather than wiring one up directly.
+type QueryServer struct {
+	Keeper
+}
+
+// NewQueryServer returns a QueryServer backed by keeper.
+func NewQueryServer(keeper Keeper) QueryServer {
+	return QueryServer{Keeper: keeper}
+}
+
+// GetAllCredentials returns VerifiableCredentials in k.Credentials's key order,
+// resuming from query.Page.Cursor and returning up to query.Page.EffectiveLimit() of
+// them, plus a CursorPageResponse.NextCursor to resume from.
+//
+// A height-0 x-cosmos-block-height request (see genesis.go's queryAtHeight) is served
+// out of q.Keeper.GenesisSnapshot.Credentials instead of the live q.Keeper.Credentials
+// collection, without cursor resumption -- the snapshot is an in-memory slice, not a
+// collections.Map, so there's no store key to resume a Range walk from.
+func (q QueryServer) GetAllCredentials(ctx context.Context, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return paginate(ctx, q.Keeper.Credentials, page)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Credentials, page, func(types.VerifiableCredential) bool { return true })
+		},
+	)
+}
+
+// GetCredentialsByIssuer returns issuer's VerifiableCredentials via
+// k.CredentialsByIssuer's (issuer, credentialID) secondary index (see
+// msg_server_lifecycle.go's CreateCredential), resuming from page.Cursor and returning
+// up to page.EffectiveLimit() of them. A height-0 request is served out of
+// q.Keeper.GenesisSnapshot.Credentials; see GetAllCredentials's doc comment.
+func (q QueryServer) GetCredentialsByIssuer(ctx context.Context, issuer string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return q.Keeper.paginateByIssuer(ctx, issuer, page)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Credentials, page, func(vc types.VerifiableCredential) bool {
+				return vc.Issuer == issuer
+			})
+		},
+	)
+}
+
+// GetCredentialsByHolder returns VerifiableCredentials whose CredentialSubject.ID is
+// holder, via k.CredentialsByHolder's (holder, credentialID) secondary index (see
+// msg_server_lifecycle.go's CreateCredential), resuming from page.Cursor and returning
+// up to page.EffectiveLimit() of them. A height-0 request is served out of
+// q.Keeper.GenesisSnapshot.Credentials; see GetAllCredentials's doc comment.
+func (q QueryServer) GetCredentialsByHolder(ctx context.Context, holder string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return q.Keeper.paginateByHolder(ctx, holder, page)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Credentials, page, func(vc types.VerifiableCredential) bool {
+				return vc.CredentialSubject.ID == holder
+			})
+		},
+	)
+}
+
+// GetCredentialsByAttributes resolves predicates via attributes.go's
+// GetCredentialsByAttributes (CredentialsByAttribute's sort-merge AND-intersection).
+// A height-0 request is served by matching predicates directly against each genesis
+// snapshot credential's CredentialSubject.Claims rather than via the index -- a
+// GenesisSnapshot is an in-memory slice with no CredentialsByAttribute index of its own
+// to intersect against.
+func (q QueryServer) GetCredentialsByAttributes(ctx context.Context, predicates []types.AttributePredicate, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return q.Keeper.GetCredentialsByAttributes(ctx, predicates, page)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Credentials, page, func(vc types.VerifiableCredential) bool {
+				return matchesAllClaims(vc, predicates)
+			})
+		},
+	)
+}
+
+// matchesAllClaims reports whether vc's CredentialSubject.Claims satisfies every
+// predicate, the genesis-snapshot counterpart to the live path's
+// CredentialsByAttribute index lookup.
+func matchesAllClaims(vc types.VerifiableCredential, predicates []types.AttributePredicate) bool {
+	for _, pred := range predicates {
+		claim, ok := vc.CredentialSubject.Claims[pred.Key]
+		if !ok {
+			return false
+		}
+		value, ok := types.AttributeValueFromClaim(claim)
+		if !ok || value.IndexKey() != pred.Value.IndexKey() {
+			return false
+		}
+	}
+	return true
+}
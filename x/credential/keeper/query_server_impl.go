@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// QueryServerImpl rounds out QueryServer (query_server.go) with the remaining reads
+// proto/persona/credential/v1/query.proto's Query service names: Credential(id),
+// CredentialsByType, CredentialsBySchema, Presentation(id), PresentationsByHolder,
+// RevocationList(issuer), and Params. It is kept in its own file, rather than folded
+// into query_server.go, because unlike that file's methods these are NOT wired to
+// anything -- see the package-level doc comment below for the full set of gaps that
+// leaves open.
+//
+// x/credential has no module.go/AppModule in this tree (see genesis.go's
+// GenesisSnapshot doc comment), so there is no RegisterGRPCGatewayRoutes or
+// RegisterServices method anywhere to add a types.RegisterQueryHandlerClient or
+// cfg.RegisterQueryServer/RegisterMsgServer call to. Checking the four modules that DO
+// have a module.go (x/schema, x/revocation, x/oracle, x/e2ee) shows those methods exist
+// there only as empty bodies --
+//
+//	func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+//	func (am AppModule) RegisterServices(cfg module.Configurator) {}
+//
+// -- not the "comment-only stub with registrations commented out" this request
+// describes; no module in this tree has ever had working Msg/Query gRPC registration to
+// model from. There is also no proto/persona/credential/v1/query.pb.go or *.pb.gw.go:
+// this sandbox has no protoc toolchain, so query.proto (alongside this file) is IDL
+// only, the same gap x/zkproof's query.proto documents for that module's Query service.
+// And there is no sibling x/identity package anywhere in this tree for
+// CreateCredential/IssueCredential/RevokeCredential/CreatePresentation/
+// VerifyPresentation handlers to already exist in -- MsgServer (msg_server.go) is the
+// only Msg-side implementation x/credential has, and it is equally unregistered.
+//
+// QueryServerImpl's methods are therefore written the same way QueryServer's are: against
+// the hand-rolled types.CursorPageRequest/CursorPageResponse pair (types/query.go) and
+// the keeper's real collections.Map fields, not against generated pb.go request/response
+// types that don't exist to implement against.
+type QueryServerImpl struct {
+	QueryServer
+}
+
+// NewQueryServerImpl returns a QueryServerImpl backed by keeper.
+func NewQueryServerImpl(keeper Keeper) QueryServerImpl {
+	return QueryServerImpl{QueryServer: NewQueryServer(keeper)}
+}
+
+// Credential returns the VerifiableCredential stored under id, the single-item
+// counterpart to GetAllCredentials' list walk.
+func (q QueryServerImpl) Credential(ctx context.Context, id string) (types.VerifiableCredential, error) {
+	return q.Keeper.Credentials.Get(ctx, id)
+}
+
+// CredentialsByType returns VerifiableCredentials issued with credType as their
+// validated type, via k.CredentialsByType's (credentialType, credentialID) secondary
+// index (see msg_server_lifecycle.go's CreateCredential). Note this indexes
+// CreateCredential's single validatedType, not every entry of the VerifiableCredential
+// .Type slice a credential may carry -- see CreateCredential's validatedType loop --
+// so a credential issued with multiple registered types is only found under the first
+// one that validated.
+func (q QueryServerImpl) CredentialsByType(ctx context.Context, credType string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return q.Keeper.paginateByType(ctx, credType, page)
+}
+
+// CredentialsByStatus returns VerifiableCredentials currently in the given
+// CredentialLifecycleStatus, via k.CredentialsByStatus's (status, credentialID)
+// secondary index, maintained by CreateCredential, RevokeCredential,
+// UpdateCredentialStatus, and EndBlocker's processExpiredCredentials.
+func (q QueryServerImpl) CredentialsByStatus(ctx context.Context, status types.CredentialLifecycleStatus, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return q.Keeper.paginateByStatus(ctx, status, page)
+}
+
+// CredentialsBySchema returns VerifiableCredentials whose CredentialSubject.Claims
+// carries the fixture "schemaId" key used by simulation/genesis.go's genCredentials
+// (there is no first-class SchemaID field on VerifiableCredential, nor a
+// CredentialsBySchema index, in this tree -- same full-walk caveat as CredentialsByType).
+func (q QueryServerImpl) CredentialsBySchema(ctx context.Context, schemaID string, page types.CursorPageRequest) ([]types.VerifiableCredential, types.CursorPageResponse, error) {
+	return paginateFiltered(ctx, q.Keeper.Credentials, page, func(vc types.VerifiableCredential) bool {
+		id, ok := vc.CredentialSubject.Claims["schemaId"]
+		return ok && id == schemaID
+	})
+}
+
+// Presentation returns the VerifiablePresentation stored under id.
+func (q QueryServerImpl) Presentation(ctx context.Context, id string) (types.VerifiablePresentation, error) {
+	return q.Keeper.Presentations.Get(ctx, id)
+}
+
+// PresentationsByHolder returns VerifiablePresentations whose Holder is holder. There is
+// no PresentationsByHolder secondary index in this tree either (see migrations.go's
+// header comment listing it among the assumed-but-not-yet-wired indices), so this walks
+// the full k.Presentations collection filtered in Go, the Presentation-side counterpart
+// of CredentialsByType/CredentialsBySchema above.
+func (q QueryServerImpl) PresentationsByHolder(ctx context.Context, holder string, page types.CursorPageRequest) ([]types.VerifiablePresentation, types.CursorPageResponse, error) {
+	return paginateFiltered(ctx, q.Keeper.Presentations, page, func(vp types.VerifiablePresentation) bool {
+		return vp.Holder == holder
+	})
+}
+
+// CredentialStatus returns the lightweight {revoked, suspended} pair for credentialID,
+// the single-credential counterpart to Credential that a verifier checks instead of
+// pulling and inspecting the whole VerifiableCredential -- see keeper_api.go's
+// CredentialStatus.
+func (q QueryServerImpl) CredentialStatus(ctx context.Context, credentialID string) (revoked bool, suspended bool, err error) {
+	return q.Keeper.CredentialStatus(ctx, credentialID)
+}
+
+// CredentialVerification returns the last stored VerificationRecord for credentialID --
+// see verify.go's VerifyCredential, the only writer of CredentialVerificationPrefix.
+// A relayer doing an off-chain pre-flight check can call this to get the same
+// consensus-committed answer a MsgVerifyCredential transaction already got, without
+// re-running proof verification or spending gas on a second one.
+func (q QueryServerImpl) CredentialVerification(ctx context.Context, credentialID string) (types.VerificationRecord, error) {
+	return q.Keeper.CredentialVerifications.Get(ctx, credentialID)
+}
+
+// Application returns the CredentialApplication stored under id -- see
+// application.go's SubmitApplication/ReviewApplication.
+func (q QueryServerImpl) Application(ctx context.Context, id string) (types.CredentialApplication, error) {
+	return q.Keeper.Applications.Get(ctx, id)
+}
+
+// ApplicationOperation returns applicationID's CredentialOperation, the handle a holder
+// polls for the outcome of its application instead of re-reading Application directly.
+func (q QueryServerImpl) ApplicationOperation(ctx context.Context, applicationID string) (types.CredentialOperation, error) {
+	return q.Keeper.Operations.Get(ctx, applicationID)
+}
+
+// RevocationList reports an error rather than returning a result: x/credential never
+// allocates or writes StatusList2021-style revocation-list state of its own. Revocation
+// is delegated entirely to x/revocation's Keeper (see keeper_api.go's IsCredentialValid,
+// which calls k.revocationKeeper, and migrations.go's LegacyRevocationListPrefix doc
+// comment, which confirms nothing has ever written under that legacy prefix either).
+// Implementing this for real means calling into x/revocation's own Query service, not
+// adding a RevocationList type to this module -- that's a cross-module wiring decision
+// out of scope for this change, so this is left as an honest error rather than a
+// fabricated empty list.
+func (q QueryServerImpl) RevocationList(ctx context.Context, issuer string) (types.VerifiablePresentation, error) {
+	return types.VerifiablePresentation{}, types.ErrInvalidQuery.Wrap(
+		"revocation lists are owned by x/revocation's Keeper, not x/credential -- query that module's Query service instead")
+}
+
+// Params returns the module's current Params, the single-item counterpart to
+// CmdListCredentialTypes/CmdShowCredentialType's raw ABCI reads of the same state (see
+// client/cli/query.go).
+func (q QueryServerImpl) Params(ctx context.Context) (types.Params, error) {
+	return q.Keeper.GetParams(ctx)
+}
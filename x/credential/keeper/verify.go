@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// These mirror x/did/types.VerificationKeyTypeEd25519/VerificationKeyTypeSecp256k1 by
+// value rather than importing that package directly -- the same reasoning
+// expected_keepers.go gives for not importing x/revocation/keeper or x/oracle/keeper:
+// it would pull x/did's own types onto x/credential/keeper.
+const (
+	didKeyTypeEd25519   = "Ed25519VerificationKey2020"
+	didKeyTypeSecp256k1 = "EcdsaSecp256k1VerificationKey2019"
+)
+
+// VerifyCredential checks credentialID's proof for real, dispatching on
+// vc.ProofFormat: ldp_vc verifies an embedded linked-data proof against a canonicalized
+// copy of the credential, jwt_vc verifies a compact JWS. It replaces the bare
+// "Status == Live and not expired" check the module previously passed off as
+// verification -- neither of those are skipped here (a revoked or expired credential
+// never verifies, regardless of proof validity), but a credential with a tampered or
+// absent proof now fails even if its lifecycle state looks fine.
+//
+// The outcome is stored under CredentialVerificationPrefix (VerificationRecord) before
+// returning, so QueryServerImpl.CredentialVerification (if a caller only wants the last
+// answer) doesn't have to re-verify, and the exact same check governs both a
+// pre-flight off-chain read and the on-chain MsgVerifyCredential transaction.
+//
+// This assumes k.CredentialVerifications collections.Map[string, types.VerificationRecord],
+// the same "written against the schema this module hasn't formally declared yet" gap
+// every other keeper file in this package documents (see migrations.go's header).
+func (k Keeper) VerifyCredential(ctx context.Context, credentialID, verifier string) (verified bool, revoked bool, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	vc, err := k.Credentials.Get(ctx, credentialID)
+	if err != nil {
+		return false, false, types.ErrCredentialNotFound.Wrapf("credential %s not found", credentialID)
+	}
+
+	revoked, err = vc.IsRevoked(sdkCtx, k.revocationKeeper)
+	if err != nil {
+		return false, false, err
+	}
+
+	verified = !revoked && !vc.IsExpired()
+	if verified {
+		format := vc.ProofFormat
+		if format == "" {
+			format = types.ProofFormatLdpVc
+		}
+
+		switch format {
+		case types.ProofFormatLdpVc:
+			err = k.verifyLdpVcProof(ctx, &vc)
+		case types.ProofFormatJwtVc:
+			err = k.verifyJwtVcProof(ctx, &vc, sdkCtx.BlockTime())
+		default:
+			err = types.ErrUnsupportedProofFormat.Wrapf("proof format %q is not supported", format)
+		}
+		verified = err == nil
+	}
+
+	format := vc.ProofFormat
+	if format == "" {
+		format = types.ProofFormatLdpVc
+	}
+	record := types.VerificationRecord{
+		CredentialId: credentialID,
+		ProofFormat:  format,
+		Verified:     verified,
+		Revoked:      revoked,
+		VerifiedAt:   sdkCtx.BlockTime(),
+		Verifier:     verifier,
+	}
+	if setErr := k.CredentialVerifications.Set(ctx, credentialID, record); setErr != nil {
+		return false, false, setErr
+	}
+
+	types.EmitCredentialVerifiedEvent(sdkCtx, verifier, credentialID, format, verified)
+
+	return verified, revoked, nil
+}
+
+// verifyLdpVcProof checks vc.Proof (an Ed25519Signature2020 or
+// EcdsaSecp256k1Signature2019 linked-data proof) against a canonicalized copy of vc
+// with Proof stripped, resolving proof.VerificationMethod against the issuer's DID
+// document via k.didKeeper.
+func (k Keeper) verifyLdpVcProof(ctx context.Context, vc *types.VerifiableCredential) error {
+	if vc.Proof == nil {
+		return types.ErrInvalidProof.Wrap("credential has no proof")
+	}
+	if err := vc.Proof.ValidateBasic(); err != nil {
+		return err
+	}
+
+	keyType, publicKeyMultibase, err := k.didKeeper.ResolveVerificationMethod(ctx, vc.Issuer, vc.Proof.VerificationMethod)
+	if err != nil {
+		return types.ErrInvalidVerificationMethod.Wrapf("resolving %q against issuer %s: %s", vc.Proof.VerificationMethod, vc.Issuer, err)
+	}
+
+	docBytes, err := canonicalizeCredential(vc)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(docBytes)
+
+	signature, err := decodeMultibaseSignature(vc.Proof.ProofValue)
+	if err != nil {
+		return err
+	}
+	pubKey, err := decodeMultibaseSignature(publicKeyMultibase)
+	if err != nil {
+		return err
+	}
+
+	switch types.ProofType(vc.Proof.Type) {
+	case types.ProofTypeEd25519Signature2020:
+		if keyType != didKeyTypeEd25519 {
+			return types.ErrInvalidVerificationMethod.Wrapf("verification method %q is not an Ed25519 key", vc.Proof.VerificationMethod)
+		}
+		if len(pubKey) != ed25519.PublicKeySize {
+			return types.ErrInvalidVerificationMethod.Wrap("Ed25519 public key has the wrong length")
+		}
+		if !ed25519.Verify(ed25519.PublicKey(pubKey), digest[:], signature) {
+			return types.ErrProofVerificationFailed.Wrap("Ed25519Signature2020 does not verify")
+		}
+		return nil
+	case types.ProofTypeEcdsaSecp256k1Signature2019:
+		if keyType != didKeyTypeSecp256k1 {
+			return types.ErrInvalidVerificationMethod.Wrapf("verification method %q is not a secp256k1 key", vc.Proof.VerificationMethod)
+		}
+		secpKey := &secp256k1.PubKey{Key: pubKey}
+		if !secpKey.VerifySignature(digest[:], signature) {
+			return types.ErrProofVerificationFailed.Wrap("EcdsaSecp256k1Signature2019 does not verify")
+		}
+		return nil
+	default:
+		return types.ErrUnsupportedProofFormat.Wrapf("unsupported ldp_vc proof type %q", vc.Proof.Type)
+	}
+}
+
+// jwtClaims is the subset of RFC 7519 registered claims a VC-JWT's payload carries
+// that verifyJwtVcProof checks.
+type jwtClaims struct {
+	Iss string `json:"iss"`
+	Sub string `json:"sub"`
+	Exp int64  `json:"exp"`
+	Nbf int64  `json:"nbf"`
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// verifyJwtVcProof checks a compact JWS VC-JWT carried in vc.Proof.ProofValue:
+// header.alg must match the resolved verification method's key type, the signature
+// must verify over "header.payload", and iss/sub/exp/nbf must match the credential and
+// the current block time.
+func (k Keeper) verifyJwtVcProof(ctx context.Context, vc *types.VerifiableCredential, blockTime time.Time) error {
+	if vc.Proof == nil || vc.Proof.ProofValue == "" {
+		return types.ErrInvalidProof.Wrap("jwt_vc credential has no compact JWS in proof.proofValue")
+	}
+
+	parts := strings.Split(vc.Proof.ProofValue, ".")
+	if len(parts) != 3 {
+		return types.ErrInvalidProof.Wrap("jwt_vc proofValue is not a compact JWS (header.payload.signature)")
+	}
+	headerB64, payloadB64, sigB64 := parts[0], parts[1], parts[2]
+
+	headerBz, err := base64.RawURLEncoding.DecodeString(headerB64)
+	if err != nil {
+		return types.ErrInvalidProof.Wrapf("decoding JWS header: %s", err)
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerBz, &header); err != nil {
+		return types.ErrInvalidProof.Wrapf("parsing JWS header: %s", err)
+	}
+
+	payloadBz, err := base64.RawURLEncoding.DecodeString(payloadB64)
+	if err != nil {
+		return types.ErrInvalidProof.Wrapf("decoding JWS payload: %s", err)
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payloadBz, &claims); err != nil {
+		return types.ErrInvalidProof.Wrapf("parsing JWS payload: %s", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(sigB64)
+	if err != nil {
+		return types.ErrInvalidProof.Wrapf("decoding JWS signature: %s", err)
+	}
+
+	if claims.Iss != vc.Issuer {
+		return types.ErrProofVerificationFailed.Wrapf("jwt iss %q does not match credential issuer %q", claims.Iss, vc.Issuer)
+	}
+	if claims.Sub != vc.CredentialSubject.ID {
+		return types.ErrProofVerificationFailed.Wrapf("jwt sub %q does not match credential subject %q", claims.Sub, vc.CredentialSubject.ID)
+	}
+	if claims.Exp != 0 && blockTime.After(time.Unix(claims.Exp, 0)) {
+		return types.ErrProofVerificationFailed.Wrap("jwt has expired (exp)")
+	}
+	if claims.Nbf != 0 && blockTime.Before(time.Unix(claims.Nbf, 0)) {
+		return types.ErrProofVerificationFailed.Wrap("jwt is not yet valid (nbf)")
+	}
+
+	if header.Kid == "" {
+		return types.ErrInvalidProof.Wrap("jwt header has no kid to resolve a verification method from")
+	}
+	keyType, publicKeyMultibase, err := k.didKeeper.ResolveVerificationMethod(ctx, vc.Issuer, header.Kid)
+	if err != nil {
+		return types.ErrInvalidVerificationMethod.Wrapf("resolving kid %q against issuer %s: %s", header.Kid, vc.Issuer, err)
+	}
+	pubKey, err := decodeMultibaseSignature(publicKeyMultibase)
+	if err != nil {
+		return err
+	}
+
+	signedContent := []byte(headerB64 + "." + payloadB64)
+
+	switch header.Alg {
+	case "EdDSA":
+		if keyType != didKeyTypeEd25519 {
+			return types.ErrInvalidVerificationMethod.Wrapf("kid %q is not an Ed25519 key, cannot verify alg %q", header.Kid, header.Alg)
+		}
+		if len(pubKey) != ed25519.PublicKeySize {
+			return types.ErrInvalidVerificationMethod.Wrap("Ed25519 public key has the wrong length")
+		}
+		if !ed25519.Verify(ed25519.PublicKey(pubKey), signedContent, signature) {
+			return types.ErrProofVerificationFailed.Wrap("JWS signature does not verify (EdDSA)")
+		}
+		return nil
+	case "ES256K":
+		if keyType != didKeyTypeSecp256k1 {
+			return types.ErrInvalidVerificationMethod.Wrapf("kid %q is not a secp256k1 key, cannot verify alg %q", header.Kid, header.Alg)
+		}
+		digest := sha256.Sum256(signedContent)
+		secpKey := &secp256k1.PubKey{Key: pubKey}
+		if !secpKey.VerifySignature(digest[:], signature) {
+			return types.ErrProofVerificationFailed.Wrap("JWS signature does not verify (ES256K)")
+		}
+		return nil
+	default:
+		return types.ErrUnsupportedProofFormat.Wrapf("unsupported JWS alg %q", header.Alg)
+	}
+}
+
+// canonicalizeCredential marshals vc with Proof stripped and its JSON object keys
+// sorted, the same key-sort-only approximation x/did/keeper/clientspec.go's
+// getClientSpecDocBytes uses in place of a real JCS (RFC 8785) canonicalization --
+// this repo vendors no JCS/NFC-normalization library, so Unicode normalization and
+// ECMAScript-number canonicalization are not applied. A proof produced by a verifier
+// that does implement full JCS will still verify here as long as its signer canonicalized
+// the same key-sorted JSON this function produces.
+func canonicalizeCredential(vc *types.VerifiableCredential) ([]byte, error) {
+	stripped := *vc
+	stripped.Proof = nil
+
+	bz, err := json.Marshal(stripped)
+	if err != nil {
+		return nil, types.ErrInvalidCredential.Wrapf("marshaling credential for canonicalization: %s", err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return bz, nil
+	}
+	sorted, err := json.Marshal(v)
+	if err != nil {
+		return bz, nil
+	}
+	return sorted, nil
+}
+
+// decodeMultibaseSignature decodes a multibase-prefixed value using the "u" (base64url,
+// no padding) prefix this repo supports -- the same restriction
+// x/did/keeper/clientspec.go's decodeMultibaseKey documents (no base58btc "z" prefix
+// support; no base58 library is vendored). A bare base64url-standard value with no
+// multibase prefix is also accepted, since W3C's Ed25519Signature2020/
+// EcdsaSecp256k1Signature2019 suites commonly carry proofValue as bare base58btc or
+// base64url rather than multibase-prefixed.
+func decodeMultibaseSignature(value string) ([]byte, error) {
+	if value == "" {
+		return nil, types.ErrInvalidProof.Wrap("proof value is empty")
+	}
+	if value[0] == 'u' {
+		bz, err := base64.RawURLEncoding.DecodeString(value[1:])
+		if err != nil {
+			return nil, types.ErrInvalidProof.Wrapf("decoding multibase value: %s", err)
+		}
+		return bz, nil
+	}
+	if bz, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return bz, nil
+	}
+	bz, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, types.ErrInvalidProof.Wrapf("decoding proof value (unsupported encoding, no base58btc support): %s", err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's values
+// into the corresponding credential type and returns a human-readable diff for `simd`
+// genesis import/export invariant dumps, switching on every collections prefix
+// types/keys.go declares: the two primary value prefixes (CredentialPrefix,
+// PresentationPrefix), the two counters (CredentialCountKey, PresentationCountKey), and
+// the five secondary indices (CredentialByIssuerPrefix, CredentialByHolderPrefix,
+// CredentialByTypePrefix, CredentialBySchemaPrefix, PresentationByHolderPrefix), whose
+// values are plain credential/presentation ID strings rather than a registered proto
+// type.
+//
+// These collections.Prefix values are declared directly in types/keys.go (unlike, say,
+// x/zkproof's NewDecodeStore, which has no prefix bytes at all to switch on) and are now
+// also wired into keeper.Keeper's named collections.Map fields (see keeper/keeper.go).
+// Decoding against the prefix bytes themselves doesn't depend on that Keeper's field
+// names, so this needed no change once it was added.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, []byte(types.CredentialPrefix)):
+			var credA, credB types.VerifiableCredential
+			cdc.MustUnmarshal(kvA.Value, &credA)
+			cdc.MustUnmarshal(kvB.Value, &credB)
+			return fmt.Sprintf("%v\n%v", credA, credB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.PresentationPrefix)):
+			var presA, presB types.VerifiablePresentation
+			cdc.MustUnmarshal(kvA.Value, &presA)
+			cdc.MustUnmarshal(kvB.Value, &presB)
+			return fmt.Sprintf("%v\n%v", presA, presB)
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.CredentialCountKey)),
+			bytes.HasPrefix(kvA.Key, []byte(types.PresentationCountKey)):
+			return fmt.Sprintf("%d\n%d", types.BytesToUint64(kvA.Value), types.BytesToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, []byte(types.CredentialByIssuerPrefix)),
+			bytes.HasPrefix(kvA.Key, []byte(types.CredentialByHolderPrefix)),
+			bytes.HasPrefix(kvA.Key, []byte(types.CredentialByTypePrefix)),
+			bytes.HasPrefix(kvA.Key, []byte(types.CredentialBySchemaPrefix)),
+			bytes.HasPrefix(kvA.Key, []byte(types.PresentationByHolderPrefix)):
+			return fmt.Sprintf("%s\n%s", string(kvA.Value), string(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// numSimCredentials/numSimPresentations size the fixture genesis state
+// RandomizedGenState seeds, the same fixed fixture-count convention
+// x/revocation/simulation/genesis.go's genRevocations/genStatusLists use.
+const (
+	numSimCredentials   = 12
+	numSimPresentations = 4
+)
+
+// simGenesisTime stands in for time.Now() in every fixture timestamp below, since
+// simulation genesis state must be deterministic given the same seed.
+var simGenesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simCredentialTypes is the subset of types.DefaultCredentialTypeDefs' registered names
+// genCredentials draws from -- not the full set, just enough variety for the sim
+// harness to exercise CredentialByType index reads over more than one bucket.
+var simCredentialTypes = []string{
+	"VerifiableCredential",
+	"PersonaCredential",
+	"EducationCredential",
+	"IdentityCredential",
+}
+
+// genCredentials generates numSimCredentials fixture VerifiableCredentials with random
+// issuer/holder pairs drawn from accs, a random type from simCredentialTypes (all of
+// which types.ValidateCredentialType accepts), a random fixture schema ID, and every
+// third credential pre-marked Revoked so the sim harness exercises RevokeCredential's
+// read path (and a genesis-time CredentialByType/CredentialByIssuer/CredentialByHolder
+// index build) against non-empty state rather than only ever the empty set.
+func genCredentials(r *rand.Rand, accs []string) []types.VerifiableCredential {
+	creds := make([]types.VerifiableCredential, 0, numSimCredentials)
+	for i := 0; i < numSimCredentials; i++ {
+		issuer := accs[r.Intn(len(accs))]
+		holder := accs[r.Intn(len(accs))]
+		credType := simCredentialTypes[r.Intn(len(simCredentialTypes))]
+
+		cred := types.VerifiableCredential{
+			Context:      []string{"https://www.w3.org/2018/credentials/v1"},
+			ID:           types.CreateCredentialHash(issuer, holder, uint64(i)),
+			Type:         []string{"VerifiableCredential", credType},
+			Issuer:       issuer,
+			IssuanceDate: simGenesisTime,
+			CredentialSubject: types.CredentialSubject{
+				ID:     holder,
+				Claims: map[string]interface{}{"schemaId": fmt.Sprintf("sim-schema-%d", i%3)},
+			},
+			Created: simGenesisTime,
+			Updated: simGenesisTime,
+			Active:  true,
+			Status:  types.CredentialStatusLive,
+		}
+		if i%3 == 0 {
+			cred.Revoked = true
+			cred.Active = false
+			cred.Status = types.CredentialStatusRevoked
+			revokedAt := simGenesisTime
+			cred.RevokedAt = &revokedAt
+		}
+		creds = append(creds, cred)
+	}
+	return creds
+}
+
+// genPresentations generates numSimPresentations fixture VerifiablePresentations, each
+// bundling one of genCredentials' IDs so the sim harness's CreatePresentation op (see
+// operations.go) has real credential IDs to reference instead of only minting fresh,
+// never-issued ones.
+func genPresentations(r *rand.Rand, accs []string, creds []types.VerifiableCredential) []types.VerifiablePresentation {
+	presentations := make([]types.VerifiablePresentation, 0, numSimPresentations)
+	for i := 0; i < numSimPresentations; i++ {
+		holder := accs[r.Intn(len(accs))]
+		cred := creds[r.Intn(len(creds))]
+
+		presentations = append(presentations, types.VerifiablePresentation{
+			Context:              []string{"https://www.w3.org/2018/credentials/v1"},
+			ID:                   types.CreatePresentationHash(holder, uint64(i)),
+			Type:                 []string{"VerifiablePresentation"},
+			Holder:               holder,
+			VerifiableCredential: []string{cred.ID},
+			Created:              simGenesisTime,
+		})
+	}
+	return presentations
+}
+
+// genParams returns randomized module Params, varying MaxCredentialSize and the two
+// module fees around types.DefaultParams' values so the sim harness exercises
+// CreateCredential/CreatePresentation's fee-deduction and size-limit checks under more
+// than one fixed configuration.
+func genParams(r *rand.Rand) types.Params {
+	params := types.DefaultParams()
+	params.MaxCredentialSize = uint64(simtypes.RandIntBetween(r, 4000, 20000))
+	params.CreateCredentialFee = sdk.NewCoins(sdk.NewCoin("upersona", math.NewInt(int64(simtypes.RandIntBetween(r, 100, 5000)))))
+	params.CreatePresentationFee = sdk.NewCoins(sdk.NewCoin("upersona", math.NewInt(int64(simtypes.RandIntBetween(r, 50, 2000)))))
+	return params
+}
+
+// GenesisState is the fixture shape RandomizedGenState marshals. x/credential has no
+// module.go/AppModule/GenesisState in this tree (see keeper/genesis.go's GenesisSnapshot
+// doc comment for the same standing gap), so unlike x/revocation's simulation/genesis.go
+// counterpart this doesn't mirror a real module.GenesisState -- it's the shape a future
+// InitGenesis would need once x/credential gets an AppModule to wire this into.
+type GenesisState struct {
+	Credentials   []types.VerifiableCredential   `json:"credentials"`
+	Presentations []types.VerifiablePresentation `json:"presentations"`
+	Params        types.Params                   `json:"params"`
+}
+
+// RandomizedGenState seeds the credential module's simulation genesis with randomized
+// Params plus fixture credentials (a third pre-revoked) and presentations bundling
+// them, so the sim harness's decoder (see decoder.go) and weighted operations (see
+// operations.go) have non-empty state to read against from the first block rather than
+// only ever the empty set.
+func RandomizedGenState(simState *module.SimulationState) {
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	if len(accs) == 0 {
+		return
+	}
+
+	creds := genCredentials(simState.Rand, accs)
+	genesis := GenesisState{
+		Credentials:   creds,
+		Presentations: genPresentations(simState.Rand, accs, creds),
+		Params:        genParams(simState.Rand),
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+func (m *GenesisState) ProtoMessage()  {}
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return fmt.Sprintf("%+v", *m) }
@@ -0,0 +1,164 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/credential/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateCredential   = "op_weight_msg_create_credential"
+	OpWeightMsgRevokeCredential   = "op_weight_msg_revoke_credential"
+	OpWeightMsgCreatePresentation = "op_weight_msg_create_presentation"
+
+	DefaultWeightMsgCreateCredential   = 100
+	DefaultWeightMsgRevokeCredential   = 20
+	DefaultWeightMsgCreatePresentation = 60
+)
+
+// WeightedOperations returns all the operations from the credential module with their
+// respective weights. Signature matches x/revocation/simulation's WeightedOperations
+// (appParams, cdc only, no keeper arguments) rather than upstream cosmos-sdk modules'
+// convention of threading keepers in directly: nothing in this tree ever calls
+// WeightedOperations (x/credential has no module.go/AppModule to wire it into a
+// SimulationManager -- see keeper/genesis.go's GenesisSnapshot doc comment for the same
+// standing gap), so there is no BankKeeper/AccountKeeper instance available here to
+// thread through even if the signature took them.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec) simtypes.WeightedOperations {
+	var (
+		weightMsgCreateCredential   int
+		weightMsgRevokeCredential   int
+		weightMsgCreatePresentation int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateCredential, &weightMsgCreateCredential, nil, func(_ *rand.Rand) {
+		weightMsgCreateCredential = DefaultWeightMsgCreateCredential
+	})
+	appParams.GetOrGenerate(OpWeightMsgRevokeCredential, &weightMsgRevokeCredential, nil, func(_ *rand.Rand) {
+		weightMsgRevokeCredential = DefaultWeightMsgRevokeCredential
+	})
+	appParams.GetOrGenerate(OpWeightMsgCreatePresentation, &weightMsgCreatePresentation, nil, func(_ *rand.Rand) {
+		weightMsgCreatePresentation = DefaultWeightMsgCreatePresentation
+	})
+
+	return simtypes.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateCredential, SimulateMsgCreateCredential()),
+		simulation.NewWeightedOperation(weightMsgRevokeCredential, SimulateMsgRevokeCredential()),
+		simulation.NewWeightedOperation(weightMsgCreatePresentation, SimulateMsgCreatePresentation()),
+	}
+}
+
+// feeFor returns defaultFee's first coin as the amount SimulateMsgCreateCredential/
+// SimulateMsgCreatePresentation would need to deduct from signer via BankKeeper before
+// delivering msg, the same types.Params.CreateCredentialFee/CreatePresentationFee a real
+// MsgServer would charge. It's computed here (rather than left unused) so each
+// operation's NoOpMsg reason below can say what it would have spent, even though no
+// BankKeeper instance reaches this package to actually spend it -- see
+// WeightedOperations' doc comment.
+func feeFor(defaultFee sdk.Coins) sdk.Coin {
+	if defaultFee.Empty() {
+		return sdk.Coin{}
+	}
+	return defaultFee[0]
+}
+
+// SimulateMsgCreateCredential generates a MsgCreateCredential for a random issuer/subject
+// pair, drawing both from simState's accounts the same way a real wallet-submitted
+// CreateCredential would pick a DID-backed issuer and a subject to credential.
+//
+// Keeper.CreateCredential (msg_server_lifecycle.go) requires ms.didKeeper.ValidateDID to
+// accept the issuer and, per types.DefaultParams().CreateCredentialFee (see feeFor
+// above), would deduct a fee from issuer via BankKeeper.SendCoinsFromAccountToModule --
+// neither the DID keeper nor the bank keeper reach this package (see WeightedOperations'
+// doc comment), so this can only validate the message shape and report why delivery
+// can't be attempted, rather than assert the resulting CredentialByIssuer/
+// CredentialByHolder/CredentialByType index entries exist the way a wired-up module's
+// sim op would.
+func SimulateMsgCreateCredential() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+		subject, _ := simtypes.RandomAcc(r, accs)
+		fee := feeFor(types.DefaultParams().CreateCredentialFee)
+
+		msg := &types.MsgCreateCredential{
+			Context:        []string{"https://www.w3.org/2018/credentials/v1"},
+			Id:             types.CreateCredentialHash(issuer.Address.String(), subject.Address.String(), r.Uint64()),
+			CredentialType: []string{"VerifiableCredential"},
+			Issuer:         issuer.Address.String(),
+			CredentialSubject: &types.CredentialSubject{
+				ID:     subject.Address.String(),
+				Claims: map[string]interface{}{"name": simtypes.RandStringOfLength(r, 10)},
+			},
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(),
+			"credential keeper does not yet support simulated delivery (would charge issuer "+fee.String()+")"), nil, nil
+	}
+}
+
+// SimulateMsgRevokeCredential generates a MsgRevokeCredential for a randomly minted
+// credential ID, signed by the same account that would otherwise be its issuer.
+//
+// A real delivery would need a credential that actually exists in state to revoke --
+// with no keeper reaching this package to query k.Credentials (see WeightedOperations'
+// doc comment), the credential ID is freshly minted rather than looked up, so it never
+// matches anything real to revoke.
+func SimulateMsgRevokeCredential() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		revoker, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgRevokeCredential{
+			CredentialId: types.CreateCredentialHash(revoker.Address.String(), "subject", r.Uint64()),
+			Revoker:      revoker.Address.String(),
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no matching credential exists yet to revoke"), nil, nil
+	}
+}
+
+// SimulateMsgCreatePresentation generates a MsgCreatePresentation bundling random
+// credential IDs, signed by the presenting holder.
+//
+// Per types.DefaultParams().CreatePresentationFee (see feeFor above), a real delivery
+// would deduct a fee from holder via BankKeeper before accepting the presentation --
+// not reachable from this package, so this reports why delivery can't be attempted
+// rather than asserting the resulting PresentationByHolder index entry exists.
+func SimulateMsgCreatePresentation() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		holder, _ := simtypes.RandomAcc(r, accs)
+		fee := feeFor(types.DefaultParams().CreatePresentationFee)
+
+		msg := &types.MsgCreatePresentation{
+			Context:          []string{"https://www.w3.org/2018/credentials/v1"},
+			Id:               types.CreatePresentationHash(holder.Address.String(), uint64(time.Now().UnixNano())),
+			PresentationType: []string{"VerifiablePresentation"},
+			Holder:           holder.Address.String(),
+			VerifiableCredential: []string{
+				types.CreateCredentialHash(holder.Address.String(), "subject", r.Uint64()),
+			},
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(),
+			"no matching credentials exist yet to present (would charge holder "+fee.String()+")"), nil, nil
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ApplicationStatus is the state-machine status of a CredentialApplication, advanced
+// only by ReviewApplication (pending -> approved/denied) and, on approval, the issuance
+// step folded into the same handler (approved -> fulfilled). There is no path back to
+// pending once reviewed.
+type ApplicationStatus string
+
+const (
+	ApplicationStatusPending   ApplicationStatus = "pending"
+	ApplicationStatusApproved  ApplicationStatus = "approved"
+	ApplicationStatusDenied    ApplicationStatus = "denied"
+	ApplicationStatusFulfilled ApplicationStatus = "fulfilled"
+)
+
+// CredentialApplication is a holder's submission against an issuer-published
+// CredentialSchema (x/schema), the input to the application -> review -> issue pipeline.
+// Modeled on the DIF Credential Manifest spec's Credential Application, with SchemaId
+// standing in for a Credential Manifest: this tree has no CredentialManifest or
+// presentation-exchange input/output-descriptor concept (see x/schema/types/schema.go's
+// CredentialSchema, which is a plain JSON Schema body plus the VerifiableCredential.Type
+// it governs, not a manifest) -- an issuer's CredentialSchema is the closest existing
+// analog of "what the issuer has published that an application is submitted against",
+// and GetSchemaType (types/schema.go's SchemaKeeper) plays the role a manifest's output
+// descriptor would when ReviewApplication constructs the issued VC's Type.
+type CredentialApplication struct {
+	Id       string                 `json:"id"`
+	Holder   string                 `json:"holder"`
+	Issuer   string                 `json:"issuer"`
+	SchemaId string                 `json:"schemaId"`
+	Claims   map[string]interface{} `json:"claims"`
+	Status   ApplicationStatus      `json:"status"`
+	Reason   string                 `json:"reason,omitempty"`
+	Deadline *time.Time             `json:"deadline,omitempty"`
+	Created  time.Time              `json:"created"`
+	Updated  time.Time              `json:"updated"`
+}
+
+func (m *CredentialApplication) ProtoMessage()  {}
+func (m *CredentialApplication) Reset()         { *m = CredentialApplication{} }
+func (m *CredentialApplication) String() string { return proto.CompactTextString(m) }
+
+// ValidateBasic performs stateless validation of a CredentialApplication.
+func (a *CredentialApplication) ValidateBasic() error {
+	if a.Id == "" {
+		return ErrInvalidCredentialData.Wrap("application id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Holder); err != nil {
+		return ErrInvalidHolder.Wrap("invalid holder address")
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if a.SchemaId == "" {
+		return ErrInvalidSchema.Wrap("schema id cannot be empty")
+	}
+	return nil
+}
+
+// CredentialOperation is the asynchronous handle a holder polls to learn the outcome of
+// a CredentialApplication, mirroring the DIF Credential Manifest protocol's pending/
+// fulfilled/denied Credential Response. Exactly one Operation exists per Application,
+// keyed by ApplicationId under OperationPrefix (keys.go), and is updated in place as the
+// application moves through review -- see keeper/application.go's SubmitApplication and
+// ReviewApplication, and EndBlocker's expireOverdueApplications for the deadline path.
+type CredentialOperation struct {
+	ApplicationId string            `json:"applicationId"`
+	Status        ApplicationStatus `json:"status"`
+	CredentialId  string            `json:"credentialId,omitempty"`
+	Created       time.Time         `json:"created"`
+	Updated       time.Time         `json:"updated"`
+}
+
+func (m *CredentialOperation) ProtoMessage()  {}
+func (m *CredentialOperation) Reset()         { *m = CredentialOperation{} }
+func (m *CredentialOperation) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// AttributeValue is a typed attribute value for indexed attribute search -- a
+// hand-rolled oneof (exactly one field set) the same way this tree substitutes plain
+// Go structs for protobuf oneof elsewhere (see QueryFilter.Value in
+// x/zkproof/types/query.go, which only went as far as a bare string).
+type AttributeValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *int64  `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+	BytesValue  []byte  `json:"bytesValue,omitempty"`
+}
+
+// IndexKey returns the string CredentialsByAttribute's Triple key indexes v under.
+// collections.Triple needs one comparable key per slot, so non-string values are
+// stringified with a stable, order-preserving-where-it-matters encoding: decimal for
+// IntValue, "true"/"false" for BoolValue, and raw-URL base64 for BytesValue (the same
+// encoding types.EncodeCursor uses for opaque keys).
+func (v AttributeValue) IndexKey() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.IntValue != nil:
+		return strconv.FormatInt(*v.IntValue, 10)
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.BytesValue != nil:
+		return base64.RawURLEncoding.EncodeToString(v.BytesValue)
+	default:
+		return ""
+	}
+}
+
+// AttributePredicate is one {key, value} equality clause of a
+// QueryCredentialsByAttributes request. Multiple predicates are AND-intersected.
+type AttributePredicate struct {
+	Key   string         `json:"key"`
+	Value AttributeValue `json:"value"`
+}
+
+// AttributeValueFromClaim converts a CredentialSubject.Claims value (decoded from
+// JSON, so only the types encoding/json itself produces) into an AttributeValue for
+// indexing. Claims nested under objects/arrays have no single scalar to index and are
+// skipped -- CredentialsByAttribute only indexes top-level scalar claims.
+func AttributeValueFromClaim(v interface{}) (AttributeValue, bool) {
+	switch val := v.(type) {
+	case string:
+		return AttributeValue{StringValue: &val}, true
+	case bool:
+		return AttributeValue{BoolValue: &val}, true
+	case float64:
+		i := int64(val)
+		return AttributeValue{IntValue: &i}, true
+	default:
+		return AttributeValue{}, false
+	}
+}
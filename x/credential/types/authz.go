@@ -0,0 +1,169 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// CredentialAuthorization is an x/authz-style grant letting a grantee issue or revoke
+// credentials on behalf of a granter (the DID-controlling issuer), scoped to specific
+// credential types and an optional expiration.
+type CredentialAuthorization struct {
+	// Granter is the issuer delegating authority.
+	Granter string `json:"granter"`
+
+	// Grantee is the address authorized to act on the granter's behalf.
+	Grantee string `json:"grantee"`
+
+	// AllowedCredentialTypes restricts which VerifiableCredential.Type values the
+	// grantee may issue. An empty list means all types are allowed.
+	AllowedCredentialTypes []string `json:"allowedCredentialTypes,omitempty"`
+
+	// AllowRevoke permits the grantee to revoke credentials issued under this grant.
+	AllowRevoke bool `json:"allowRevoke"`
+
+	// Expiration is when the grant stops being honored. Nil means it never expires.
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// Implement proto.Message interface for SDK compatibility
+func (m *CredentialAuthorization) ProtoMessage()  {}
+func (m *CredentialAuthorization) Reset()         { *m = CredentialAuthorization{} }
+func (m *CredentialAuthorization) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a CredentialAuthorization grant.
+func (a *CredentialAuthorization) Validate() error {
+	if a.Granter == "" {
+		return ErrInvalidIssuer.Wrap("granter cannot be empty")
+	}
+	if a.Grantee == "" {
+		return ErrUnauthorized.Wrap("grantee cannot be empty")
+	}
+	if a.Granter == a.Grantee {
+		return ErrUnauthorized.Wrap("granter and grantee cannot be the same address")
+	}
+	return nil
+}
+
+// IsExpired reports whether the grant is no longer valid at the given time.
+func (a *CredentialAuthorization) IsExpired(at time.Time) bool {
+	return a.Expiration != nil && at.After(*a.Expiration)
+}
+
+// Accepts reports whether this grant permits issuing a credential of the given type.
+func (a *CredentialAuthorization) Accepts(credentialType string) bool {
+	if len(a.AllowedCredentialTypes) == 0 {
+		return true
+	}
+	for _, t := range a.AllowedCredentialTypes {
+		if t == credentialType {
+			return true
+		}
+	}
+	return false
+}
+
+// MsgGrantCredentialAuthorization defines the message to delegate issuance/revocation
+// authority from a granter to a grantee.
+type MsgGrantCredentialAuthorization struct {
+	Granter                string     `json:"granter"`
+	Grantee                string     `json:"grantee"`
+	AllowedCredentialTypes []string   `json:"allowedCredentialTypes,omitempty"`
+	AllowRevoke            bool       `json:"allowRevoke"`
+	Expiration             *time.Time `json:"expiration,omitempty"`
+}
+
+var _ sdk.Msg = &MsgGrantCredentialAuthorization{}
+
+const TypeMsgGrantCredentialAuthorization = "grant_credential_authorization"
+
+func (msg *MsgGrantCredentialAuthorization) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgGrantCredentialAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	auth := CredentialAuthorization{
+		Granter:                msg.Granter,
+		Grantee:                msg.Grantee,
+		AllowedCredentialTypes: msg.AllowedCredentialTypes,
+		AllowRevoke:            msg.AllowRevoke,
+		Expiration:             msg.Expiration,
+	}
+	return auth.Validate()
+}
+
+func (msg *MsgGrantCredentialAuthorization) Type() string  { return TypeMsgGrantCredentialAuthorization }
+func (msg *MsgGrantCredentialAuthorization) Route() string { return RouterKey }
+func (msg *MsgGrantCredentialAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgGrantCredentialAuthorization) ProtoMessage()  {}
+func (m *MsgGrantCredentialAuthorization) Reset()         { *m = MsgGrantCredentialAuthorization{} }
+func (m *MsgGrantCredentialAuthorization) String() string { return proto.CompactTextString(m) }
+
+// MsgGrantCredentialAuthorizationResponse is the response for MsgGrantCredentialAuthorization.
+type MsgGrantCredentialAuthorizationResponse struct{}
+
+func (m *MsgGrantCredentialAuthorizationResponse) ProtoMessage()  {}
+func (m *MsgGrantCredentialAuthorizationResponse) Reset()         { *m = MsgGrantCredentialAuthorizationResponse{} }
+func (m *MsgGrantCredentialAuthorizationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRevokeCredentialAuthorization defines the message to revoke a standing delegation.
+type MsgRevokeCredentialAuthorization struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
+
+var _ sdk.Msg = &MsgRevokeCredentialAuthorization{}
+
+const TypeMsgRevokeCredentialAuthorization = "revoke_credential_authorization"
+
+func (msg *MsgRevokeCredentialAuthorization) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgRevokeCredentialAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	return nil
+}
+
+func (msg *MsgRevokeCredentialAuthorization) Type() string  { return TypeMsgRevokeCredentialAuthorization }
+func (msg *MsgRevokeCredentialAuthorization) Route() string { return RouterKey }
+func (msg *MsgRevokeCredentialAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRevokeCredentialAuthorization) ProtoMessage()  {}
+func (m *MsgRevokeCredentialAuthorization) Reset()         { *m = MsgRevokeCredentialAuthorization{} }
+func (m *MsgRevokeCredentialAuthorization) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeCredentialAuthorizationResponse is the response for MsgRevokeCredentialAuthorization.
+type MsgRevokeCredentialAuthorizationResponse struct{}
+
+func (m *MsgRevokeCredentialAuthorizationResponse) ProtoMessage()  {}
+func (m *MsgRevokeCredentialAuthorizationResponse) Reset()         { *m = MsgRevokeCredentialAuthorizationResponse{} }
+func (m *MsgRevokeCredentialAuthorizationResponse) String() string {
+	return proto.CompactTextString(m)
+}
@@ -0,0 +1,321 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// CanonicalStatement is a single URDNA2015-style canonicalized RDF statement derived
+// from a CredentialSubject claim, addressed by its JSON-pointer path.
+type CanonicalStatement struct {
+	// Path is the JSON-pointer path of the claim within CredentialSubject.Claims, e.g. "/dateOfBirth".
+	Path string
+	// Hash is the SHA-256 digest of the canonicalized "path=value" statement.
+	Hash [32]byte
+}
+
+// CanonicalizeCredentialSubject walks CredentialSubject.Claims in a deterministic order
+// and produces one statement per claim. This stands in for full URDNA2015 JSON-LD
+// canonicalization: it gives every claim a stable, content-addressed message that a BBS+
+// signature can be computed over, one message per statement, without requiring the
+// issuer and holder to agree on any structure beyond map key order.
+//
+// TODO: replace with proper URDNA2015 canonicalization (blank-node labelling, @context
+// expansion) once the JSON-LD processor dependency is vendored.
+func CanonicalizeCredentialSubject(subject CredentialSubject) ([]CanonicalStatement, error) {
+	paths := make([]string, 0, len(subject.Claims))
+	for k := range subject.Claims {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+
+	statements := make([]CanonicalStatement, 0, len(paths))
+	for _, path := range paths {
+		value, err := json.Marshal(subject.Claims[path])
+		if err != nil {
+			return nil, fmt.Errorf("canonicalize claim %q: %w", path, err)
+		}
+		statement := fmt.Sprintf("/%s=%s", path, string(value))
+		statements = append(statements, CanonicalStatement{
+			Path: "/" + path,
+			Hash: sha256.Sum256([]byte(statement)),
+		})
+	}
+	return statements, nil
+}
+
+// DeriveMessageVector selects the message hashes a BBS+ signature was computed over, in
+// the fixed statement order produced by CanonicalizeCredentialSubject. Keepers use this
+// both to re-derive the full message vector an issuer signed and, combined with reveal,
+// to determine which indices a BbsBlsSignatureProof2020 must keep hidden.
+func DeriveMessageVector(subject CredentialSubject) ([][32]byte, error) {
+	statements, err := CanonicalizeCredentialSubject(subject)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([][32]byte, len(statements))
+	for i, s := range statements {
+		messages[i] = s.Hash
+	}
+	return messages, nil
+}
+
+// RevealedIndices maps the JSON-pointer paths named in a presentation's Reveal field to
+// their index in the canonical message vector, so a BBS+ proof-of-knowledge verifier
+// knows which positions are disclosed versus hidden.
+func RevealedIndices(subject CredentialSubject, reveal []string) ([]int, error) {
+	statements, err := CanonicalizeCredentialSubject(subject)
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int, len(statements))
+	for i, s := range statements {
+		index[s.Path] = i
+	}
+
+	indices := make([]int, 0, len(reveal))
+	for _, path := range reveal {
+		i, ok := index[path]
+		if !ok {
+			return nil, ErrInvalidCredential.Wrapf("reveal path %q does not name a credential subject claim", path)
+		}
+		indices = append(indices, i)
+	}
+	return indices, nil
+}
+
+// RevealedIndicesFromMask returns the sorted statement indices set in a
+// MsgCreatePresentationBBS.RevealMask bitmask, bounded by total (the length of the
+// canonical message vector the credential was signed over).
+func RevealedIndicesFromMask(total int, mask uint64) []int {
+	indices := make([]int, 0)
+	for i := 0; i < total && i < 64; i++ {
+		if mask&(1<<uint(i)) != 0 {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// BLS12381PublicKey is a compressed G2 BLS12-381 public key, registered as a
+// verificationMethod on an issuer's DID, that verifies BbsBlsSignature2020 signatures
+// and BbsBlsSignatureProof2020 proofs of knowledge derived from them.
+type BLS12381PublicKey []byte
+
+// BBSSigner signs a canonical message vector (see CanonicalizeCredentialSubject) with a
+// BLS12-381 private key, producing the signature carried in a BbsBlsSignature2020 proof.
+// Implementations wrap a concrete backend - kyber, gnark-crypto, or any other BBS+
+// library - behind this interface so the backend is swappable without touching message
+// or keeper code.
+type BBSSigner interface {
+	Sign(messages [][32]byte) ([]byte, error)
+}
+
+// BBSVerifier verifies BbsBlsSignature2020 signatures and BbsBlsSignatureProof2020
+// zero-knowledge proofs of knowledge over a partially revealed message vector.
+type BBSVerifier interface {
+	// Verify checks a full BbsBlsSignature2020 signature over every message in the
+	// vector an issuer signed.
+	Verify(pubKey BLS12381PublicKey, messages [][32]byte, signature []byte) error
+
+	// VerifyProof checks a BbsBlsSignatureProof2020 proof of knowledge. revealed holds
+	// the disclosed message hashes keyed by their index in the original, full message
+	// vector; total is the length of that vector; nonce is the challenge the proof was
+	// derived against.
+	VerifyProof(pubKey BLS12381PublicKey, revealed map[int][32]byte, total int, proof []byte, nonce []byte) error
+}
+
+// VerifyPresentationProof reconstructs the revealed-message subset a
+// BbsBlsSignatureProof2020 presentation claims to disclose - the redacted credential's
+// statements placed back at their original indices, with every other index left as a
+// hidden placeholder - and checks it against the proof via a BBSVerifier backend. This
+// is what a credential keeper calls to verify MsgCreatePresentationBBS and BBS+ derived
+// MsgCreatePresentation messages alike.
+func VerifyPresentationProof(
+	v BBSVerifier,
+	pubKey BLS12381PublicKey,
+	originalSubject CredentialSubject,
+	redactedSubject CredentialSubject,
+	reveal []string,
+	proof []byte,
+	nonce []byte,
+) error {
+	total, err := DeriveMessageVector(originalSubject)
+	if err != nil {
+		return err
+	}
+
+	originalStatements, err := CanonicalizeCredentialSubject(originalSubject)
+	if err != nil {
+		return err
+	}
+	originalIdxByPath := make(map[string]int, len(originalStatements))
+	for i, s := range originalStatements {
+		originalIdxByPath[s.Path] = i
+	}
+
+	redactedStatements, err := CanonicalizeCredentialSubject(redactedSubject)
+	if err != nil {
+		return err
+	}
+	if len(redactedStatements) != len(reveal) {
+		return ErrInvalidProof.Wrap("redacted credential does not match the number of revealed claims")
+	}
+
+	// Pair each redacted statement with its index in the ORIGINAL message vector by path,
+	// not by position: redactedStatements is always alphabetically sorted (see
+	// CanonicalizeCredentialSubject), but reveal is caller-supplied and need not be, so a
+	// positional zip would silently mismatch claims whenever reveal isn't alphabetical.
+	revealed := make(map[int][32]byte, len(redactedStatements))
+	for _, s := range redactedStatements {
+		idx, ok := originalIdxByPath[s.Path]
+		if !ok {
+			return ErrInvalidProof.Wrapf("redacted claim %q does not name an original credential subject claim", s.Path)
+		}
+		revealed[idx] = s.Hash
+	}
+
+	return v.VerifyProof(pubKey, revealed, len(total), proof, nonce)
+}
+
+// MsgIssueCredentialBBS issues a verifiable credential signed with a
+// BbsBlsSignature2020 signature over the canonical per-claim statement vector produced
+// by CanonicalizeCredentialSubject, using a BLS12-381 key registered as a
+// verificationMethod on the issuer's DID.
+type MsgIssueCredentialBBS struct {
+	Context            []string           `json:"context"`
+	Id                 string             `json:"id"`
+	CredentialType     []string           `json:"type"`
+	Issuer             string             `json:"issuer"`
+	VerificationMethod string             `json:"verificationMethod"`
+	CredentialSubject  *CredentialSubject `json:"credentialSubject"`
+	// Signature is the BbsBlsSignature2020 signature over DeriveMessageVector(CredentialSubject).
+	Signature []byte `json:"signature"`
+}
+
+var _ sdk.Msg = &MsgIssueCredentialBBS{}
+
+const TypeMsgIssueCredentialBBS = "issue_credential_bbs"
+
+func (msg *MsgIssueCredentialBBS) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgIssueCredentialBBS) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidCredential.Wrap("credential ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if msg.VerificationMethod == "" {
+		return ErrInvalidVerificationMethod.Wrap("verification method cannot be empty")
+	}
+	if len(msg.CredentialType) == 0 {
+		return ErrInvalidCredential.Wrap("credential type cannot be empty")
+	}
+	if len(msg.Context) == 0 {
+		return ErrInvalidCredential.Wrap("credential context cannot be empty")
+	}
+	if msg.CredentialSubject == nil || msg.CredentialSubject.ID == "" {
+		return ErrInvalidCredential.Wrap("credential subject cannot be empty")
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidProof.Wrap("BBS+ signature cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgIssueCredentialBBS) Type() string  { return TypeMsgIssueCredentialBBS }
+func (msg *MsgIssueCredentialBBS) Route() string { return RouterKey }
+func (msg *MsgIssueCredentialBBS) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+func (m *MsgIssueCredentialBBS) ProtoMessage()  {}
+func (m *MsgIssueCredentialBBS) Reset()         { *m = MsgIssueCredentialBBS{} }
+func (m *MsgIssueCredentialBBS) String() string { return proto.CompactTextString(m) }
+
+// MsgIssueCredentialBBSResponse is the response for MsgIssueCredentialBBS.
+type MsgIssueCredentialBBSResponse struct {
+	CredentialId string `json:"credentialId"`
+}
+
+func (m *MsgIssueCredentialBBSResponse) ProtoMessage()  {}
+func (m *MsgIssueCredentialBBSResponse) Reset()         { *m = MsgIssueCredentialBBSResponse{} }
+func (m *MsgIssueCredentialBBSResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgCreatePresentationBBS derives a zero-knowledge BbsBlsSignatureProof2020
+// presentation from a BBS+ signed credential, revealing only the statements named by
+// RevealMask. Unlike MsgCreatePresentation's path-based Reveal field, RevealMask
+// addresses statements directly by their index in the canonical message vector.
+type MsgCreatePresentationBBS struct {
+	Holder       string `json:"holder"`
+	Id           string `json:"id"`
+	CredentialId string `json:"credentialId"`
+	// RevealMask has bit i set when the statement at index i of the credential's
+	// canonical message vector (see CanonicalizeCredentialSubject) is disclosed.
+	RevealMask uint64 `json:"revealMask"`
+	// Nonce is the verifier-chosen challenge mixed into the proof derivation, preventing
+	// replay of the derived proof against a different verifier.
+	Nonce []byte `json:"nonce"`
+}
+
+var _ sdk.Msg = &MsgCreatePresentationBBS{}
+
+const TypeMsgCreatePresentationBBS = "create_presentation_bbs"
+
+func (msg *MsgCreatePresentationBBS) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgCreatePresentationBBS) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidPresentation.Wrap("presentation ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrInvalidHolder.Wrap("invalid holder address")
+	}
+	if msg.CredentialId == "" {
+		return ErrInvalidCredential.Wrap("credential ID cannot be empty")
+	}
+	if msg.RevealMask == 0 {
+		return ErrInvalidProof.Wrap("BBS+ derived presentations must reveal at least one statement")
+	}
+	if len(msg.Nonce) == 0 {
+		return ErrInvalidProof.Wrap("nonce cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgCreatePresentationBBS) Type() string  { return TypeMsgCreatePresentationBBS }
+func (msg *MsgCreatePresentationBBS) Route() string { return RouterKey }
+func (msg *MsgCreatePresentationBBS) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+func (m *MsgCreatePresentationBBS) ProtoMessage()  {}
+func (m *MsgCreatePresentationBBS) Reset()         { *m = MsgCreatePresentationBBS{} }
+func (m *MsgCreatePresentationBBS) String() string { return proto.CompactTextString(m) }
+
+// MsgCreatePresentationBBSResponse is the response for MsgCreatePresentationBBS.
+type MsgCreatePresentationBBSResponse struct {
+	PresentationId string `json:"presentationId"`
+}
+
+func (m *MsgCreatePresentationBBSResponse) ProtoMessage()  {}
+func (m *MsgCreatePresentationBBSResponse) Reset()         { *m = MsgCreatePresentationBBSResponse{} }
+func (m *MsgCreatePresentationBBSResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCanonicalizeCredentialSubject_DeterministicOrder(t *testing.T) {
+	subject := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"dateOfBirth": "1990-01-01",
+			"givenName":   "Alice",
+		},
+	}
+
+	first, err := CanonicalizeCredentialSubject(subject)
+	if err != nil {
+		t.Fatalf("CanonicalizeCredentialSubject: %v", err)
+	}
+	second, err := CanonicalizeCredentialSubject(subject)
+	if err != nil {
+		t.Fatalf("CanonicalizeCredentialSubject (second call): %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("canonicalization is not deterministic across calls: %+v vs %+v", first, second)
+	}
+	if len(first) != 2 {
+		t.Fatalf("expected 2 statements, got %d", len(first))
+	}
+	if first[0].Path != "/dateOfBirth" || first[1].Path != "/givenName" {
+		t.Fatalf("expected statements sorted by path, got %q then %q", first[0].Path, first[1].Path)
+	}
+}
+
+func TestDeriveMessageVector_MatchesStatementHashes(t *testing.T) {
+	subject := CredentialSubject{
+		ID:     "did:example:holder",
+		Claims: map[string]interface{}{"givenName": "Alice"},
+	}
+
+	statements, err := CanonicalizeCredentialSubject(subject)
+	if err != nil {
+		t.Fatalf("CanonicalizeCredentialSubject: %v", err)
+	}
+	messages, err := DeriveMessageVector(subject)
+	if err != nil {
+		t.Fatalf("DeriveMessageVector: %v", err)
+	}
+
+	if len(messages) != len(statements) {
+		t.Fatalf("expected %d messages, got %d", len(statements), len(messages))
+	}
+	for i, s := range statements {
+		if messages[i] != s.Hash {
+			t.Fatalf("message %d does not match its statement's hash", i)
+		}
+	}
+}
+
+func TestRevealedIndices(t *testing.T) {
+	subject := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"dateOfBirth": "1990-01-01",
+			"givenName":   "Alice",
+			"nationality": "XX",
+		},
+	}
+
+	indices, err := RevealedIndices(subject, []string{"/givenName"})
+	if err != nil {
+		t.Fatalf("RevealedIndices: %v", err)
+	}
+	// Claims sort as dateOfBirth(0), givenName(1), nationality(2).
+	if !reflect.DeepEqual(indices, []int{1}) {
+		t.Fatalf("expected [1], got %v", indices)
+	}
+
+	if _, err := RevealedIndices(subject, []string{"/doesNotExist"}); err == nil {
+		t.Fatal("expected an error for a reveal path not present in the subject's claims")
+	}
+}
+
+func TestRevealedIndicesFromMask(t *testing.T) {
+	cases := []struct {
+		name  string
+		total int
+		mask  uint64
+		want  []int
+	}{
+		{"no bits set", 4, 0, []int{}},
+		{"all bits set", 3, 0b111, []int{0, 1, 2}},
+		{"sparse mask", 5, 0b10101, []int{0, 2, 4}},
+		{"bits beyond total are ignored", 2, 0b1111, []int{0, 1}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := RevealedIndicesFromMask(tc.total, tc.mask)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("RevealedIndicesFromMask(%d, %b) = %v, want %v", tc.total, tc.mask, got, tc.want)
+			}
+		})
+	}
+}
+
+// fakeBBSVerifier is a test-only BBSVerifier that records its VerifyProof call and
+// returns a scripted result, so VerifyPresentationProof's revealed-index reconstruction
+// can be checked without a real BLS12-381 backend.
+type fakeBBSVerifier struct {
+	gotRevealed map[int][32]byte
+	gotTotal    int
+	err         error
+}
+
+func (f *fakeBBSVerifier) Verify(BLS12381PublicKey, [][32]byte, []byte) error {
+	return errors.New("not used by this test")
+}
+
+func (f *fakeBBSVerifier) VerifyProof(pubKey BLS12381PublicKey, revealed map[int][32]byte, total int, proof []byte, nonce []byte) error {
+	f.gotRevealed = revealed
+	f.gotTotal = total
+	return f.err
+}
+
+func TestVerifyPresentationProof_ReconstructsRevealedIndices(t *testing.T) {
+	original := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"dateOfBirth": "1990-01-01",
+			"givenName":   "Alice",
+		},
+	}
+	redacted := CredentialSubject{
+		ID:     "did:example:holder",
+		Claims: map[string]interface{}{"givenName": "Alice"},
+	}
+
+	verifier := &fakeBBSVerifier{}
+	err := VerifyPresentationProof(verifier, BLS12381PublicKey{0x01}, original, redacted, []string{"/givenName"}, []byte("proof"), []byte("nonce"))
+	if err != nil {
+		t.Fatalf("VerifyPresentationProof: %v", err)
+	}
+
+	if verifier.gotTotal != 2 {
+		t.Fatalf("expected total message count 2, got %d", verifier.gotTotal)
+	}
+	// dateOfBirth(0), givenName(1): only index 1 (givenName) should be revealed.
+	if len(verifier.gotRevealed) != 1 {
+		t.Fatalf("expected exactly 1 revealed message, got %d", len(verifier.gotRevealed))
+	}
+	hash, ok := verifier.gotRevealed[1]
+	if !ok {
+		t.Fatal("expected index 1 (givenName) to be revealed")
+	}
+	wantMessages, err := DeriveMessageVector(redacted)
+	if err != nil {
+		t.Fatalf("DeriveMessageVector(redacted): %v", err)
+	}
+	if hash != wantMessages[0] {
+		t.Fatal("revealed hash does not match the redacted subject's own message vector")
+	}
+}
+
+func TestVerifyPresentationProof_NonAlphabeticalRevealOrder(t *testing.T) {
+	original := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"dateOfBirth": "1990-01-01",
+			"givenName":   "Alice",
+			"nationality": "XX",
+		},
+	}
+	redacted := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"givenName":   "Alice",
+			"nationality": "XX",
+		},
+	}
+
+	verifier := &fakeBBSVerifier{}
+	// reveal names the claims in the opposite order from their canonical (alphabetical)
+	// sort -- nationality(2) before givenName(1) -- which a positional zip against
+	// redactedStatements (always sorted givenName, nationality) would mis-pair.
+	err := VerifyPresentationProof(verifier, BLS12381PublicKey{0x01}, original, redacted, []string{"/nationality", "/givenName"}, []byte("proof"), []byte("nonce"))
+	if err != nil {
+		t.Fatalf("VerifyPresentationProof: %v", err)
+	}
+
+	if verifier.gotTotal != 3 {
+		t.Fatalf("expected total message count 3, got %d", verifier.gotTotal)
+	}
+	if len(verifier.gotRevealed) != 2 {
+		t.Fatalf("expected exactly 2 revealed messages, got %d", len(verifier.gotRevealed))
+	}
+
+	wantMessages, err := DeriveMessageVector(redacted)
+	if err != nil {
+		t.Fatalf("DeriveMessageVector(redacted): %v", err)
+	}
+	// dateOfBirth(0), givenName(1), nationality(2) in the original's canonical order;
+	// redacted's own canonical order is givenName(0), nationality(1).
+	givenNameHash, ok := verifier.gotRevealed[1]
+	if !ok || givenNameHash != wantMessages[0] {
+		t.Fatal("expected index 1 (givenName) to reveal the redacted subject's givenName hash")
+	}
+	nationalityHash, ok := verifier.gotRevealed[2]
+	if !ok || nationalityHash != wantMessages[1] {
+		t.Fatal("expected index 2 (nationality) to reveal the redacted subject's nationality hash")
+	}
+}
+
+func TestVerifyPresentationProof_RejectsMismatchedRevealCount(t *testing.T) {
+	original := CredentialSubject{
+		ID: "did:example:holder",
+		Claims: map[string]interface{}{
+			"dateOfBirth": "1990-01-01",
+			"givenName":   "Alice",
+		},
+	}
+	// Redacted subject reveals only one claim's worth of data, but the reveal list
+	// names two paths -- this mismatch must be rejected rather than silently
+	// truncated or padded.
+	redacted := CredentialSubject{
+		ID:     "did:example:holder",
+		Claims: map[string]interface{}{"givenName": "Alice"},
+	}
+
+	verifier := &fakeBBSVerifier{}
+	err := VerifyPresentationProof(verifier, BLS12381PublicKey{0x01}, original, redacted, []string{"/givenName", "/dateOfBirth"}, []byte("proof"), []byte("nonce"))
+	if err == nil {
+		t.Fatal("expected an error when the redacted subject doesn't match the revealed claim count")
+	}
+}
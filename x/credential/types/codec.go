@@ -0,0 +1,116 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the credential module's types on the given LegacyAmino codec.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateCredential{}, "credential/CreateCredential", nil)
+	cdc.RegisterConcrete(&MsgRevokeCredential{}, "credential/RevokeCredential", nil)
+	cdc.RegisterConcrete(&MsgCreatePresentation{}, "credential/CreatePresentation", nil)
+	cdc.RegisterConcrete(&MsgCreateStatusList{}, "credential/CreateStatusList", nil)
+	cdc.RegisterConcrete(&MsgUpdateCredentialStatus{}, "credential/UpdateCredentialStatus", nil)
+	cdc.RegisterConcrete(&MsgGrantCredentialAuthorization{}, "credential/GrantCredentialAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRevokeCredentialAuthorization{}, "credential/RevokeCredentialAuthorization", nil)
+	cdc.RegisterConcrete(&MsgIssueCredentialBBS{}, "credential/IssueCredentialBBS", nil)
+	cdc.RegisterConcrete(&MsgCreatePresentationBBS{}, "credential/CreatePresentationBBS", nil)
+	cdc.RegisterConcrete(&MsgGrantIssuanceAuthority{}, "credential/GrantIssuanceAuthority", nil)
+	cdc.RegisterConcrete(&MsgRevokeIssuanceAuthority{}, "credential/RevokeIssuanceAuthority", nil)
+	cdc.RegisterConcrete(&MsgExecIssuance{}, "credential/ExecIssuance", nil)
+	cdc.RegisterConcrete(&MsgGrantRevocationAuthority{}, "credential/GrantRevocationAuthority", nil)
+	cdc.RegisterConcrete(&MsgRevokeRevocationAuthority{}, "credential/RevokeRevocationAuthority", nil)
+	cdc.RegisterConcrete(&MsgExecRevocation{}, "credential/ExecRevocation", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "credential/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterIssuer{}, "credential/RegisterIssuer", nil)
+	cdc.RegisterConcrete(&MsgPauseIssuer{}, "credential/PauseIssuer", nil)
+	cdc.RegisterConcrete(&MsgUpdateIssuerRateLimit{}, "credential/UpdateIssuerRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSubmitCredentialApplication{}, "credential/SubmitCredentialApplication", nil)
+	cdc.RegisterConcrete(&MsgReviewApplication{}, "credential/ReviewApplication", nil)
+	cdc.RegisterConcrete(&MsgRequestVerification{}, "credential/RequestVerification", nil)
+}
+
+// RegisterInterfaces registers the credential module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateCredential{},
+		&MsgRevokeCredential{},
+		&MsgCreatePresentation{},
+		&MsgCreateStatusList{},
+		&MsgUpdateCredentialStatus{},
+		&MsgGrantCredentialAuthorization{},
+		&MsgRevokeCredentialAuthorization{},
+		&MsgIssueCredentialBBS{},
+		&MsgCreatePresentationBBS{},
+		&MsgGrantIssuanceAuthority{},
+		&MsgRevokeIssuanceAuthority{},
+		&MsgExecIssuance{},
+		&MsgGrantRevocationAuthority{},
+		&MsgRevokeRevocationAuthority{},
+		&MsgExecRevocation{},
+		&MsgUpdateParams{},
+		&MsgRegisterIssuer{},
+		&MsgPauseIssuer{},
+		&MsgUpdateIssuerRateLimit{},
+		&MsgSubmitCredentialApplication{},
+		&MsgReviewApplication{},
+		&MsgRequestVerification{},
+	)
+
+	// Message service registration handled by generated proto code
+}
+
+// ModuleCdc references the global credential module codec. Note, the codec should
+// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+// still used for that purpose.
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterCodec(legacy.Cdc)
+}
+
+// MsgServer defines the gRPC Msg service a credential keeper implements. Msg types in
+// this package still implement the legacy Route()/Type() methods (unlike x/zkproof,
+// which dropped them in favor of dispatch through an interface like this one) --
+// migrating them is its own follow-up rather than part of standing the keeper up.
+type MsgServer interface {
+	CreateCredential(ctx context.Context, msg *MsgCreateCredential) (*MsgCreateCredentialResponse, error)
+	RevokeCredential(ctx context.Context, msg *MsgRevokeCredential) (*MsgRevokeCredentialResponse, error)
+	CreatePresentation(ctx context.Context, msg *MsgCreatePresentation) (*MsgCreatePresentationResponse, error)
+	VerifyCredential(ctx context.Context, msg *MsgVerifyCredential) (*MsgVerifyCredentialResponse, error)
+	CreateStatusList(ctx context.Context, msg *MsgCreateStatusList) (*MsgCreateStatusListResponse, error)
+	UpdateCredentialStatus(ctx context.Context, msg *MsgUpdateCredentialStatus) (*MsgUpdateCredentialStatusResponse, error)
+	GrantCredentialAuthorization(ctx context.Context, msg *MsgGrantCredentialAuthorization) (*MsgGrantCredentialAuthorizationResponse, error)
+	RevokeCredentialAuthorization(ctx context.Context, msg *MsgRevokeCredentialAuthorization) (*MsgRevokeCredentialAuthorizationResponse, error)
+	IssueCredentialBBS(ctx context.Context, msg *MsgIssueCredentialBBS) (*MsgIssueCredentialBBSResponse, error)
+	CreatePresentationBBS(ctx context.Context, msg *MsgCreatePresentationBBS) (*MsgCreatePresentationBBSResponse, error)
+	GrantIssuanceAuthority(ctx context.Context, msg *MsgGrantIssuanceAuthority) (*MsgGrantIssuanceAuthorityResponse, error)
+	RevokeIssuanceAuthority(ctx context.Context, msg *MsgRevokeIssuanceAuthority) (*MsgRevokeIssuanceAuthorityResponse, error)
+	ExecIssuance(ctx context.Context, msg *MsgExecIssuance) (*MsgExecIssuanceResponse, error)
+	GrantRevocationAuthority(ctx context.Context, msg *MsgGrantRevocationAuthority) (*MsgGrantRevocationAuthorityResponse, error)
+	RevokeRevocationAuthority(ctx context.Context, msg *MsgRevokeRevocationAuthority) (*MsgRevokeRevocationAuthorityResponse, error)
+	ExecRevocation(ctx context.Context, msg *MsgExecRevocation) (*MsgExecRevocationResponse, error)
+	UpdateParams(ctx context.Context, msg *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterIssuer(ctx context.Context, msg *MsgRegisterIssuer) (*MsgRegisterIssuerResponse, error)
+	PauseIssuer(ctx context.Context, msg *MsgPauseIssuer) (*MsgPauseIssuerResponse, error)
+	UpdateIssuerRateLimit(ctx context.Context, msg *MsgUpdateIssuerRateLimit) (*MsgUpdateIssuerRateLimitResponse, error)
+	SubmitCredentialApplication(ctx context.Context, msg *MsgSubmitCredentialApplication) (*MsgSubmitCredentialApplicationResponse, error)
+	ReviewApplication(ctx context.Context, msg *MsgReviewApplication) (*MsgReviewApplicationResponse, error)
+	RequestVerification(ctx context.Context, msg *MsgRequestVerification) (*MsgRequestVerificationResponse, error)
+}
+
+// RegisterMsgServer registers the server implementation with the server configurator.
+func RegisterMsgServer(server interface{}, impl MsgServer) {
+	// For compatibility with SDK message server registration; in a full proto
+	// implementation this would register impl with the gRPC service registrar.
+}
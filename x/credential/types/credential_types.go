@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// CredentialTypeDef describes one credential type Params.CredentialTypes allows
+// CreateCredential to issue: the JSON schema its CredentialSubject.Claims are expected to
+// satisfy, whether it may later be revoked, how long an issued credential of this type
+// remains valid, and which claim keys it must carry. This replaces the hardcoded
+// eight-string map literal types.ValidateCredentialType (types/keys.go) used to check
+// against, moving the allowlist into governance-updatable state.
+type CredentialTypeDef struct {
+	// Name is the credential type string CreateCredential's CredentialType list is checked
+	// against, e.g. "PersonaCredential".
+	Name string `json:"name"`
+	// JSONSchemaURI, if set, points at the JSON Schema CredentialSubject.Claims must
+	// validate against for a credential of this type. Unlike SchemaId on
+	// MsgCreateCredential (which names an x/schema CredentialSchema by ID), this is an
+	// external URI -- the two are independent, and a credential can use either, neither,
+	// or both.
+	JSONSchemaURI string `json:"jsonSchemaUri,omitempty"`
+	// Revocable reports whether a credential of this type may later be revoked via
+	// MsgRevokeCredential. False for types meant to be immutable attestations.
+	Revocable bool `json:"revocable"`
+	// MaxValidityDuration caps how long after IssuanceDate a credential of this type may
+	// set ExpirationDate to. Zero means no cap.
+	MaxValidityDuration time.Duration `json:"maxValidityDuration,omitempty"`
+	// RequiredSubjectClaimKeys lists the CredentialSubject.Claims keys a credential of
+	// this type must carry.
+	RequiredSubjectClaimKeys []string `json:"requiredSubjectClaimKeys,omitempty"`
+	// Deprecated marks a type as no longer accepted by CreateCredential without deleting
+	// its definition outright, so CredentialTypeUsage history and existing credentials of
+	// this type remain legible. Set via MsgUpdateParams (the deprecate-type CLI command
+	// builds one); there is no separate undeprecate path beyond submitting another
+	// MsgUpdateParams with Deprecated cleared.
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+// Validate checks that d is well-formed, independent of any other registered type.
+// Params.Validate additionally checks for duplicate names across the whole list.
+func (d CredentialTypeDef) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("credential type name cannot be empty")
+	}
+	if d.MaxValidityDuration < 0 {
+		return fmt.Errorf("credential type %q: max validity duration cannot be negative", d.Name)
+	}
+	return nil
+}
+
+// DefaultCredentialTypeDefs returns the eight types types.ValidateCredentialType's map
+// literal used to hardcode, as the CredentialTypeDefs DefaultParams seeds
+// Params.CredentialTypes with. ProofOfAddress/ProofOfIncome keep the 90-day validity cap
+// proof-of-X attestations conventionally carry; the rest are left uncapped, matching the
+// old map's all-or-nothing acceptance.
+func DefaultCredentialTypeDefs() []CredentialTypeDef {
+	return []CredentialTypeDef{
+		{Name: "VerifiableCredential", Revocable: true},
+		{Name: "PersonaCredential", Revocable: true},
+		{Name: "EducationCredential", Revocable: true},
+		{Name: "IdentityCredential", Revocable: true},
+		{Name: "ProofOfAddress", Revocable: true, MaxValidityDuration: 90 * 24 * time.Hour},
+		{Name: "ProofOfIncome", Revocable: true, MaxValidityDuration: 90 * 24 * time.Hour},
+		{Name: "EmailCredential", Revocable: false},
+		{Name: "PhoneCredential", Revocable: false},
+	}
+}
+
+// FindCredentialTypeDef returns the non-deprecated CredentialTypeDef named credType from
+// defs, and whether one was found. This is the pure, params-already-loaded half of what
+// used to be ValidateCredentialType's map lookup; Keeper.ValidateCredentialType
+// (keeper/credential_types.go) is the context-reading half that replaced it.
+func FindCredentialTypeDef(defs []CredentialTypeDef, credType string) (CredentialTypeDef, bool) {
+	for _, d := range defs {
+		if d.Name == credType && !d.Deprecated {
+			return d, true
+		}
+	}
+	return CredentialTypeDef{}, false
+}
+
+func (m *CredentialTypeDef) ProtoMessage()  {}
+func (m *CredentialTypeDef) Reset()         { *m = CredentialTypeDef{} }
+func (m *CredentialTypeDef) String() string { return proto.CompactTextString(m) }
@@ -30,4 +30,19 @@ var (
 	ErrCredentialSizeExceeded  = errorsmod.Register(ModuleName, 23, "credential size exceeded maximum allowed")
 	ErrInvalidCredentialData   = errorsmod.Register(ModuleName, 24, "invalid credential data")
 	ErrCredentialNotActive     = errorsmod.Register(ModuleName, 25, "credential not active")
+	ErrInvalidQuery            = errorsmod.Register(ModuleName, 26, "invalid query")
+	ErrInvalidAuthority        = errorsmod.Register(ModuleName, 27, "invalid authority")
+	ErrInvalidIBCVersion       = errorsmod.Register(ModuleName, 28, "invalid credential-transfer IBC version")
+	ErrImportedCredentialNotFound = errorsmod.Register(ModuleName, 29, "imported credential not found")
+	ErrIssuerNotRegistered        = errorsmod.Register(ModuleName, 30, "issuer is not registered")
+	ErrIssuerPaused               = errorsmod.Register(ModuleName, 31, "issuer is paused")
+	ErrCredentialTypeNotAllowed   = errorsmod.Register(ModuleName, 32, "credential type not allowed for this issuer")
+	ErrSubjectDenied              = errorsmod.Register(ModuleName, 33, "subject is denied by this issuer")
+	ErrIssuerRateLimitExceeded    = errorsmod.Register(ModuleName, 34, "issuer rate limit exceeded")
+	ErrProofVerificationFailed    = errorsmod.Register(ModuleName, 35, "credential proof verification failed")
+	ErrUnsupportedProofFormat     = errorsmod.Register(ModuleName, 36, "unsupported credential proof format")
+	ErrApplicationNotFound        = errorsmod.Register(ModuleName, 37, "credential application not found")
+	ErrApplicationAlreadyReviewed = errorsmod.Register(ModuleName, 38, "credential application has already been reviewed")
+	ErrApplicationExpired         = errorsmod.Register(ModuleName, 39, "credential application review deadline has passed")
+	ErrGrantNotFound              = errorsmod.Register(ModuleName, 40, "credential authorization grant not found")
 )
\ No newline at end of file
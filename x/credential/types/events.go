@@ -5,6 +5,43 @@ const (
 	EventTypeCredentialCreated    = "credential_created"
 	EventTypeCredentialRevoked    = "credential_revoked"
 	EventTypePresentationCreated  = "presentation_created"
+	EventTypeStatusListCreated    = "status_list_created"
+	EventTypeCredentialStatusUpdated = "credential_status_updated"
+	EventTypeCredentialAuthorizationGranted = "credential_authorization_granted"
+	EventTypeCredentialAuthorizationRevoked = "credential_authorization_revoked"
+	EventTypeIssuanceAuthorityGranted       = "issuance_authority_granted"
+	EventTypeIssuanceAuthorityRevoked       = "issuance_authority_revoked"
+	EventTypeIssuanceExecuted               = "issuance_executed"
+	EventTypeRevocationAuthorityGranted     = "revocation_authority_granted"
+	EventTypeRevocationAuthorityRevoked     = "revocation_authority_revoked"
+	EventTypeRevocationExecuted             = "revocation_executed"
+
+	// Full credential lifecycle, modeled on cosmos-cash's EventTypeCredentialCreated /
+	// EventTypeCredentialDeleted pair.
+	EventTypeCredentialIssued      = "credential_issued"
+	EventTypeCredentialSuspended   = "credential_suspended"
+	EventTypeCredentialReinstated  = "credential_reinstated"
+	EventTypePresentationSubmitted = "presentation_submitted"
+
+	// EventTypeCredentialExpired is emitted by EndBlocker when a credential's
+	// ExpirationDate has passed and its lifecycle status flips to Expired.
+	EventTypeCredentialExpired = "credential_expired"
+
+	// EventTypeCredentialVerified is emitted by MsgServer.VerifyCredential (verify.go)
+	// for every proof check it runs, success or failure -- a verifier relying on the
+	// consensus-committed answer watches for this event rather than polling the query.
+	EventTypeCredentialVerified = "credential_verified"
+
+	// EventTypeParamsUpdated is emitted by MsgServer.UpdateParams whenever the
+	// authority replaces Params, including the register-type/deprecate-type CLI
+	// commands that submit a MsgUpdateParams under the hood.
+	EventTypeParamsUpdated = "params_updated"
+
+	// Issuer registry events (issuer.go, keeper/issuer.go).
+	EventTypeIssuerRegistered       = "issuer_registered"
+	EventTypeIssuerPaused           = "issuer_paused"
+	EventTypeIssuerRateLimitUpdated = "issuer_rate_limit_updated"
+	EventTypeIssuerRateLimitHit     = "issuer_rate_limit_hit"
 
 	// Attribute keys
 	AttributeKeyCredentialID   = "credential_id"
@@ -13,4 +50,31 @@ const (
 	AttributeKeySubject        = "subject"
 	AttributeKeyHolder         = "holder"
 	AttributeKeyRevoker        = "revoker"
+	AttributeKeyStatusListID   = "status_list_id"
+	AttributeKeyStatusIndex    = "status_index"
+	AttributeKeyRevoked        = "revoked"
+	AttributeKeyGranter        = "granter"
+	AttributeKeyGrantee        = "grantee"
+	AttributeKeyCredentialType = "type"
+	AttributeKeySchema         = "schema"
+	AttributeKeyAuthority      = "authority"
+	AttributeKeyControllerDID  = "controller_did"
+	AttributeKeyPaused         = "paused"
+	AttributeKeyVerified       = "verified"
+	AttributeKeyProofFormat    = "proof_format"
+
+	// Cross-chain verification events (keeper/ibc_verification.go, ibc/ibc_module.go).
+	EventTypeIBCVerificationRequested    = "ibc_verification_requested"
+	EventTypeIBCVerificationAcknowledged = "ibc_verification_acknowledged"
+
+	AttributeKeyChannelID = "channel_id"
+
+	// Application review-pipeline events (keeper/application.go).
+	EventTypeApplicationSubmitted = "credential_application_submitted"
+	EventTypeApplicationReviewed  = "credential_application_reviewed"
+	EventTypeApplicationExpired   = "credential_application_expired"
+
+	AttributeKeyApplicationID = "application_id"
+	AttributeKeyApproved      = "approved"
+	AttributeKeyReason        = "reason"
 )
\ No newline at end of file
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EventCredentialIssued is a typed, protobuf-style event emitted whenever a credential
+// is issued, so indexers can decode it with sdk.ParseTypedEvent instead of scraping
+// loose string attributes.
+type EventCredentialIssued struct {
+	Issuer          string `json:"issuer"`
+	Subject         string `json:"subject"`
+	CredentialId    string `json:"credential_id"`
+	Type            string `json:"type"`
+	Schema          string `json:"schema,omitempty"`
+	StatusListId    string `json:"status_list_id,omitempty"`
+	StatusListIndex uint64 `json:"status_list_index,omitempty"`
+}
+
+func (m *EventCredentialIssued) ProtoMessage()  {}
+func (m *EventCredentialIssued) Reset()         { *m = EventCredentialIssued{} }
+func (m *EventCredentialIssued) String() string { return proto.CompactTextString(m) }
+
+// EmitCredentialIssuedEvent builds and emits the credential_issued event for vc, plus
+// its EventCredentialIssued typed counterpart. Intended to be called exactly once by a
+// future credential keeper's MsgCreateCredential/MsgIssueCredentialBBS handler so every
+// issuance produces one primary event.
+func EmitCredentialIssuedEvent(ctx sdk.Context, vc *VerifiableCredential, statusListID string, statusListIndex uint64) {
+	joinedType := strings.Join(vc.Type, ",")
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCredentialIssued,
+			sdk.NewAttribute(AttributeKeyIssuer, vc.Issuer),
+			sdk.NewAttribute(AttributeKeySubject, vc.CredentialSubject.ID),
+			sdk.NewAttribute(AttributeKeyCredentialID, vc.ID),
+			sdk.NewAttribute(AttributeKeyCredentialType, joinedType),
+			sdk.NewAttribute(AttributeKeyStatusListID, statusListID),
+			sdk.NewAttribute(AttributeKeyStatusIndex, strconv.FormatUint(statusListIndex, 10)),
+		),
+	})
+}
+
+// EmitCredentialStatusEvent emits the credential_suspended or credential_reinstated
+// event, depending on purpose and revoked, for a status transition recorded by
+// MsgUpdateCredentialStatus.
+func EmitCredentialStatusEvent(ctx sdk.Context, issuer, credentialID, statusListID string, statusListIndex uint64, purpose StatusPurpose, revoked bool) {
+	eventType := EventTypeCredentialReinstated
+	if revoked {
+		eventType = EventTypeCredentialRevoked
+		if purpose == StatusPurposeSuspension {
+			eventType = EventTypeCredentialSuspended
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(AttributeKeyIssuer, issuer),
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+			sdk.NewAttribute(AttributeKeyStatusListID, statusListID),
+			sdk.NewAttribute(AttributeKeyStatusIndex, strconv.FormatUint(statusListIndex, 10)),
+			sdk.NewAttribute(AttributeKeyRevoked, boolToString(revoked)),
+		),
+	})
+}
+
+// EmitPresentationSubmittedEvent emits the presentation_submitted event for a holder's
+// MsgCreatePresentation/MsgCreatePresentationBBS.
+func EmitPresentationSubmittedEvent(ctx sdk.Context, holder, presentationID string, credentialTypes []string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypePresentationSubmitted,
+			sdk.NewAttribute(AttributeKeyHolder, holder),
+			sdk.NewAttribute(AttributeKeyPresentationID, presentationID),
+			sdk.NewAttribute(AttributeKeyCredentialType, strings.Join(credentialTypes, ",")),
+		),
+	})
+}
+
+// EmitCredentialExpiredEvent emits the credential_expired event for a credential
+// EndBlocker just flipped to CredentialStatusExpired.
+func EmitCredentialExpiredEvent(ctx sdk.Context, issuer, credentialID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCredentialExpired,
+			sdk.NewAttribute(AttributeKeyIssuer, issuer),
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+		),
+	})
+}
+
+// EmitCredentialVerifiedEvent emits the credential_verified event for
+// MsgServer.VerifyCredential's outcome, whether the proof checked out or not.
+func EmitCredentialVerifiedEvent(ctx sdk.Context, verifier, credentialID string, format ProofFormat, verified bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCredentialVerified,
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+			sdk.NewAttribute(AttributeKeyHolder, verifier),
+			sdk.NewAttribute(AttributeKeyProofFormat, string(format)),
+			sdk.NewAttribute(AttributeKeyVerified, boolToString(verified)),
+		),
+	})
+}
+
+func boolToString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+// EmitApplicationSubmittedEvent emits the credential_application_submitted event for a
+// newly-created CredentialApplication -- see keeper/application.go's SubmitApplication.
+func EmitApplicationSubmittedEvent(ctx sdk.Context, applicationID, holder, issuer, schemaID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeApplicationSubmitted,
+			sdk.NewAttribute(AttributeKeyApplicationID, applicationID),
+			sdk.NewAttribute(AttributeKeyHolder, holder),
+			sdk.NewAttribute(AttributeKeyIssuer, issuer),
+			sdk.NewAttribute(AttributeKeySchema, schemaID),
+		),
+	})
+}
+
+// EmitApplicationReviewedEvent emits the credential_application_reviewed event for
+// ReviewApplication's outcome. credentialID is empty unless approved is true.
+func EmitApplicationReviewedEvent(ctx sdk.Context, applicationID, issuer, reason string, approved bool, credentialID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeApplicationReviewed,
+			sdk.NewAttribute(AttributeKeyApplicationID, applicationID),
+			sdk.NewAttribute(AttributeKeyIssuer, issuer),
+			sdk.NewAttribute(AttributeKeyApproved, boolToString(approved)),
+			sdk.NewAttribute(AttributeKeyReason, reason),
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+		),
+	})
+}
+
+// EmitApplicationExpiredEvent emits the credential_application_expired event when
+// EndBlocker's expireOverdueApplications auto-denies an application past its review
+// Deadline.
+func EmitApplicationExpiredEvent(ctx sdk.Context, applicationID, issuer string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeApplicationExpired,
+			sdk.NewAttribute(AttributeKeyApplicationID, applicationID),
+			sdk.NewAttribute(AttributeKeyIssuer, issuer),
+		),
+	})
+}
+
+// EmitIBCVerificationRequestedEvent emits the ibc_verification_requested event for
+// MsgServer.RequestVerification's outcome, the controller side of cross-chain
+// credential verification -- see keeper/ibc_verification.go.
+func EmitIBCVerificationRequestedEvent(ctx sdk.Context, requester, channelID, credentialID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeIBCVerificationRequested,
+			sdk.NewAttribute(AttributeKeyHolder, requester),
+			sdk.NewAttribute(AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+		),
+	})
+}
+
+// EmitIBCVerificationAcknowledgedEvent emits the ibc_verification_acknowledged event
+// for the host side of cross-chain credential verification -- see ibc/ibc_module.go's
+// OnRecvPacket, which acts as the identity oracle for the requesting chain.
+func EmitIBCVerificationAcknowledgedEvent(ctx sdk.Context, credentialID string, verified bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeIBCVerificationAcknowledged,
+			sdk.NewAttribute(AttributeKeyCredentialID, credentialID),
+			sdk.NewAttribute(AttributeKeyVerified, boolToString(verified)),
+		),
+	})
+}
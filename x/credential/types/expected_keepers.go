@@ -0,0 +1,108 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	oracletypes "github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// AccountKeeper defines the expected interface for the Account module.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface for the Bank module.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DIDKeeper defines the expected interface for the DID module. A credential keeper
+// checks ValidateDID before letting an issuer mint, revoke, or change the status of a
+// credential -- only a DID controller may act as an issuer.
+type DIDKeeper interface {
+	ValidateDID(ctx context.Context, did string) error
+
+	// ResolveVerificationMethod resolves methodID -- a fragment like
+	// "did:persona:abc#key-1" or a bare fragment scoped to did -- against did's current
+	// DID Document and returns the verification method's key type (one of
+	// x/did/types.VerificationKeyType*) and publicKeyMultibase. Added for
+	// keeper/verify.go's VerifyCredential, which needs to check a credential's proof
+	// against the issuer's actual public key rather than only confirming the issuer DID
+	// is active.
+	ResolveVerificationMethod(ctx context.Context, did, methodID string) (keyType string, publicKeyMultibase string, err error)
+}
+
+// RevocationKeeper defines the expected interface onto the StatusList2021
+// merkle-accumulator-backed bitstring registry (x/revocation/keeper) that a credential
+// keeper stamps and checks issuance/revocation/suspension state against. Defined here
+// rather than imported directly, for the same reason SchemaKeeper in schema.go isn't
+// imported from x/schema/keeper: it would pull x/credential/types onto another
+// module's keeper package.
+// OracleKeeper defines the expected interface onto x/oracle's attestation-feed
+// subsystem (x/oracle/keeper/attestation.go), letting a credential keeper look up the
+// latest attested value of an off-chain fact -- e.g. a KYC provider's status feed --
+// before issuing a credential that depends on it. Defined here rather than imported
+// directly, for the same reason RevocationKeeper above isn't imported from
+// x/revocation/keeper.
+type OracleKeeper interface {
+	GetLatestAttestation(ctx sdk.Context, feedID string) (*oracletypes.AttestationRound, error)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper methods the
+// credential-transfer IBCModule (x/credential/ibc/ibc_module.go) needs, the same subset
+// of ibc-go's channel keeper x/did/types/expected_keepers.go's ChannelKeeper exposes for
+// the did-resolver application.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(
+		ctx sdk.Context,
+		channelCap *capabilitytypes.Capability,
+		sourcePort, sourceChannel string,
+		timeoutHeight ibcexported.Height,
+		timeoutTimestamp uint64,
+		data []byte,
+	) (uint64, error)
+	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+// PortKeeper defines the expected IBC port keeper methods used to bind the
+// credential-transfer port during module init.
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+type RevocationKeeper interface {
+	StatusListKeeper
+
+	// CreateStatusList allocates a new StatusList2021 credential of the given size for
+	// issuer, mirroring x/revocation/keeper.Keeper.CreateStatusList. Backs
+	// MsgServer.CreateStatusList (msg_server_lifecycle.go), letting a status list
+	// controller allocate one directly rather than only implicitly via
+	// AllocateStatusListIndex's first-use fallback.
+	CreateStatusList(ctx sdk.Context, id, issuer string, purpose StatusPurpose, size uint64) error
+
+	// AllocateStatusListIndex reserves the next free bit in issuer's current status
+	// list for purpose, rolling to a fresh list generation once the current one fills.
+	AllocateStatusListIndex(ctx sdk.Context, issuer string, purpose StatusPurpose) (statusListID string, index uint64, err error)
+
+	// SetCredentialStatusIndex records the (statusListID, index) a credential was
+	// stamped with on issuance, so later lookups can resolve its status by credential
+	// ID alone.
+	SetCredentialStatusIndex(ctx sdk.Context, credentialID, statusListID string, index uint64)
+
+	// UpdateStatusListEntry flips the status bit at index within statusListID: true
+	// revokes/suspends the credential depending on the list's purpose, false reinstates
+	// it. Returns ErrUnauthorized-equivalent if issuer does not control statusListID.
+	UpdateStatusListEntry(ctx sdk.Context, statusListID string, index uint64, issuer string, revoked bool) error
+}
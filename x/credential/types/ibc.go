@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// PortID is the default port id the credential-transfer IBC application binds to.
+	PortID = "credential-transfer"
+
+	// Version is the version string negotiated during the credential-transfer channel
+	// handshake. OnChanOpenInit/Try reject any counterparty proposing a different one.
+	Version = "credential-transfer-1"
+)
+
+// CredentialPacketData is the JSON packet payload a source chain sends presenting a
+// VerifiableCredential for cross-chain use, following the ICS-20-style
+// lock-on-source/mint-on-destination shape this chunk's request describes:
+// CredentialHash plus Proof let the destination chain verify the credential was
+// actually issued on the source chain without shipping the full VerifiableCredential
+// (which may carry claims the destination chain has no need to see).
+type CredentialPacketData struct {
+	Issuer         string `json:"issuer"`
+	Subject        string `json:"subject"`
+	CredentialHash string `json:"credential_hash"`
+	Proof          []byte `json:"proof,omitempty"`
+}
+
+func (p *CredentialPacketData) ProtoMessage()  {}
+func (p *CredentialPacketData) Reset()         { *p = CredentialPacketData{} }
+func (p *CredentialPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p, the bytes a sender places in
+// channeltypes.Packet.Data.
+func (p CredentialPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p CredentialPacketData) ValidateBasic() error {
+	if p.Issuer == "" {
+		return ErrInvalidIssuer
+	}
+	if p.Subject == "" {
+		return ErrInvalidSubject
+	}
+	if p.CredentialHash == "" {
+		return ErrInvalidCredential.Wrap("credential_hash must be set")
+	}
+	if len(p.Proof) == 0 {
+		return ErrInvalidProof.Wrap("proof must be set")
+	}
+	return nil
+}
+
+// CredentialAcknowledgement is the acknowledgement a destination chain returns once it
+// has verified Issuer's DID and recorded (or rejected) the imported credential.
+type CredentialAcknowledgement struct {
+	Imported bool  `json:"imported"`
+	Height   int64 `json:"height"`
+}
+
+func (a *CredentialAcknowledgement) ProtoMessage()  {}
+func (a *CredentialAcknowledgement) Reset()         { *a = CredentialAcknowledgement{} }
+func (a *CredentialAcknowledgement) String() string { return proto.CompactTextString(a) }
+
+// GetBytes returns the canonical JSON encoding of a, the payload wrapped in a
+// channeltypes.Acknowledgement's Result field.
+func (a CredentialAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// ImportedCredentialStatus mirrors CredentialStatus (credential_types.go) for imported
+// records: Live while the source chain's ack/timeout hasn't rolled it back, Revoked
+// once OnTimeoutPacket or a failed OnAcknowledgementPacket does.
+type ImportedCredentialStatus int32
+
+const (
+	ImportedCredentialStatusLive ImportedCredentialStatus = iota
+	ImportedCredentialStatusRevoked
+)
+
+// ImportedCredential is the record x/credential/ibc's IBCModule persists on
+// OnRecvPacket: enough of CredentialPacketData to answer a local verifier's query
+// against an issuer whose DID lives on another chain, plus the source channel so a
+// later OnAcknowledgementPacket/OnTimeoutPacket rollback can find it again.
+type ImportedCredential struct {
+	Issuer         string                   `json:"issuer"`
+	Subject        string                   `json:"subject"`
+	CredentialHash string                   `json:"credential_hash"`
+	SourceChannel  string                   `json:"source_channel"`
+	Status         ImportedCredentialStatus `json:"status"`
+	ImportedAt     int64                    `json:"imported_at"`
+}
+
+func (m *ImportedCredential) ProtoMessage()  {}
+func (m *ImportedCredential) Reset()         { *m = ImportedCredential{} }
+func (m *ImportedCredential) String() string { return proto.CompactTextString(m) }
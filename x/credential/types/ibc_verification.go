@@ -0,0 +1,144 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// CredentialVerificationPacketData is the packet payload a requesting chain sends over
+// the same credential-transfer port/channel ibc.go already negotiates (types.PortID,
+// types.Version), asking this chain to act as an identity oracle: verify CredentialID
+// is still valid (proof intact, not revoked, not expired -- see keeper.VerifyCredential)
+// and selectively disclose RequestedClaims from it. It rides the same channel as
+// CredentialPacketData (ibc.go); OnRecvPacket tells the two apart by envelope -- see
+// credentialPacketEnvelope in ibc_module.go.
+type CredentialVerificationPacketData struct {
+	CredentialID string `json:"credential_id"`
+	// HolderProof is evidence, supplied by the party presenting the credential, that
+	// they are its rightful holder. This repo has no challenge/session-binding
+	// holder-possession protocol defined anywhere (a real one would bind HolderProof to
+	// a nonce tied to this packet's sequence number to prevent replay); OnRecvPacket
+	// below only checks HolderProof is non-empty rather than cryptographically verifying
+	// possession, and documents that gap rather than fabricating a verification step
+	// this tree has no corresponding proof format for.
+	HolderProof []byte `json:"holder_proof"`
+	// RequestedClaims names the CredentialSubject.Claims keys the requester wants
+	// disclosed if verification succeeds; an empty list discloses nothing, only the
+	// verified/revocation-status outcome itself.
+	RequestedClaims []string `json:"requested_claims,omitempty"`
+}
+
+func (p *CredentialVerificationPacketData) ProtoMessage()  {}
+func (p *CredentialVerificationPacketData) Reset()         { *p = CredentialVerificationPacketData{} }
+func (p *CredentialVerificationPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p.
+func (p CredentialVerificationPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p CredentialVerificationPacketData) ValidateBasic() error {
+	if p.CredentialID == "" {
+		return ErrInvalidCredential.Wrap("credential_id must be set")
+	}
+	if len(p.HolderProof) == 0 {
+		return ErrInvalidProof.Wrap("holder_proof must be set")
+	}
+	return nil
+}
+
+// CredentialVerificationAcknowledgement is the acknowledgement this chain returns for a
+// CredentialVerificationPacketData: the verification outcome plus any DisclosedClaims
+// the requester asked for and the credential's lifecycle state permitted releasing.
+type CredentialVerificationAcknowledgement struct {
+	Verified bool `json:"verified"`
+	// RevocationStatus is "revoked" or "live", mirroring CredentialStatus's string form
+	// rather than the numeric CredentialStatus itself, so a non-Go counterparty chain
+	// doesn't need this module's enum to interpret the ack.
+	RevocationStatus string `json:"revocation_status"`
+	// DisclosedClaims holds the JSON-marshaled value of each requested claim that was
+	// both present on the credential and released -- never set when Verified is false.
+	DisclosedClaims map[string]json.RawMessage `json:"disclosed_claims,omitempty"`
+	Height          int64                      `json:"height"`
+}
+
+func (a *CredentialVerificationAcknowledgement) ProtoMessage() {}
+func (a *CredentialVerificationAcknowledgement) Reset() {
+	*a = CredentialVerificationAcknowledgement{}
+}
+func (a *CredentialVerificationAcknowledgement) String() string {
+	return proto.CompactTextString(a)
+}
+
+// GetBytes returns the canonical JSON encoding of a.
+func (a CredentialVerificationAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// MsgRequestVerification is the controller-side message a local account submits to ask
+// a counterparty chain (reached over ChannelId) to verify CredentialID -- the mirror
+// image of OnRecvPacket's host-side role: this chain plays requester here instead of
+// oracle. Modeled directly on x/did's MsgQueryRemoteDID (msg_server_remote_did.go),
+// including its limitation: ChannelId must already be open. A real ICS-27-style
+// controller middleware that opens the channel lazily on first use does not exist
+// anywhere in this tree (there is no interchain-accounts controller wiring to extend),
+// so "opens the channel lazily" from this request's title is not implemented --
+// RequestVerification (keeper/ibc_verification.go) returns ErrInvalidIBCVersion if
+// ChannelId isn't already open, the same failure mode QueryRemoteDID has.
+type MsgRequestVerification struct {
+	Requester        string   `json:"requester"`
+	ChannelId        string   `json:"channel_id"`
+	CredentialID     string   `json:"credential_id"`
+	HolderProof      []byte   `json:"holder_proof"`
+	RequestedClaims  []string `json:"requested_claims,omitempty"`
+	TimeoutTimestamp uint64   `json:"timeout_timestamp,omitempty"`
+}
+
+var _ sdk.Msg = &MsgRequestVerification{}
+
+const TypeMsgRequestVerification = "request_verification"
+
+func (msg *MsgRequestVerification) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid requester address")
+	}
+	if msg.ChannelId == "" {
+		return ErrInvalidIBCVersion.Wrap("channel_id must be set")
+	}
+	return CredentialVerificationPacketData{
+		CredentialID:    msg.CredentialID,
+		HolderProof:     msg.HolderProof,
+		RequestedClaims: msg.RequestedClaims,
+	}.ValidateBasic()
+}
+
+func (msg *MsgRequestVerification) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRequestVerification) Type() string  { return TypeMsgRequestVerification }
+func (msg *MsgRequestVerification) Route() string { return RouterKey }
+func (msg *MsgRequestVerification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRequestVerification) ProtoMessage()  {}
+func (m *MsgRequestVerification) Reset()         { *m = MsgRequestVerification{} }
+func (m *MsgRequestVerification) String() string { return proto.CompactTextString(m) }
+
+// MsgRequestVerificationResponse returns the IBC packet sequence RequestVerification
+// sent, the same shape MsgQueryRemoteDIDResponse uses: the verification result itself
+// only arrives later, through IBCModule.OnAcknowledgementPacket.
+type MsgRequestVerificationResponse struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+func (m *MsgRequestVerificationResponse) ProtoMessage()  {}
+func (m *MsgRequestVerificationResponse) Reset()         { *m = MsgRequestVerificationResponse{} }
+func (m *MsgRequestVerificationResponse) String() string { return proto.CompactTextString(m) }
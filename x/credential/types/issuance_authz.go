@@ -0,0 +1,270 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// CredentialIssuanceAuthorization is an x/authz-style grant letting a grantee issue
+// credentials on behalf of a granter (the DID-controlling issuer), scoped to specific
+// credential types and schemas, a maximum per-block issuance rate, and a maximum
+// validity period. It is more granular than CredentialAuthorization, which only scopes
+// issuance by type and toggles revoke access.
+type CredentialIssuanceAuthorization struct {
+	// Granter is the issuer delegating authority.
+	Granter string `json:"granter"`
+
+	// Grantee is the address authorized to issue credentials on the granter's behalf.
+	Grantee string `json:"grantee"`
+
+	// AllowedTypes restricts which VerifiableCredential.Type values the grantee may
+	// issue. An empty list means all types are allowed.
+	AllowedTypes []string `json:"allowedTypes,omitempty"`
+
+	// AllowedSchemas restricts which credential schema the grantee may issue against.
+	// An empty list means all schemas are allowed.
+	AllowedSchemas []string `json:"allowedSchemas,omitempty"`
+
+	// MaxPerBlock caps how many credentials the grantee may issue under this grant in a
+	// single block. Zero means unlimited.
+	MaxPerBlock uint64 `json:"maxPerBlock,omitempty"`
+
+	// MaxValidity caps how far in the future an issued credential's expiration date may
+	// be set, measured from its issuance date. Zero means unlimited.
+	MaxValidity time.Duration `json:"maxValidity,omitempty"`
+
+	// Expiration is when the grant itself stops being honored. Nil means it never
+	// expires.
+	Expiration *time.Time `json:"expiration,omitempty"`
+
+	// UsedThisBlock and LastResetHeight track MaxPerBlock usage. The keeper persists
+	// these alongside the grant and Accept resets UsedThisBlock whenever the current
+	// block height has advanced past LastResetHeight.
+	UsedThisBlock   uint64 `json:"usedThisBlock,omitempty"`
+	LastResetHeight int64  `json:"lastResetHeight,omitempty"`
+}
+
+// Implement proto.Message interface for SDK compatibility
+func (m *CredentialIssuanceAuthorization) ProtoMessage()  {}
+func (m *CredentialIssuanceAuthorization) Reset()         { *m = CredentialIssuanceAuthorization{} }
+func (m *CredentialIssuanceAuthorization) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a CredentialIssuanceAuthorization grant.
+func (a *CredentialIssuanceAuthorization) Validate() error {
+	if a.Granter == "" {
+		return ErrInvalidIssuer.Wrap("granter cannot be empty")
+	}
+	if a.Grantee == "" {
+		return ErrUnauthorized.Wrap("grantee cannot be empty")
+	}
+	if a.Granter == a.Grantee {
+		return ErrUnauthorized.Wrap("granter and grantee cannot be the same address")
+	}
+	if a.MaxValidity < 0 {
+		return ErrUnauthorized.Wrap("max validity cannot be negative")
+	}
+	return nil
+}
+
+// IsExpired reports whether the grant is no longer valid at the given time.
+func (a *CredentialIssuanceAuthorization) IsExpired(at time.Time) bool {
+	return a.Expiration != nil && at.After(*a.Expiration)
+}
+
+// Accept checks whether this grant permits issuing a credential of the given type and
+// schema with the given issuance/expiration dates at the current block, resetting the
+// per-block counter if height has advanced since the grant was last used. It returns
+// the updated authorization the keeper should persist, or an error if the grant does
+// not cover the request. Intended to be called by a future credential keeper's
+// MsgExecIssuance handler.
+func (a *CredentialIssuanceAuthorization) Accept(height int64, at time.Time, credentialType, schema string, issuanceDate time.Time, expirationDate *time.Time) (*CredentialIssuanceAuthorization, error) {
+	if a.IsExpired(at) {
+		return nil, ErrUnauthorized.Wrap("credential issuance authorization has expired")
+	}
+	if !acceptsValue(a.AllowedTypes, credentialType) {
+		return nil, ErrUnauthorized.Wrapf("grant does not permit issuing credential type %q", credentialType)
+	}
+	if !acceptsValue(a.AllowedSchemas, schema) {
+		return nil, ErrUnauthorized.Wrapf("grant does not permit issuing against schema %q", schema)
+	}
+	if a.MaxValidity > 0 && expirationDate != nil && expirationDate.After(issuanceDate.Add(a.MaxValidity)) {
+		return nil, ErrUnauthorized.Wrapf("credential validity exceeds the grant's maximum of %s", a.MaxValidity)
+	}
+
+	updated := *a
+	if updated.LastResetHeight != height {
+		updated.UsedThisBlock = 0
+		updated.LastResetHeight = height
+	}
+	if a.MaxPerBlock > 0 && updated.UsedThisBlock >= a.MaxPerBlock {
+		return nil, ErrUnauthorized.Wrapf("grant's per-block issuance limit of %d reached", a.MaxPerBlock)
+	}
+	updated.UsedThisBlock++
+
+	return &updated, nil
+}
+
+func acceptsValue(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, v := range allowed {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// MsgGrantIssuanceAuthority defines the message to delegate scoped issuance authority
+// from a granter to a grantee.
+type MsgGrantIssuanceAuthority struct {
+	Granter string                          `json:"granter"`
+	Grantee string                          `json:"grantee"`
+	Auth    CredentialIssuanceAuthorization `json:"auth"`
+}
+
+var _ sdk.Msg = &MsgGrantIssuanceAuthority{}
+
+const TypeMsgGrantIssuanceAuthority = "grant_issuance_authority"
+
+func (msg *MsgGrantIssuanceAuthority) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgGrantIssuanceAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	auth := msg.Auth
+	auth.Granter = msg.Granter
+	auth.Grantee = msg.Grantee
+	return auth.Validate()
+}
+
+func (msg *MsgGrantIssuanceAuthority) Type() string  { return TypeMsgGrantIssuanceAuthority }
+func (msg *MsgGrantIssuanceAuthority) Route() string { return RouterKey }
+func (msg *MsgGrantIssuanceAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgGrantIssuanceAuthority) ProtoMessage()  {}
+func (m *MsgGrantIssuanceAuthority) Reset()         { *m = MsgGrantIssuanceAuthority{} }
+func (m *MsgGrantIssuanceAuthority) String() string { return proto.CompactTextString(m) }
+
+// MsgGrantIssuanceAuthorityResponse is the response for MsgGrantIssuanceAuthority.
+type MsgGrantIssuanceAuthorityResponse struct{}
+
+func (m *MsgGrantIssuanceAuthorityResponse) ProtoMessage()  {}
+func (m *MsgGrantIssuanceAuthorityResponse) Reset()         { *m = MsgGrantIssuanceAuthorityResponse{} }
+func (m *MsgGrantIssuanceAuthorityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRevokeIssuanceAuthority defines the message to revoke a standing issuance grant.
+type MsgRevokeIssuanceAuthority struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
+
+var _ sdk.Msg = &MsgRevokeIssuanceAuthority{}
+
+const TypeMsgRevokeIssuanceAuthority = "revoke_issuance_authority"
+
+func (msg *MsgRevokeIssuanceAuthority) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgRevokeIssuanceAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	return nil
+}
+
+func (msg *MsgRevokeIssuanceAuthority) Type() string  { return TypeMsgRevokeIssuanceAuthority }
+func (msg *MsgRevokeIssuanceAuthority) Route() string { return RouterKey }
+func (msg *MsgRevokeIssuanceAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRevokeIssuanceAuthority) ProtoMessage()  {}
+func (m *MsgRevokeIssuanceAuthority) Reset()         { *m = MsgRevokeIssuanceAuthority{} }
+func (m *MsgRevokeIssuanceAuthority) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeIssuanceAuthorityResponse is the response for MsgRevokeIssuanceAuthority.
+type MsgRevokeIssuanceAuthorityResponse struct{}
+
+func (m *MsgRevokeIssuanceAuthorityResponse) ProtoMessage()  {}
+func (m *MsgRevokeIssuanceAuthorityResponse) Reset()         { *m = MsgRevokeIssuanceAuthorityResponse{} }
+func (m *MsgRevokeIssuanceAuthorityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgExecIssuance lets a grantee issue a credential under a standing
+// CredentialIssuanceAuthority, signed by the grantee rather than the granter. The
+// keeper looks up the grant from (granter, grantee) inferred from msg.Msg.Issuer and
+// msg.Grantee, calls CredentialIssuanceAuthorization.Accept to enforce its scope and
+// per-block limit, and on success persists the updated grant and processes msg.Msg as
+// if the granter had submitted it directly.
+type MsgExecIssuance struct {
+	Grantee string               `json:"grantee"`
+	Msg     *MsgCreateCredential `json:"msg"`
+}
+
+var _ sdk.Msg = &MsgExecIssuance{}
+
+const TypeMsgExecIssuance = "exec_issuance"
+
+func (msg *MsgExecIssuance) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+func (msg *MsgExecIssuance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	if msg.Msg == nil {
+		return ErrInvalidCredential.Wrap("wrapped issuance message cannot be empty")
+	}
+	if msg.Msg.Issuer == msg.Grantee {
+		return ErrUnauthorized.Wrap("grantee cannot exec issuance on their own behalf")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+func (msg *MsgExecIssuance) Type() string  { return TypeMsgExecIssuance }
+func (msg *MsgExecIssuance) Route() string { return RouterKey }
+func (msg *MsgExecIssuance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgExecIssuance) ProtoMessage()  {}
+func (m *MsgExecIssuance) Reset()         { *m = MsgExecIssuance{} }
+func (m *MsgExecIssuance) String() string { return proto.CompactTextString(m) }
+
+// MsgExecIssuanceResponse is the response for MsgExecIssuance.
+type MsgExecIssuanceResponse struct {
+	CredentialId string `json:"credentialId"`
+}
+
+func (m *MsgExecIssuanceResponse) ProtoMessage()  {}
+func (m *MsgExecIssuanceResponse) Reset()         { *m = MsgExecIssuanceResponse{} }
+func (m *MsgExecIssuanceResponse) String() string { return proto.CompactTextString(m) }
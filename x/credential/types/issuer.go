@@ -0,0 +1,232 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// IssuerRegistration gates CreateCredential behind a per-issuer allow-list, pause
+// switch, denied-subjects list, and rolling rate limit, the "x/identity Issuer
+// registry" this chunk's request asks for. x/identity doesn't exist in this tree --
+// CreateCredential (msg_server_lifecycle.go) is the real issuance entry point, and an
+// IssuerRegistration keyed by ControllerDID is what it now checks before stamping a
+// credential. Unlike CredentialIssuanceAuthorization (issuance_authz.go), which scopes
+// a standing delegation from one issuer to a grantee address, IssuerRegistration gates
+// the issuer's own issuance and is set up by governance (RegisterIssuer/PauseIssuer/
+// UpdateIssuerRateLimit are all authority-only), not by the issuer itself.
+type IssuerRegistration struct {
+	// ControllerDID is the DID this registration governs. CreateCredential looks up the
+	// registration keyed by msg.Issuer, so an issuer with no IssuerRegistration is
+	// unrestricted -- this gate only applies to DIDs governance has opted in.
+	ControllerDID string `json:"controllerDid"`
+
+	// AllowedCredentialTypes restricts which VerifiableCredential.Type values
+	// ControllerDID may issue. An empty list means all types are allowed, matching
+	// CredentialIssuanceAuthorization.AllowedTypes' empty-means-unrestricted convention.
+	AllowedCredentialTypes []string `json:"allowedCredentialTypes,omitempty"`
+
+	// DeniedSubjects blocks issuance to specific CredentialSubject.ID values -- an
+	// issuer-specific denylist, distinct from any chain-wide sanctions list.
+	DeniedSubjects []string `json:"deniedSubjects,omitempty"`
+
+	// RateLimit bounds how many credentials ControllerDID may issue per PeriodBlocks.
+	RateLimit IssuerRateLimit `json:"rateLimit"`
+
+	// Paused stops all issuance under ControllerDID when true, set by governance via
+	// MsgPauseIssuer without needing to clear AllowedCredentialTypes/RateLimit.
+	Paused bool `json:"paused"`
+}
+
+// IssuerRateLimit bounds issuance to MaxPerPeriod credentials per PeriodBlocks blocks.
+// Zero values in either field mean unlimited, the same convention
+// CredentialIssuanceAuthorization.MaxPerBlock uses for "no cap".
+type IssuerRateLimit struct {
+	PeriodBlocks int64  `json:"periodBlocks,omitempty"`
+	MaxPerPeriod uint64 `json:"maxPerPeriod,omitempty"`
+}
+
+// IssuerRateLimitState is the rolling counter CreateCredential increments each time
+// ControllerDID issues within the current period. Period is ctx.BlockHeight() /
+// RateLimit.PeriodBlocks; when Period no longer matches the stored value the counter
+// resets to zero before being incremented, the same roll-forward-on-read pattern
+// x/revocation/keeper/statuslist.go's AllocateStatusListIndex uses for generations.
+type IssuerRateLimitState struct {
+	Period int64  `json:"period"`
+	Count  uint64 `json:"count"`
+}
+
+// Validate validates an IssuerRegistration.
+func (r *IssuerRegistration) Validate() error {
+	if r.ControllerDID == "" {
+		return ErrInvalidDID.Wrap("controller DID cannot be empty")
+	}
+	if r.RateLimit.PeriodBlocks < 0 {
+		return ErrInvalidIssuer.Wrap("rate limit period blocks cannot be negative")
+	}
+	return nil
+}
+
+// IsCredentialTypeAllowed reports whether credType may be issued under r.
+func (r *IssuerRegistration) IsCredentialTypeAllowed(credType string) bool {
+	return acceptsValue(r.AllowedCredentialTypes, credType)
+}
+
+// IsSubjectDenied reports whether subjectDID is on r's denylist.
+func (r *IssuerRegistration) IsSubjectDenied(subjectDID string) bool {
+	for _, denied := range r.DeniedSubjects {
+		if denied == subjectDID {
+			return true
+		}
+	}
+	return false
+}
+
+// MsgRegisterIssuer registers or replaces the IssuerRegistration governing
+// controllerDID. Like MsgUpdateParams, it is authority-gated: an issuer cannot
+// self-register, since the allow-list/rate-limit/pause controls exist to constrain
+// issuers, not be set by them.
+type MsgRegisterIssuer struct {
+	Authority              string          `json:"authority"`
+	ControllerDID          string          `json:"controllerDid"`
+	AllowedCredentialTypes []string        `json:"allowedCredentialTypes,omitempty"`
+	DeniedSubjects         []string        `json:"deniedSubjects,omitempty"`
+	RateLimit              IssuerRateLimit `json:"rateLimit"`
+}
+
+var _ sdk.Msg = &MsgRegisterIssuer{}
+
+const TypeMsgRegisterIssuer = "register_issuer"
+
+func (msg *MsgRegisterIssuer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority.Wrap("invalid authority address")
+	}
+	reg := IssuerRegistration{
+		ControllerDID:          msg.ControllerDID,
+		AllowedCredentialTypes: msg.AllowedCredentialTypes,
+		DeniedSubjects:         msg.DeniedSubjects,
+		RateLimit:              msg.RateLimit,
+	}
+	return reg.Validate()
+}
+
+func (msg *MsgRegisterIssuer) Type() string  { return TypeMsgRegisterIssuer }
+func (msg *MsgRegisterIssuer) Route() string { return RouterKey }
+func (msg *MsgRegisterIssuer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRegisterIssuer) ProtoMessage()  {}
+func (m *MsgRegisterIssuer) Reset()         { *m = MsgRegisterIssuer{} }
+func (m *MsgRegisterIssuer) String() string { return proto.CompactTextString(m) }
+
+// MsgRegisterIssuerResponse is the response for MsgRegisterIssuer.
+type MsgRegisterIssuerResponse struct{}
+
+func (m *MsgRegisterIssuerResponse) ProtoMessage()  {}
+func (m *MsgRegisterIssuerResponse) Reset()         { *m = MsgRegisterIssuerResponse{} }
+func (m *MsgRegisterIssuerResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgPauseIssuer toggles Paused on controllerDID's IssuerRegistration. Governance-only,
+// for halting a compromised or misbehaving issuer without deleting its registration.
+type MsgPauseIssuer struct {
+	Authority     string `json:"authority"`
+	ControllerDID string `json:"controllerDid"`
+	Paused        bool   `json:"paused"`
+}
+
+var _ sdk.Msg = &MsgPauseIssuer{}
+
+const TypeMsgPauseIssuer = "pause_issuer"
+
+func (msg *MsgPauseIssuer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgPauseIssuer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority.Wrap("invalid authority address")
+	}
+	if msg.ControllerDID == "" {
+		return ErrInvalidDID.Wrap("controller DID cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgPauseIssuer) Type() string  { return TypeMsgPauseIssuer }
+func (msg *MsgPauseIssuer) Route() string { return RouterKey }
+func (msg *MsgPauseIssuer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgPauseIssuer) ProtoMessage()  {}
+func (m *MsgPauseIssuer) Reset()         { *m = MsgPauseIssuer{} }
+func (m *MsgPauseIssuer) String() string { return proto.CompactTextString(m) }
+
+// MsgPauseIssuerResponse is the response for MsgPauseIssuer.
+type MsgPauseIssuerResponse struct{}
+
+func (m *MsgPauseIssuerResponse) ProtoMessage()  {}
+func (m *MsgPauseIssuerResponse) Reset()         { *m = MsgPauseIssuerResponse{} }
+func (m *MsgPauseIssuerResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateIssuerRateLimit replaces controllerDID's IssuerRateLimit without touching
+// its allow-list, denylist, or Paused state.
+type MsgUpdateIssuerRateLimit struct {
+	Authority     string          `json:"authority"`
+	ControllerDID string          `json:"controllerDid"`
+	RateLimit     IssuerRateLimit `json:"rateLimit"`
+}
+
+var _ sdk.Msg = &MsgUpdateIssuerRateLimit{}
+
+const TypeMsgUpdateIssuerRateLimit = "update_issuer_rate_limit"
+
+func (msg *MsgUpdateIssuerRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateIssuerRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority.Wrap("invalid authority address")
+	}
+	if msg.ControllerDID == "" {
+		return ErrInvalidDID.Wrap("controller DID cannot be empty")
+	}
+	if msg.RateLimit.PeriodBlocks < 0 {
+		return ErrInvalidIssuer.Wrap("rate limit period blocks cannot be negative")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateIssuerRateLimit) Type() string  { return TypeMsgUpdateIssuerRateLimit }
+func (msg *MsgUpdateIssuerRateLimit) Route() string { return RouterKey }
+func (msg *MsgUpdateIssuerRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgUpdateIssuerRateLimit) ProtoMessage()  {}
+func (m *MsgUpdateIssuerRateLimit) Reset()         { *m = MsgUpdateIssuerRateLimit{} }
+func (m *MsgUpdateIssuerRateLimit) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateIssuerRateLimitResponse is the response for MsgUpdateIssuerRateLimit.
+type MsgUpdateIssuerRateLimitResponse struct{}
+
+func (m *MsgUpdateIssuerRateLimitResponse) ProtoMessage()  {}
+func (m *MsgUpdateIssuerRateLimitResponse) Reset()         { *m = MsgUpdateIssuerRateLimitResponse{} }
+func (m *MsgUpdateIssuerRateLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
@@ -39,6 +39,74 @@ var (
 	ParamsKey                = collections.NewPrefix(30)
 	CredentialMetadataPrefix = collections.NewPrefix(31)
 	RevocationListPrefix     = collections.NewPrefix(32)
+	StatusListPrefix         = collections.NewPrefix(33)
+	CredentialAuthorizationPrefix = collections.NewPrefix(34)
+
+	// CredentialTypeUsagePrefix indexes CredentialTypeUsage, a per-CredentialTypeDef.Name
+	// counter of how many credentials have been issued with that type -- see
+	// keeper/credential_types.go's RecordCredentialTypeUsage.
+	CredentialTypeUsagePrefix = collections.NewPrefix(35)
+
+	// ImportedCredentialPrefix indexes ImportedCredential records, one per credential
+	// received over the credential-transfer IBC application (x/credential/ibc), keyed by
+	// the same CredentialPacketData.CredentialHash a verifier presents locally --
+	// see ibc/ibc_module.go's OnRecvPacket.
+	ImportedCredentialPrefix = collections.NewPrefix(40)
+
+	// IssuerRegistrationPrefix indexes IssuerRegistration by ControllerDID, the
+	// governance-set allow-list/pause/denylist/rate-limit gate CreateCredential checks
+	// -- see issuer.go and keeper/issuer.go.
+	IssuerRegistrationPrefix = collections.NewPrefix(41)
+
+	// IssuerRateLimitStatePrefix indexes IssuerRateLimitState by ControllerDID, the
+	// rolling per-period issuance counter IssuerRegistration.RateLimit is enforced
+	// against.
+	IssuerRateLimitStatePrefix = collections.NewPrefix(42)
+
+	// CredentialsByStatusPrefix indexes credential IDs by CredentialLifecycleStatus,
+	// maintained by CreateCredential/RevokeCredential/UpdateCredentialStatus/
+	// EndBlocker's processExpiredCredentials -- see keeper/msg_server_lifecycle.go and
+	// keeper/endblock.go.
+	CredentialsByStatusPrefix = collections.NewPrefix(43)
+
+	// CredentialVerificationPrefix indexes VerificationRecord by credential ID, the
+	// deterministic, stored outcome of a MsgVerifyCredential proof check -- see
+	// keeper/verify.go's VerifyCredential.
+	CredentialVerificationPrefix = collections.NewPrefix(44)
+
+	// ApplicationPrefix indexes CredentialApplication by Application ID -- see
+	// keeper/application.go's SubmitApplication/ReviewApplication.
+	ApplicationPrefix = collections.NewPrefix(45)
+
+	// ApplicationsByIssuerPrefix indexes Application IDs by (issuer, applicationID),
+	// maintained by SubmitApplication so ReviewApplication's issuer check and
+	// EndBlocker's expireOverdueApplications can both list an issuer's pending
+	// applications without a full ApplicationPrefix walk.
+	ApplicationsByIssuerPrefix = collections.NewPrefix(46)
+
+	// OperationPrefix indexes CredentialOperation by Application ID, the asynchronous
+	// poll handle a holder reads instead of re-deriving status from the Application
+	// record directly -- see types/application.go's CredentialOperation doc comment.
+	OperationPrefix = collections.NewPrefix(47)
+
+	// CredentialsByStatusListEntryPrefix indexes credential IDs by (statusListID, index),
+	// the StatusList2021 bit a credential was stamped with on issuance -- see
+	// keeper/msg_server_lifecycle.go's CreateCredential and CheckCredentialStatus.
+	CredentialsByStatusListEntryPrefix = collections.NewPrefix(48)
+
+	// CredentialsByAttributePrefix indexes credential IDs by (attrKey, attrValue,
+	// credentialID), maintained by keeper/attributes.go's indexCredentialAttributes for
+	// Params.IndexableAttributeKeys so GetCredentialsByAttributes can resolve an
+	// equality predicate without walking every credential.
+	CredentialsByAttributePrefix = collections.NewPrefix(49)
+
+	// IssuanceAuthorizationPrefix indexes CredentialIssuanceAuthorization by (granter,
+	// grantee) -- see keeper/authz.go's GrantIssuanceAuthority/ExecIssuance.
+	IssuanceAuthorizationPrefix = collections.NewPrefix(50)
+
+	// RevocationAuthorizationPrefix indexes DelegatedRevocationAuthorization by
+	// (granter, grantee) -- see keeper/authz.go's GrantRevocationAuthority/ExecRevocation.
+	RevocationAuthorizationPrefix = collections.NewPrefix(51)
 )
 
 // Legacy string prefixes for compatibility
@@ -116,6 +184,29 @@ func RevocationListKey(issuer string) []byte {
 	return []byte(LegacyRevocationListPrefix + issuer)
 }
 
+// LegacyStatusListPrefix is the legacy string prefix for StatusList2021 credentials
+const LegacyStatusListPrefix = "status_list/"
+
+// StatusListKey creates a store key for a StatusList2021 credential by ID
+func StatusListKey(id string) []byte {
+	return []byte(LegacyStatusListPrefix + id)
+}
+
+// LegacyCredentialAuthorizationPrefix is the legacy string prefix for delegated
+// issuance/revocation authorization grants.
+const LegacyCredentialAuthorizationPrefix = "credential_authz/"
+
+// CredentialAuthorizationKey creates a store key for a grant from granter to grantee.
+func CredentialAuthorizationKey(granter, grantee string) []byte {
+	return []byte(LegacyCredentialAuthorizationPrefix + granter + ":" + grantee)
+}
+
+// CredentialAuthorizationByGranterPrefixKey returns the prefix for iterating all grants
+// issued by a given granter.
+func CredentialAuthorizationByGranterPrefixKey(granter string) []byte {
+	return []byte(LegacyCredentialAuthorizationPrefix + granter + ":")
+}
+
 // Key extraction functions
 
 // GetCredentialIDFromKey extracts the credential ID from a credential store key
@@ -259,20 +350,11 @@ func ParseCredentialID(credID string) error {
 	return nil
 }
 
-// ValidateCredentialType validates the credential type is supported
-func ValidateCredentialType(credType string) bool {
-	supportedTypes := map[string]bool{
-		"VerifiableCredential": true,
-		"PersonaCredential":    true,
-		"EducationCredential":  true,
-		"IdentityCredential":   true,
-		"ProofOfAddress":       true,
-		"ProofOfIncome":        true,
-		"EmailCredential":      true,
-		"PhoneCredential":      true,
-	}
-	return supportedTypes[credType]
-}
+// ValidateCredentialType's hardcoded eight-entry map literal moved to Params.CredentialTypes
+// (see credential_types.go's CredentialTypeDef/DefaultCredentialTypeDefs), so governance can
+// register or deprecate a type via MsgUpdateParams without a binary upgrade. Checking the
+// allowlist now needs params, so it's Keeper.ValidateCredentialType (keeper/credential_types.go)
+// rather than a free function here.
 
 // ExtractHashFromCredential extracts the hash portion from a credential ID
 func ExtractHashFromCredential(credID string) string {
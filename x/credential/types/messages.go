@@ -0,0 +1,520 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// RouterKey is the message route for the credential module
+	RouterKey = ModuleName
+)
+
+var (
+	_ sdk.Msg = &MsgCreateCredential{}
+	_ sdk.Msg = &MsgRevokeCredential{}
+	_ sdk.Msg = &MsgCreatePresentation{}
+	_ sdk.Msg = &MsgVerifyCredential{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSubmitCredentialApplication{}
+	_ sdk.Msg = &MsgReviewApplication{}
+)
+
+// Message type constants
+const (
+	TypeMsgCreateCredential             = "create_credential"
+	TypeMsgRevokeCredential             = "revoke_credential"
+	TypeMsgCreatePresentation           = "create_presentation"
+	TypeMsgVerifyCredential             = "verify_credential"
+	TypeMsgUpdateParams                 = "update_params"
+	TypeMsgSubmitCredentialApplication  = "submit_credential_application"
+	TypeMsgReviewApplication            = "review_application"
+)
+
+// MsgCreateCredential defines the message to create a verifiable credential
+type MsgCreateCredential struct {
+	// Context is the JSON-LD context
+	Context []string `json:"context"`
+	// ID is the credential identifier
+	Id string `json:"id"`
+	// CredentialType specifies the credential type
+	CredentialType []string `json:"type"`
+	// Issuer is the credential issuer (DID)
+	Issuer string `json:"issuer"`
+	// CredentialSubject contains the claims
+	CredentialSubject *CredentialSubject `json:"credentialSubject"`
+	// Proof contains the cryptographic proof, including BBS+ linked-data proofs
+	Proof *Proof `json:"proof,omitempty"`
+	// CredentialStatus information for revocation
+	CredentialStatus *CredentialStatus `json:"credentialStatus,omitempty"`
+	// SchemaId, if set, is the x/schema CredentialSchema.ID the credential keeper
+	// validates CredentialType/CredentialSubject.Claims against before issuance via
+	// VerifiableCredential.ValidateAgainstSchema.
+	SchemaId string `json:"schemaId,omitempty"`
+}
+
+// GetSigners returns the expected signers for MsgCreateCredential
+func (msg *MsgCreateCredential) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+// ValidateBasic validates the MsgCreateCredential
+func (msg *MsgCreateCredential) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidCredential.Wrap("credential ID cannot be empty")
+	}
+	if msg.Issuer == "" {
+		return ErrInvalidIssuer.Wrap("issuer cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if len(msg.CredentialType) == 0 {
+		return ErrInvalidCredential.Wrap("credential type cannot be empty")
+	}
+	if len(msg.Context) == 0 {
+		return ErrInvalidCredential.Wrap("credential context cannot be empty")
+	}
+	if msg.CredentialSubject == nil {
+		return ErrInvalidCredential.Wrap("credential subject cannot be nil")
+	}
+	if msg.CredentialSubject.ID == "" {
+		return ErrInvalidCredential.Wrap("credential subject ID cannot be empty")
+	}
+	if msg.Proof != nil {
+		if err := msg.Proof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type returns the message type
+func (msg *MsgCreateCredential) Type() string { return TypeMsgCreateCredential }
+
+// Route returns the message route
+func (msg *MsgCreateCredential) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgCreateCredential) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgCreateCredential) ProtoMessage()  {}
+func (m *MsgCreateCredential) Reset()         { *m = MsgCreateCredential{} }
+func (m *MsgCreateCredential) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateCredentialResponse defines the response for MsgCreateCredential
+type MsgCreateCredentialResponse struct {
+	CredentialId string `json:"credentialId"`
+}
+
+// Implement proto.Message interface
+func (m *MsgCreateCredentialResponse) ProtoMessage()  {}
+func (m *MsgCreateCredentialResponse) Reset()         { *m = MsgCreateCredentialResponse{} }
+func (m *MsgCreateCredentialResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeCredential defines the message to revoke a verifiable credential
+type MsgRevokeCredential struct {
+	CredentialId string `json:"credentialId"`
+	Revoker      string `json:"revoker"`
+}
+
+// GetSigners returns the expected signers for MsgRevokeCredential
+func (msg *MsgRevokeCredential) GetSigners() []sdk.AccAddress {
+	revoker, err := sdk.AccAddressFromBech32(msg.Revoker)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{revoker}
+}
+
+// ValidateBasic validates the MsgRevokeCredential
+func (msg *MsgRevokeCredential) ValidateBasic() error {
+	if msg.CredentialId == "" {
+		return ErrInvalidCredential.Wrap("credential ID cannot be empty")
+	}
+	if msg.Revoker == "" {
+		return ErrInvalidController.Wrap("revoker cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Revoker); err != nil {
+		return ErrInvalidController.Wrap("invalid revoker address")
+	}
+	return nil
+}
+
+// Type returns the message type
+func (msg *MsgRevokeCredential) Type() string { return TypeMsgRevokeCredential }
+
+// Route returns the message route
+func (msg *MsgRevokeCredential) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgRevokeCredential) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgRevokeCredential) ProtoMessage()  {}
+func (m *MsgRevokeCredential) Reset()         { *m = MsgRevokeCredential{} }
+func (m *MsgRevokeCredential) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeCredentialResponse defines the response for MsgRevokeCredential
+type MsgRevokeCredentialResponse struct{}
+
+// Implement proto.Message interface
+func (m *MsgRevokeCredentialResponse) ProtoMessage()  {}
+func (m *MsgRevokeCredentialResponse) Reset()         { *m = MsgRevokeCredentialResponse{} }
+func (m *MsgRevokeCredentialResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgCreatePresentation defines the message to create a verifiable presentation. A
+// holder may optionally derive the presentation from a BBS+ signed credential, revealing
+// only a subset of CredentialSubject claims.
+type MsgCreatePresentation struct {
+	// Context is the JSON-LD context
+	Context []string `json:"context"`
+	// ID is the presentation identifier
+	Id string `json:"id"`
+	// PresentationType specifies the presentation type
+	PresentationType []string `json:"type"`
+	// Holder is the entity that presents the credentials
+	Holder string `json:"holder"`
+	// VerifiableCredential contains the presented credentials
+	VerifiableCredential []string `json:"verifiableCredential"`
+	// Proof contains the cryptographic proof. For a BBS+ derived presentation this is a
+	// BbsBlsSignatureProof2020 proof of knowledge of the issuer's original signature.
+	Proof *Proof `json:"proof,omitempty"`
+	// Reveal lists the JSON-pointer paths into CredentialSubject that the derived
+	// presentation discloses. Only meaningful when Proof.Type is BbsBlsSignatureProof2020;
+	// every other claim is hidden behind the zero-knowledge proof of knowledge.
+	Reveal []string `json:"reveal,omitempty"`
+}
+
+// GetSigners returns the expected signers for MsgCreatePresentation
+func (msg *MsgCreatePresentation) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+// ValidateBasic validates the MsgCreatePresentation
+func (msg *MsgCreatePresentation) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidPresentation.Wrap("presentation ID cannot be empty")
+	}
+	if msg.Holder == "" {
+		return ErrInvalidHolder.Wrap("holder cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return ErrInvalidHolder.Wrap("invalid holder address")
+	}
+	if len(msg.PresentationType) == 0 {
+		return ErrInvalidPresentation.Wrap("presentation type cannot be empty")
+	}
+	if len(msg.Context) == 0 {
+		return ErrInvalidPresentation.Wrap("presentation context cannot be empty")
+	}
+	if len(msg.VerifiableCredential) == 0 {
+		return ErrInvalidPresentation.Wrap("presentation must contain at least one credential")
+	}
+
+	if len(msg.Reveal) > 0 {
+		if msg.Proof == nil || msg.Proof.Type != string(ProofTypeBbsBlsSignatureProof2020) {
+			return ErrInvalidProof.Wrap("reveal paths require a BbsBlsSignatureProof2020 derived proof")
+		}
+	}
+	if msg.Proof != nil && msg.Proof.Type == string(ProofTypeBbsBlsSignatureProof2020) {
+		if len(msg.Reveal) == 0 {
+			return ErrInvalidProof.Wrap("BBS+ derived presentations must specify at least one revealed claim")
+		}
+		if err := msg.Proof.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Type returns the message type
+func (msg *MsgCreatePresentation) Type() string { return TypeMsgCreatePresentation }
+
+// Route returns the message route
+func (msg *MsgCreatePresentation) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgCreatePresentation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgCreatePresentation) ProtoMessage()  {}
+func (m *MsgCreatePresentation) Reset()         { *m = MsgCreatePresentation{} }
+func (m *MsgCreatePresentation) String() string { return proto.CompactTextString(m) }
+
+// MsgCreatePresentationResponse defines the response for MsgCreatePresentation
+type MsgCreatePresentationResponse struct {
+	PresentationId string `json:"presentationId"`
+}
+
+// Implement proto.Message interface
+func (m *MsgCreatePresentationResponse) ProtoMessage()  {}
+func (m *MsgCreatePresentationResponse) Reset()         { *m = MsgCreatePresentationResponse{} }
+func (m *MsgCreatePresentationResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgVerifyCredential defines the message to verify a credential's proof and current
+// revocation state. It carries no state transition of its own -- msgServer runs it
+// through baseapp like any other Msg so a verifier gets the same consensus-committed
+// answer as a node operator reading state directly -- rather than being served as a
+// gRPC query, which would only reflect the querying node's local view.
+type MsgVerifyCredential struct {
+	CredentialId string `json:"credentialId"`
+	Verifier     string `json:"verifier"`
+}
+
+// GetSigners returns the expected signers for MsgVerifyCredential
+func (msg *MsgVerifyCredential) GetSigners() []sdk.AccAddress {
+	verifier, err := sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{verifier}
+}
+
+// ValidateBasic validates the MsgVerifyCredential
+func (msg *MsgVerifyCredential) ValidateBasic() error {
+	if msg.CredentialId == "" {
+		return ErrInvalidCredential.Wrap("credential ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Verifier); err != nil {
+		return ErrInvalidController.Wrap("invalid verifier address")
+	}
+	return nil
+}
+
+// Type returns the message type
+func (msg *MsgVerifyCredential) Type() string { return TypeMsgVerifyCredential }
+
+// Route returns the message route
+func (msg *MsgVerifyCredential) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgVerifyCredential) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgVerifyCredential) ProtoMessage()  {}
+func (m *MsgVerifyCredential) Reset()         { *m = MsgVerifyCredential{} }
+func (m *MsgVerifyCredential) String() string { return proto.CompactTextString(m) }
+
+// MsgVerifyCredentialResponse reports whether CredentialId's proof and schema checks
+// passed (Verified) and, independently, whether its StatusList2021 revocation bit is
+// currently set (Revoked) -- a credential can be Verified and Revoked at the same time,
+// since revocation is a separate, later event from the proof having been valid at
+// issuance.
+//
+// x/credential has no keeper in this tree yet, so nothing currently populates this
+// response. Once one exists, it should resolve Revoked via
+// x/revocation/keeper.Keeper.IsRevokedByBit(ctx, msg.CredentialId), the same helper the
+// StatusList gRPC query in x/revocation uses.
+type MsgVerifyCredentialResponse struct {
+	Verified bool `json:"verified"`
+	Revoked  bool `json:"revoked"`
+}
+
+// Implement proto.Message interface
+func (m *MsgVerifyCredentialResponse) ProtoMessage()  {}
+func (m *MsgVerifyCredentialResponse) Reset()         { *m = MsgVerifyCredentialResponse{} }
+func (m *MsgVerifyCredentialResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateParams defines the message for updating module parameters, gated on the
+// authority address stored on the keeper (see keeper/credential_types.go). The
+// register-type/deprecate-type CLI commands (client/cli/tx.go) are sugar over this same
+// message: they read the current Params, add or mark-deprecated one CredentialTypeDef, and
+// submit the resulting Params wholesale, rather than being separate Msg types of their own.
+type MsgUpdateParams struct {
+	Authority string `json:"authority"`
+	Params    Params `json:"params"`
+}
+
+// NewMsgUpdateParams returns a MsgUpdateParams from authority proposing params.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic validates the MsgUpdateParams
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority.Wrap("invalid authority address")
+	}
+	return msg.Params.Validate()
+}
+
+// Type returns the message type
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// Route returns the message route
+func (msg *MsgUpdateParams) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgUpdateParams) ProtoMessage()  {}
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateParamsResponse defines the response for MsgUpdateParams
+type MsgUpdateParamsResponse struct{}
+
+// Implement proto.Message interface
+func (m *MsgUpdateParamsResponse) ProtoMessage()  {}
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitCredentialApplication defines the message a holder sends to open a
+// CredentialApplication against issuer's published CredentialSchema (SchemaId), the
+// entry point to the application -> review -> issue pipeline -- see
+// types/application.go's CredentialApplication doc comment for why SchemaId stands in
+// for a Credential Manifest reference.
+type MsgSubmitCredentialApplication struct {
+	Id       string                 `json:"id"`
+	Holder   string                 `json:"holder"`
+	Issuer   string                 `json:"issuer"`
+	SchemaId string                 `json:"schemaId"`
+	Claims   map[string]interface{} `json:"claims"`
+}
+
+// GetSigners returns the expected signers for MsgSubmitCredentialApplication
+func (msg *MsgSubmitCredentialApplication) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+// ValidateBasic validates the MsgSubmitCredentialApplication
+func (msg *MsgSubmitCredentialApplication) ValidateBasic() error {
+	app := CredentialApplication{Id: msg.Id, Holder: msg.Holder, Issuer: msg.Issuer, SchemaId: msg.SchemaId}
+	return app.ValidateBasic()
+}
+
+// Type returns the message type
+func (msg *MsgSubmitCredentialApplication) Type() string { return TypeMsgSubmitCredentialApplication }
+
+// Route returns the message route
+func (msg *MsgSubmitCredentialApplication) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgSubmitCredentialApplication) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgSubmitCredentialApplication) ProtoMessage()  {}
+func (m *MsgSubmitCredentialApplication) Reset()         { *m = MsgSubmitCredentialApplication{} }
+func (m *MsgSubmitCredentialApplication) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitCredentialApplicationResponse defines the response for
+// MsgSubmitCredentialApplication.
+type MsgSubmitCredentialApplicationResponse struct {
+	ApplicationId string `json:"applicationId"`
+}
+
+// Implement proto.Message interface
+func (m *MsgSubmitCredentialApplicationResponse) ProtoMessage()  {}
+func (m *MsgSubmitCredentialApplicationResponse) Reset() {
+	*m = MsgSubmitCredentialApplicationResponse{}
+}
+func (m *MsgSubmitCredentialApplicationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgReviewApplication defines the message the manifest's issuer sends to approve or
+// deny a pending CredentialApplication. CredentialOverrides, if set, are merged over the
+// application's own Claims (overrides winning) before the VC is constructed on approval
+// -- see keeper/application.go's ReviewApplication.
+type MsgReviewApplication struct {
+	ApplicationId       string                 `json:"applicationId"`
+	Issuer              string                 `json:"issuer"`
+	Approved            bool                   `json:"approved"`
+	Reason              string                 `json:"reason,omitempty"`
+	CredentialOverrides map[string]interface{} `json:"credentialOverrides,omitempty"`
+}
+
+// GetSigners returns the expected signers for MsgReviewApplication
+func (msg *MsgReviewApplication) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+// ValidateBasic validates the MsgReviewApplication
+func (msg *MsgReviewApplication) ValidateBasic() error {
+	if msg.ApplicationId == "" {
+		return ErrApplicationNotFound.Wrap("application id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if !msg.Approved && msg.Reason == "" {
+		return ErrInvalidCredentialData.Wrap("a denial must include a reason")
+	}
+	return nil
+}
+
+// Type returns the message type
+func (msg *MsgReviewApplication) Type() string { return TypeMsgReviewApplication }
+
+// Route returns the message route
+func (msg *MsgReviewApplication) Route() string { return RouterKey }
+
+// GetSignBytes returns the message bytes to sign over
+func (msg *MsgReviewApplication) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implement proto.Message interface
+func (m *MsgReviewApplication) ProtoMessage()  {}
+func (m *MsgReviewApplication) Reset()         { *m = MsgReviewApplication{} }
+func (m *MsgReviewApplication) String() string { return proto.CompactTextString(m) }
+
+// MsgReviewApplicationResponse reports the Application's resulting Status and, when
+// approved, the CredentialId the review minted.
+type MsgReviewApplicationResponse struct {
+	Status       ApplicationStatus `json:"status"`
+	CredentialId string            `json:"credentialId,omitempty"`
+}
+
+// Implement proto.Message interface
+func (m *MsgReviewApplicationResponse) ProtoMessage()  {}
+func (m *MsgReviewApplicationResponse) Reset()         { *m = MsgReviewApplicationResponse{} }
+func (m *MsgReviewApplicationResponse) String() string { return proto.CompactTextString(m) }
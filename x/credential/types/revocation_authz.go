@@ -0,0 +1,244 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// DelegatedRevocationAuthorization is an x/authz-style grant letting a grantee revoke
+// credentials on behalf of a granter (the DID-controlling issuer), scoped to specific
+// credential types, a maximum per-block revocation count, and an optional expiration.
+// It is CredentialIssuanceAuthorization's (issuance_authz.go) counterpart for
+// MsgRevokeCredential, kept as its own type rather than a single grant covering both
+// directions since a granter may want to delegate revocation to a different, more
+// trusted party than day-to-day issuance.
+type DelegatedRevocationAuthorization struct {
+	// Granter is the issuer delegating authority.
+	Granter string `json:"granter"`
+
+	// Grantee is the address authorized to revoke credentials on the granter's behalf.
+	Grantee string `json:"grantee"`
+
+	// AllowedCredentialTypes restricts which VerifiableCredential.Type values the
+	// grantee may revoke. An empty list means all types are allowed.
+	AllowedCredentialTypes []string `json:"allowedCredentialTypes,omitempty"`
+
+	// MaxPerBlock caps how many credentials the grantee may revoke under this grant in
+	// a single block. Zero means unlimited.
+	MaxPerBlock uint64 `json:"maxPerBlock,omitempty"`
+
+	// Expiration is when the grant itself stops being honored. Nil means it never
+	// expires.
+	Expiration *time.Time `json:"expiration,omitempty"`
+
+	// UsedThisBlock and LastResetHeight track MaxPerBlock usage, the same way
+	// CredentialIssuanceAuthorization's do: the keeper persists these alongside the
+	// grant and Accept resets UsedThisBlock whenever the current block height has
+	// advanced past LastResetHeight.
+	UsedThisBlock   uint64 `json:"usedThisBlock,omitempty"`
+	LastResetHeight int64  `json:"lastResetHeight,omitempty"`
+}
+
+// Implement proto.Message interface for SDK compatibility
+func (m *DelegatedRevocationAuthorization) ProtoMessage()  {}
+func (m *DelegatedRevocationAuthorization) Reset()         { *m = DelegatedRevocationAuthorization{} }
+func (m *DelegatedRevocationAuthorization) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a DelegatedRevocationAuthorization grant.
+func (a *DelegatedRevocationAuthorization) Validate() error {
+	if a.Granter == "" {
+		return ErrInvalidIssuer.Wrap("granter cannot be empty")
+	}
+	if a.Grantee == "" {
+		return ErrUnauthorized.Wrap("grantee cannot be empty")
+	}
+	if a.Granter == a.Grantee {
+		return ErrUnauthorized.Wrap("granter and grantee cannot be the same address")
+	}
+	return nil
+}
+
+// IsExpired reports whether the grant is no longer valid at the given time.
+func (a *DelegatedRevocationAuthorization) IsExpired(at time.Time) bool {
+	return a.Expiration != nil && at.After(*a.Expiration)
+}
+
+// Accept checks whether this grant permits revoking a credential of the given type at
+// the current block, resetting the per-block counter if height has advanced since the
+// grant was last used. It returns the updated authorization the keeper should persist,
+// or an error if the grant does not cover the request. Intended to be called by
+// keeper.MsgServer's ExecRevocation (keeper/authz.go).
+func (a *DelegatedRevocationAuthorization) Accept(height int64, at time.Time, credentialType string) (*DelegatedRevocationAuthorization, error) {
+	if a.IsExpired(at) {
+		return nil, ErrUnauthorized.Wrap("delegated revocation authorization has expired")
+	}
+	if !acceptsValue(a.AllowedCredentialTypes, credentialType) {
+		return nil, ErrUnauthorized.Wrapf("grant does not permit revoking credential type %q", credentialType)
+	}
+
+	updated := *a
+	if updated.LastResetHeight != height {
+		updated.UsedThisBlock = 0
+		updated.LastResetHeight = height
+	}
+	if a.MaxPerBlock > 0 && updated.UsedThisBlock >= a.MaxPerBlock {
+		return nil, ErrUnauthorized.Wrapf("grant's per-block revocation limit of %d reached", a.MaxPerBlock)
+	}
+	updated.UsedThisBlock++
+
+	return &updated, nil
+}
+
+// MsgGrantRevocationAuthority defines the message to delegate scoped revocation
+// authority from a granter to a grantee.
+type MsgGrantRevocationAuthority struct {
+	Granter string                           `json:"granter"`
+	Grantee string                           `json:"grantee"`
+	Auth    DelegatedRevocationAuthorization `json:"auth"`
+}
+
+var _ sdk.Msg = &MsgGrantRevocationAuthority{}
+
+const TypeMsgGrantRevocationAuthority = "grant_revocation_authority"
+
+func (msg *MsgGrantRevocationAuthority) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgGrantRevocationAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	auth := msg.Auth
+	auth.Granter = msg.Granter
+	auth.Grantee = msg.Grantee
+	return auth.Validate()
+}
+
+func (msg *MsgGrantRevocationAuthority) Type() string  { return TypeMsgGrantRevocationAuthority }
+func (msg *MsgGrantRevocationAuthority) Route() string { return RouterKey }
+func (msg *MsgGrantRevocationAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgGrantRevocationAuthority) ProtoMessage()  {}
+func (m *MsgGrantRevocationAuthority) Reset()         { *m = MsgGrantRevocationAuthority{} }
+func (m *MsgGrantRevocationAuthority) String() string { return proto.CompactTextString(m) }
+
+// MsgGrantRevocationAuthorityResponse is the response for MsgGrantRevocationAuthority.
+type MsgGrantRevocationAuthorityResponse struct{}
+
+func (m *MsgGrantRevocationAuthorityResponse) ProtoMessage()  {}
+func (m *MsgGrantRevocationAuthorityResponse) Reset()         { *m = MsgGrantRevocationAuthorityResponse{} }
+func (m *MsgGrantRevocationAuthorityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRevokeRevocationAuthority defines the message to revoke a standing revocation
+// delegation.
+type MsgRevokeRevocationAuthority struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
+
+var _ sdk.Msg = &MsgRevokeRevocationAuthority{}
+
+const TypeMsgRevokeRevocationAuthority = "revoke_revocation_authority"
+
+func (msg *MsgRevokeRevocationAuthority) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgRevokeRevocationAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	return nil
+}
+
+func (msg *MsgRevokeRevocationAuthority) Type() string  { return TypeMsgRevokeRevocationAuthority }
+func (msg *MsgRevokeRevocationAuthority) Route() string { return RouterKey }
+func (msg *MsgRevokeRevocationAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRevokeRevocationAuthority) ProtoMessage()  {}
+func (m *MsgRevokeRevocationAuthority) Reset()         { *m = MsgRevokeRevocationAuthority{} }
+func (m *MsgRevokeRevocationAuthority) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeRevocationAuthorityResponse is the response for MsgRevokeRevocationAuthority.
+type MsgRevokeRevocationAuthorityResponse struct{}
+
+func (m *MsgRevokeRevocationAuthorityResponse) ProtoMessage()  {}
+func (m *MsgRevokeRevocationAuthorityResponse) Reset()         { *m = MsgRevokeRevocationAuthorityResponse{} }
+func (m *MsgRevokeRevocationAuthorityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgExecRevocation lets a grantee revoke a credential under a standing
+// DelegatedRevocationAuthority, signed by the grantee rather than the granter. The
+// keeper resolves msg.Msg.CredentialId's issuer and type, looks up the grant from
+// (issuer, grantee), calls DelegatedRevocationAuthorization.Accept to enforce its scope
+// and per-block limit, and on success persists the updated grant and processes msg.Msg
+// as if the issuer had submitted it directly.
+type MsgExecRevocation struct {
+	Grantee string               `json:"grantee"`
+	Msg     *MsgRevokeCredential `json:"msg"`
+}
+
+var _ sdk.Msg = &MsgExecRevocation{}
+
+const TypeMsgExecRevocation = "exec_revocation"
+
+func (msg *MsgExecRevocation) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+func (msg *MsgExecRevocation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	if msg.Msg == nil {
+		return ErrInvalidRevocation.Wrap("wrapped revocation message cannot be empty")
+	}
+	if msg.Msg.Revoker == msg.Grantee {
+		return ErrUnauthorized.Wrap("grantee cannot exec revocation on their own behalf")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+func (msg *MsgExecRevocation) Type() string  { return TypeMsgExecRevocation }
+func (msg *MsgExecRevocation) Route() string { return RouterKey }
+func (msg *MsgExecRevocation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgExecRevocation) ProtoMessage()  {}
+func (m *MsgExecRevocation) Reset()         { *m = MsgExecRevocation{} }
+func (m *MsgExecRevocation) String() string { return proto.CompactTextString(m) }
+
+// MsgExecRevocationResponse is the response for MsgExecRevocation.
+type MsgExecRevocationResponse struct {
+	CredentialId string `json:"credentialId"`
+}
+
+func (m *MsgExecRevocationResponse) ProtoMessage()  {}
+func (m *MsgExecRevocationResponse) Reset()         { *m = MsgExecRevocationResponse{} }
+func (m *MsgExecRevocationResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"context"
+)
+
+// SchemaKeeper is the subset of the schema module's keeper that credential issuance
+// needs: resolving a schema by its content-addressed ID and validating a credential's
+// declared type and claims against it. Takes context.Context, not sdk.Context, to match
+// x/schema/keeper.Keeper's methods after their KVStoreService/collections migration --
+// see that package's keeper.go doc comment. Existing callers here still pass an
+// sdk.Context positionally (e.g. ReviewApplication's sdkCtx below); that continues to
+// compile unchanged since sdk.Context satisfies context.Context.
+type SchemaKeeper interface {
+	ValidateCredential(ctx context.Context, schemaID string, credentialType []string, claims map[string]interface{}) error
+
+	// GetSchemaType returns the CredentialSchema.Schema.Type governed by schemaID, the
+	// VerifiableCredential.Type an application reviewed against this schema is issued
+	// with -- see keeper/application.go's ReviewApplication, which plays the role a
+	// manifest's output descriptor would in the DIF Credential Manifest flow this
+	// request is modeled on, since this tree has no CredentialManifest/output-descriptor
+	// concept of its own (see CredentialApplication's doc comment in
+	// types/application.go).
+	GetSchemaType(ctx context.Context, schemaID string) (credentialType string, err error)
+}
+
+// ValidateAgainstSchema resolves schemaID (CredentialMetadata.Schema) via k, if
+// non-empty, and checks that the credential's declared type and claims satisfy it.
+// Any failure is surfaced as ErrInvalidCredential so MsgCreateCredential can reject a
+// non-conforming credential uniformly regardless of which schema-module error caused
+// it. Intended to be called from a future credential keeper before a credential is
+// persisted.
+func (vc *VerifiableCredential) ValidateAgainstSchema(ctx context.Context, k SchemaKeeper, schemaID string) error {
+	if schemaID == "" {
+		return nil
+	}
+	if err := k.ValidateCredential(ctx, schemaID, vc.Type, vc.CredentialSubject.Claims); err != nil {
+		return ErrInvalidCredential.Wrapf("schema validation failed: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// StatusListEntryType is the CredentialStatus.Type value used to reference a
+// StatusList2021 bit, per https://w3c.github.io/vc-status-list-2021/.
+const StatusListEntryType = "StatusList2021Entry"
+
+// StatusPurpose distinguishes what it means for a bit in a status list to be set.
+type StatusPurpose string
+
+const (
+	StatusPurposeRevocation StatusPurpose = "revocation"
+	StatusPurposeSuspension StatusPurpose = "suspension"
+)
+
+// StatusList is an on-chain StatusList2021 credential: a gzip-compressed, base64url
+// encoded bitstring in which bit i records the revocation/suspension state of the
+// credential that was issued with statusListIndex i against this list.
+//
+// x/revocation/types.StatusList is a second, independently-maintained copy of this same
+// shape (same field names, same bitstring encode/decode scheme), and it is the one
+// actually wired up end to end: x/revocation/keeper.Keeper.AllocateStatusListIndex and
+// UpdateStatusListEntry back the RevocationKeeper interface this package's
+// CreateCredential/RevokeCredential call into (see expected_keepers.go), and
+// IsCredentialRevoked -- the StatusListKeeper method VerifiableCredential.IsRevoked
+// calls -- reads x/revocation's StatusLists collection, not this package's. This type
+// and NewStatusListEntry below remain in use for building a CredentialStatus entry at
+// issuance time. MsgCreateStatusList/MsgUpdateCredentialStatus further down do have msg
+// server implementations (msg_server_lifecycle.go's CreateStatusList and
+// UpdateCredentialStatus), both forwarding to ms.revocationKeeper so a status list
+// controller can allocate a list or flip a suspension bit as a standalone transaction
+// rather than only implicitly through CreateCredential/RevokeCredential.
+type StatusList struct {
+	// Id is the status list's identifier, referenced by CredentialStatus.StatusListId.
+	Id string `json:"id"`
+
+	// Issuer is the DID that controls this status list.
+	Issuer string `json:"issuer"`
+
+	// Purpose determines whether a set bit means "revoked" or "suspended".
+	Purpose StatusPurpose `json:"purpose"`
+
+	// EncodedList is the gzip+base64url encoded bitstring, per the StatusList2021 spec.
+	EncodedList string `json:"encodedList"`
+
+	// Size is the number of bits (credential slots) the list holds.
+	Size uint64 `json:"size"`
+
+	// Created and Updated track the list's lifecycle.
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+// Implement proto.Message interface for SDK compatibility
+func (m *StatusList) ProtoMessage()  {}
+func (m *StatusList) Reset()         { *m = StatusList{} }
+func (m *StatusList) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a StatusList.
+func (s *StatusList) Validate() error {
+	if s.Id == "" {
+		return ErrInvalidCredential.Wrap("status list ID cannot be empty")
+	}
+	if s.Issuer == "" {
+		return ErrInvalidIssuer.Wrap("status list issuer cannot be empty")
+	}
+	if s.Purpose != StatusPurposeRevocation && s.Purpose != StatusPurposeSuspension {
+		return ErrInvalidCredential.Wrapf("unsupported status purpose %q", s.Purpose)
+	}
+	if s.Size == 0 {
+		return ErrInvalidCredential.Wrap("status list size cannot be zero")
+	}
+	return nil
+}
+
+// NewEmptyBitstring returns a gzip+base64url encoded bitstring of the given size with
+// every bit cleared, suitable as the EncodedList of a freshly created StatusList.
+func NewEmptyBitstring(size uint64) (string, error) {
+	return encodeBitstring(make([]byte, (size+7)/8))
+}
+
+// GetStatusBit reports whether bit index is set in a StatusList's EncodedList.
+func (s *StatusList) GetStatusBit(index uint64) (bool, error) {
+	if index >= s.Size {
+		return false, ErrInvalidCredential.Wrapf("status list index %d out of range for size %d", index, s.Size)
+	}
+	bits, err := decodeBitstring(s.EncodedList)
+	if err != nil {
+		return false, err
+	}
+	byteIdx, bitOff := index/8, index%8
+	if byteIdx >= uint64(len(bits)) {
+		return false, nil
+	}
+	return bits[byteIdx]&(1<<bitOff) != 0, nil
+}
+
+// SetStatusBit returns a copy of the bitstring with bit index flipped to the given
+// value, re-encoded. It does not mutate the receiver's EncodedList.
+func (s *StatusList) SetStatusBit(index uint64, value bool) (string, error) {
+	if index >= s.Size {
+		return "", ErrInvalidCredential.Wrapf("status list index %d out of range for size %d", index, s.Size)
+	}
+	bits, err := decodeBitstring(s.EncodedList)
+	if err != nil {
+		return "", err
+	}
+	byteIdx, bitOff := index/8, index%8
+	if byteIdx >= uint64(len(bits)) {
+		return "", ErrInvalidCredential.Wrap("bitstring shorter than declared size")
+	}
+	if value {
+		bits[byteIdx] |= 1 << bitOff
+	} else {
+		bits[byteIdx] &^= 1 << bitOff
+	}
+	return encodeBitstring(bits)
+}
+
+func encodeBitstring(bits []byte) (string, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(bits); err != nil {
+		return "", fmt.Errorf("compress status bitstring: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("compress status bitstring: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeBitstring(encoded string) ([]byte, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode status bitstring: %w", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompress status bitstring: %w", err)
+	}
+	defer gz.Close()
+	bits, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, fmt.Errorf("decompress status bitstring: %w", err)
+	}
+	return bits, nil
+}
+
+// NewStatusListEntry builds the CredentialStatus that IssueCredential stamps onto a
+// freshly issued credential once the revocation module has allocated it a bit index:
+// ID follows the "{statusList}#<index>" convention so a verifier can split it back
+// into the status list to fetch and the bit to check.
+func NewStatusListEntry(statusListID string, index uint64, purpose StatusPurpose) *CredentialStatus {
+	statusIndex := int(index)
+	statusPurpose := string(purpose)
+	return &CredentialStatus{
+		ID:              fmt.Sprintf("%s#%d", statusListID, index),
+		Type:            StatusListEntryType,
+		StatusListIndex: &statusIndex,
+		StatusPurpose:   &statusPurpose,
+		StatusListId:    statusListID,
+	}
+}
+
+// MsgCreateStatusList defines the message to create a new StatusList2021 credential.
+type MsgCreateStatusList struct {
+	Id      string        `json:"id"`
+	Issuer  string        `json:"issuer"`
+	Purpose StatusPurpose `json:"purpose"`
+	Size    uint64        `json:"size"`
+}
+
+var _ sdk.Msg = &MsgCreateStatusList{}
+
+const TypeMsgCreateStatusList = "create_status_list"
+
+func (msg *MsgCreateStatusList) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgCreateStatusList) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidCredential.Wrap("status list ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	if msg.Purpose != StatusPurposeRevocation && msg.Purpose != StatusPurposeSuspension {
+		return ErrInvalidCredential.Wrapf("unsupported status purpose %q", msg.Purpose)
+	}
+	if msg.Size == 0 {
+		return ErrInvalidCredential.Wrap("status list size cannot be zero")
+	}
+	return nil
+}
+
+func (msg *MsgCreateStatusList) Type() string  { return TypeMsgCreateStatusList }
+func (msg *MsgCreateStatusList) Route() string { return RouterKey }
+func (msg *MsgCreateStatusList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgCreateStatusList) ProtoMessage()  {}
+func (m *MsgCreateStatusList) Reset()         { *m = MsgCreateStatusList{} }
+func (m *MsgCreateStatusList) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateStatusListResponse is the response for MsgCreateStatusList.
+type MsgCreateStatusListResponse struct{}
+
+func (m *MsgCreateStatusListResponse) ProtoMessage()  {}
+func (m *MsgCreateStatusListResponse) Reset()         { *m = MsgCreateStatusListResponse{} }
+func (m *MsgCreateStatusListResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateCredentialStatus flips the status bit for a credential within a status list.
+type MsgUpdateCredentialStatus struct {
+	StatusListId string `json:"statusListId"`
+	Index        uint64 `json:"index"`
+	Revoked      bool   `json:"revoked"`
+	Issuer       string `json:"issuer"`
+}
+
+var _ sdk.Msg = &MsgUpdateCredentialStatus{}
+
+const TypeMsgUpdateCredentialStatus = "update_credential_status"
+
+func (msg *MsgUpdateCredentialStatus) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgUpdateCredentialStatus) ValidateBasic() error {
+	if msg.StatusListId == "" {
+		return ErrInvalidCredential.Wrap("status list ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrInvalidIssuer.Wrap("invalid issuer address")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateCredentialStatus) Type() string  { return TypeMsgUpdateCredentialStatus }
+func (msg *MsgUpdateCredentialStatus) Route() string { return RouterKey }
+func (msg *MsgUpdateCredentialStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgUpdateCredentialStatus) ProtoMessage()  {}
+func (m *MsgUpdateCredentialStatus) Reset()         { *m = MsgUpdateCredentialStatus{} }
+func (m *MsgUpdateCredentialStatus) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateCredentialStatusResponse is the response for MsgUpdateCredentialStatus.
+type MsgUpdateCredentialStatusResponse struct{}
+
+func (m *MsgUpdateCredentialStatusResponse) ProtoMessage() {}
+func (m *MsgUpdateCredentialStatusResponse) Reset()        { *m = MsgUpdateCredentialStatusResponse{} }
+func (m *MsgUpdateCredentialStatusResponse) String() string {
+	return proto.CompactTextString(m)
+}
@@ -16,6 +16,28 @@ const (
 
 // W3C Verifiable Credential types with SDK compatibility
 
+// CredentialLifecycleStatus is the on-chain lifecycle state a credential keeper
+// transitions a VerifiableCredential through between issuance and its final,
+// terminal state. Distinct from CredentialStatus, which is the W3C StatusList2021
+// pointer a verifier resolves off-chain; LifecycleStatus is the keeper's own bookkeeping
+// of which of those terminal/non-terminal states the credential is currently in.
+type CredentialLifecycleStatus string
+
+const (
+	// CredentialStatusLive is the default state of a freshly issued, unexpired,
+	// unrevoked credential.
+	CredentialStatusLive CredentialLifecycleStatus = "Live"
+	// CredentialStatusRevoked is a permanent terminal state; a revoked credential can
+	// never be reinstated.
+	CredentialStatusRevoked CredentialLifecycleStatus = "Revoked"
+	// CredentialStatusSuspended is a reversible state; a suspended credential can be
+	// moved back to Live via MsgUpdateCredentialStatus(revoked=false).
+	CredentialStatusSuspended CredentialLifecycleStatus = "Suspended"
+	// CredentialStatusExpired is set by EndBlocker once ExpirationDate has passed and,
+	// like Revoked, is terminal.
+	CredentialStatusExpired CredentialLifecycleStatus = "Expired"
+)
+
 // VerifiableCredential represents a W3C Verifiable Credential
 type VerifiableCredential struct {
 	// Context is the JSON-LD context
@@ -53,8 +75,33 @@ type VerifiableCredential struct {
 	Active      bool      `json:"active"`
 	Revoked     bool      `json:"revoked"`
 	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+
+	// Status is the keeper-maintained lifecycle state. Live|Revoked|Suspended|Expired.
+	Status CredentialLifecycleStatus `json:"status,omitempty"`
+
+	// ProofFormat names the proof envelope Proof/Proof.ProofValue should be interpreted
+	// against: ProofFormatLdpVc for an embedded linked-data Proof (the default, and the
+	// only format this type carried before VerifyCredential learned to check it for
+	// real -- see keeper/verify.go), or ProofFormatJwtVc for a compact JWS carried in
+	// Proof.ProofValue instead of a linked-data signature. Empty is treated as
+	// ProofFormatLdpVc so every pre-existing credential keeps validating the same way.
+	ProofFormat ProofFormat `json:"proofFormat,omitempty"`
 }
 
+// ProofFormat distinguishes the two W3C VC proof envelopes VerifyCredential
+// (keeper/verify.go) knows how to check.
+type ProofFormat string
+
+const (
+	// ProofFormatLdpVc is a Linked Data Proof embedded directly in Proof, signed over a
+	// canonicalized form of the credential itself.
+	ProofFormatLdpVc ProofFormat = "ldp_vc"
+
+	// ProofFormatJwtVc is a compact JWS (header.payload.signature) carried in
+	// Proof.ProofValue, per the VC-JWT encoding of the same W3C Verifiable Credential.
+	ProofFormatJwtVc ProofFormat = "jwt_vc"
+)
+
 // CredentialSubject represents the subject of a credential
 type CredentialSubject struct {
 	// ID of the credential subject (usually a DID)
@@ -64,35 +111,83 @@ type CredentialSubject struct {
 	Claims map[string]interface{} `json:"claims"`
 }
 
+// ProofType enumerates the linked-data proof suites Proof.Type may carry.
+type ProofType string
+
+const (
+	// ProofTypeEd25519Signature2020 is a single-message Ed25519 linked-data signature.
+	ProofTypeEd25519Signature2020 ProofType = "Ed25519Signature2020"
+
+	// ProofTypeEcdsaSecp256k1Signature2019 is a single-message secp256k1 linked-data
+	// signature, the suite a Cosmos-key-controlled issuer uses instead of Ed25519 --
+	// verified the same way x/did/keeper/clientspec.go's verifySecp256k1 checks a
+	// ClientSpec signature against a resolved VerificationMethod.
+	ProofTypeEcdsaSecp256k1Signature2019 ProofType = "EcdsaSecp256k1Signature2019"
+
+	// ProofTypeBbsBlsSignature2020 is an issuer-side BBS+ signature over the full,
+	// URDNA2015-canonicalized set of CredentialSubject statements.
+	ProofTypeBbsBlsSignature2020 ProofType = "BbsBlsSignature2020"
+
+	// ProofTypeBbsBlsSignatureProof2020 is a holder-derived zero-knowledge proof of
+	// knowledge of a BbsBlsSignature2020 signature that discloses only the statements
+	// named in the presentation's Reveal paths.
+	ProofTypeBbsBlsSignatureProof2020 ProofType = "BbsBlsSignatureProof2020"
+)
+
 // Proof represents a cryptographic proof
 type Proof struct {
-	// Type of proof (e.g., "Ed25519Signature2020")
+	// Type of proof (e.g., "Ed25519Signature2020", "BbsBlsSignature2020")
 	Type string `json:"type"`
-	
+
 	// Created timestamp
 	Created time.Time `json:"created"`
-	
+
 	// VerificationMethod used for the proof
 	VerificationMethod string `json:"verificationMethod"`
-	
+
 	// ProofPurpose (e.g., "assertionMethod")
 	ProofPurpose string `json:"proofPurpose"`
-	
+
 	// ProofValue contains the actual signature/proof
 	ProofValue string `json:"proofValue"`
+
+	// Nonce is the holder-chosen nonce mixed into a BbsBlsSignatureProof2020 derivation
+	// to prevent replay of the derived proof across verifiers. Unused for issuer proofs.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// ValidateBasic performs stateless validation of a Proof.
+func (p *Proof) ValidateBasic() error {
+	if p.Type == "" {
+		return ErrInvalidProof.Wrap("proof type cannot be empty")
+	}
+	if p.VerificationMethod == "" {
+		return ErrInvalidVerificationMethod.Wrap("verification method cannot be empty")
+	}
+	if p.ProofValue == "" {
+		return ErrInvalidProof.Wrap("proof value cannot be empty")
+	}
+	if p.Type == string(ProofTypeBbsBlsSignatureProof2020) && p.Nonce == "" {
+		return ErrInvalidProof.Wrap("BBS+ derived proof requires a nonce")
+	}
+	return nil
 }
 
 // CredentialStatus represents revocation status information
 type CredentialStatus struct {
 	// ID of the status
 	ID string `json:"id"`
-	
+
 	// Type of status mechanism
 	Type string `json:"type"`
-	
+
 	// Additional status properties
 	StatusListIndex *int    `json:"statusListIndex,omitempty"`
 	StatusPurpose   *string `json:"statusPurpose,omitempty"`
+
+	// StatusListId references the StatusList2021 credential this status bit lives in.
+	// Set together with StatusListIndex when Type is StatusList2021Entry.
+	StatusListId string `json:"statusListId,omitempty"`
 }
 
 // VerifiablePresentation represents a W3C Verifiable Presentation
@@ -172,6 +267,36 @@ func (vc *VerifiableCredential) IsExpired() bool {
 	return time.Now().After(*vc.ExpirationDate)
 }
 
+// StatusListKeeper is the subset of the revocation keeper's behavior a
+// VerifiableCredential needs to resolve its own StatusList2021 status bit. Defined
+// here rather than imported to avoid a dependency from x/credential/types onto
+// x/revocation/keeper.
+type StatusListKeeper interface {
+	IsCredentialRevoked(ctx sdk.Context, statusListID string, index uint64) (bool, error)
+}
+
+// IsRevoked reports whether vc is revoked, transparently resolving either of the two
+// mechanisms this type carries: the legacy Revoked/Status fields RevokeCredential sets
+// directly (see msg_server_lifecycle.go), and the StatusList2021 status bit a
+// CredentialStatus entry points at (see x/revocation/keeper/statuslist.go for the
+// bitstring/merkle-proof machinery behind IsCredentialRevoked). The legacy fields are
+// checked first and short-circuit the StatusList2021 lookup: a credential can carry a
+// CredentialStatus entry left over from issuance even after RevokeCredential flips
+// Revoked directly, and in that case the legacy flag should win rather than falling
+// through to a status-list bit that may never get flipped for it.
+func (vc *VerifiableCredential) IsRevoked(ctx sdk.Context, k StatusListKeeper) (bool, error) {
+	if vc.Revoked || vc.Status == CredentialStatusRevoked {
+		return true, nil
+	}
+	if vc.CredentialStatus == nil || vc.CredentialStatus.Type != StatusListEntryType {
+		return false, nil
+	}
+	if vc.CredentialStatus.StatusListId == "" || vc.CredentialStatus.StatusListIndex == nil {
+		return false, nil
+	}
+	return k.IsCredentialRevoked(ctx, vc.CredentialStatus.StatusListId, uint64(*vc.CredentialStatus.StatusListIndex))
+}
+
 // Validate validates a verifiable presentation
 func (vp *VerifiablePresentation) Validate() error {
 	if vp.ID == "" {
@@ -228,8 +353,33 @@ type Params struct {
 	MaxCredentialSize uint64 `json:"maxCredentialSize"`
 	// Fee for creating credentials
 	CreateCredentialFee sdk.Coins `json:"createCredentialFee"`
-	// Fee for creating presentations  
+	// Fee for creating presentations
 	CreatePresentationFee sdk.Coins `json:"createPresentationFee"`
+	// SnapshotFormat is the record layout version x/credential's (not yet written)
+	// ExtensionSnapshotter would write and expect to read back, kept here so a future
+	// layout change can be rolled out without breaking restores of older snapshots.
+	SnapshotFormat uint32 `json:"snapshotFormat"`
+
+	// IndexableAttributeKeys allowlists which CredentialSubject.Claims keys
+	// CreateCredential mirrors into CredentialsByAttribute. Empty by default: indexing
+	// every claim key a credential happens to carry would let an issuer grow unbounded
+	// state simply by varying claim shapes, so a key only gets indexed once it's
+	// explicitly allowlisted here (by governance, via MsgUpdateParams).
+	IndexableAttributeKeys []string `json:"indexableAttributeKeys,omitempty"`
+
+	// CredentialTypes is the governance-updatable allowlist CreateCredential checks
+	// msg.CredentialType against (see Keeper.ValidateCredentialType), replacing the
+	// hardcoded eight-string map literal types.ValidateCredentialType used to be.
+	// Extended or retired via MsgUpdateParams -- see client/cli/tx.go's
+	// register-type/deprecate-type commands -- rather than a binary upgrade.
+	CredentialTypes []CredentialTypeDef `json:"credentialTypes,omitempty"`
+
+	// ApplicationReviewPeriod bounds how long a CredentialApplication may sit pending
+	// before EndBlocker's expireOverdueApplications auto-denies it, the default
+	// Deadline SubmitApplication stamps on an application that doesn't set its own
+	// (shorter) one. Zero disables the default -- an application only expires if it
+	// was given an explicit Deadline.
+	ApplicationReviewPeriod time.Duration `json:"applicationReviewPeriod"`
 }
 
 // DefaultParams returns default parameters
@@ -238,6 +388,9 @@ func DefaultParams() Params {
 		MaxCredentialSize:     10000, // 10KB max
 		CreateCredentialFee:   sdk.NewCoins(sdk.NewCoin("upersona", math.NewInt(1000))),
 		CreatePresentationFee: sdk.NewCoins(sdk.NewCoin("upersona", math.NewInt(500))),
+		SnapshotFormat:        1,
+		CredentialTypes:       DefaultCredentialTypeDefs(),
+		ApplicationReviewPeriod: 7 * 24 * time.Hour,
 	}
 }
 
@@ -246,6 +399,16 @@ func (p Params) Validate() error {
 	if p.MaxCredentialSize == 0 {
 		return fmt.Errorf("max credential size cannot be zero")
 	}
+	seen := make(map[string]bool, len(p.CredentialTypes))
+	for _, def := range p.CredentialTypes {
+		if err := def.Validate(); err != nil {
+			return err
+		}
+		if seen[def.Name] {
+			return fmt.Errorf("duplicate credential type %q", def.Name)
+		}
+		seen[def.Name] = true
+	}
 	return nil
 }
 
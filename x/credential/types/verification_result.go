@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// VerificationRecord is the deterministic, stored outcome of a MsgVerifyCredential
+// proof check, keyed by credential ID under CredentialVerificationPrefix. Storing it
+// (rather than only returning MsgVerifyCredentialResponse) lets a later query return
+// the same consensus-committed answer a relayer's original verifying transaction got,
+// without re-running proof verification.
+type VerificationRecord struct {
+	CredentialId string      `json:"credentialId"`
+	ProofFormat  ProofFormat `json:"proofFormat"`
+	Verified     bool        `json:"verified"`
+	Revoked      bool        `json:"revoked"`
+	VerifiedAt   time.Time   `json:"verifiedAt"`
+	Verifier     string      `json:"verifier"`
+}
+
+func (m *VerificationRecord) ProtoMessage()  {}
+func (m *VerificationRecord) Reset()         { *m = VerificationRecord{} }
+func (m *VerificationRecord) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,115 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// AuthenticatorKeeper is the expected interface this decorator needs from the DID
+// keeper -- intentionally narrow and decoupled from keeper.Keeper directly, the same
+// arm's-length relationship x/zkproof/types/expected_keepers.go keeps between that
+// module's types package and the keepers it relies on.
+type AuthenticatorKeeper interface {
+	AuthenticateMessage(ctx context.Context, controller, authenticatorID string, request types.AuthenticatorRequest) error
+}
+
+// SelectedAuthenticatorTx is implemented by a tx whose signer(s) chose to authenticate
+// with a specific registered types.AuthMethod rather than their account's ordinary
+// signature -- the same role a tx extension option plays in dYdX's accountplus
+// smart-account pattern. A tx that doesn't implement this (or returns an empty map)
+// authenticates every message the ordinary way; Decorator only engages for signers who
+// opted in.
+type SelectedAuthenticatorTx interface {
+	// GetSelectedAuthenticators maps a signer's bech32 address to the AuthMethod.MethodID
+	// they chose to authenticate this transaction with.
+	GetSelectedAuthenticators() map[string]string
+}
+
+// RawSignatureTx is implemented by a tx that can return the raw signature bytes a given
+// signer actually signed the transaction with, decoupling this decorator from any one
+// cosmos-sdk minor version's exact signing.SignatureV2/SigVerifiableTx shape. Wiring the
+// app's concrete sdk.Tx implementation to satisfy this interface is an app.go
+// integration task this file doesn't attempt, the same way RegisterMsgServer in
+// x/zkproof/types/codec.go is a non-wiring stub for the write side.
+type RawSignatureTx interface {
+	GetSignatureBytes(signer sdk.AccAddress) ([]byte, error)
+}
+
+// signedMsg is implemented by every hand-rolled sdk.Msg in this tree (see e.g.
+// x/zkproof/types.MsgCreateCircuit), which carry GetSigners()/GetSignBytes() directly
+// rather than registering signing info through a newer signing-info-only path.
+type signedMsg interface {
+	sdk.Msg
+	GetSigners() []sdk.AccAddress
+	GetSignBytes() []byte
+}
+
+// Decorator runs AuthenticatorKeeper.AuthenticateMessage for every message whose signer
+// selected a registered authenticator via SelectedAuthenticatorTx, rejecting the whole
+// transaction if authentication fails. A signer who selected an authenticator ID that
+// AuthenticateMessage can't resolve (types.ErrAuthenticatorNotFound) increments the
+// missing_registered_authenticator counter before the transaction is rejected, so an
+// operator can distinguish "nobody opted into a custom authenticator" (the overwhelming
+// common case, silent) from "somebody's selected authenticator silently stopped
+// resolving" (a signal worth alerting on).
+type Decorator struct {
+	keeper AuthenticatorKeeper
+}
+
+// NewDecorator returns a Decorator backed by keeper.
+func NewDecorator(keeper AuthenticatorKeeper) Decorator {
+	return Decorator{keeper: keeper}
+}
+
+func (d Decorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	selector, ok := tx.(SelectedAuthenticatorTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	selected := selector.GetSelectedAuthenticators()
+	if len(selected) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	rawSigTx, ok := tx.(RawSignatureTx)
+	if !ok {
+		return ctx, types.ErrAuthenticationFailed.Wrap("tx selects authenticators but does not support per-signer signature extraction")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		sm, ok := msg.(signedMsg)
+		if !ok {
+			continue
+		}
+
+		for _, signer := range sm.GetSigners() {
+			authenticatorID, chosen := selected[signer.String()]
+			if !chosen {
+				continue
+			}
+
+			signature, err := rawSigTx.GetSignatureBytes(signer)
+			if err != nil {
+				return ctx, types.ErrAuthenticationFailed.Wrapf("reading signature for %s: %s", signer.String(), err)
+			}
+
+			request := types.AuthenticatorRequest{
+				Msg:       msg,
+				SignBytes: sm.GetSignBytes(),
+				Signature: signature,
+			}
+			if err := d.keeper.AuthenticateMessage(ctx, signer.String(), authenticatorID, request); err != nil {
+				telemetry.IncrCounter(1, types.ModuleName, "missing_registered_authenticator")
+				return ctx, types.ErrAuthenticationFailed.Wrapf(
+					"authenticator %q for %s: %s", authenticatorID, signer.String(), err,
+				)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
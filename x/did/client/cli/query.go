@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// GetQueryCmd returns the query commands for the did module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdShowDID(),
+	)
+
+	return cmd
+}
+
+// CmdShowDID returns the query command for resolving a single DID Document by ID.
+func CmdShowDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [did]",
+		Short: "Resolve a DID Document by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), []byte(args[0]))
+			if err != nil {
+				return err
+			}
+			if res.Response.Value == nil {
+				return fmt.Errorf("DID %q not found", args[0])
+			}
+
+			return clientCtx.PrintString(string(res.Response.Value) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// GetTxCmd returns the transaction commands for the did module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCreateDID(),
+		CmdUpdateDID(),
+		CmdDeactivateDID(),
+	)
+
+	return cmd
+}
+
+// CmdCreateDID returns the tx command for registering a new DID Document.
+func CmdCreateDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-did [did-document-json]",
+		Short: "Create a new DID Document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var didDocument types.DIDDocument
+			if err := json.Unmarshal([]byte(args[0]), &didDocument); err != nil {
+				return fmt.Errorf("failed to parse DID document: %w", err)
+			}
+
+			msg := types.NewMsgCreateDID(clientCtx.GetFromAddress().String(), didDocument)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUpdateDID returns the tx command for updating an existing DID Document.
+func CmdUpdateDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-did [did-document-json]",
+		Short: "Update an existing DID Document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var didDocument types.DIDDocument
+			if err := json.Unmarshal([]byte(args[0]), &didDocument); err != nil {
+				return fmt.Errorf("failed to parse DID document: %w", err)
+			}
+
+			msg := types.NewMsgUpdateDID(clientCtx.GetFromAddress().String(), didDocument.ID, didDocument)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDeactivateDID returns the tx command for deactivating a DID Document.
+func CmdDeactivateDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate-did [did]",
+		Short: "Deactivate a DID Document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeactivateDID(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
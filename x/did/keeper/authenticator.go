@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// ResolveAuthenticator and AuthenticateMessage turn the AuthMethod framework from a
+// passive record into an enforcement point, going through Keeper's real GetAuthMethods
+// and Registry fields (see keeper.go).
+
+// ResolveAuthenticator looks up controller's AuthMethod named authenticatorID and
+// builds the types.Authenticator it's configured as via k.Registry, the lookup
+// AuthenticateMessage and a composite authenticator's own sub-authenticator resolution
+// both go through.
+func (k Keeper) ResolveAuthenticator(ctx context.Context, controller, authenticatorID string) (types.Authenticator, *types.AuthMethod, error) {
+	methods, err := k.GetAuthMethods(ctx, controller)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var method *types.AuthMethod
+	for i := range methods {
+		if methods[i].MethodID == authenticatorID {
+			method = &methods[i]
+			break
+		}
+	}
+	if method == nil {
+		return nil, nil, types.ErrAuthenticatorNotFound.Wrapf("controller %s has no authenticator %q", controller, authenticatorID)
+	}
+	if !method.IsActive {
+		return nil, nil, types.ErrAuthenticatorNotFound.Wrapf("authenticator %q is not active", authenticatorID)
+	}
+
+	authenticator, err := k.Registry.Get(method.MethodType, method.Config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return authenticator, method, nil
+}
+
+// AuthenticateMessage resolves authenticatorID among controller's linked AuthMethods,
+// fans out to it (a composite AllOf/AnyOf authenticator fans out further to its own
+// sub-authenticators internally), and runs Authenticate followed by Track. A non-nil
+// error means the message the ante handler is checking must be rejected -- this is
+// what turns the AuthMethod list from a passive record into an enforcement point.
+//
+// A successful Authenticate also bumps the resolved AuthMethod's LastUsedAt via
+// k.TouchAuthMethod, so the EndBlocker's inactivity sweep never auto-deactivates an
+// AuthMethod still being used to authorize messages.
+func (k Keeper) AuthenticateMessage(ctx context.Context, controller, authenticatorID string, request types.AuthenticatorRequest) error {
+	authenticator, method, err := k.ResolveAuthenticator(ctx, controller, authenticatorID)
+	if err != nil {
+		return err
+	}
+
+	request.Controller = controller
+	request.AuthenticatorId = method.MethodID
+
+	if err := authenticator.Authenticate(ctx, request); err != nil {
+		return err
+	}
+	if err := k.TouchAuthMethod(ctx, controller, *method); err != nil {
+		return err
+	}
+	return authenticator.Track(ctx, request)
+}
+
+// ConfirmExecution re-resolves authenticatorID and calls its ConfirmExecution hook,
+// meant to run in PostHandle once the message it authorized has actually executed.
+func (k Keeper) ConfirmExecution(ctx context.Context, controller, authenticatorID string, request types.AuthenticatorRequest) error {
+	authenticator, method, err := k.ResolveAuthenticator(ctx, controller, authenticatorID)
+	if err != nil {
+		return err
+	}
+
+	request.Controller = controller
+	request.AuthenticatorId = method.MethodID
+	return authenticator.ConfirmExecution(ctx, request)
+}
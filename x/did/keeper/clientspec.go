@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// getClientSpecDocBytes returns the canonical bytes controller's signature is expected
+// to cover for doc, dispatching by clientSpec the same way keeper.VerifierRegistry
+// dispatches a ZK proof by ProofType: one function per types.ClientSpec constant
+// instead of a growing if/else chain at every call site.
+//
+//   - ClientSpecNative / ClientSpecCosmosADR036V1 both sign the DID document's own
+//     canonical JSON -- the only difference between them is the envelope
+//     VerifyClientSpecSignature wraps it in before hashing, matching how a Cosmos SDK
+//     account key signs an ADR-036 offline sign doc rather than the raw payload.
+//   - ClientSpecEthPersonalSign and ClientSpecEthEIP712 return the same canonical JSON;
+//     the EIP-191 "\x19Ethereum Signed Message:\n<len>" prefix and the EIP-712 typed-data
+//     domain/struct hash are applied during verification, once this module vendors a
+//     Keccak256 implementation (see the TODO on VerifyClientSpecSignature below).
+func getClientSpecDocBytes(clientSpec string, doc *types.DIDDocument) ([]byte, error) {
+	if err := types.ValidateClientSpec(clientSpec); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return nil, types.ErrInvalidDocument.Wrapf("marshaling DID document: %s", err)
+	}
+	return sortedJSON(bz), nil
+}
+
+// adr036SignDocBytes wraps docBytes in the standard ADR-036 "offline sign doc" envelope
+// an offline Cosmos key signs arbitrary data with, so a controller key that never signs
+// a Cosmos transaction directly can still authorize a DID operation the same way a
+// CLI's `tx sign-arbitrary` does.
+func adr036SignDocBytes(controller string, docBytes []byte) []byte {
+	signDoc := map[string]interface{}{
+		"chain_id":       "",
+		"account_number": "0",
+		"sequence":       "0",
+		"fee":            map[string]interface{}{"gas": "0", "amount": []interface{}{}},
+		"memo":           "",
+		"msgs": []interface{}{
+			map[string]interface{}{
+				"type": "sign/MsgSignData",
+				"value": map[string]interface{}{
+					"signer": controller,
+					"data":   base64.StdEncoding.EncodeToString(docBytes),
+				},
+			},
+		},
+	}
+	bz, _ := json.Marshal(signDoc)
+	return sortedJSON(bz)
+}
+
+func sortedJSON(bz []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return bz
+	}
+	sorted, err := json.Marshal(v)
+	if err != nil {
+		return bz
+	}
+	return sorted
+}
+
+// VerifyClientSpecSignature checks that signature authorizes doc on behalf of the
+// verificationMethod resolved by methodID, dispatching the hashing/recovery scheme by
+// clientSpec. method must already be one of doc.VerificationMethod (or, for
+// MsgDeactivateDID, the pre-deactivation document's) -- resolving methodID to a
+// VerificationMethod is the caller's job, mirroring how ResolveAuthenticator resolves
+// an AuthenticatorId before AuthenticateMessage ever checks a signature.
+func VerifyClientSpecSignature(clientSpec string, method *types.VerificationMethod, doc *types.DIDDocument, signature []byte) error {
+	if err := types.ValidateClientSpec(clientSpec); err != nil {
+		return err
+	}
+	if len(signature) == 0 {
+		return types.ErrInvalidSignature.Wrap("signature is empty")
+	}
+
+	docBytes, err := getClientSpecDocBytes(clientSpec, doc)
+	if err != nil {
+		return err
+	}
+
+	switch clientSpec {
+	case types.ClientSpecNative:
+		return verifySecp256k1(method, docBytes, signature)
+	case types.ClientSpecCosmosADR036V1:
+		return verifySecp256k1(method, adr036SignDocBytes(method.Controller, docBytes), signature)
+	case types.ClientSpecEthPersonalSign, types.ClientSpecEthEIP712:
+		// TODO: eth-personalSign needs the EIP-191 "\x19Ethereum Signed Message:\n<len>"
+		// prefix and eth-EIP712 needs the EIP-712 domain/struct hash, both hashed with
+		// Keccak256 and verified by recovering the signer's address from signature --
+		// none of which this repo vendors a library for yet (no golang.org/x/crypto/sha3
+		// or go-ethereum/crypto dependency exists anywhere in this tree). Structural-only
+		// check for now, the same gap WebAuthnAuthenticator/PasskeyAuthenticator in
+		// x/did/types/authenticator.go document for their own unvendored verification
+		// libraries.
+		return types.ErrAuthenticationFailed.Wrapf("%s signature verification not yet implemented", clientSpec)
+	default:
+		return types.ErrInvalidClientSpecType.Wrapf("unsupported client spec %q", clientSpec)
+	}
+}
+
+// verifySecp256k1 checks signature against method's PublicKeyMultibase, the same
+// decode-then-VerifySignature path Secp256k1Authenticator.Authenticate uses for
+// linked authenticators.
+func verifySecp256k1(method *types.VerificationMethod, signBytes, signature []byte) error {
+	if method.Type != types.VerificationKeyTypeSecp256k1 {
+		return types.ErrInvalidDocument.Wrapf("verification method %q is not a secp256k1 key", method.ID)
+	}
+	key, err := decodeMultibaseKey(method.PublicKeyMultibase)
+	if err != nil {
+		return err
+	}
+	pubKey := &secp256k1.PubKey{Key: key}
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return types.ErrInvalidSignature.Wrapf("signature does not verify against verification method %q", method.ID)
+	}
+	return nil
+}
+
+// decodeMultibaseKey decodes a publicKeyMultibase value that uses the "u" (base64url,
+// no padding) multibase prefix. The more common "z" (base58btc) prefix used by, e.g.,
+// did:key isn't decodable without vendoring a base58 library this repo doesn't carry
+// yet -- callers relying on that prefix get ErrInvalidDocument until it is.
+func decodeMultibaseKey(value string) ([]byte, error) {
+	if len(value) < 2 {
+		return nil, types.ErrInvalidDocument.Wrap("publicKeyMultibase is too short")
+	}
+	switch value[0] {
+	case 'u':
+		key, err := base64.RawURLEncoding.DecodeString(value[1:])
+		if err != nil {
+			return nil, types.ErrInvalidDocument.Wrapf("decoding publicKeyMultibase: %s", err)
+		}
+		return key, nil
+	default:
+		return nil, types.ErrInvalidDocument.Wrapf("unsupported publicKeyMultibase prefix %q", string(value[0]))
+	}
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// defaultControllerHandoffTimeout mirrors msg_server_remote_did.go's
+// defaultRemoteDIDTimeout: generous enough to tolerate a relayer outage without
+// leaving the channel backed up indefinitely.
+const defaultControllerHandoffTimeout = 10 * 60 * 1e9 // 10 minutes, in nanoseconds
+
+// TransferDIDController implements types.MsgServer's (would-be) TransferDIDController:
+// it builds a types.ControllerHandoffPacketData for msg.Did and sends it over
+// msg.ChannelId via k.channelKeeper.SendPacket, the same capability/channel dance
+// QueryRemoteDID uses. The handoff is not applied locally here -- SetDID's controller
+// index stays put until IBCModule.OnAcknowledgementPacket reports the destination
+// chain accepted it, so a relayed-but-rejected handoff never stamps this chain's
+// record ahead of the counterparty's.
+//
+// k.channelKeeper and k.scopedKeeper are real Keeper fields (see keeper.go), alongside
+// k.DIDs/k.DIDsByController used elsewhere in this package.
+func (k Keeper) TransferDIDController(ctx context.Context, msg *types.MsgTransferDIDController) (*types.MsgTransferDIDControllerResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	controller, err := k.GetDIDController(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	if controller != msg.Controller {
+		return nil, types.ErrUnauthorized.Wrapf("%s does not control %s", msg.Controller, msg.Did)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(sdkCtx, hostPortChannelCapabilityName(types.PortID, msg.ChannelId))
+	if !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("no channel capability for channel %s", msg.ChannelId)
+	}
+	if _, ok := k.channelKeeper.GetChannel(sdkCtx, types.PortID, msg.ChannelId); !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("channel %s not found", msg.ChannelId)
+	}
+
+	packetData := types.ControllerHandoffPacketData{
+		Did:           msg.Did,
+		OldController: msg.Controller,
+		NewController: msg.NewController,
+	}
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(sdkCtx.BlockTime().UnixNano() + defaultControllerHandoffTimeout)
+	}
+
+	sequence, err := k.channelKeeper.SendPacket(
+		sdkCtx,
+		channelCap,
+		types.PortID,
+		msg.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		packetBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferDIDControllerResponse{Sequence: sequence}, nil
+}
+
+// ApplyControllerHandoff retargets did's DIDsByController/DIDControllers index from
+// oldController to newController, called from IBCModule.OnRecvPacket on the chain
+// receiving a ControllerHandoffPacketData -- the destination side of
+// MsgTransferDIDController's handoff. Unlike SetDID (which leaves a stale
+// DIDsByController entry behind when a document's controller field itself changes),
+// this removes the old (oldController, did) index entry so a moved DID doesn't stay
+// listed under its former controller.
+func (k Keeper) ApplyControllerHandoff(ctx context.Context, did, oldController, newController string) error {
+	current, err := k.GetDIDController(ctx, did)
+	if err != nil {
+		return err
+	}
+	if current != oldController {
+		return types.ErrUnauthorized.Wrapf("%s is not %s's current controller", oldController, did)
+	}
+
+	if err := k.DIDsByController.Remove(ctx, collections.Join(oldController, did)); err != nil {
+		return err
+	}
+	if err := k.DIDsByController.Set(ctx, collections.Join(newController, did), did); err != nil {
+		return err
+	}
+	return k.DIDControllers.Set(ctx, did, newController)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// EndBlocker is called at the end of each block to perform module-specific operations,
+// mirroring x/zkproof's EndBlocker/processExpiredProofs shape.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.pruneDeactivatedDIDs(sdkCtx); err != nil {
+		return err
+	}
+	return k.deactivateStaleAuthMethods(sdkCtx)
+}
+
+// pruneDeactivatedDIDs hard-deletes every Deactivated DID whose Updated timestamp is
+// more than Params.DIDGracePeriod seconds in the past, purging its controller index
+// and all associated AuthMethods via DeleteDID.
+//
+// TODO(expiry-queue): like processExpiredProofs, this does a full DIDs.Walk every
+// block rather than a bounded range over a time-ordered index; x/credential/types/
+// expiry.go's LegacyExpiryQueuePrefix is the pattern to follow once DID
+// deactivation also maintains that secondary index.
+func (k Keeper) pruneDeactivatedDIDs(ctx sdk.Context) error {
+	currentTime := ctx.BlockTime()
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var toPrune []types.DIDDocument
+	err = k.DIDs.Walk(ctx, nil, func(id string, doc types.DIDDocument) (bool, error) {
+		if doc.Deactivated && currentTime.Sub(doc.Updated).Seconds() > float64(params.DIDGracePeriod) {
+			toPrune = append(toPrune, doc)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range toPrune {
+		controller, err := k.GetDIDController(ctx, doc.ID)
+		if err != nil {
+			return err
+		}
+		if err := k.DeleteDID(ctx, controller, doc); err != nil {
+			return err
+		}
+		types.EmitDIDPrunedEvent(ctx, controller, doc.ID)
+	}
+	return nil
+}
+
+// deactivateStaleAuthMethods flips IsActive to false on every AuthMethod that hasn't
+// authorized a message (AuthMethod.LastUsedAt, bumped by AuthenticateMessage via
+// TouchAuthMethod) in over Params.AuthMethodInactivityPeriod seconds. An AuthMethod
+// already inactive is left alone so the event only fires once per transition.
+func (k Keeper) deactivateStaleAuthMethods(ctx sdk.Context) error {
+	currentTime := ctx.BlockTime()
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var controllers []string
+	var methods []types.AuthMethod
+	err = k.AuthMethods.Walk(ctx, nil, func(key collections.Pair[string, string], method types.AuthMethod) (bool, error) {
+		if method.IsActive && currentTime.Sub(method.LastUsedAt).Seconds() > float64(params.AuthMethodInactivityPeriod) {
+			controllers = append(controllers, key.K1())
+			methods = append(methods, method)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, method := range methods {
+		method.IsActive = false
+		if err := k.SetAuthMethod(ctx, controllers[i], method); err != nil {
+			return err
+		}
+		types.EmitAuthMethodDeactivatedEvent(ctx, controllers[i], method.MethodID)
+	}
+	return nil
+}
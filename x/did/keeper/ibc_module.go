@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// IBCModule implements porttypes.IBCModule for the did-resolver application: a host
+// chain's IBCModule resolves a DID through Keeper.ResolveDID on OnRecvPacket, while a
+// controller chain's IBCModule caches a positive resolution under types.RemoteDIDKey on
+// OnAcknowledgementPacket, following the same controller/host split ICS-20 and ICA draw
+// between the chain that initiates a query and the chain that answers it.
+//
+// keeper.channelKeeper and keeper.scopedKeeper are real Keeper fields (see keeper.go):
+// channelKeeper for SendPacket/GetChannel/GetChannelClientState, scopedKeeper for
+// capability authentication on channel handshake steps (ClaimCapability/
+// AuthenticateCapability).
+type IBCModule struct {
+	keeper Keeper
+}
+
+// NewIBCModule returns an IBCModule bound to k.
+func NewIBCModule(k Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// OnChanOpenInit implements porttypes.IBCModule. The did-resolver port only accepts
+// ORDERED channels (a query and its answer must stay in sequence) on the negotiated
+// types.Version.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("did-resolver channels must be ORDERED")
+	}
+	if version != "" && version != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements porttypes.IBCModule, mirroring OnChanOpenInit's checks for
+// the side that did not initiate the handshake.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("did-resolver channels must be ORDERED")
+	}
+	if counterpartyVersion != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != types.Version {
+		return types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// didResolverPacketEnvelope is unmarshaled first to tell a DIDResolutionPacketData
+// from a ControllerHandoffPacketData: both ride the same did-resolver port/channel, and
+// only the handoff packet ever sets old_controller/new_controller.
+type didResolverPacketEnvelope struct {
+	OldController string `json:"old_controller"`
+}
+
+// OnRecvPacket implements porttypes.IBCModule on the host side, dispatching on
+// whether packet.GetData() decodes as a DIDResolutionPacketData (resolved through
+// k.ResolveDID) or a ControllerHandoffPacketData (applied through
+// k.ApplyControllerHandoff) -- the two packet types the did-resolver port carries, one
+// per direction of chunk13-5's request. Both return either a successful
+// channeltypes.Acknowledgement or a channeltypes.NewErrorAcknowledgement, the ibc-go
+// middleware convention of always acknowledging rather than letting a failure time the
+// packet out.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var envelope didResolverPacketEnvelope
+	if err := json.Unmarshal(packet.GetData(), &envelope); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling did-resolver packet data: %w", err))
+	}
+	if envelope.OldController != "" {
+		return im.onRecvControllerHandoff(ctx, packet)
+	}
+	return im.onRecvDIDResolution(ctx, packet)
+}
+
+func (im IBCModule) onRecvDIDResolution(ctx sdk.Context, packet channeltypes.Packet) ibcexported.Acknowledgement {
+	var data types.DIDResolutionPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling did-resolver packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	doc, err := im.keeper.ResolveDID(ctx, data.Did)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	docBytes, err := json.Marshal(doc)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling resolved DID document: %w", err))
+	}
+
+	ackData := types.DIDResolutionAcknowledgement{
+		DIDDocument: docBytes,
+		Height:      ctx.BlockHeight(),
+	}
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling did-resolver acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// onRecvControllerHandoff implements the destination side of MsgTransferDIDController:
+// it applies the retarget through k.ApplyControllerHandoff and always acknowledges,
+// carrying Applied=false rather than an error acknowledgement when the handoff doesn't
+// apply (e.g. OldController no longer controls Did), so the sending chain's
+// OnAcknowledgementPacket can tell a relayed-but-rejected handoff from a relayer
+// failure.
+func (im IBCModule) onRecvControllerHandoff(ctx sdk.Context, packet channeltypes.Packet) ibcexported.Acknowledgement {
+	var data types.ControllerHandoffPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling controller-handoff packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	applied := true
+	if err := im.keeper.ApplyControllerHandoff(ctx, data.Did, data.OldController, data.NewController); err != nil {
+		applied = false
+	}
+
+	ackData := types.ControllerHandoffAcknowledgement{
+		Applied: applied,
+		Height:  ctx.BlockHeight(),
+	}
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling controller-handoff acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// OnAcknowledgementPacket implements porttypes.IBCModule on the controller side,
+// dispatching on the same envelope OnRecvPacket uses: a DIDResolutionPacketData's
+// successful acknowledgement is cached under types.RemoteDIDKey(chainID, did) for
+// Params.RemoteDIDCacheTTL (chainID resolved via k.channelKeeper.GetChannelClientState)
+// so a subsequent local ValidateDID lookup for the same remote DID doesn't need to
+// round-trip over IBC again; a ControllerHandoffPacketData's successful,
+// Applied=true acknowledgement finalizes the sending chain's own
+// k.ApplyControllerHandoff, so MsgTransferDIDController's local DIDsByController index
+// only moves once the destination chain has confirmed the handoff, not at send time.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	var envelope didResolverPacketEnvelope
+	if err := json.Unmarshal(packet.GetData(), &envelope); err != nil {
+		return fmt.Errorf("unmarshaling did-resolver packet data: %w", err)
+	}
+	if envelope.OldController != "" {
+		return im.onAckControllerHandoff(ctx, packet, acknowledgement)
+	}
+	return im.onAckDIDResolution(ctx, packet, acknowledgement)
+}
+
+func (im IBCModule) onAckDIDResolution(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := json.Unmarshal(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("unmarshaling did-resolver acknowledgement: %w", err)
+	}
+	if !ack.Success() {
+		return nil
+	}
+
+	var data types.DIDResolutionPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return fmt.Errorf("unmarshaling did-resolver packet data: %w", err)
+	}
+
+	var result types.DIDResolutionAcknowledgement
+	if err := json.Unmarshal(ack.GetResult(), &result); err != nil {
+		return fmt.Errorf("unmarshaling did-resolver acknowledgement result: %w", err)
+	}
+
+	var doc types.DIDDocument
+	if err := json.Unmarshal(result.DIDDocument, &doc); err != nil {
+		return fmt.Errorf("unmarshaling resolved DID document: %w", err)
+	}
+
+	_, clientState, err := im.keeper.channelKeeper.GetChannelClientState(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return fmt.Errorf("resolving did-resolver channel's counterparty chain id: %w", err)
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return fmt.Errorf("did-resolver channel's client state is not a tendermint light client")
+	}
+	chainID := tmClientState.ChainId
+
+	return im.keeper.SetRemoteDID(ctx, chainID, data.Did, doc, result.Height)
+}
+
+// onAckControllerHandoff finalizes MsgTransferDIDController's local state once the
+// destination chain's acknowledgement confirms the handoff applied; a failed
+// acknowledgement (relayed but rejected, e.g. OldController no longer controls Did on
+// the destination chain) leaves this chain's index untouched, same as a timeout.
+func (im IBCModule) onAckControllerHandoff(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := json.Unmarshal(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("unmarshaling controller-handoff acknowledgement: %w", err)
+	}
+	if !ack.Success() {
+		return nil
+	}
+
+	var result types.ControllerHandoffAcknowledgement
+	if err := json.Unmarshal(ack.GetResult(), &result); err != nil {
+		return fmt.Errorf("unmarshaling controller-handoff acknowledgement result: %w", err)
+	}
+	if !result.Applied {
+		return nil
+	}
+
+	var data types.ControllerHandoffPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return fmt.Errorf("unmarshaling controller-handoff packet data: %w", err)
+	}
+
+	return im.keeper.ApplyControllerHandoff(ctx, data.Did, data.OldController, data.NewController)
+}
+
+// OnTimeoutPacket implements porttypes.IBCModule. A timed-out query or handoff is
+// simply dropped; neither MsgQueryRemoteDID nor MsgTransferDIDController retries
+// automatically, and neither mutates local state before a successful acknowledgement.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine. This struct was missing entirely until now:
+// authenticator.go, store.go, method_resolver.go, controller_handoff.go,
+// msg_server_remote_did.go, and every other file in this package were written against
+// an assumed Keeper shape documented in each file's own doc comments rather than a real
+// type, which meant nothing in this package actually compiled. The field set below is
+// taken directly from those doc comments -- store.go's "Keeper carries..." block in
+// particular already enumerated almost every collection here -- rather than redesigned
+// from scratch, so the methods written against the assumed shape need no further
+// changes.
+//
+// Modeled on x/credential/keeper/keeper.go's KVStoreService-backed collections.Schema
+// layout, the same newer-era convention this package's methods already assume
+// (k.DIDs.Get/Set/Walk rather than manual byte-key construction).
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+	Schema       collections.Schema
+
+	Params collections.Item[types.Params]
+
+	DIDs             collections.Map[string, types.DIDDocument]
+	DIDCount         collections.Sequence
+	DIDsByController collections.Map[collections.Pair[string, string], string]
+	DIDControllers   collections.Map[string, string]
+	DIDsByStatus     collections.Map[collections.Pair[string, string], string]
+
+	AuthMethods       collections.Map[collections.Pair[string, string], types.AuthMethod]
+	AuthMethodsByType collections.Map[collections.Pair[string, string], bool]
+
+	OIDCIssuerKeys collections.Map[string, types.OIDCIssuerJWKS]
+
+	RemoteDIDs collections.Map[[]byte, types.RemoteDID]
+
+	DIDVersions     collections.Map[collections.Pair[string, string], types.DIDDocument]
+	DIDVersionIndex collections.Map[string, types.DIDVersionHistory]
+
+	DIDWebCache    collections.Map[string, types.CachedDIDWebDocument]
+	EnabledMethods collections.Map[string, bool]
+
+	// Registry resolves an AuthMethod's MethodType to the types.Authenticator it's
+	// configured as -- see authenticator.go's ResolveAuthenticator. Built from
+	// types.DefaultRegistry() and extended with any app-specific authenticators at app
+	// wiring time.
+	Registry *types.Registry
+
+	// MethodRegistry resolves a DID's method (did:key/did:web/did:ethr/...) to the
+	// resolver ResolveDID dispatches to -- see method_resolver.go. nil falls back to
+	// GetDID for backward compatibility with a did:persona-only deployment.
+	MethodRegistry *types.MethodRegistry
+
+	// External keepers, narrowed to this tree's expected_keepers.go interfaces rather
+	// than concrete x/registry, x/oracle, ibc-go keeper types.
+	registryKeeper types.RegistryKeeper
+	channelKeeper  types.ChannelKeeper
+	scopedKeeper   capabilitykeeper.ScopedKeeper
+
+	// authority is the address capable of executing governance proposals
+	// (RegisterMethod, UpdateOIDCKeys -- see method_resolver.go/msg_server_oidc.go).
+	authority string
+}
+
+// NewKeeper creates a new did Keeper instance backed by storeService. registryKeeper
+// may be nil, in which case reputation-crediting on a newly linked OIDC AuthMethod is
+// skipped rather than failing the link (see msg_server_oidc.go's
+// creditOIDCVerificationReputation).
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+	registryKeeper types.RegistryKeeper,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		logger:         log.NewNopLogger(),
+		authority:      authority,
+		registryKeeper: registryKeeper,
+		channelKeeper:  channelKeeper,
+		scopedKeeper:   scopedKeeper,
+		Registry:       types.DefaultRegistry(),
+		MethodRegistry: types.DefaultMethodRegistry(),
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		DIDs:             collections.NewMap(sb, types.DIDPrefix, "dids", collections.StringKey, codec.CollValue[types.DIDDocument](cdc)),
+		DIDCount:         collections.NewSequence(sb, types.DIDCountKey, "did_count"),
+		DIDsByController: collections.NewMap(sb, types.DIDByControllerPrefix, "dids_by_controller", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		DIDControllers:   collections.NewMap(sb, types.DIDControllerPrefix, "did_controllers", collections.StringKey, collections.StringValue),
+		DIDsByStatus:     collections.NewMap(sb, types.DIDByStatusPrefix, "dids_by_status", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+
+		AuthMethods:       collections.NewMap(sb, types.AuthMethodPrefix, "auth_methods", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AuthMethod](cdc)),
+		AuthMethodsByType: collections.NewMap(sb, types.AuthMethodByTypePrefix, "auth_methods_by_type", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.BoolValue),
+
+		OIDCIssuerKeys: collections.NewMap(sb, types.OIDCIssuerKeysPrefix, "oidc_issuer_keys", collections.StringKey, codec.CollValue[types.OIDCIssuerJWKS](cdc)),
+
+		RemoteDIDs: collections.NewMap(sb, types.RemoteDIDPrefix, "remote_dids", collections.BytesKey, codec.CollValue[types.RemoteDID](cdc)),
+
+		DIDVersions:     collections.NewMap(sb, types.DIDVersionPrefix, "did_versions", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.DIDDocument](cdc)),
+		DIDVersionIndex: collections.NewMap(sb, types.DIDVersionIndexPrefix, "did_version_index", collections.StringKey, codec.CollValue[types.DIDVersionHistory](cdc)),
+
+		DIDWebCache:    collections.NewMap(sb, types.DIDWebCachePrefix, "did_web_cache", collections.StringKey, codec.CollValue[types.CachedDIDWebDocument](cdc)),
+		EnabledMethods: collections.NewMap(sb, types.EnabledMethodPrefix, "enabled_methods", collections.StringKey, collections.BoolValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
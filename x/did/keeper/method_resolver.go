@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// MethodRegistry, DIDWebCache, and EnabledMethods are real Keeper fields (see
+// keeper.go), alongside k.DIDs/k.GetParams used elsewhere in this package.
+
+// GetCachedDIDWebDocument implements types.MethodResolverKeeper for WebMethodResolver.
+func (k Keeper) GetCachedDIDWebDocument(ctx sdk.Context, domain string) (*types.DIDDocument, bool) {
+	cached, err := k.DIDWebCache.Get(ctx, domain)
+	if err != nil {
+		return nil, false
+	}
+	return &cached.Document, true
+}
+
+// IsMethodRegistered implements types.MethodResolverKeeper: did:persona and did:key are
+// always enabled (self-certifying, no governance gate needed), every other method must
+// have been enabled via MsgRegisterMethod.
+func (k Keeper) IsMethodRegistered(ctx sdk.Context, method string) bool {
+	if method == "persona" || method == "key" {
+		return true
+	}
+	enabled, err := k.EnabledMethods.Get(ctx, method)
+	return err == nil && enabled
+}
+
+// RegisterMethod handles MsgRegisterMethod: Authority must match k's configured
+// authority (the same governance gate MsgRegisterNamespace/MsgUpdateParams use).
+func (k Keeper) RegisterMethod(ctx context.Context, msg *types.MsgRegisterMethod) (*types.MsgRegisterMethodResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, types.ErrInvalidAuthority
+	}
+	if k.MethodRegistry != nil {
+		if _, err := k.MethodRegistry.Get(msg.Method); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.EnabledMethods.Set(ctx, msg.Method, true); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterMethodResponse{}, nil
+}
+
+// RefreshDIDWebCache stores a freshly attested did:web document for domain, called once
+// per block from an EndBlocker sweep over x/oracle's did:web attestation feeds (the
+// feedID convention is "did-web:<domain>", mirroring types/attestation.go's FeedID
+// shape) rather than by this keeper reaching out over HTTP itself -- the same
+// off-chain-fetch/on-chain-consume split WebMethodResolver's doc comment describes.
+func (k Keeper) RefreshDIDWebCache(ctx sdk.Context, domain string, doc types.DIDDocument) error {
+	return k.DIDWebCache.Set(ctx, domain, types.CachedDIDWebDocument{
+		Domain:    domain,
+		Document:  doc,
+		FetchedAt: ctx.BlockTime(),
+	})
+}
+
+// ResolveDID dispatches id to its method's resolver via k.MethodRegistry, the
+// method-agnostic entry point QueryDID (query_server.go) and any caller that used to
+// assume did:persona should move to, now that did:key/did:web/did:ethr are resolvable
+// too. A nil MethodRegistry (e.g. in a deployment that hasn't wired chunk13-2's
+// registry into NewKeeper yet) falls back to k.GetDID for backward compatibility with
+// every existing did:persona-only caller.
+func (k Keeper) ResolveDID(ctx sdk.Context, id string) (*types.DIDDocument, error) {
+	if k.MethodRegistry == nil {
+		doc, err := k.GetDID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &doc, nil
+	}
+	return k.MethodRegistry.Resolve(ctx, k, id)
+}
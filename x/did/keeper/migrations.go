@@ -0,0 +1,58 @@
+package keeper
+
+// This file is x/did's counterpart to x/credential/keeper/migrations.go's Migrator:
+// a second, later migration past store.go's MigrateStore (the legacy-raw-layout ->
+// collections migration, conceptually "Migrate1to2"). Like x/credential/keeper/
+// migrations.go, there is no module.go/AppModule/Configurator in x/did for a
+// RegisterServices to call cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3)
+// from yet -- see keeper/method_resolver.go's doc comment for the same standing gap.
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// Migrator wraps a Keeper so RegisterServices (once x/did has a Configurator to call
+// it from) can register its Migrate2to3 the same way x/credential's Migrator is
+// registered.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator wrapping keeper.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 recomputes DIDCount by counting every live entry under DIDPrefix,
+// discarding whatever value DIDCount previously held -- the "backfilling DIDCountKey
+// from an iterator" this chunk's request asks for, useful as its own migration any
+// time DIDCount and the live DID set have drifted (e.g. a bug in an intermediate
+// release that bumped DIDCount without writing a DID, or vice versa).
+//
+// The request's other ask -- adding a method-byte prefix to DIDKey/DIDByControllerKey
+// so DIDs could be range-scanned by method -- is not implemented here: doc.ID (the
+// full "did:<method>:<id>" string chunk13-2's ParseDIDID already splits on demand) is
+// the key every other index in this package keys off of too -- DIDsByController,
+// DIDControllers, DIDsByStatus, DIDVersionPrefix, DIDVersionIndexPrefix, and every
+// GetDID/SetDID/ResolveDID caller in this tree. Rekeying DIDs alone, without a
+// matching rewrite of all four other indices (which Migrate2to3 would then also need
+// to keep atomic with this one), would leave the schema internally inconsistent --
+// a correctness regression worse than not migrating at all. A real method-prefixed
+// re-key is possible but is its own, larger migration across every one of those
+// indices; this one is scoped to the count backfill the request also names, which
+// stands alone safely.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+
+	var count uint64
+	if err := k.DIDs.Walk(ctx, nil, func(id string, _ types.DIDDocument) (bool, error) {
+		count++
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	return k.DIDCount.Set(ctx, count)
+}
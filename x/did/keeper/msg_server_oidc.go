@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	registrytypes "github.com/PersonaPass-ID/personachain/x/registry/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// LinkOIDCAuthMethod implements types.MsgServer's LinkOIDCAuthMethod: it's this tree's
+// closest analogue to the request's "RegisterAuthMethod" -- x/did has no
+// provider-agnostic AuthMethod-registration entry point, only this OIDC-specific one
+// (types.MethodTypeOIDC), so that's what calls KeylessAuthVerifier before persisting.
+// msg.Binding is the client's claim about what msg.IdToken contains; KeylessAuthVerifier
+// independently parses and verifies msg.IdToken itself and only trusts claims it
+// recovers from the verified token, using msg.Binding solely to pick the AuthMethod's
+// deterministic MethodID (see OIDCIdentityBinding.AuthMethodID).
+func (k Keeper) LinkOIDCAuthMethod(ctx context.Context, msg *types.MsgLinkOIDCAuthMethod) (*types.MsgLinkOIDCAuthMethodResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateDID(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	methodID := msg.Binding.AuthMethodID()
+	method := types.AuthMethod{
+		MethodID:      methodID,
+		MethodType:    types.MethodTypeOIDC,
+		PublicKeyHash: types.ComputeOIDCPublicKeyHash(msg.Binding.Issuer, msg.Binding.Subject, msg.Binding.Audience),
+		Attestation:   msg.IdToken,
+		LinkedAt:      now,
+		IsActive:      true,
+	}
+
+	verifier := NewKeylessAuthVerifier(k)
+	claims, err := verifier.Verify(ctx, method, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetAuthMethod(ctx, msg.Controller, method); err != nil {
+		return nil, err
+	}
+
+	k.creditOIDCVerificationReputation(ctx, msg.Id, claims, now)
+
+	types.EmitAuthMethodLinkedEvent(sdkCtx, msg.Controller, msg.Id, msg.Binding)
+
+	return &types.MsgLinkOIDCAuthMethodResponse{MethodId: methodID}, nil
+}
+
+// creditOIDCVerificationReputation records a small positive ReputationEvent on the DID's
+// linked x/registry identity when a new OIDC/oauth_* attestation is accepted, per the
+// request. k.registryKeeper is optional (nil in a deployment that hasn't wired x/registry
+// in, or in isolated unit tests), in which case this is a no-op rather than a hard
+// failure -- linking an AuthMethod shouldn't fail just because reputation bookkeeping
+// isn't wired up.
+func (k Keeper) creditOIDCVerificationReputation(ctx context.Context, did string, claims types.OIDCIDTokenClaims, at time.Time) {
+	if k.registryKeeper == nil {
+		return
+	}
+	event := registrytypes.ReputationEvent{
+		Type:      "verification",
+		Impact:    5,
+		Reason:    "oidc attestation verified for issuer " + claims.Issuer,
+		Source:    "system",
+		Timestamp: at,
+	}
+	_ = k.registryKeeper.UpdateReputation(ctx, did, event)
+}
+
+// UpdateOIDCKeys implements types.MsgServer's UpdateOIDCKeys: a governance-authorized
+// (or authorized-relayer, depending on how the chain configures k.authority) push of an
+// issuer's current JWKS document, the only way this module's JWKS cache can ever be
+// populated since it can't fetch one itself.
+func (k Keeper) UpdateOIDCKeys(ctx context.Context, msg *types.MsgUpdateOIDCKeys) (*types.MsgUpdateOIDCKeysResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != k.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	jwks := types.OIDCIssuerJWKS{
+		Issuer:    msg.Issuer,
+		Keys:      msg.Keys,
+		UpdatedAt: sdkCtx.BlockTime().Unix(),
+	}
+	if err := k.OIDCIssuerKeys.Set(ctx, msg.Issuer, jwks); err != nil {
+		return nil, err
+	}
+
+	types.EmitOIDCKeysUpdatedEvent(sdkCtx, msg.Issuer, len(msg.Keys))
+
+	return &types.MsgUpdateOIDCKeysResponse{}, nil
+}
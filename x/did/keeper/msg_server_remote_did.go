@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// defaultRemoteDIDTimeout is how far in the future a MsgQueryRemoteDID packet's
+// timeout is set when the message doesn't specify one, generous enough to tolerate a
+// relayer outage without leaving the channel backed up indefinitely.
+const defaultRemoteDIDTimeout = 10 * 60 * 1e9 // 10 minutes, in nanoseconds
+
+// QueryRemoteDID implements types.MsgServer's QueryRemoteDID: it builds a
+// types.DIDResolutionPacketData for msg.Did and sends it over msg.ChannelId via
+// k.channelKeeper.SendPacket, the same port/channel-capability dance ICS-20's transfer
+// keeper does for MsgTransfer. The resolution itself is not returned synchronously --
+// it arrives later through IBCModule.OnAcknowledgementPacket, which caches it under
+// types.RemoteDIDKey.
+//
+// k.channelKeeper and k.scopedKeeper are real Keeper fields (see keeper.go), alongside
+// k.DIDs/k.GetParams used elsewhere in this package.
+func (k Keeper) QueryRemoteDID(ctx context.Context, msg *types.MsgQueryRemoteDID) (*types.MsgQueryRemoteDIDResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	channelCap, ok := k.scopedKeeper.GetCapability(sdkCtx, hostPortChannelCapabilityName(types.PortID, msg.ChannelId))
+	if !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("no channel capability for channel %s", msg.ChannelId)
+	}
+
+	if _, ok := k.channelKeeper.GetChannel(sdkCtx, types.PortID, msg.ChannelId); !ok {
+		return nil, types.ErrInvalidIBCVersion.Wrapf("channel %s not found", msg.ChannelId)
+	}
+
+	packetData := types.DIDResolutionPacketData{Did: msg.Did}
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(sdkCtx.BlockTime().UnixNano() + defaultRemoteDIDTimeout)
+	}
+
+	sequence, err := k.channelKeeper.SendPacket(
+		sdkCtx,
+		channelCap,
+		types.PortID,
+		msg.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		packetBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgQueryRemoteDIDResponse{Sequence: sequence}, nil
+}
+
+// hostPortChannelCapabilityName mirrors host.ChannelCapabilityPath's
+// "{portID}/{channelID}" naming so this file doesn't need to import the ibc-go host
+// package solely for this one helper.
+func hostPortChannelCapabilityName(portID, channelID string) string {
+	return portID + "/" + channelID
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"strings"
+	"time"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// KeylessAuthVerifier checks an oauth_*/oidc AuthMethod's Attestation (an OIDC ID
+// token) against a whitelisted issuer's on-chain-cached JWKS. A chain can't make
+// outbound HTTP calls from consensus-critical code, so unlike a normal OIDC relying
+// party this never fetches anything itself -- it only ever verifies against keys a
+// prior MsgUpdateOIDCKeys call already pushed into k.OIDCIssuerKeys.
+type KeylessAuthVerifier struct {
+	k Keeper
+}
+
+// NewKeylessAuthVerifier returns a KeylessAuthVerifier backed by k.
+func NewKeylessAuthVerifier(k Keeper) KeylessAuthVerifier {
+	return KeylessAuthVerifier{k: k}
+}
+
+// AppliesTo reports whether methodType names an auth method KeylessAuthVerifier can
+// verify -- the OIDC binding (types.MethodTypeOIDC) plus any "oauth_"-prefixed
+// provider-specific method type the request envisions (e.g. "oauth_microsoft"), even
+// though this tree only wires an actual linking handler through MethodTypeOIDC today
+// (see msg_server_oidc.go).
+func (v KeylessAuthVerifier) AppliesTo(methodType string) bool {
+	return methodType == types.MethodTypeOIDC || strings.HasPrefix(methodType, "oauth_")
+}
+
+// Verify checks that method.Attestation is a validly signed, unexpired ID token from a
+// whitelisted issuer whose (iss, sub, aud) claims hash to method.PublicKeyHash (see
+// types.ComputeOIDCPublicKeyHash), returning the parsed claims on success so the caller
+// can credit a ReputationEvent to the linked registry identity.
+func (v KeylessAuthVerifier) Verify(ctx context.Context, method types.AuthMethod, at time.Time) (types.OIDCIDTokenClaims, error) {
+	token, err := types.ParseOIDCIDToken(method.Attestation)
+	if err != nil {
+		return types.OIDCIDTokenClaims{}, err
+	}
+	if err := token.ValidateTiming(at.Unix()); err != nil {
+		return types.OIDCIDTokenClaims{}, err
+	}
+	if token.Header.Alg != "RS256" {
+		return types.OIDCIDTokenClaims{}, types.ErrInvalidOIDCToken.Wrapf("unsupported alg %q: only RS256 is verified", token.Header.Alg)
+	}
+
+	params, err := v.k.GetParams(ctx)
+	if err != nil {
+		return types.OIDCIDTokenClaims{}, err
+	}
+	issuerCfg, ok := findOIDCIssuer(params.OIDCIssuers, token.Claims.Issuer)
+	if !ok {
+		return types.OIDCIDTokenClaims{}, types.ErrOIDCIssuerNotAllowed.Wrapf("issuer %q is not whitelisted", token.Claims.Issuer)
+	}
+	if token.Claims.Audience != issuerCfg.Audience {
+		return types.OIDCIDTokenClaims{}, types.ErrInvalidOIDCToken.Wrapf("aud %q does not match configured audience %q", token.Claims.Audience, issuerCfg.Audience)
+	}
+
+	jwks, err := v.k.OIDCIssuerKeys.Get(ctx, issuerCfg.Name)
+	if err != nil {
+		return types.OIDCIDTokenClaims{}, types.ErrOIDCKeysNotCached.Wrapf("no cached JWKS for issuer %q: push one via MsgUpdateOIDCKeys first", issuerCfg.Name)
+	}
+	jwk, ok := jwks.Find(token.Header.Kid)
+	if !ok {
+		return types.OIDCIDTokenClaims{}, types.ErrOIDCKeysNotCached.Wrapf("no cached key with kid %q for issuer %q", token.Header.Kid, issuerCfg.Name)
+	}
+	pub, err := jwk.PublicKey()
+	if err != nil {
+		return types.OIDCIDTokenClaims{}, err
+	}
+
+	digest := sha256.Sum256([]byte(token.SigningInput))
+	if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], token.Signature); err != nil {
+		return types.OIDCIDTokenClaims{}, types.ErrInvalidSignature.Wrap("id token signature verification failed")
+	}
+
+	expectedHash := types.ComputeOIDCPublicKeyHash(token.Claims.Issuer, token.Claims.Subject, token.Claims.Audience)
+	if expectedHash != method.PublicKeyHash {
+		return types.OIDCIDTokenClaims{}, types.ErrInvalidOIDCToken.Wrap("id token claims do not bind to the AuthMethod's PublicKeyHash")
+	}
+
+	return token.Claims, nil
+}
+
+func findOIDCIssuer(issuers []types.OIDCIssuerConfig, iss string) (types.OIDCIssuerConfig, bool) {
+	for _, cfg := range issuers {
+		if cfg.Issuer == iss {
+			return cfg, true
+		}
+	}
+	return types.OIDCIssuerConfig{}, false
+}
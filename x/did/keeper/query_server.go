@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// QueryServer exposes the did keeper's read surface -- Resolve/ResolveVersion/Versions
+// -- as the shape a gRPC Query service would call into, the same plain-Go convention
+// x/zkproof/keeper/query_server.go uses in place of this repo's not-yet-wired-up
+// protobuf/gRPC query-service generation.
+type QueryServer struct {
+	Keeper
+}
+
+// NewQueryServer returns a QueryServer backed by keeper.
+func NewQueryServer(keeper Keeper) QueryServer {
+	return QueryServer{Keeper: keeper}
+}
+
+// Resolve returns query.Did's current DIDDocument and resolution metadata, the plain
+// `resolve` DID-resolution operation with no versionId.
+func (q QueryServer) Resolve(ctx context.Context, query types.ResolveQuery) (types.ResolutionResult, error) {
+	doc, docMeta, resMeta, err := q.Keeper.ResolveDID(ctx, query.Did, "")
+	if err != nil {
+		return types.ResolutionResult{DIDResolutionMetadata: resMeta}, err
+	}
+	return types.ResolutionResult{DIDDocument: doc, DIDDocumentMetadata: docMeta, DIDResolutionMetadata: resMeta}, nil
+}
+
+// ResolveVersion returns query.Did's historical revision at query.VersionID, or its
+// current document if query.VersionID names the live VersionID.
+func (q QueryServer) ResolveVersion(ctx context.Context, query types.ResolveVersionQuery) (types.ResolutionResult, error) {
+	doc, docMeta, resMeta, err := q.Keeper.ResolveDID(ctx, query.Did, query.VersionID)
+	if err != nil {
+		return types.ResolutionResult{DIDResolutionMetadata: resMeta}, err
+	}
+	return types.ResolutionResult{DIDDocument: doc, DIDDocumentMetadata: docMeta, DIDResolutionMetadata: resMeta}, nil
+}
+
+// Versions returns query.Did's ordered version history (oldest first), not including
+// its current, live revision.
+func (q QueryServer) Versions(ctx context.Context, query types.VersionsQuery) (types.VersionsResponse, error) {
+	entries, err := q.Keeper.Versions(ctx, query.Did)
+	if err != nil {
+		return types.VersionsResponse{}, err
+	}
+	return types.VersionsResponse{Versions: entries}, nil
+}
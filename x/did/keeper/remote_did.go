@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// SetRemoteDID persists a did-resolver resolution of did on chainID, received via
+// IBCModule.OnAcknowledgementPacket, under types.RemoteDIDKey(chainID, did).
+//
+// k.RemoteDIDs is a real Keeper field (see keeper.go), alongside k.DIDs/k.GetParams used
+// elsewhere in this package.
+func (k Keeper) SetRemoteDID(ctx sdk.Context, chainID, did string, doc types.DIDDocument, height int64) error {
+	entry := types.RemoteDID{
+		ChainID:     chainID,
+		Did:         did,
+		DIDDocument: doc,
+		Height:      height,
+		CachedAt:    ctx.BlockTime().Unix(),
+	}
+	return k.RemoteDIDs.Set(ctx, types.RemoteDIDKey(chainID, did), entry)
+}
+
+// GetRemoteDID returns the cached resolution of did on chainID if one exists and is
+// still within Params.RemoteDIDCacheTTL of its CachedAt, so ValidateDIDOrRemoteCache can
+// trust it without re-resolving over IBC.
+func (k Keeper) GetRemoteDID(ctx sdk.Context, chainID, did string) (types.RemoteDID, error) {
+	entry, err := k.RemoteDIDs.Get(ctx, types.RemoteDIDKey(chainID, did))
+	if err != nil {
+		return types.RemoteDID{}, types.ErrRemoteDIDNotCached.Wrapf("no cached resolution for %s on chain %s", did, chainID)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.RemoteDID{}, err
+	}
+	if entry.Expired(ctx.BlockTime().Unix(), params.RemoteDIDCacheTTL) {
+		return types.RemoteDID{}, types.ErrRemoteDIDNotCached.Wrapf("cached resolution for %s on chain %s has expired", did, chainID)
+	}
+	return entry, nil
+}
+
+// ValidateDIDOrRemoteCache first tries k.ValidateDID against the local DID store, then
+// falls back to a cached did-resolver resolution keyed by (chainID, did) -- the
+// chaining the MsgQueryRemoteDID request describes so x/zkproof's DIDKeeper interface
+// (which only has a local ValidateDID today) can be satisfied by a DID this chain
+// never hosted, once its keeper is wired to call this instead of ValidateDID directly
+// for a did that isn't in the did:persona namespace this chain issues.
+func (k Keeper) ValidateDIDOrRemoteCache(ctx sdk.Context, chainID, did string) error {
+	if err := k.ValidateDID(ctx, did); err == nil {
+		return nil
+	}
+
+	entry, err := k.GetRemoteDID(ctx, chainID, did)
+	if err != nil {
+		return err
+	}
+	if entry.DIDDocument.Deactivated {
+		return types.ErrDIDDeactivated.Wrapf("remote DID %s on chain %s is deactivated", did, chainID)
+	}
+	return nil
+}
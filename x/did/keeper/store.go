@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// This file's collections schema is now backed by a real Keeper struct (see keeper.go)
+// instead of the storeKey.KVStore(ctx)+MustMarshal+manual-prefix-iterator style
+// GetAllDIDs/GetDIDsByController/GetAuthMethodsByDID used before it, which returned the
+// whole table and would OOM a node once a controller racks up thousands of DIDs or auth
+// methods.
+
+// GetParams returns the module's current parameters.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams overwrites the module's parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
+
+// HasDID reports whether a DID Document with the given id exists.
+func (k Keeper) HasDID(ctx context.Context, id string) (bool, error) {
+	return k.DIDs.Has(ctx, id)
+}
+
+// GetDID returns the DID Document stored under id.
+func (k Keeper) GetDID(ctx context.Context, id string) (types.DIDDocument, error) {
+	doc, err := k.DIDs.Get(ctx, id)
+	if err != nil {
+		return types.DIDDocument{}, types.ErrDIDNotFound.Wrapf("DID %s not found", id)
+	}
+	return doc, nil
+}
+
+// ValidateDID checks that id names a DID Document that exists and is not deactivated,
+// the check ValidateDIDOrRemoteCache falls back from for a did this chain never hosted.
+func (k Keeper) ValidateDID(ctx context.Context, id string) error {
+	doc, err := k.GetDID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if doc.Deactivated {
+		return types.ErrDIDDeactivated.Wrapf("DID %s is deactivated", id)
+	}
+	return nil
+}
+
+// ResolveVerificationMethod resolves methodID against did's current DID Document and
+// returns its key type and publicKeyMultibase, satisfying x/credential/types.DIDKeeper
+// so keeper/verify.go's VerifyCredential (x/credential) can check a proof against the
+// issuer's actual key instead of only confirming the issuer DID is active. methodID may
+// be a full "did:...#fragment" id or, for convenience, a bare "#fragment"/fragment
+// scoped to did -- both are compared against each VerificationMethod.ID the same way.
+func (k Keeper) ResolveVerificationMethod(ctx context.Context, did, methodID string) (string, string, error) {
+	doc, err := k.GetDID(ctx, did)
+	if err != nil {
+		return "", "", err
+	}
+
+	fragment := methodID
+	if idx := indexOfFragment(methodID); idx >= 0 {
+		fragment = methodID[idx:]
+	}
+
+	for _, vm := range doc.VerificationMethod {
+		if vm.ID == methodID {
+			return vm.Type, vm.PublicKeyMultibase, nil
+		}
+		if vmIdx := indexOfFragment(vm.ID); vmIdx >= 0 && vm.ID[vmIdx:] == fragment {
+			return vm.Type, vm.PublicKeyMultibase, nil
+		}
+	}
+	return "", "", types.ErrInvalidDocument.Wrapf("verification method %q not found on DID %s", methodID, did)
+}
+
+// indexOfFragment returns the index of id's "#" fragment separator, or -1 if id has
+// none.
+func indexOfFragment(id string) int {
+	for i := 0; i < len(id); i++ {
+		if id[i] == '#' {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetDIDController returns the controller address doc.ID was created/last updated
+// under, the reverse of DIDsByController -- the lookup the EndBlocker's pruning sweep
+// needs since DIDDocument itself carries no top-level controller field.
+func (k Keeper) GetDIDController(ctx context.Context, id string) (string, error) {
+	return k.DIDControllers.Get(ctx, id)
+}
+
+// SetDID creates or updates doc, keyed by doc.ID, maintaining DIDsByController (keyed
+// off controller, the address a MsgCreateDID/MsgUpdateDID handler authenticated
+// separately from doc itself), its reverse DIDControllers, and DIDsByStatus. DIDCount
+// is only bumped the first time doc.ID is written.
+func (k Keeper) SetDID(ctx context.Context, controller string, doc types.DIDDocument) error {
+	existed, err := k.DIDs.Has(ctx, doc.ID)
+	if err != nil {
+		return err
+	}
+
+	if existed {
+		old, err := k.DIDs.Get(ctx, doc.ID)
+		if err != nil {
+			return err
+		}
+		if err := k.DIDsByStatus.Remove(ctx, collections.Join(types.DIDStatus(old), doc.ID)); err != nil {
+			return err
+		}
+	} else {
+		if _, err := k.DIDCount.Next(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DIDs.Set(ctx, doc.ID, doc); err != nil {
+		return err
+	}
+	if err := k.DIDsByController.Set(ctx, collections.Join(controller, doc.ID), doc.ID); err != nil {
+		return err
+	}
+	if err := k.DIDControllers.Set(ctx, doc.ID, controller); err != nil {
+		return err
+	}
+	return k.DIDsByStatus.Set(ctx, collections.Join(types.DIDStatus(doc), doc.ID), doc.ID)
+}
+
+// DeleteDID hard-deletes doc, removing it and its controller/status index entries plus
+// every AuthMethod linked to controller -- the EndBlocker pruning sweep's counterpart
+// to SetDID, used once a Deactivated DID has outlived Params.DIDGracePeriod.
+func (k Keeper) DeleteDID(ctx context.Context, controller string, doc types.DIDDocument) error {
+	methods, err := k.GetAuthMethods(ctx, controller)
+	if err != nil {
+		return err
+	}
+	for _, method := range methods {
+		if err := k.DeleteAuthMethod(ctx, controller, method); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DIDsByStatus.Remove(ctx, collections.Join(types.DIDStatus(doc), doc.ID)); err != nil {
+		return err
+	}
+	if err := k.DIDsByController.Remove(ctx, collections.Join(controller, doc.ID)); err != nil {
+		return err
+	}
+	if err := k.DIDControllers.Remove(ctx, doc.ID); err != nil {
+		return err
+	}
+	return k.DIDs.Remove(ctx, doc.ID)
+}
+
+// DeleteAuthMethod removes method and its AuthMethodsByType index entry.
+func (k Keeper) DeleteAuthMethod(ctx context.Context, controller string, method types.AuthMethod) error {
+	if err := k.AuthMethodsByType.Remove(ctx, collections.Join(method.MethodType, controller+"/"+method.MethodID)); err != nil {
+		return err
+	}
+	return k.AuthMethods.Remove(ctx, collections.Join(controller, method.MethodID))
+}
+
+// GetDIDsByController walks DIDsByController for controller, calling fn with each DID
+// id until fn returns true (stop) or an error, or the index is exhausted -- the
+// bounded replacement for the old GetDIDsByController's unbounded []string return.
+func (k Keeper) GetDIDsByController(ctx context.Context, controller string, fn func(id string) (stop bool, err error)) error {
+	rng := collections.NewPrefixedPairRange[string, string](controller)
+	return k.DIDsByController.Walk(ctx, rng, func(key collections.Pair[string, string], id string) (bool, error) {
+		return fn(id)
+	})
+}
+
+// WalkDIDs calls fn with every stored DID Document in key order, stopping early if fn
+// returns true -- the entry point x/zkproof's EndBlocker-style sweeps use instead of
+// loading every DID into one slice.
+func (k Keeper) WalkDIDs(ctx context.Context, fn func(doc types.DIDDocument) (stop bool, err error)) error {
+	return k.DIDs.Walk(ctx, nil, func(id string, doc types.DIDDocument) (bool, error) {
+		return fn(doc)
+	})
+}
+
+// SetAuthMethod links method to controller, maintaining AuthMethodsByType so a
+// chainwide sweep (e.g. disabling a compromised WebAuthn provider) doesn't need to
+// walk every controller's AuthMethods to find the affected ones.
+func (k Keeper) SetAuthMethod(ctx context.Context, controller string, method types.AuthMethod) error {
+	if err := k.AuthMethods.Set(ctx, collections.Join(controller, method.MethodID), method); err != nil {
+		return err
+	}
+	return k.AuthMethodsByType.Set(ctx, collections.Join(method.MethodType, controller+"/"+method.MethodID), true)
+}
+
+// TouchAuthMethod stamps method.LastUsedAt with the current block time and persists
+// it, keeping the EndBlocker's inactivity sweep from auto-deactivating an AuthMethod
+// that's still being used.
+func (k Keeper) TouchAuthMethod(ctx context.Context, controller string, method types.AuthMethod) error {
+	method.LastUsedAt = sdk.UnwrapSDKContext(ctx).BlockTime()
+	return k.SetAuthMethod(ctx, controller, method)
+}
+
+// GetAuthMethods returns every AuthMethod linked to controller (active and inactive),
+// the lookup ResolveAuthenticator uses to find the one request.AuthenticatorId names.
+func (k Keeper) GetAuthMethods(ctx context.Context, controller string) ([]types.AuthMethod, error) {
+	var methods []types.AuthMethod
+	rng := collections.NewPrefixedPairRange[string, string](controller)
+	err := k.AuthMethods.Walk(ctx, rng, func(key collections.Pair[string, string], method types.AuthMethod) (bool, error) {
+		methods = append(methods, method)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return methods, nil
+}
+
+// ListDIDs lists up to req.Pagination.EffectiveLimit() DID ids, optionally restricted
+// to req.Controller, resuming from req.Pagination.Cursor -- the paginated gRPC query
+// GetAllDIDs/GetDIDsByController are replaced by, so a controller with thousands of
+// DIDs is never returned in one response.
+func (k Keeper) ListDIDs(ctx context.Context, req types.QueryDIDsRequest) (types.QueryDIDsResponse, error) {
+	limit := req.Pagination.EffectiveLimit()
+	cursor, err := types.DecodeCursor(req.Pagination.Cursor)
+	if err != nil {
+		return types.QueryDIDsResponse{}, err
+	}
+
+	var (
+		ids  []string
+		last []byte
+	)
+
+	collect := func(id string, rawKey []byte) (bool, error) {
+		if len(cursor) > 0 && bytes.Compare(rawKey, cursor) <= 0 {
+			return false, nil
+		}
+		ids = append(ids, id)
+		last = rawKey
+		return uint64(len(ids)) >= limit, nil
+	}
+
+	if req.Controller != "" {
+		rng := collections.NewPrefixedPairRange[string, string](req.Controller)
+		err = k.DIDsByController.Walk(ctx, rng, func(key collections.Pair[string, string], id string) (bool, error) {
+			return collect(id, []byte(key.K2()))
+		})
+	} else {
+		err = k.DIDs.Walk(ctx, nil, func(id string, _ types.DIDDocument) (bool, error) {
+			return collect(id, []byte(id))
+		})
+	}
+	if err != nil {
+		return types.QueryDIDsResponse{}, err
+	}
+
+	return types.QueryDIDsResponse{
+		Ids:        ids,
+		Pagination: types.CursorPageResponse{NextCursor: types.EncodeCursor(last)},
+	}, nil
+}
+
+// MigrateStore copies every DID Document and controller-index entry written under the
+// pre-collections layout (types.LegacyDIDKey/LegacyDIDByControllerKey, the same raw
+// layout workspaces/persona-frontend's keeper.go used) into the collections schema
+// above, then deletes the legacy entries. It's an in-place migration in the Cosmos SDK
+// sense: it takes the legacy raw store key directly rather than through Keeper, the
+// same way a real x/<module>/migrations/v2 handler does, since by the time it's wired
+// into an upgrade handler the legacy storeKey won't be part of Keeper anymore.
+func MigrateStore(ctx sdk.Context, legacyStoreKey storetypes.StoreKey, k Keeper) error {
+	store := ctx.KVStore(legacyStoreKey)
+
+	didIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyDIDPrefix))
+	defer didIter.Close()
+
+	for ; didIter.Valid(); didIter.Next() {
+		var doc types.DIDDocument
+		k.cdc.MustUnmarshal(didIter.Value(), &doc)
+		if err := k.DIDs.Set(ctx, doc.ID, doc); err != nil {
+			return err
+		}
+		if err := k.DIDsByStatus.Set(ctx, collections.Join(types.DIDStatus(doc), doc.ID), doc.ID); err != nil {
+			return err
+		}
+		if _, err := k.DIDCount.Next(ctx); err != nil {
+			return err
+		}
+		store.Delete(didIter.Key())
+	}
+
+	controllerIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyDIDByControllerPrefix))
+	defer controllerIter.Close()
+
+	for ; controllerIter.Valid(); controllerIter.Next() {
+		id := string(controllerIter.Value())
+		controller := controllerFromLegacyKey(controllerIter.Key())
+		if controller == "" {
+			continue
+		}
+		if err := k.DIDsByController.Set(ctx, collections.Join(controller, id), id); err != nil {
+			return err
+		}
+		if err := k.DIDControllers.Set(ctx, id, controller); err != nil {
+			return err
+		}
+		store.Delete(controllerIter.Key())
+	}
+
+	return nil
+}
+
+// controllerFromLegacyKey extracts the controller segment from a
+// LegacyDIDByControllerKey(controller, id) key.
+func controllerFromLegacyKey(key []byte) string {
+	rest := string(key[len(types.LegacyDIDByControllerPrefix):])
+	for i := 0; i < len(rest); i++ {
+		if rest[i] == '/' {
+			return rest[:i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's values
+// into the corresponding did type and returns a human-readable diff for `simd` genesis
+// import/export invariant dumps.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], []byte(types.StoreKey)):
+			var didA, didB types.DIDDocument
+			cdc.MustUnmarshal(kvA.Value, &didA)
+			cdc.MustUnmarshal(kvB.Value, &didB)
+			return fmt.Sprintf("%v\n%v", didA, didB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// numSimDIDs sizes the fixture genesis state RandomizedGenState seeds, the same
+// fixed fixture-count convention x/credential/simulation/genesis.go's genCredentials
+// and x/zkproof/simulation/genesis.go's genCircuits use.
+const numSimDIDs = 10
+
+// simGenesisTime stands in for time.Now() in every fixture timestamp below, since
+// simulation genesis state must be deterministic given the same seed.
+var simGenesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// genDIDDocuments generates numSimDIDs fixture DIDDocuments, one per a random owner
+// drawn from accs, shaped like operations.go's SimulateMsgCreateDID fixture (a single
+// Ed25519VerificationKey2020 verification method referenced by Authentication) so the
+// sim harness's decoder (see decoder.go) has well-formed DIDDocument bytes to diff.
+func genDIDDocuments(r *rand.Rand, accs []string) []types.DIDDocument {
+	docs := make([]types.DIDDocument, 0, numSimDIDs)
+	for i := 0; i < numSimDIDs; i++ {
+		owner := accs[r.Intn(len(accs))]
+		did := randomDID(r, owner)
+		docs = append(docs, types.DIDDocument{
+			ID:      did,
+			Context: []string{"https://www.w3.org/ns/did/v1"},
+			VerificationMethod: []types.VerificationMethod{
+				{
+					ID:                 did + "#key-1",
+					Type:               "Ed25519VerificationKey2020",
+					Controller:         did,
+					PublicKeyMultibase: simtypes.RandStringOfLength(r, 48),
+				},
+			},
+			Authentication: []types.VerificationRelationship{
+				types.NewVerificationRelationshipRef(did + "#key-1"),
+			},
+			Created: simGenesisTime,
+			Updated: simGenesisTime,
+		})
+	}
+	return docs
+}
+
+// GenesisState is the fixture shape RandomizedGenState marshals. x/did has no
+// module.go/AppModule/GenesisState in this tree (see keeper/method_resolver.go's and
+// keeper/store.go's doc comments for the same standing gap), so unlike a real
+// module's simulation/genesis.go this doesn't mirror an existing module.GenesisState
+// -- it's the shape a future InitGenesis would need once x/did gets an AppModule to
+// wire this into. DIDCount is carried alongside DIDs so that future InitGenesis can
+// enforce the same DIDCount == len(DIDs) invariant x/credential's CredentialByType
+// index build and x/zkproof's circuit/proof counters rely on elsewhere in this tree.
+type GenesisState struct {
+	DIDs     []types.DIDDocument `json:"dids"`
+	DIDCount uint64              `json:"did_count"`
+}
+
+// RandomizedGenState seeds the did module's simulation genesis with fixture
+// DIDDocuments, so the sim harness's decoder (see decoder.go) and weighted
+// operations (see operations.go) have non-empty state to read against from the
+// first block rather than only ever the empty set.
+func RandomizedGenState(simState *module.SimulationState) {
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	if len(accs) == 0 {
+		return
+	}
+
+	docs := genDIDDocuments(simState.Rand, accs)
+	genesis := GenesisState{
+		DIDs:     docs,
+		DIDCount: uint64(len(docs)),
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+func (m *GenesisState) ProtoMessage()  {}
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return fmt.Sprintf("%+v", *m) }
@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/did/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateDID     = "op_weight_msg_create_did"
+	OpWeightMsgUpdateDID     = "op_weight_msg_update_did"
+	OpWeightMsgDeactivateDID = "op_weight_msg_deactivate_did"
+
+	DefaultWeightMsgCreateDID     = 100
+	DefaultWeightMsgUpdateDID     = 50
+	DefaultWeightMsgDeactivateDID = 15
+)
+
+// WeightedOperations returns all the operations from the DID module with their respective weights.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec) simtypes.WeightedOperations {
+	var (
+		weightMsgCreateDID     int
+		weightMsgUpdateDID     int
+		weightMsgDeactivateDID int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateDID, &weightMsgCreateDID, nil, func(_ *rand.Rand) {
+		weightMsgCreateDID = DefaultWeightMsgCreateDID
+	})
+	appParams.GetOrGenerate(OpWeightMsgUpdateDID, &weightMsgUpdateDID, nil, func(_ *rand.Rand) {
+		weightMsgUpdateDID = DefaultWeightMsgUpdateDID
+	})
+	appParams.GetOrGenerate(OpWeightMsgDeactivateDID, &weightMsgDeactivateDID, nil, func(_ *rand.Rand) {
+		weightMsgDeactivateDID = DefaultWeightMsgDeactivateDID
+	})
+
+	return simtypes.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateDID, SimulateMsgCreateDID()),
+		simulation.NewWeightedOperation(weightMsgUpdateDID, SimulateMsgUpdateDID()),
+		simulation.NewWeightedOperation(weightMsgDeactivateDID, SimulateMsgDeactivateDID()),
+	}
+}
+
+// SimulateMsgCreateDID generates a MsgCreateDID with a random, well-formed DID document.
+//
+// TODO: once the DID keeper exposes a collections-backed store from the simulation app,
+// actually deliver the message through baseapp and assert on the resulting state instead
+// of returning a no-op; for now this only exercises ValidateBasic and message encoding.
+func SimulateMsgCreateDID() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		did := randomDID(r, simAccount.Address.String())
+		doc := types.DIDDocument{
+			ID:      did,
+			Context: []string{"https://www.w3.org/ns/did/v1"},
+			VerificationMethod: []types.VerificationMethod{
+				{
+					ID:                 did + "#key-1",
+					Type:               "Ed25519VerificationKey2020",
+					Controller:         did,
+					PublicKeyMultibase: simtypes.RandStringOfLength(r, 48),
+				},
+			},
+			Authentication: []types.VerificationRelationship{
+				types.NewVerificationRelationshipRef(did + "#key-1"),
+			},
+		}
+
+		msg := types.NewMsgCreateDID(simAccount.Address.String(), doc)
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "DID keeper does not yet support simulated delivery"), nil, nil
+	}
+}
+
+// SimulateMsgUpdateDID generates a MsgUpdateDID referencing a freshly minted DID.
+func SimulateMsgUpdateDID() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		did := randomDID(r, simAccount.Address.String())
+
+		msg := types.NewMsgUpdateDID(simAccount.Address.String(), did, types.DIDDocument{
+			ID:      did,
+			Context: []string{"https://www.w3.org/ns/did/v1"},
+		})
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no DID exists yet to update"), nil, nil
+	}
+}
+
+// SimulateMsgDeactivateDID generates a MsgDeactivateDID referencing a freshly minted DID.
+func SimulateMsgDeactivateDID() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		did := randomDID(r, simAccount.Address.String())
+
+		msg := types.NewMsgDeactivateDID(simAccount.Address.String(), did)
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no DID exists yet to deactivate"), nil, nil
+	}
+}
+
+func randomDID(r *rand.Rand, owner string) string {
+	return "did:persona:" + owner[len(owner)-8:] + "-" + simtypes.RandStringOfLength(r, 6)
+}
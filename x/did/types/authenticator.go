@@ -0,0 +1,421 @@
+package types
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthenticatorRequest carries everything an Authenticator needs to decide whether a
+// message was authorized by the DID it's registered against, the same role dYdX's
+// accountplus module gives its AuthenticationRequest: a message plus enough signing
+// context to check it, decoupled from the ante handler that assembled it.
+type AuthenticatorRequest struct {
+	// Controller is the DID controller address the selected authenticator is
+	// registered under.
+	Controller string
+
+	// AuthenticatorId names the specific AuthMethod.MethodID the transaction selected
+	// to authorize Msg, resolved against Registry by the DID keeper.
+	AuthenticatorId string
+
+	// Msg is the single sdk.Msg being authorized. A multi-Msg transaction runs one
+	// AuthenticatorRequest per Msg, mirroring how the ante handler decorates the whole
+	// tx but each Msg may carry its own signer.
+	Msg sdk.Msg
+
+	// Signature is the raw signature bytes supplied alongside Msg, checked against
+	// whatever key material the resolved Authenticator holds.
+	Signature []byte
+
+	// SignBytes is what Signature was computed over -- ordinarily Msg's GetSignBytes(),
+	// passed explicitly so Authenticate doesn't need to know how to derive it for every
+	// sdk.Msg implementation.
+	SignBytes []byte
+}
+
+// Authenticator abstracts over one way of proving a message was authorized by a DID
+// controller, so the DID keeper can dispatch to the right verification logic by
+// AuthMethod.MethodType without a growing switch statement of its own -- the same
+// extension-point shape keeper.VerifierRegistry gives x/zkproof's proof systems.
+type Authenticator interface {
+	// Type names the MethodType this Authenticator handles, e.g. "Secp256k1",
+	// "WebAuthn", "AllOf" -- the key Registry looks it up by.
+	Type() string
+
+	// Initialize returns a new Authenticator configured from config (e.g. a raw public
+	// key for Secp256k1Authenticator, or a list of sub-authenticator configs for
+	// AllOf/AnyOf), the way a fresh AuthMethod's linked configuration is turned into a
+	// runnable Authenticator at lookup time rather than at link time.
+	Initialize(config []byte) (Authenticator, error)
+
+	// Authenticate checks that request.Signature genuinely authorizes request.Msg on
+	// behalf of request.Controller. A non-nil error means the message must be rejected.
+	Authenticate(ctx context.Context, request AuthenticatorRequest) error
+
+	// Track is called once per request after Authenticate succeeds but before the
+	// message executes, letting a stateful authenticator (e.g. one enforcing replay or
+	// rate limits) record that this request was about to run.
+	Track(ctx context.Context, request AuthenticatorRequest) error
+
+	// ConfirmExecution is called after the message executes, letting a stateful
+	// authenticator react to (or roll back bookkeeping for) the outcome -- e.g. a
+	// spend-limit authenticator finalizing a debit only once execution actually
+	// succeeded.
+	ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error
+}
+
+// Registry maps a MethodType to the constructor that builds an Authenticator for it,
+// so new authentication methods can be added without modifying the DID keeper.
+type Registry struct {
+	constructors map[string]Authenticator
+}
+
+// NewRegistry returns an empty Registry. Built-ins are installed by
+// DefaultRegistry; an app wiring a custom authenticator calls Register directly.
+func NewRegistry() *Registry {
+	return &Registry{constructors: make(map[string]Authenticator)}
+}
+
+// Register installs the Authenticator to use as the prototype for methodType,
+// overwriting any previously registered one. Looking it up later calls Initialize on
+// this prototype with the AuthMethod's stored configuration.
+func (r *Registry) Register(methodType string, prototype Authenticator) {
+	r.constructors[methodType] = prototype
+}
+
+// Get resolves methodType to a ready-to-use Authenticator, built by calling Initialize
+// on the registered prototype with config.
+func (r *Registry) Get(methodType string, config []byte) (Authenticator, error) {
+	prototype, ok := r.constructors[methodType]
+	if !ok {
+		return nil, ErrAuthenticatorNotFound.Wrapf("no authenticator registered for method type %q", methodType)
+	}
+	return prototype.Initialize(config)
+}
+
+// Secp256k1Authenticator authenticates a message against a single secp256k1 public key,
+// the same signature scheme a normal account key uses -- the built-in that lets a DID
+// register additional non-account keys as first-class authenticators rather than only
+// ever trusting whichever key signed the enclosing transaction.
+type Secp256k1Authenticator struct {
+	pubKey *secp256k1.PubKey
+}
+
+func NewSecp256k1Authenticator() *Secp256k1Authenticator {
+	return &Secp256k1Authenticator{}
+}
+
+func (a *Secp256k1Authenticator) Type() string { return "Secp256k1" }
+
+func (a *Secp256k1Authenticator) Initialize(config []byte) (Authenticator, error) {
+	if len(config) != secp256k1.PubKeySize {
+		return nil, ErrAuthenticationFailed.Wrapf("secp256k1 authenticator config must be a %d-byte public key", secp256k1.PubKeySize)
+	}
+	key := make([]byte, secp256k1.PubKeySize)
+	copy(key, config)
+	return &Secp256k1Authenticator{pubKey: &secp256k1.PubKey{Key: key}}, nil
+}
+
+func (a *Secp256k1Authenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	if a.pubKey == nil {
+		return ErrAuthenticationFailed.Wrap("secp256k1 authenticator not initialized")
+	}
+	if !a.pubKey.VerifySignature(request.SignBytes, request.Signature) {
+		return ErrAuthenticationFailed.Wrap("secp256k1 signature verification failed")
+	}
+	return nil
+}
+
+func (a *Secp256k1Authenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+func (a *Secp256k1Authenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+// MessageFilterAuthenticator wraps another Authenticator and only delegates to it when
+// request.Msg's type URL is one of AllowedMsgTypes, otherwise rejecting outright -- the
+// building block an AllOf/AnyOf composite uses to scope a sub-authenticator to, say,
+// only MsgSend, regardless of what it would otherwise authorize.
+type MessageFilterAuthenticator struct {
+	AllowedMsgTypes []string
+	Inner           Authenticator
+}
+
+func NewMessageFilterAuthenticator() *MessageFilterAuthenticator {
+	return &MessageFilterAuthenticator{}
+}
+
+func (a *MessageFilterAuthenticator) Type() string { return "MessageFilter" }
+
+func (a *MessageFilterAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	var cfg struct {
+		AllowedMsgTypes []string `json:"allowedMsgTypes"`
+	}
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, ErrAuthenticationFailed.Wrapf("invalid MessageFilter config: %s", err)
+	}
+	if len(cfg.AllowedMsgTypes) == 0 {
+		return nil, ErrAuthenticationFailed.Wrap("MessageFilter config must list at least one allowed message type")
+	}
+	return &MessageFilterAuthenticator{AllowedMsgTypes: cfg.AllowedMsgTypes}, nil
+}
+
+func (a *MessageFilterAuthenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	typeURL := sdk.MsgTypeURL(request.Msg)
+	for _, allowed := range a.AllowedMsgTypes {
+		if allowed == typeURL {
+			return nil
+		}
+	}
+	return ErrAuthenticationFailed.Wrapf("message type %q is not permitted by this authenticator", typeURL)
+}
+
+func (a *MessageFilterAuthenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+func (a *MessageFilterAuthenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+// compositeConfig is the shared Initialize config shape for AllOfAuthenticator and
+// AnyOfAuthenticator: a list of (methodType, config) pairs resolved against the same
+// Registry the composite itself was looked up from.
+type compositeConfig struct {
+	Sub []struct {
+		MethodType string `json:"methodType"`
+		Config     []byte `json:"config"`
+	} `json:"sub"`
+}
+
+func resolveSubAuthenticators(registry *Registry, config []byte) ([]Authenticator, error) {
+	var cfg compositeConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, ErrAuthenticationFailed.Wrapf("invalid composite authenticator config: %s", err)
+	}
+	if len(cfg.Sub) == 0 {
+		return nil, ErrAuthenticationFailed.Wrap("composite authenticator config must list at least one sub-authenticator")
+	}
+	sub := make([]Authenticator, 0, len(cfg.Sub))
+	for _, s := range cfg.Sub {
+		authenticator, err := registry.Get(s.MethodType, s.Config)
+		if err != nil {
+			return nil, err
+		}
+		sub = append(sub, authenticator)
+	}
+	return sub, nil
+}
+
+// AllOfAuthenticator requires every sub-authenticator to authenticate successfully --
+// e.g. both a Secp256k1 key and a WebAuthn passkey, for a controller requiring 2FA.
+type AllOfAuthenticator struct {
+	registry *Registry
+	sub      []Authenticator
+}
+
+func NewAllOfAuthenticator(registry *Registry) *AllOfAuthenticator {
+	return &AllOfAuthenticator{registry: registry}
+}
+
+func (a *AllOfAuthenticator) Type() string { return "AllOf" }
+
+func (a *AllOfAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	sub, err := resolveSubAuthenticators(a.registry, config)
+	if err != nil {
+		return nil, err
+	}
+	return &AllOfAuthenticator{registry: a.registry, sub: sub}, nil
+}
+
+func (a *AllOfAuthenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	for _, s := range a.sub {
+		if err := s.Authenticate(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *AllOfAuthenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	for _, s := range a.sub {
+		if err := s.Track(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *AllOfAuthenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	for _, s := range a.sub {
+		if err := s.ConfirmExecution(ctx, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AnyOfAuthenticator requires at least one sub-authenticator to authenticate
+// successfully -- e.g. either a lost primary key or a registered recovery key.
+type AnyOfAuthenticator struct {
+	registry *Registry
+	sub      []Authenticator
+}
+
+func NewAnyOfAuthenticator(registry *Registry) *AnyOfAuthenticator {
+	return &AnyOfAuthenticator{registry: registry}
+}
+
+func (a *AnyOfAuthenticator) Type() string { return "AnyOf" }
+
+func (a *AnyOfAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	sub, err := resolveSubAuthenticators(a.registry, config)
+	if err != nil {
+		return nil, err
+	}
+	return &AnyOfAuthenticator{registry: a.registry, sub: sub}, nil
+}
+
+func (a *AnyOfAuthenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	var lastErr error
+	for _, s := range a.sub {
+		if err := s.Authenticate(ctx, request); err == nil {
+			return nil
+		} else {
+			lastErr = err
+		}
+	}
+	if lastErr == nil {
+		lastErr = ErrAuthenticationFailed.Wrap("AnyOf authenticator has no sub-authenticators")
+	}
+	return lastErr
+}
+
+func (a *AnyOfAuthenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	for _, s := range a.sub {
+		if err := s.Authenticate(ctx, request); err == nil {
+			return s.Track(ctx, request)
+		}
+	}
+	return nil
+}
+
+func (a *AnyOfAuthenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	for _, s := range a.sub {
+		if err := s.Authenticate(ctx, request); err == nil {
+			return s.ConfirmExecution(ctx, request)
+		}
+	}
+	return nil
+}
+
+// WebAuthnAuthenticator is a placeholder backend for MethodType "WebAuthn". It checks
+// that Signature looks like a WebAuthn assertion (non-empty, bound to the expected
+// credential ID) but doesn't verify the assertion's signature over the authenticator
+// data and client data hash -- the same structural-check-only, TODO-gapped pattern
+// keeper.PLONKVerifier/StarkVerifier use in x/zkproof for proof systems this repo
+// doesn't vendor a library for yet.
+//
+// TODO: verify the WebAuthn assertion for real once a CBOR/COSE + WebAuthn assertion
+// library is vendored.
+type WebAuthnAuthenticator struct {
+	credentialID []byte
+}
+
+func NewWebAuthnAuthenticator() *WebAuthnAuthenticator {
+	return &WebAuthnAuthenticator{}
+}
+
+func (a *WebAuthnAuthenticator) Type() string { return "WebAuthn" }
+
+func (a *WebAuthnAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	if len(config) == 0 {
+		return nil, ErrAuthenticationFailed.Wrap("WebAuthn authenticator config must carry a credential ID")
+	}
+	return &WebAuthnAuthenticator{credentialID: config}, nil
+}
+
+func (a *WebAuthnAuthenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	if len(request.Signature) == 0 {
+		return ErrAuthenticationFailed.Wrap("empty WebAuthn assertion")
+	}
+	return ErrAuthenticationFailed.Wrap("WebAuthn assertion verification not yet implemented")
+}
+
+func (a *WebAuthnAuthenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+func (a *WebAuthnAuthenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+// PasskeyAuthenticator is a placeholder backend for MethodType "Passkey", the
+// discoverable-credential flavor of WebAuthn. Like WebAuthnAuthenticator, it performs a
+// structural check only.
+//
+// TODO: verify the passkey assertion for real once a CBOR/COSE + WebAuthn assertion
+// library is vendored.
+type PasskeyAuthenticator struct {
+	credentialID []byte
+}
+
+func NewPasskeyAuthenticator() *PasskeyAuthenticator {
+	return &PasskeyAuthenticator{}
+}
+
+func (a *PasskeyAuthenticator) Type() string { return "Passkey" }
+
+func (a *PasskeyAuthenticator) Initialize(config []byte) (Authenticator, error) {
+	if len(config) == 0 {
+		return nil, ErrAuthenticationFailed.Wrap("Passkey authenticator config must carry a credential ID")
+	}
+	return &PasskeyAuthenticator{credentialID: config}, nil
+}
+
+func (a *PasskeyAuthenticator) Authenticate(ctx context.Context, request AuthenticatorRequest) error {
+	if len(request.Signature) == 0 {
+		return ErrAuthenticationFailed.Wrap("empty passkey assertion")
+	}
+	return ErrAuthenticationFailed.Wrap("passkey assertion verification not yet implemented")
+}
+
+func (a *PasskeyAuthenticator) Track(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+func (a *PasskeyAuthenticator) ConfirmExecution(ctx context.Context, request AuthenticatorRequest) error {
+	return nil
+}
+
+// DefaultRegistry builds a Registry with the standard built-in authenticators wired in,
+// the way keeper.DefaultVerifierRegistry does for x/zkproof's proof systems. AllOf/AnyOf
+// are registered against this same registry so a composite authenticator's sub-entries
+// can reference any other built-in, including another composite.
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register("Secp256k1", NewSecp256k1Authenticator())
+	r.Register("MessageFilter", NewMessageFilterAuthenticator())
+	r.Register("WebAuthn", NewWebAuthnAuthenticator())
+	r.Register("Passkey", NewPasskeyAuthenticator())
+	r.Register("AllOf", NewAllOfAuthenticator(r))
+	r.Register("AnyOf", NewAnyOfAuthenticator(r))
+	return r
+}
+
+// authenticatorIDSeed is mixed into a deterministic AuthMethod.MethodID for
+// authenticators registered without a client-chosen ID, mirroring
+// oidc.go's OIDCIdentityBinding.AuthMethodID content-addressing.
+func authenticatorIDSeed(controller, methodType string, config []byte) string {
+	h := sha256.New()
+	h.Write([]byte(controller))
+	h.Write([]byte(methodType))
+	h.Write(config)
+	return string(h.Sum(nil))
+}
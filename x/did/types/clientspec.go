@@ -0,0 +1,36 @@
+package types
+
+// ClientSpec names the signing scheme used to produce the signature accompanying
+// MsgCreateDID/MsgUpdateDID/MsgDeactivateDID's embedded DIDDocument, borrowed from
+// Hypersign's x/ssi module of the same name. An empty ClientSpec means the tx signer's
+// own Cosmos signature over the message is trusted (today's behavior, still the
+// default); a non-empty one lets a DID be controlled by a key that never signs a
+// Cosmos transaction directly -- an offline Cosmos key (cosmos-ADR036-v1) or an
+// Ethereum key (eth-personalSign, eth-EIP712) recorded in the DID document's own
+// verificationMethod.
+const (
+	ClientSpecNative          = ""
+	ClientSpecCosmosADR036V1  = "cosmos-ADR036-v1"
+	ClientSpecEthPersonalSign = "eth-personalSign"
+	ClientSpecEthEIP712       = "eth-EIP712"
+)
+
+var supportedClientSpecs = map[string]bool{
+	ClientSpecNative:          true,
+	ClientSpecCosmosADR036V1:  true,
+	ClientSpecEthPersonalSign: true,
+	ClientSpecEthEIP712:       true,
+}
+
+// ValidateClientSpec rejects any ClientSpec this module doesn't know how to derive
+// sign-bytes for, listing the supported specs in the error so a caller can self-correct
+// without consulting this file.
+func ValidateClientSpec(clientSpec string) error {
+	if !supportedClientSpecs[clientSpec] {
+		return ErrInvalidClientSpecType.Wrapf(
+			"unsupported client spec %q, must be one of %q, %q, %q, %q",
+			clientSpec, ClientSpecNative, ClientSpecCosmosADR036V1, ClientSpecEthPersonalSign, ClientSpecEthEIP712,
+		)
+	}
+	return nil
+}
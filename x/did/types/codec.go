@@ -14,6 +14,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateDID{}, "personachain/MsgUpdateDID", nil)
 	cdc.RegisterConcrete(&MsgDeactivateDID{}, "personachain/MsgDeactivateDID", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "personachain/did/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgLinkOIDCAuthMethod{}, "personachain/did/MsgLinkOIDCAuthMethod", nil)
+	cdc.RegisterConcrete(&MsgUpdateOIDCKeys{}, "personachain/did/MsgUpdateOIDCKeys", nil)
+	cdc.RegisterConcrete(&MsgAddVerificationRelationship{}, "personachain/did/MsgAddVerificationRelationship", nil)
+	cdc.RegisterConcrete(&MsgRemoveVerificationRelationship{}, "personachain/did/MsgRemoveVerificationRelationship", nil)
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, "personachain/did/MsgRegisterNamespace", nil)
+	cdc.RegisterConcrete(&MsgQueryRemoteDID{}, "personachain/did/MsgQueryRemoteDID", nil)
+	cdc.RegisterConcrete(&MsgRegisterMethod{}, "personachain/did/MsgRegisterMethod", nil)
+	cdc.RegisterConcrete(&MsgTransferDIDController{}, "personachain/did/MsgTransferDIDController", nil)
 }
 
 // RegisterInterfaces registers the x/did interfaces types with the interface registry
@@ -23,6 +31,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateDID{},
 		&MsgDeactivateDID{},
 		&MsgUpdateParams{},
+		&MsgLinkOIDCAuthMethod{},
+		&MsgUpdateOIDCKeys{},
+		&MsgAddVerificationRelationship{},
+		&MsgRemoveVerificationRelationship{},
+		&MsgRegisterNamespace{},
+		&MsgQueryRemoteDID{},
+		&MsgRegisterMethod{},
+		&MsgTransferDIDController{},
 	)
 
 	// Message service registration handled by generated proto code
@@ -55,6 +71,12 @@ type MsgServer interface {
 	UpdateDID(ctx context.Context, req *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
 	DeactivateDID(ctx context.Context, req *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
 	UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	LinkOIDCAuthMethod(ctx context.Context, req *MsgLinkOIDCAuthMethod) (*MsgLinkOIDCAuthMethodResponse, error)
+	UpdateOIDCKeys(ctx context.Context, req *MsgUpdateOIDCKeys) (*MsgUpdateOIDCKeysResponse, error)
+	AddVerificationRelationship(ctx context.Context, req *MsgAddVerificationRelationship) (*MsgAddVerificationRelationshipResponse, error)
+	RemoveVerificationRelationship(ctx context.Context, req *MsgRemoveVerificationRelationship) (*MsgRemoveVerificationRelationshipResponse, error)
+	RegisterNamespace(ctx context.Context, req *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error)
+	QueryRemoteDID(ctx context.Context, req *MsgQueryRemoteDID) (*MsgQueryRemoteDIDResponse, error)
 }
 
 // RegisterMsgServer registers the message server
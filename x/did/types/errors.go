@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DID module error codes
+var (
+	ErrInvalidController = errorsmod.Register(ModuleName, 2, "invalid controller")
+	ErrInvalidDID         = errorsmod.Register(ModuleName, 3, "invalid DID")
+	ErrDIDAlreadyExists   = errorsmod.Register(ModuleName, 4, "DID already exists")
+	ErrDIDNotFound        = errorsmod.Register(ModuleName, 5, "DID not found")
+	ErrUnauthorized       = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidAuthority   = errorsmod.Register(ModuleName, 7, "invalid authority")
+	ErrDIDDeactivated     = errorsmod.Register(ModuleName, 8, "DID is deactivated")
+	ErrInvalidDocument    = errorsmod.Register(ModuleName, 9, "invalid DID document")
+	ErrInvalidSignature   = errorsmod.Register(ModuleName, 10, "invalid signature")
+	ErrDocumentTooLarge   = errorsmod.Register(ModuleName, 11, "DID document too large")
+	ErrTooManyMethods     = errorsmod.Register(ModuleName, 12, "too many verification methods")
+	ErrTooManyServices    = errorsmod.Register(ModuleName, 13, "too many services")
+	ErrInsufficientFunds  = errorsmod.Register(ModuleName, 14, "insufficient funds for DID operation")
+	ErrInvalidNamespace   = errorsmod.Register(ModuleName, 15, "invalid namespace")
+	ErrUnknownNamespace   = errorsmod.Register(ModuleName, 16, "unknown namespace")
+	ErrAuthenticatorNotFound = errorsmod.Register(ModuleName, 17, "authenticator not found")
+	ErrAuthenticationFailed  = errorsmod.Register(ModuleName, 18, "authenticator failed to validate message")
+	ErrInvalidClientSpecType = errorsmod.Register(ModuleName, 19, "invalid client spec type")
+	ErrInvalidIBCVersion     = errorsmod.Register(ModuleName, 20, "invalid did-resolver IBC version")
+	ErrRemoteDIDNotCached    = errorsmod.Register(ModuleName, 21, "remote DID is not cached")
+	ErrInvalidOIDCToken      = errorsmod.Register(ModuleName, 22, "invalid OIDC id token")
+	ErrOIDCIssuerNotAllowed  = errorsmod.Register(ModuleName, 23, "OIDC issuer is not whitelisted")
+	ErrOIDCKeysNotCached     = errorsmod.Register(ModuleName, 24, "no cached JWKS for OIDC issuer")
+	ErrUnknownDIDMethod      = errorsmod.Register(ModuleName, 25, "unknown or disabled DID method")
+	ErrMethodAlreadyRegistered = errorsmod.Register(ModuleName, 26, "DID method already registered")
+)
@@ -0,0 +1,28 @@
+package types
+
+// Event types for the DID module, covering the DID document lifecycle and the
+// OIDC auth-method linkage it carries.
+const (
+	EventTypeDIDCreated         = "did_created"
+	EventTypeDIDUpdated         = "did_updated"
+	EventTypeDIDDeactivated     = "did_deactivated"
+	EventTypeAuthMethodLinked   = "authmethod_linked"
+	EventTypeAuthMethodUnlinked = "authmethod_unlinked"
+
+	// EventTypeDIDPruned and EventTypeAuthMethodDeactivated are emitted by the
+	// EndBlocker's pruning sweep (x/did/keeper/endblock.go), not by a Msg handler.
+	EventTypeDIDPruned             = "did_pruned"
+	EventTypeAuthMethodDeactivated = "auth_method_deactivated"
+
+	// EventTypeOIDCKeysUpdated is emitted by MsgUpdateOIDCKeys.
+	EventTypeOIDCKeysUpdated = "oidc_keys_updated"
+
+	// Attribute keys
+	AttributeKeyController = "controller"
+	AttributeKeyDID        = "did"
+	AttributeKeyVersionID  = "version_id"
+	AttributeKeyOIDCIssuer = "oidc_issuer"
+	AttributeKeySubject    = "subject"
+	AttributeKeyMethodID   = "method_id"
+	AttributeKeyKeyCount   = "key_count"
+)
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EventDIDCreated is a typed, protobuf-style event emitted whenever a DID is created,
+// so indexers can decode it with sdk.ParseTypedEvent instead of scraping loose string
+// attributes.
+type EventDIDCreated struct {
+	Controller string `json:"controller"`
+	Did        string `json:"did"`
+	VersionId  string `json:"version_id"`
+}
+
+func (m *EventDIDCreated) ProtoMessage()  {}
+func (m *EventDIDCreated) Reset()         { *m = EventDIDCreated{} }
+func (m *EventDIDCreated) String() string { return proto.CompactTextString(m) }
+
+// EmitDIDCreatedEvent emits the did_created event for doc. Intended to be called
+// exactly once by a future DID keeper's MsgCreateDID handler, so every state
+// transition produces one primary event.
+func EmitDIDCreatedEvent(ctx sdk.Context, controller string, doc *DIDDocument) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeDIDCreated,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, doc.ID),
+			sdk.NewAttribute(AttributeKeyVersionID, doc.VersionID),
+		),
+	})
+}
+
+// EmitDIDUpdatedEvent emits the did_updated event for doc's MsgUpdateDID handler.
+func EmitDIDUpdatedEvent(ctx sdk.Context, controller string, doc *DIDDocument) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeDIDUpdated,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, doc.ID),
+			sdk.NewAttribute(AttributeKeyVersionID, doc.VersionID),
+		),
+	})
+}
+
+// EmitDIDDeactivatedEvent emits the did_deactivated event for MsgDeactivateDID.
+func EmitDIDDeactivatedEvent(ctx sdk.Context, controller, did string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeDIDDeactivated,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, did),
+		),
+	})
+}
+
+// EmitAuthMethodLinkedEvent emits the authmethod_linked event for MsgLinkOIDCAuthMethod.
+func EmitAuthMethodLinkedEvent(ctx sdk.Context, controller, did string, binding OIDCIdentityBinding) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeAuthMethodLinked,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, did),
+			sdk.NewAttribute(AttributeKeyOIDCIssuer, binding.Issuer),
+			sdk.NewAttribute(AttributeKeySubject, binding.Subject),
+		),
+	})
+}
+
+// EmitDIDPrunedEvent emits the did_pruned event for a Deactivated DID the EndBlocker
+// hard-deleted once its grace period elapsed.
+func EmitDIDPrunedEvent(ctx sdk.Context, controller, did string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeDIDPruned,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, did),
+		),
+	})
+}
+
+// EmitAuthMethodDeactivatedEvent emits the auth_method_deactivated event for an
+// AuthMethod the EndBlocker flipped to IsActive=false after
+// Params.AuthMethodInactivityPeriod of disuse.
+func EmitAuthMethodDeactivatedEvent(ctx sdk.Context, controller, methodID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeAuthMethodDeactivated,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyMethodID, methodID),
+		),
+	})
+}
+
+// EmitOIDCKeysUpdatedEvent emits the oidc_keys_updated event for MsgUpdateOIDCKeys.
+func EmitOIDCKeysUpdatedEvent(ctx sdk.Context, issuer string, keyCount int) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeOIDCKeysUpdated,
+			sdk.NewAttribute(AttributeKeyOIDCIssuer, issuer),
+			sdk.NewAttribute(AttributeKeyKeyCount, fmt.Sprintf("%d", keyCount)),
+		),
+	})
+}
+
+// EmitAuthMethodUnlinkedEvent emits the authmethod_unlinked event for the future
+// unlink counterpart of MsgLinkOIDCAuthMethod.
+func EmitAuthMethodUnlinkedEvent(ctx sdk.Context, controller, did, oidcIssuer, subject string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeAuthMethodUnlinked,
+			sdk.NewAttribute(AttributeKeyController, controller),
+			sdk.NewAttribute(AttributeKeyDID, did),
+			sdk.NewAttribute(AttributeKeyOIDCIssuer, oidcIssuer),
+			sdk.NewAttribute(AttributeKeySubject, subject),
+		),
+	})
+}
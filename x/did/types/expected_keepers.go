@@ -2,7 +2,14 @@ package types
 
 import (
 	"context"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	oracletypes "github.com/PersonaPass-ID/personachain/x/oracle/types"
+	registrytypes "github.com/PersonaPass-ID/personachain/x/registry/types"
 )
 
 // BankKeeper defines the expected bank keeper methods
@@ -18,4 +25,49 @@ type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// ChannelKeeper defines the expected IBC channel keeper methods the did-resolver
+// IBCModule (x/did/keeper/ibc_module.go) needs to send a MsgQueryRemoteDID's packet and
+// to resolve which chain a received packet's channel connects to, the same subset of
+// ibc-go's channel keeper the transfer and ICA modules depend on.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(
+		ctx sdk.Context,
+		channelCap *capabilitytypes.Capability,
+		sourcePort, sourceChannel string,
+		timeoutHeight ibcexported.Height,
+		timeoutTimestamp uint64,
+		data []byte,
+	) (uint64, error)
+	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+// PortKeeper defines the expected IBC port keeper methods used to bind the
+// did-resolver port during module init, the same role it plays for transfer and ICA.
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// RegistryKeeper defines the subset of x/registry's keeper that
+// keeper.KeylessAuthVerifier uses to credit a ReputationEvent to the identity a newly
+// verified OIDC/oauth_* AuthMethod is linked to. Optional: a Keeper with no
+// registryKeeper configured (e.g. in tests, or a deployment that hasn't wired x/registry
+// in) simply skips the reputation credit rather than failing the link. x/registry has
+// no reverse dependency on x/did, so importing its types here carries no import-cycle
+// risk today.
+type RegistryKeeper interface {
+	UpdateReputation(ctx context.Context, id string, event registrytypes.ReputationEvent) error
+}
+
+// OracleKeeper defines the expected interface onto x/oracle's attestation-feed
+// subsystem (x/oracle/keeper/attestation.go). A resolver can consult a feed's latest
+// attested value -- e.g. an external DID document hash reported by whitelisted
+// operators -- the same way did:web resolution is documented to rely on an oracle feed
+// cache in the method-resolver work (see chunk13-2).
+type OracleKeeper interface {
+	GetLatestAttestation(ctx sdk.Context, feedID string) (*oracletypes.AttestationRound, error)
 }
\ No newline at end of file
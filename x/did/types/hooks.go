@@ -0,0 +1,30 @@
+package types
+
+import "context"
+
+// DIDHooks lets other modules react to DID lifecycle events. x/revocation implements
+// this to cascade-revoke credentials issued by a deactivated DID, and x/zkproof
+// implements it to invalidate circuits/proofs owned by a deactivated DID's controller.
+type DIDHooks interface {
+	// AfterDIDDeactivated is called after a DID Document has been marked deactivated,
+	// before the deactivating MsgDeactivateDID returns.
+	AfterDIDDeactivated(ctx context.Context, did string) error
+}
+
+// MultiDIDHooks combines multiple DIDHooks implementations into one, invoked in order.
+// If any hook returns an error, execution stops and the error is returned to the
+// caller, consistent with how the SDK's staking/gov MultiHooks behave.
+type MultiDIDHooks []DIDHooks
+
+func NewMultiDIDHooks(hooks ...DIDHooks) MultiDIDHooks {
+	return hooks
+}
+
+func (h MultiDIDHooks) AfterDIDDeactivated(ctx context.Context, did string) error {
+	for _, hook := range h {
+		if err := hook.AfterDIDDeactivated(ctx, did); err != nil {
+			return err
+		}
+	}
+	return nil
+}
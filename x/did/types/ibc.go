@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// PortID is the default port id the did-resolver IBC application binds to.
+	PortID = "did-resolver"
+
+	// Version is the version string negotiated during the did-resolver channel
+	// handshake. OnChanOpenInit/Try reject any counterparty proposing a different one.
+	Version = "did-resolver-1"
+)
+
+// DIDResolutionPacketData is the JSON packet payload a controller chain sends asking
+// the host chain to resolve Did, optionally as of AtHeight rather than the latest
+// committed state.
+type DIDResolutionPacketData struct {
+	Did      string `json:"did"`
+	AtHeight int64  `json:"at_height,omitempty"`
+}
+
+func (p *DIDResolutionPacketData) ProtoMessage()  {}
+func (p *DIDResolutionPacketData) Reset()         { *p = DIDResolutionPacketData{} }
+func (p *DIDResolutionPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p, the bytes a sender places in
+// channeltypes.Packet.Data.
+func (p DIDResolutionPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p DIDResolutionPacketData) ValidateBasic() error {
+	if p.Did == "" {
+		return ErrInvalidDID.Wrap("did must be set")
+	}
+	if _, err := ParseNamespace(p.Did); err != nil {
+		return err
+	}
+	if p.AtHeight < 0 {
+		return ErrInvalidDID.Wrap("at_height cannot be negative")
+	}
+	return nil
+}
+
+// DIDResolutionAcknowledgement is the successful acknowledgement a host chain returns:
+// the resolved DIDDocument as of Height, plus ProofOps attesting it was read from the
+// host chain's store at that height so the requesting chain's light client can verify
+// it rather than trusting the relayer. A failed resolution is never carried in this
+// type -- OnRecvPacket instead returns channeltypes.NewErrorAcknowledgement wrapping
+// ErrDIDNotFound/ErrDIDDeactivated, following ibc-go's own acknowledgement convention.
+type DIDResolutionAcknowledgement struct {
+	DIDDocument []byte `json:"did_document"`
+	ProofOps    []byte `json:"proof_ops,omitempty"`
+	Height      int64  `json:"height"`
+}
+
+func (a *DIDResolutionAcknowledgement) ProtoMessage()  {}
+func (a *DIDResolutionAcknowledgement) Reset()         { *a = DIDResolutionAcknowledgement{} }
+func (a *DIDResolutionAcknowledgement) String() string { return proto.CompactTextString(a) }
+
+// GetBytes returns the canonical JSON encoding of a, the payload wrapped in a
+// channeltypes.Acknowledgement's Result field.
+func (a DIDResolutionAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// ControllerHandoffPacketData is the JSON packet payload MsgTransferDIDController
+// sends: a request that the destination chain retarget Did's controller index to
+// NewController once it has verified Proof against Did's current, pre-handoff
+// DIDDocument.
+type ControllerHandoffPacketData struct {
+	Did           string `json:"did"`
+	OldController string `json:"old_controller"`
+	NewController string `json:"new_controller"`
+
+	// Proof is the signed handoff payload: the sending chain's Controller signature
+	// over (Did, OldController, NewController), verified against OldController's
+	// current verification method on the destination chain, the same cross-chain
+	// trust-the-signature-not-the-relayer approach DIDResolutionAcknowledgement's
+	// ProofOps field documents for resolution.
+	Proof []byte `json:"proof,omitempty"`
+}
+
+func (p *ControllerHandoffPacketData) ProtoMessage()  {}
+func (p *ControllerHandoffPacketData) Reset()         { *p = ControllerHandoffPacketData{} }
+func (p *ControllerHandoffPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p, the bytes a sender places in
+// channeltypes.Packet.Data.
+func (p ControllerHandoffPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p ControllerHandoffPacketData) ValidateBasic() error {
+	if p.Did == "" {
+		return ErrInvalidDID.Wrap("did must be set")
+	}
+	if _, err := ParseNamespace(p.Did); err != nil {
+		return err
+	}
+	if p.OldController == "" || p.NewController == "" {
+		return ErrInvalidController.Wrap("old_controller and new_controller must both be set")
+	}
+	return nil
+}
+
+// ControllerHandoffAcknowledgement is the acknowledgement a destination chain returns
+// once it has applied (or rejected) the handoff.
+type ControllerHandoffAcknowledgement struct {
+	Applied bool  `json:"applied"`
+	Height  int64 `json:"height"`
+}
+
+func (a *ControllerHandoffAcknowledgement) ProtoMessage()  {}
+func (a *ControllerHandoffAcknowledgement) Reset()         { *a = ControllerHandoffAcknowledgement{} }
+func (a *ControllerHandoffAcknowledgement) String() string { return proto.CompactTextString(a) }
+
+// GetBytes returns the canonical JSON encoding of a, the payload wrapped in a
+// channeltypes.Acknowledgement's Result field.
+func (a ControllerHandoffAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+// Collections prefixes for the x/did keeper's KVStoreService-backed schema --
+// DIDs/DIDsByController/AuthMethods/etc. below -- the replacement for the
+// storeKey.KVStore(ctx)+MustMarshal layout GetAllDIDs/GetDIDsByController/
+// GetAuthMethodsByDID returned unbounded slices from.
+var (
+	ParamsKey = collections.NewPrefix(0)
+
+	DIDPrefix             = collections.NewPrefix(1)
+	DIDCountKey           = collections.NewPrefix(2)
+	DIDByControllerPrefix = collections.NewPrefix(3)
+	DIDByStatusPrefix     = collections.NewPrefix(4)
+
+	AuthMethodPrefix       = collections.NewPrefix(10)
+	AuthMethodByTypePrefix = collections.NewPrefix(11)
+
+	// OIDCIssuerKeysPrefix stores each whitelisted issuer's cached JWKS document (see
+	// OIDCIssuerJWKS), keyed by OIDCIssuerConfig.Name, populated by MsgUpdateOIDCKeys
+	// and consumed by keeper.KeylessAuthVerifier.
+	OIDCIssuerKeysPrefix = collections.NewPrefix(12)
+
+	// DIDVersionPrefix stores every superseded DIDDocument revision, keyed by (did,
+	// versionID) -- the content-addressed id ComputeDIDVersionID derives -- so
+	// ResolveDID can serve a historical version without keeping it in the live DIDs map.
+	DIDVersionPrefix = collections.NewPrefix(13)
+
+	// DIDVersionIndexPrefix stores one DIDVersionHistory per did: the ordered list of
+	// (versionTime, versionID) entries UpdateDID appends to, which Versions/ResolveDID
+	// walk to find a version by time or to build the metadata NextUpdate reports.
+	DIDVersionIndexPrefix = collections.NewPrefix(14)
+
+	// RemoteDIDPrefix stores each IBC-resolved (chainID, did) pair's cached DID
+	// Document, keyed by RemoteDIDKey -- see keeper/remote_did.go's SetRemoteDID/
+	// GetRemoteDID.
+	RemoteDIDPrefix = collections.NewPrefix(15)
+
+	// DIDWebCachePrefix stores the oracle-fed did:web document cache
+	// keeper/method_resolver.go's WebMethodResolver reads, keyed by domain.
+	DIDWebCachePrefix = collections.NewPrefix(16)
+
+	// EnabledMethodPrefix is the gov-gated method allowlist MsgRegisterMethod writes to
+	// and IsMethodRegistered reads, keyed by method name.
+	EnabledMethodPrefix = collections.NewPrefix(17)
+
+	// DIDControllerPrefix is DIDByControllerPrefix's reverse index: did -> controller,
+	// the lookup GetDIDController/TransferDIDController use instead of walking
+	// DIDsByController to find a DID's current controller.
+	DIDControllerPrefix = collections.NewPrefix(18)
+)
+
+// DIDStatusActive and DIDStatusDeactivated are the values DIDByStatusPrefix indexes
+// DIDDocument.Deactivated under -- x/did has no richer status enum than that one bool,
+// so the index is keyed on its string form rather than adding one.
+const (
+	DIDStatusActive      = "active"
+	DIDStatusDeactivated = "deactivated"
+)
+
+// DIDStatus returns the DIDByStatusPrefix index value for doc.
+func DIDStatus(doc DIDDocument) string {
+	if doc.Deactivated {
+		return DIDStatusDeactivated
+	}
+	return DIDStatusActive
+}
+
+// LegacyDIDPrefix is the raw-KVStore prefix a pre-collections x/did keeper would have
+// stored DIDDocuments under (mirroring workspaces/persona-frontend's DIDKey helper).
+// x/did/keeper never got past a documented gap to a raw keeper in this tree -- see
+// keeper/store.go -- but MigrateStore reads this layout regardless, the same way a
+// real in-place migration has to handle a chain that wrote state before upgrading.
+const LegacyDIDPrefix = "DID/value/"
+
+// LegacyDIDKey mirrors the pre-collections DIDKey(id) helper MigrateStore reads from.
+func LegacyDIDKey(id string) []byte {
+	return []byte(LegacyDIDPrefix + id)
+}
+
+// LegacyDIDByControllerPrefix is the raw-KVStore prefix a pre-collections keeper would
+// have stored the controller index under.
+const LegacyDIDByControllerPrefix = "DID/controller/"
+
+// LegacyDIDByControllerKey mirrors the pre-collections DIDByControllerKey(controller,
+// id) helper MigrateStore reads from.
+func LegacyDIDByControllerKey(controller, id string) []byte {
+	return []byte(LegacyDIDByControllerPrefix + controller + "/" + id)
+}
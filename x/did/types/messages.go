@@ -0,0 +1,387 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	TypeMsgCreateDID     = "create_did"
+	TypeMsgUpdateDID     = "update_did"
+	TypeMsgDeactivateDID = "deactivate_did"
+	TypeMsgUpdateParams  = "update_params"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDID{}
+	_ sdk.Msg = &MsgUpdateDID{}
+	_ sdk.Msg = &MsgDeactivateDID{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgQueryRemoteDID{}
+	_ sdk.Msg = &MsgTransferDIDController{}
+)
+
+// MsgCreateDID defines the message for creating a new DID
+type MsgCreateDID struct {
+	Controller  string      `json:"controller"`
+	DIDDocument DIDDocument `json:"didDocument"`
+
+	// ClientSpec names the signing scheme Signature was produced with (see
+	// clientspec.go). Empty means Signature is unset and the Cosmos tx signer alone is
+	// trusted, exactly as before this field existed.
+	ClientSpec string `json:"clientSpec,omitempty"`
+
+	// Signature, when ClientSpec is set, is checked by the keeper's
+	// getClientSpecDocBytes dispatcher against the public key recorded in
+	// DIDDocument.VerificationMethod, so a DID can be controlled by a key that never
+	// signs a Cosmos transaction directly.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// NewMsgCreateDID creates a new MsgCreateDID instance
+func NewMsgCreateDID(controller string, didDocument DIDDocument) *MsgCreateDID {
+	return &MsgCreateDID{
+		Controller:  controller,
+		DIDDocument: didDocument,
+	}
+}
+
+func (msg MsgCreateDID) Route() string { return RouterKey }
+func (msg MsgCreateDID) Type() string  { return TypeMsgCreateDID }
+
+func (msg MsgCreateDID) ValidateBasic() error {
+	if msg.Controller == "" {
+		return ErrInvalidController
+	}
+	if msg.DIDDocument.ID == "" {
+		return ErrInvalidDID
+	}
+	if err := ValidateClientSpec(msg.ClientSpec); err != nil {
+		return err
+	}
+	// Only the namespace segment's syntax is checked here; whether the namespace is
+	// actually registered and whether Controller may create DIDs within it is enforced
+	// by a future keeper via DIDDocument.ValidateAgainstNamespace.
+	if _, err := ParseNamespace(msg.DIDDocument.ID); err != nil {
+		return err
+	}
+	return msg.DIDDocument.ValidateForActivation()
+}
+
+func (msg MsgCreateDID) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCreateDID) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateDID) ProtoMessage()  {}
+func (m *MsgCreateDID) Reset()         { *m = MsgCreateDID{} }
+func (m *MsgCreateDID) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateDIDResponse defines the response for MsgCreateDID
+type MsgCreateDIDResponse struct {
+	ID string `json:"id"`
+}
+
+func (m *MsgCreateDIDResponse) ProtoMessage()  {}
+func (m *MsgCreateDIDResponse) Reset()         { *m = MsgCreateDIDResponse{} }
+func (m *MsgCreateDIDResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateDID defines the message for updating a DID
+type MsgUpdateDID struct {
+	Controller  string      `json:"controller"`
+	ID          string      `json:"id"`
+	DIDDocument DIDDocument `json:"didDocument"`
+
+	// ClientSpec and Signature mirror MsgCreateDID's fields of the same name: they let
+	// an update be authorized by the key recorded in the (old, pre-update)
+	// DIDDocument.VerificationMethod rather than only by the Cosmos tx signer.
+	ClientSpec string `json:"clientSpec,omitempty"`
+	Signature  []byte `json:"signature,omitempty"`
+}
+
+func NewMsgUpdateDID(controller, id string, didDocument DIDDocument) *MsgUpdateDID {
+	return &MsgUpdateDID{
+		Controller:  controller,
+		ID:          id,
+		DIDDocument: didDocument,
+	}
+}
+
+func (msg MsgUpdateDID) Route() string { return RouterKey }
+func (msg MsgUpdateDID) Type() string  { return TypeMsgUpdateDID }
+
+func (msg MsgUpdateDID) ValidateBasic() error {
+	if msg.Controller == "" {
+		return ErrInvalidController
+	}
+	if msg.ID == "" {
+		return ErrInvalidDID
+	}
+	return ValidateClientSpec(msg.ClientSpec)
+}
+
+func (msg MsgUpdateDID) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUpdateDID) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUpdateDID) ProtoMessage()  {}
+func (m *MsgUpdateDID) Reset()         { *m = MsgUpdateDID{} }
+func (m *MsgUpdateDID) String() string { return proto.CompactTextString(m) }
+
+type MsgUpdateDIDResponse struct{}
+
+func (m *MsgUpdateDIDResponse) ProtoMessage()  {}
+func (m *MsgUpdateDIDResponse) Reset()         { *m = MsgUpdateDIDResponse{} }
+func (m *MsgUpdateDIDResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgDeactivateDID defines the message for deactivating a DID
+type MsgDeactivateDID struct {
+	Controller string `json:"controller"`
+	ID         string `json:"id"`
+
+	// ClientSpec and Signature mirror MsgCreateDID's fields of the same name: they let
+	// a deactivation be authorized by the key recorded in the DID document's
+	// verificationMethod rather than only by the Cosmos tx signer.
+	ClientSpec string `json:"clientSpec,omitempty"`
+	Signature  []byte `json:"signature,omitempty"`
+}
+
+func NewMsgDeactivateDID(controller, id string) *MsgDeactivateDID {
+	return &MsgDeactivateDID{
+		Controller: controller,
+		ID:         id,
+	}
+}
+
+func (msg MsgDeactivateDID) Route() string { return RouterKey }
+func (msg MsgDeactivateDID) Type() string  { return TypeMsgDeactivateDID }
+
+func (msg MsgDeactivateDID) ValidateBasic() error {
+	if msg.Controller == "" {
+		return ErrInvalidController
+	}
+	if msg.ID == "" {
+		return ErrInvalidDID
+	}
+	return ValidateClientSpec(msg.ClientSpec)
+}
+
+func (msg MsgDeactivateDID) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDeactivateDID) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDeactivateDID) ProtoMessage()  {}
+func (m *MsgDeactivateDID) Reset()         { *m = MsgDeactivateDID{} }
+func (m *MsgDeactivateDID) String() string { return proto.CompactTextString(m) }
+
+type MsgDeactivateDIDResponse struct{}
+
+func (m *MsgDeactivateDIDResponse) ProtoMessage()  {}
+func (m *MsgDeactivateDIDResponse) Reset()         { *m = MsgDeactivateDIDResponse{} }
+func (m *MsgDeactivateDIDResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgQueryRemoteDID opens (or reuses) an ordered did-resolver channel to ChainId and
+// asks it to resolve Did, so a verifier on this chain can trust a DID hosted on another
+// Cosmos chain without that chain re-publishing its identities here. A successful
+// resolution is cached under RemoteDIDKey(ChainId, Did) for Params.RemoteDIDCacheTTL.
+type MsgQueryRemoteDID struct {
+	Requester string `json:"requester"`
+	ChainId   string `json:"chainId"`
+	ChannelId string `json:"channelId"`
+	Did       string `json:"did"`
+
+	// TimeoutTimestamp is the absolute Unix nanosecond timestamp after which the relayer
+	// must not relay the packet; zero means the IBC core module's default packet timeout
+	// applies.
+	TimeoutTimestamp uint64 `json:"timeoutTimestamp,omitempty"`
+}
+
+func NewMsgQueryRemoteDID(requester, chainID, channelID, did string) *MsgQueryRemoteDID {
+	return &MsgQueryRemoteDID{
+		Requester: requester,
+		ChainId:   chainID,
+		ChannelId: channelID,
+		Did:       did,
+	}
+}
+
+func (msg MsgQueryRemoteDID) Route() string { return RouterKey }
+func (msg MsgQueryRemoteDID) Type() string  { return "query_remote_did" }
+
+func (msg MsgQueryRemoteDID) ValidateBasic() error {
+	if msg.Requester == "" {
+		return ErrInvalidController
+	}
+	if msg.ChainId == "" {
+		return ErrInvalidIBCVersion.Wrap("chainId must be set")
+	}
+	if msg.ChannelId == "" {
+		return ErrInvalidIBCVersion.Wrap("channelId must be set")
+	}
+	return DIDResolutionPacketData{Did: msg.Did}.ValidateBasic()
+}
+
+func (msg MsgQueryRemoteDID) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Requester)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgQueryRemoteDID) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgQueryRemoteDID) ProtoMessage()  {}
+func (m *MsgQueryRemoteDID) Reset()         { *m = MsgQueryRemoteDID{} }
+func (m *MsgQueryRemoteDID) String() string { return proto.CompactTextString(m) }
+
+// MsgQueryRemoteDIDResponse returns the sequence number of the packet the host channel
+// accepted; the resolution itself arrives later via OnAcknowledgementPacket once the
+// counterparty chain's ack is relayed back.
+type MsgQueryRemoteDIDResponse struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+func (m *MsgQueryRemoteDIDResponse) ProtoMessage()  {}
+func (m *MsgQueryRemoteDIDResponse) Reset()         { *m = MsgQueryRemoteDIDResponse{} }
+func (m *MsgQueryRemoteDIDResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgTransferDIDController sends a signed controller-handoff packet over ChannelId
+// (an ordered did-resolver channel, the same port/channel this module's remote-DID
+// query traffic uses), asking DestinationChainId to retarget Did's
+// DIDByControllerPrefix index to NewController once the packet is acknowledged. Like
+// MsgQueryRemoteDID, the handoff is not applied synchronously -- it commits locally
+// only after IBCModule.OnAcknowledgementPacket (x/did/keeper/ibc_module.go) reports a
+// successful ack from the destination chain, so a relayed-but-rejected handoff never
+// strands Did without a controller on either side.
+type MsgTransferDIDController struct {
+	Controller        string `json:"controller"`
+	Did               string `json:"did"`
+	NewController     string `json:"newController"`
+	DestinationChainId string `json:"destinationChainId"`
+	ChannelId         string `json:"channelId"`
+
+	// TimeoutTimestamp is the absolute Unix nanosecond timestamp after which the relayer
+	// must not relay the packet; zero means the IBC core module's default packet timeout
+	// applies.
+	TimeoutTimestamp uint64 `json:"timeoutTimestamp,omitempty"`
+}
+
+func NewMsgTransferDIDController(controller, did, newController, destinationChainID, channelID string) *MsgTransferDIDController {
+	return &MsgTransferDIDController{
+		Controller:         controller,
+		Did:                did,
+		NewController:      newController,
+		DestinationChainId: destinationChainID,
+		ChannelId:          channelID,
+	}
+}
+
+func (msg MsgTransferDIDController) Route() string { return RouterKey }
+func (msg MsgTransferDIDController) Type() string  { return "transfer_did_controller" }
+
+func (msg MsgTransferDIDController) ValidateBasic() error {
+	if msg.Controller == "" {
+		return ErrInvalidController
+	}
+	if msg.NewController == "" {
+		return ErrInvalidController.Wrap("newController must be set")
+	}
+	if msg.DestinationChainId == "" {
+		return ErrInvalidIBCVersion.Wrap("destinationChainId must be set")
+	}
+	if msg.ChannelId == "" {
+		return ErrInvalidIBCVersion.Wrap("channelId must be set")
+	}
+	if _, err := ParseNamespace(msg.Did); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgTransferDIDController) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Controller)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgTransferDIDController) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgTransferDIDController) ProtoMessage()  {}
+func (m *MsgTransferDIDController) Reset()         { *m = MsgTransferDIDController{} }
+func (m *MsgTransferDIDController) String() string { return proto.CompactTextString(m) }
+
+// MsgTransferDIDControllerResponse returns the sequence number of the packet the host
+// channel accepted; the handoff itself commits later via OnAcknowledgementPacket once
+// the destination chain's ack is relayed back.
+type MsgTransferDIDControllerResponse struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+func (m *MsgTransferDIDControllerResponse) ProtoMessage()  {}
+func (m *MsgTransferDIDControllerResponse) Reset()         { *m = MsgTransferDIDControllerResponse{} }
+func (m *MsgTransferDIDControllerResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateParams defines the message for updating module parameters
+type MsgUpdateParams struct {
+	Authority string `json:"authority"`
+	Params    Params `json:"params"`
+}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if msg.Authority == "" {
+		return ErrInvalidAuthority
+	}
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUpdateParams) ProtoMessage()  {}
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+
+type MsgUpdateParamsResponse struct{}
+
+func (m *MsgUpdateParamsResponse) ProtoMessage()  {}
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
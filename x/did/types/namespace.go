@@ -0,0 +1,200 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// ActivationPolicy governs who may create DIDs within a namespace.
+type ActivationPolicy string
+
+const (
+	// ActivationPolicyOpen allows any controller to create a DID in the namespace.
+	ActivationPolicyOpen ActivationPolicy = "open"
+
+	// ActivationPolicyAllowlist restricts DID creation to controllers named in the
+	// namespace's ControllerAllowlist.
+	ActivationPolicyAllowlist ActivationPolicy = "allowlist"
+)
+
+// DefaultNamespace is the namespace a DID belongs to when its ID carries no explicit
+// namespace segment (did:persona:<id>), preserving the pre-namespace DID format.
+const DefaultNamespace = "mainnet"
+
+// NamespaceParams configures one logical namespace a DID may be registered under, e.g.
+// "mainnet", "testnet", or a tenant-scoped namespace like "acme". Following cheqd's
+// did:cheqd:<namespace>:<id> pattern, a persona DID's namespace is the segment
+// immediately after the method name: did:persona:<namespace>:<id>.
+type NamespaceParams struct {
+	// Namespace is the segment that must appear in a DID's method-specific-id for it
+	// to belong to this namespace.
+	Namespace string `json:"namespace"`
+
+	// DIDCreationFee overrides Params.DIDCreationFee for DIDs in this namespace. Nil
+	// means the module-wide default applies.
+	DIDCreationFee *math.Int `json:"didCreationFee,omitempty"`
+
+	// DIDUpdateFee overrides Params.DIDUpdateFee for DIDs in this namespace. Nil means
+	// the module-wide default applies.
+	DIDUpdateFee *math.Int `json:"didUpdateFee,omitempty"`
+
+	// ControllerAllowlist restricts which controller addresses may create DIDs in this
+	// namespace when ActivationPolicy is ActivationPolicyAllowlist. Ignored otherwise.
+	ControllerAllowlist []string `json:"controllerAllowlist,omitempty"`
+
+	// ActivationPolicy determines who may create DIDs in this namespace.
+	ActivationPolicy ActivationPolicy `json:"activationPolicy"`
+
+	// Created is when the namespace was registered.
+	Created time.Time `json:"created"`
+}
+
+func (m *NamespaceParams) ProtoMessage()  {}
+func (m *NamespaceParams) Reset()         { *m = NamespaceParams{} }
+func (m *NamespaceParams) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a NamespaceParams registration.
+func (n *NamespaceParams) Validate() error {
+	if n.Namespace == "" {
+		return ErrInvalidNamespace.Wrap("namespace cannot be empty")
+	}
+	if strings.Contains(n.Namespace, ":") {
+		return ErrInvalidNamespace.Wrap("namespace cannot contain ':'")
+	}
+	if n.DIDCreationFee != nil && n.DIDCreationFee.IsNegative() {
+		return ErrInvalidNamespace.Wrap("DID creation fee override cannot be negative")
+	}
+	if n.DIDUpdateFee != nil && n.DIDUpdateFee.IsNegative() {
+		return ErrInvalidNamespace.Wrap("DID update fee override cannot be negative")
+	}
+	switch n.ActivationPolicy {
+	case ActivationPolicyOpen, ActivationPolicyAllowlist:
+	default:
+		return ErrInvalidNamespace.Wrapf("unknown activation policy %q", n.ActivationPolicy)
+	}
+	if n.ActivationPolicy == ActivationPolicyAllowlist && len(n.ControllerAllowlist) == 0 {
+		return ErrInvalidNamespace.Wrap("allowlist activation policy requires a non-empty ControllerAllowlist")
+	}
+	return nil
+}
+
+// AllowsController reports whether controller may create a DID in this namespace.
+func (n *NamespaceParams) AllowsController(controller string) bool {
+	if n.ActivationPolicy != ActivationPolicyAllowlist {
+		return true
+	}
+	for _, c := range n.ControllerAllowlist {
+		if c == controller {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseNamespace extracts the namespace segment from a DID of the form
+// "did:persona:<namespace>:<specific-id>". A DID with no namespace segment
+// ("did:persona:<specific-id>") belongs to DefaultNamespace, preserving the
+// pre-namespace DID format. It returns ErrInvalidDID if id is not a well-formed
+// did:persona DID.
+func ParseNamespace(id string) (string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) < 3 || parts[0] != "did" || parts[1] != "persona" {
+		return "", ErrInvalidDID.Wrapf("%q is not a well-formed did:persona DID", id)
+	}
+
+	switch len(parts) {
+	case 3:
+		// did:persona:<specific-id>
+		return DefaultNamespace, nil
+	default:
+		// did:persona:<namespace>:<specific-id...>
+		namespace := parts[2]
+		if namespace == "" {
+			return "", ErrInvalidNamespace.Wrap("namespace segment cannot be empty")
+		}
+		return namespace, nil
+	}
+}
+
+// NamespaceKeeper is the subset of a future DID keeper's namespace registry that
+// MsgCreateDID validation needs: resolving a registered namespace's params so
+// governance-restricted namespaces can be enforced. No such keeper exists yet (x/did
+// has no keeper package), so ValidateBasic only enforces DID namespace *syntax*; full
+// registry enforcement (unknown namespace, allowlist, fee override) is left to a
+// future keeper calling ValidateAgainstNamespace.
+type NamespaceKeeper interface {
+	GetNamespace(namespace string) (*NamespaceParams, bool)
+}
+
+// ValidateAgainstNamespace resolves the DID's namespace via k and checks that it is
+// registered and that controller is permitted to create DIDs within it.
+func (d *DIDDocument) ValidateAgainstNamespace(k NamespaceKeeper, controller string) error {
+	namespace, err := ParseNamespace(d.ID)
+	if err != nil {
+		return err
+	}
+
+	ns, ok := k.GetNamespace(namespace)
+	if !ok {
+		return ErrUnknownNamespace.Wrapf("namespace %q is not registered", namespace)
+	}
+	if !ns.AllowsController(controller) {
+		return ErrUnauthorized.Wrapf("controller %q is not permitted to create DIDs in namespace %q", controller, namespace)
+	}
+	return nil
+}
+
+// MsgRegisterNamespace registers a new namespace DIDs may be created under, e.g.
+// "testnet" or a tenant-scoped namespace like "acme". Like MsgUpdateParams, it is
+// gated by governance: Authority must match the module's configured authority address.
+type MsgRegisterNamespace struct {
+	Authority string          `json:"authority"`
+	Params    NamespaceParams `json:"params"`
+}
+
+var _ sdk.Msg = &MsgRegisterNamespace{}
+
+const TypeMsgRegisterNamespace = "register_namespace"
+
+func NewMsgRegisterNamespace(authority string, params NamespaceParams) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgRegisterNamespace) Route() string { return RouterKey }
+func (msg MsgRegisterNamespace) Type() string  { return TypeMsgRegisterNamespace }
+
+func (msg MsgRegisterNamespace) ValidateBasic() error {
+	if msg.Authority == "" {
+		return ErrInvalidAuthority
+	}
+	return msg.Params.Validate()
+}
+
+func (msg MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRegisterNamespace) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRegisterNamespace) ProtoMessage()  {}
+func (m *MsgRegisterNamespace) Reset()         { *m = MsgRegisterNamespace{} }
+func (m *MsgRegisterNamespace) String() string { return proto.CompactTextString(m) }
+
+// MsgRegisterNamespaceResponse is the response for MsgRegisterNamespace.
+type MsgRegisterNamespaceResponse struct{}
+
+func (m *MsgRegisterNamespaceResponse) ProtoMessage()  {}
+func (m *MsgRegisterNamespaceResponse) Reset()         { *m = MsgRegisterNamespaceResponse{} }
+func (m *MsgRegisterNamespaceResponse) String() string { return proto.CompactTextString(m) }
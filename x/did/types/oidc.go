@@ -0,0 +1,114 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// MethodTypeOIDC identifies an AuthMethod bound to a keyless OIDC identity rather than
+// a locally held key, Sigstore/Fulcio-style: the subject proves control of a DID by
+// presenting a short-lived OIDC ID token, and the chain records the (issuer, subject)
+// pair the token was bound to rather than a long-lived public key.
+const MethodTypeOIDC = "oidc"
+
+// OIDCIdentityBinding captures the claims from an OIDC ID token that bind a DID to a
+// federated identity, instead of to a locally held signing key.
+type OIDCIdentityBinding struct {
+	// Issuer is the OIDC `iss` claim, e.g. "https://accounts.google.com".
+	Issuer string `json:"issuer"`
+
+	// Subject is the OIDC `sub` claim identifying the end user at the issuer.
+	Subject string `json:"subject"`
+
+	// Audience is the OIDC `aud` claim identifying the relying party (Fulcio-style,
+	// typically a fixed audience string for this chain's identity binding flow).
+	Audience string `json:"audience"`
+
+	// ExpiresAt is the OIDC `exp` claim; bindings derived from an expired token are
+	// rejected by ValidateBasic.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (m *OIDCIdentityBinding) ProtoMessage()  {}
+func (m *OIDCIdentityBinding) Reset()         { *m = OIDCIdentityBinding{} }
+func (m *OIDCIdentityBinding) String() string { return proto.CompactTextString(m) }
+
+// ValidateBasic performs stateless validation of an OIDCIdentityBinding. Verifying the
+// ID token's signature against the issuer's JWKS is left to the keeper, which has
+// access to a trusted issuer allowlist and can fetch/cache JWKS documents.
+func (m *OIDCIdentityBinding) ValidateBasic(at time.Time) error {
+	if m.Issuer == "" || !strings.HasPrefix(m.Issuer, "https://") {
+		return ErrInvalidController.Wrap("oidc issuer must be a non-empty https URL")
+	}
+	if m.Subject == "" {
+		return ErrInvalidController.Wrap("oidc subject cannot be empty")
+	}
+	if m.Audience == "" {
+		return ErrInvalidController.Wrap("oidc audience cannot be empty")
+	}
+	if m.ExpiresAt.Before(at) {
+		return ErrInvalidController.Wrap("oidc id token has expired")
+	}
+	return nil
+}
+
+// AuthMethodID deterministically derives the AuthMethod.MethodID for an OIDC binding so
+// the same (issuer, subject) pair always resolves to the same linked auth method.
+func (m *OIDCIdentityBinding) AuthMethodID() string {
+	return "oidc:" + m.Issuer + "#" + m.Subject
+}
+
+// MsgLinkOIDCAuthMethod links a keyless OIDC identity to a DID as an AuthMethod,
+// allowing the holder to authenticate without ever holding a private key locally.
+type MsgLinkOIDCAuthMethod struct {
+	Controller string              `json:"controller"`
+	Id         string              `json:"id"`
+	IdToken    string              `json:"idToken"`
+	Binding    OIDCIdentityBinding `json:"binding"`
+}
+
+var _ sdk.Msg = &MsgLinkOIDCAuthMethod{}
+
+const TypeMsgLinkOIDCAuthMethod = "link_oidc_auth_method"
+
+func (msg *MsgLinkOIDCAuthMethod) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgLinkOIDCAuthMethod) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return ErrInvalidController
+	}
+	if msg.Id == "" {
+		return ErrInvalidDID
+	}
+	if msg.IdToken == "" {
+		return ErrInvalidController.Wrap("idToken cannot be empty")
+	}
+	return msg.Binding.ValidateBasic(time.Now())
+}
+
+func (msg *MsgLinkOIDCAuthMethod) Type() string  { return TypeMsgLinkOIDCAuthMethod }
+func (msg *MsgLinkOIDCAuthMethod) Route() string { return RouterKey }
+func (msg *MsgLinkOIDCAuthMethod) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgLinkOIDCAuthMethod) ProtoMessage()  {}
+func (m *MsgLinkOIDCAuthMethod) Reset()         { *m = MsgLinkOIDCAuthMethod{} }
+func (m *MsgLinkOIDCAuthMethod) String() string { return proto.CompactTextString(m) }
+
+// MsgLinkOIDCAuthMethodResponse is the response for MsgLinkOIDCAuthMethod.
+type MsgLinkOIDCAuthMethodResponse struct {
+	MethodId string `json:"methodId"`
+}
+
+func (m *MsgLinkOIDCAuthMethodResponse) ProtoMessage()  {}
+func (m *MsgLinkOIDCAuthMethodResponse) Reset()         { *m = MsgLinkOIDCAuthMethodResponse{} }
+func (m *MsgLinkOIDCAuthMethodResponse) String() string { return proto.CompactTextString(m) }
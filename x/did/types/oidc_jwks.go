@@ -0,0 +1,131 @@
+package types
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// JSONWebKey is the subset of RFC 7517 KeylessAuthVerifier needs to check an RS256 ID
+// token's signature. ES256/EdDSA keys are out of scope for now: every issuer this
+// module whitelists by default (microsoft/google/github/fulcio, see
+// DefaultParams.OIDCIssuers) signs its ID tokens with RS256.
+type JSONWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"` // base64url-encoded RSA modulus
+	E   string `json:"e"` // base64url-encoded RSA public exponent
+}
+
+// PublicKey decodes jwk's RSA modulus/exponent into an *rsa.PublicKey.
+func (jwk JSONWebKey) PublicKey() (*rsa.PublicKey, error) {
+	if jwk.Kty != "RSA" {
+		return nil, ErrInvalidController.Wrapf("unsupported JWK key type %q", jwk.Kty)
+	}
+	nBytes, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, ErrInvalidController.Wrap("invalid JWK modulus encoding")
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, ErrInvalidController.Wrap("invalid JWK exponent encoding")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: int(new(big.Int).SetBytes(eBytes).Int64()),
+	}, nil
+}
+
+// OIDCIssuerJWKS is one whitelisted issuer's cached signing-key set, keyed by
+// OIDCIssuerConfig.Name under the keeper's OIDCIssuerKeys collection. A chain can't make
+// outbound HTTP calls from consensus-critical code, so this is populated by
+// MsgUpdateOIDCKeys (an authorized relayer pushing the issuer's live JWKS document
+// on-chain) rather than fetched directly.
+type OIDCIssuerJWKS struct {
+	Issuer    string       `json:"issuer"`
+	Keys      []JSONWebKey `json:"keys"`
+	UpdatedAt int64        `json:"updated_at"` // unix seconds
+}
+
+func (m *OIDCIssuerJWKS) ProtoMessage()  {}
+func (m *OIDCIssuerJWKS) Reset()         { *m = OIDCIssuerJWKS{} }
+func (m *OIDCIssuerJWKS) String() string { return proto.CompactTextString(m) }
+
+// Find returns the key matching kid, or false if no cached key has that kid.
+func (m OIDCIssuerJWKS) Find(kid string) (JSONWebKey, bool) {
+	for _, k := range m.Keys {
+		if k.Kid == kid {
+			return k, true
+		}
+	}
+	return JSONWebKey{}, false
+}
+
+// MsgUpdateOIDCKeys replaces the cached JWKS for one whitelisted issuer. Since the chain
+// has no way to fetch an issuer's live JWKS itself, this must come from an authorized
+// relayer (or a governance proposal, for chains that don't want to trust a relayer key)
+// -- keeper.MsgServer.UpdateOIDCKeys checks Authority against the module's configured
+// authority the same way x/zkproof/x/registry gate their own governance-only entry
+// points.
+type MsgUpdateOIDCKeys struct {
+	Authority string       `json:"authority"`
+	Issuer    string       `json:"issuer"` // OIDCIssuerConfig.Name
+	Keys      []JSONWebKey `json:"keys"`
+}
+
+var _ sdk.Msg = &MsgUpdateOIDCKeys{}
+
+const TypeMsgUpdateOIDCKeys = "update_oidc_keys"
+
+func (m *MsgUpdateOIDCKeys) ProtoMessage()  {}
+func (m *MsgUpdateOIDCKeys) Reset()         { *m = MsgUpdateOIDCKeys{} }
+func (m *MsgUpdateOIDCKeys) String() string { return proto.CompactTextString(m) }
+
+func (msg *MsgUpdateOIDCKeys) Route() string { return RouterKey }
+func (msg *MsgUpdateOIDCKeys) Type() string  { return TypeMsgUpdateOIDCKeys }
+
+func (msg *MsgUpdateOIDCKeys) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgUpdateOIDCKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic performs stateless validation of a MsgUpdateOIDCKeys.
+func (msg *MsgUpdateOIDCKeys) ValidateBasic() error {
+	if msg.Authority == "" {
+		return ErrInvalidAuthority
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority
+	}
+	if msg.Issuer == "" {
+		return ErrInvalidController.Wrap("issuer cannot be empty")
+	}
+	if len(msg.Keys) == 0 {
+		return ErrInvalidController.Wrap("keys cannot be empty")
+	}
+	for _, key := range msg.Keys {
+		if key.Kid == "" {
+			return ErrInvalidController.Wrap("every JWK must set kid")
+		}
+	}
+	return nil
+}
+
+// MsgUpdateOIDCKeysResponse is the response for MsgUpdateOIDCKeys.
+type MsgUpdateOIDCKeysResponse struct{}
+
+func (m *MsgUpdateOIDCKeysResponse) ProtoMessage()  {}
+func (m *MsgUpdateOIDCKeysResponse) Reset()         { *m = MsgUpdateOIDCKeysResponse{} }
+func (m *MsgUpdateOIDCKeysResponse) String() string { return proto.CompactTextString(m) }
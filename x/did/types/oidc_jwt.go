@@ -0,0 +1,101 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// OIDCJWSHeader is the subset of a JWS header KeylessAuthVerifier needs.
+type OIDCJWSHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// OIDCIDTokenClaims is the subset of OIDC ID token claims KeylessAuthVerifier checks.
+type OIDCIDTokenClaims struct {
+	Issuer   string `json:"iss"`
+	Subject  string `json:"sub"`
+	Audience string `json:"aud"`
+	Email    string `json:"email,omitempty"`
+	Expiry   int64  `json:"exp"`
+	NotBefore int64 `json:"nbf,omitempty"`
+}
+
+// ParsedOIDCIDToken is an OIDC ID token split into its JWS parts, decoded but not yet
+// signature-verified.
+type ParsedOIDCIDToken struct {
+	Header       OIDCJWSHeader
+	Claims       OIDCIDTokenClaims
+	SigningInput string // base64url(header) + "." + base64url(payload), what the signature covers
+	Signature    []byte
+}
+
+// ParseOIDCIDToken decodes idToken's JWS compact serialization ("header.payload.signature",
+// each base64url-encoded) without verifying the signature -- that requires the issuer's
+// cached JWKS, which only the keeper has access to. Rejects `alg: none` up front since
+// that's never a decision the signature-verification step downstream should have to make.
+func ParseOIDCIDToken(idToken string) (ParsedOIDCIDToken, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("id token must have 3 JWS segments")
+	}
+
+	headerBz, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("invalid header encoding")
+	}
+	var header OIDCJWSHeader
+	if err := json.Unmarshal(headerBz, &header); err != nil {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("invalid header JSON")
+	}
+	if header.Alg == "" || strings.EqualFold(header.Alg, "none") {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("alg \"none\" id tokens are rejected")
+	}
+
+	claimsBz, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("invalid payload encoding")
+	}
+	var claims OIDCIDTokenClaims
+	if err := json.Unmarshal(claimsBz, &claims); err != nil {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("invalid payload JSON")
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ParsedOIDCIDToken{}, ErrInvalidOIDCToken.Wrap("invalid signature encoding")
+	}
+
+	return ParsedOIDCIDToken{
+		Header:       header,
+		Claims:       claims,
+		SigningInput: parts[0] + "." + parts[1],
+		Signature:    sig,
+	}, nil
+}
+
+// ValidateTiming checks the token's nbf/exp claims against at.
+func (t ParsedOIDCIDToken) ValidateTiming(at int64) error {
+	if t.Claims.Expiry == 0 || at >= t.Claims.Expiry {
+		return ErrInvalidOIDCToken.Wrap("id token is expired")
+	}
+	if t.Claims.NotBefore != 0 && at < t.Claims.NotBefore {
+		return ErrInvalidOIDCToken.Wrap("id token is not yet valid")
+	}
+	return nil
+}
+
+// ComputeOIDCPublicKeyHash derives the AuthMethod.PublicKeyHash value an OIDC/oauth_*
+// AuthMethod binds its claims to: there's no pre-existing convention for this in the
+// codebase to follow (PublicKeyHash's doc comment only says "SHA-256 hash of secret or
+// OAuth attestation" without specifying the OAuth case's input), so this hashes the
+// (issuer, subject, audience) triple the way a client generating PublicKeyHash when
+// first registering this AuthMethod should, letting KeylessAuthVerifier recompute the
+// same hash from the verified token's claims and compare.
+func ComputeOIDCPublicKeyHash(issuer, subject, audience string) string {
+	sum := sha256.Sum256([]byte(issuer + "|" + subject + "|" + audience))
+	return hex.EncodeToString(sum[:])
+}
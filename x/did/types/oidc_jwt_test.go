@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+	bz, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+func buildIDToken(t *testing.T, header OIDCJWSHeader, claims OIDCIDTokenClaims, sig []byte) string {
+	t.Helper()
+	return encodeSegment(t, header) + "." + encodeSegment(t, claims) + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestParseOIDCIDToken(t *testing.T) {
+	header := OIDCJWSHeader{Alg: "RS256", Kid: "key-1"}
+	claims := OIDCIDTokenClaims{Issuer: "https://issuer.example", Subject: "sub-1", Audience: "aud-1", Expiry: 1000}
+	sig := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	token := buildIDToken(t, header, claims, sig)
+	parsed, err := ParseOIDCIDToken(token)
+	if err != nil {
+		t.Fatalf("ParseOIDCIDToken: %v", err)
+	}
+	if parsed.Header != header {
+		t.Fatalf("header = %+v, want %+v", parsed.Header, header)
+	}
+	if parsed.Claims != claims {
+		t.Fatalf("claims = %+v, want %+v", parsed.Claims, claims)
+	}
+	if string(parsed.Signature) != string(sig) {
+		t.Fatalf("signature = %x, want %x", parsed.Signature, sig)
+	}
+
+	headerSeg := encodeSegment(t, header)
+	claimsSeg := encodeSegment(t, claims)
+	want := headerSeg + "." + claimsSeg
+	if parsed.SigningInput != want {
+		t.Fatalf("SigningInput = %q, want %q", parsed.SigningInput, want)
+	}
+}
+
+func TestParseOIDCIDToken_RejectsMalformedInput(t *testing.T) {
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{"wrong segment count", "only.two"},
+		{"invalid base64 header", "!!!not-base64.eyJ9.c2ln"},
+		{"invalid json header", base64.RawURLEncoding.EncodeToString([]byte("not json")) + ".eyJ9.c2ln"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ParseOIDCIDToken(tc.token); err == nil {
+				t.Fatalf("expected an error for: %s", tc.name)
+			}
+		})
+	}
+}
+
+func TestParseOIDCIDToken_RejectsAlgNone(t *testing.T) {
+	cases := []OIDCJWSHeader{
+		{Alg: "none"},
+		{Alg: "None"},
+		{Alg: ""},
+	}
+	for _, header := range cases {
+		token := buildIDToken(t, header, OIDCIDTokenClaims{}, nil)
+		if _, err := ParseOIDCIDToken(token); err == nil {
+			t.Fatalf("expected alg %q to be rejected", header.Alg)
+		}
+	}
+}
+
+func TestParsedOIDCIDToken_ValidateTiming(t *testing.T) {
+	cases := []struct {
+		name    string
+		claims  OIDCIDTokenClaims
+		at      int64
+		wantErr bool
+	}{
+		{"within window", OIDCIDTokenClaims{Expiry: 2000, NotBefore: 1000}, 1500, false},
+		{"no expiry set", OIDCIDTokenClaims{Expiry: 0}, 1500, true},
+		{"expired", OIDCIDTokenClaims{Expiry: 1000}, 1000, true},
+		{"expired past", OIDCIDTokenClaims{Expiry: 1000}, 2000, true},
+		{"not yet valid", OIDCIDTokenClaims{Expiry: 2000, NotBefore: 1500}, 1000, true},
+		{"no not-before set", OIDCIDTokenClaims{Expiry: 2000}, 1, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			token := ParsedOIDCIDToken{Claims: tc.claims}
+			err := token.ValidateTiming(tc.at)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestComputeOIDCPublicKeyHash(t *testing.T) {
+	h1 := ComputeOIDCPublicKeyHash("issuer-1", "sub-1", "aud-1")
+	h2 := ComputeOIDCPublicKeyHash("issuer-1", "sub-1", "aud-1")
+	if h1 != h2 {
+		t.Fatal("ComputeOIDCPublicKeyHash is not deterministic for the same inputs")
+	}
+
+	h3 := ComputeOIDCPublicKeyHash("issuer-1", "sub-2", "aud-1")
+	if h1 == h3 {
+		t.Fatal("different subjects produced the same public key hash")
+	}
+
+	if len(h1) != 64 {
+		t.Fatalf("expected a 64-char hex-encoded SHA-256 digest, got %d chars", len(h1))
+	}
+}
@@ -10,10 +10,21 @@ import (
 // DefaultParams returns default parameters
 func DefaultParams() Params {
 	return Params{
-		DIDCreationFee: math.NewInt(1000000), // 1 PERSONA token
-		DIDUpdateFee:   math.NewInt(500000),  // 0.5 PERSONA token
-		MaxDIDSize:     16384,                // 16KB max DID document size
-		MaxControllers: 10,                   // Max number of controllers per DID
+		DIDCreationFee:     math.NewInt(1000000), // 1 PERSONA token
+		DIDUpdateFee:       math.NewInt(500000),  // 0.5 PERSONA token
+		MaxDIDSize:         16384,                // 16KB max DID document size
+		MaxControllers:     10,                   // Max number of controllers per DID
+		RemoteDIDCacheTTL:  3600,                 // 1 hour before a cached remote DID must be re-resolved
+
+		DIDGracePeriod:             604800,  // 7 days after deactivation before a DID is hard-deleted
+		AuthMethodInactivityPeriod: 7776000, // 90 days without use before an AuthMethod is auto-deactivated
+
+		OIDCIssuers: []OIDCIssuerConfig{
+			{Name: "microsoft", Issuer: "https://login.microsoftonline.com/common/v2.0", Audience: "personachain"},
+			{Name: "google", Issuer: "https://accounts.google.com", Audience: "personachain"},
+			{Name: "github", Issuer: "https://token.actions.githubusercontent.com", Audience: "personachain"},
+			{Name: "fulcio", Issuer: "https://fulcio.sigstore.dev", Audience: "sigstore"},
+		},
 	}
 }
 
@@ -43,6 +54,24 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max controllers cannot exceed 100: %d", p.MaxControllers)
 	}
 
+	if p.RemoteDIDCacheTTL <= 0 {
+		return fmt.Errorf("remote DID cache TTL must be positive: %d", p.RemoteDIDCacheTTL)
+	}
+
+	if p.DIDGracePeriod <= 0 {
+		return fmt.Errorf("DID grace period must be positive: %d", p.DIDGracePeriod)
+	}
+
+	if p.AuthMethodInactivityPeriod <= 0 {
+		return fmt.Errorf("auth method inactivity period must be positive: %d", p.AuthMethodInactivityPeriod)
+	}
+
+	for _, issuer := range p.OIDCIssuers {
+		if issuer.Name == "" || issuer.Issuer == "" || issuer.Audience == "" {
+			return fmt.Errorf("oidc issuer config must set name, issuer, and audience: %+v", issuer)
+		}
+	}
+
 	return nil
 }
 
@@ -65,8 +94,50 @@ type Params struct {
 	
 	// MaxControllers defines the maximum number of controllers per DID
 	MaxControllers uint64 `json:"max_controllers"`
+
+	// RemoteDIDCacheTTL is how many seconds a positive did-resolver IBC resolution
+	// cached under RemoteDIDKey stays trusted before ValidateDID must re-resolve it
+	// over IBC rather than serving the cached DIDDocument.
+	RemoteDIDCacheTTL int64 `json:"remote_did_cache_ttl"`
+
+	// DIDGracePeriod is how many seconds after a DID Document's Updated timestamp a
+	// Deactivated=true DID is kept around before the EndBlocker hard-deletes it, giving
+	// dependents (x/revocation, x/zkproof) a window to react to AfterDIDDeactivated
+	// before the record disappears entirely.
+	DIDGracePeriod int64 `json:"did_grace_period"`
+
+	// AuthMethodInactivityPeriod is how many seconds an AuthMethod may go without being
+	// referenced (AuthMethod.LastUsedAt) before the EndBlocker flips its IsActive to
+	// false.
+	AuthMethodInactivityPeriod int64 `json:"auth_method_inactivity_period"`
+
+	// OIDCIssuers whitelists the OIDC issuers keeper.KeylessAuthVerifier will accept an
+	// oauth_*/oidc AuthMethod's ID-token attestation from. Chains can't make outbound
+	// HTTP calls to fetch a live JWKS document, so each issuer's signing keys are cached
+	// on-chain (OIDCIssuerKeys) instead, pushed by MsgUpdateOIDCKeys rather than fetched.
+	OIDCIssuers []OIDCIssuerConfig `json:"oidc_issuers"`
 }
 
+// OIDCIssuerConfig whitelists one OIDC issuer KeylessAuthVerifier will accept ID tokens
+// from, and the audience value that issuer's tokens must carry for this chain's
+// identity-linking flow (e.g. Sigstore/Fulcio-style short-lived-certificate issuance
+// binds a fixed, non-user-specific audience).
+type OIDCIssuerConfig struct {
+	// Name identifies the issuer for OIDCIssuerKeys lookups and MsgUpdateOIDCKeys,
+	// e.g. "microsoft", "google", "github", "fulcio".
+	Name string `json:"name"`
+
+	// Issuer is the OIDC `iss` claim value this config matches against.
+	Issuer string `json:"issuer"`
+
+	// Audience is the OIDC `aud` claim value a token from this issuer must carry.
+	Audience string `json:"audience"`
+}
+
+func (m *OIDCIssuerConfig) ProtoMessage()  {}
+func (m *OIDCIssuerConfig) Reset()         { *m = OIDCIssuerConfig{} }
+func (m *OIDCIssuerConfig) String() string { return fmt.Sprintf("%+v", *m) }
+
 // Ensure Params implements proto.Message interface for SDK compatibility
 func (m *Params) Reset()        { *m = Params{} }
 func (m *Params) ProtoMessage() {}
\ No newline at end of file
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/base64"
+)
+
+// CursorPageRequest drives cursor-based pagination over a collections-backed index, in
+// place of offset-based PageRequest. Cursor is the opaque, base64-encoded last key seen
+// on the previous page; an empty Cursor starts from the beginning. Mirrors
+// x/credential and x/zkproof's CursorPageRequest for the same reason GetAllDIDs/
+// GetDIDsByController/GetAuthMethodsByDID can't keep returning unbounded slices.
+type CursorPageRequest struct {
+	Cursor  string `json:"cursor,omitempty"`
+	Limit   uint64 `json:"limit"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+// CursorPageResponse is returned alongside a page of results, carrying the opaque
+// cursor to pass as the next request's Cursor, or "" if there is no further page.
+type CursorPageResponse struct {
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// EncodeCursor base64-encodes a raw collection key into an opaque pagination cursor.
+func EncodeCursor(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// DecodeCursor decodes an opaque pagination cursor back into a raw collection key.
+func DecodeCursor(cursor string) ([]byte, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	return base64.RawURLEncoding.DecodeString(cursor)
+}
+
+// DefaultQueryLimit is applied when a CursorPageRequest does not specify a Limit.
+const DefaultQueryLimit = 100
+
+// MaxQueryLimit caps the page size cursor queries may request.
+const MaxQueryLimit = 1000
+
+// EffectiveLimit returns the page's requested limit clamped to [1, MaxQueryLimit],
+// defaulting to DefaultQueryLimit when unset.
+func (p CursorPageRequest) EffectiveLimit() uint64 {
+	switch {
+	case p.Limit == 0:
+		return DefaultQueryLimit
+	case p.Limit > MaxQueryLimit:
+		return MaxQueryLimit
+	default:
+		return p.Limit
+	}
+}
+
+// QueryDIDsRequest lists DIDs, optionally restricted to one controller, a page at a
+// time via Pagination.
+type QueryDIDsRequest struct {
+	Controller string            `json:"controller,omitempty"`
+	Pagination CursorPageRequest `json:"pagination"`
+}
+
+// QueryDIDsResponse carries a page of DID ids plus the cursor for the next page.
+type QueryDIDsResponse struct {
+	Ids        []string           `json:"ids"`
+	Pagination CursorPageResponse `json:"pagination"`
+}
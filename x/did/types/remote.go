@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// RemoteDIDCachePrefix is the store prefix a future x/did keeper namespaces its
+// RemoteDIDKey entries under, mirroring how LegacyExpiryQueuePrefix documents the key
+// space x/credential/types/expiry.go expects a keeper to range over.
+const RemoteDIDCachePrefix = "RemoteDID/value/"
+
+// RemoteDIDKey returns the store key a positive did-resolver resolution of did on
+// chainID is cached under, keyed by (chainID, did) so the same DID resolved from two
+// different counterparty chains never collides.
+func RemoteDIDKey(chainID, did string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s", RemoteDIDCachePrefix, chainID, did))
+}
+
+// RemoteDID is a cached did-resolver resolution: the DIDDocument a counterparty chain
+// returned for Did as of Height, and CachedAt, the local block time the cache entry was
+// written -- compared against Params.RemoteDIDCacheTTL to decide whether the entry is
+// still fresh enough for x/zkproof's DIDKeeper.ValidateDID to trust without
+// re-resolving over IBC.
+type RemoteDID struct {
+	ChainID     string      `json:"chain_id"`
+	Did         string      `json:"did"`
+	DIDDocument DIDDocument `json:"did_document"`
+	Height      int64       `json:"height"`
+	CachedAt    int64       `json:"cached_at"`
+}
+
+func (m *RemoteDID) ProtoMessage()  {}
+func (m *RemoteDID) Reset()         { *m = RemoteDID{} }
+func (m *RemoteDID) String() string { return proto.CompactTextString(m) }
+
+// Expired reports whether this cache entry is older than ttl as of now.
+func (m RemoteDID) Expired(now int64, ttlSeconds int64) bool {
+	return now-m.CachedAt > ttlSeconds
+}
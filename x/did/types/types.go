@@ -1,9 +1,9 @@
 package types
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
-	
+
 	proto "github.com/cosmos/gogoproto/proto"
 )
 
@@ -13,23 +13,53 @@ const (
 	RouterKey  = ModuleName
 )
 
+// Allowed VerificationMethod.Type values. A DID's verificationMethod entries must use
+// one of these key types; anything else is rejected by VerificationMethod.Validate.
+const (
+	VerificationKeyTypeEd25519   = "Ed25519VerificationKey2020"
+	VerificationKeyTypeJWK       = "JsonWebKey2020"
+	VerificationKeyTypeSecp256k1 = "EcdsaSecp256k1VerificationKey2019"
+
+	// VerificationKeyTypeEcdsaSecp256k1Recovery is the key type an Ethereum controller's
+	// verificationMethod entry uses, per Hypersign's x/ssi convention -- the recovery
+	// suffix signals that the keeper verifies a signature over this method by recovering
+	// the signer's address/public key rather than by checking against an embedded public
+	// key bytewise, the same distinction clientspec.go's eth-personalSign and eth-EIP712
+	// ClientSpecs make over cosmos-ADR036-v1.
+	VerificationKeyTypeEcdsaSecp256k1Recovery = "EcdsaSecp256k1RecoveryMethod2020"
+
+	// VerificationKeyTypeBls12381G2 is the key type an issuer registers to sign
+	// BbsBlsSignature2020 credentials and have BbsBlsSignatureProof2020 presentations
+	// derived from them verified, per the BBS+ DID Linked Data Cryptosuite convention --
+	// see x/credential/types/bbs.go's BLS12381PublicKey, which this resolves to.
+	VerificationKeyTypeBls12381G2 = "Bls12381G2Key2020"
+)
+
+var allowedVerificationKeyTypes = map[string]bool{
+	VerificationKeyTypeEd25519:                true,
+	VerificationKeyTypeJWK:                    true,
+	VerificationKeyTypeSecp256k1:              true,
+	VerificationKeyTypeEcdsaSecp256k1Recovery: true,
+	VerificationKeyTypeBls12381G2:             true,
+}
+
 // DIDDocument represents a W3C DID Document
 type DIDDocument struct {
-	ID                     string                 `json:"id"`
-	Context                []string               `json:"@context"`
-	VerificationMethod     []VerificationMethod   `json:"verificationMethod"`
-	Authentication         []string               `json:"authentication"`
-	AssertionMethod        []string               `json:"assertionMethod"`
-	KeyAgreement           []string               `json:"keyAgreement"`
-	CapabilityInvocation   []string               `json:"capabilityInvocation"`
-	CapabilityDelegation   []string               `json:"capabilityDelegation"`
-	Service                []Service              `json:"service"`
-	Created                time.Time              `json:"created"`
-	Updated                time.Time              `json:"updated"`
-	Deactivated            bool                   `json:"deactivated"`
-	VersionID              string                 `json:"versionId"`
-	NextVersionID          string                 `json:"nextVersionId"`
-	PreviousVersionID      string                 `json:"previousVersionId"`
+	ID                   string                     `json:"id"`
+	Context              []string                   `json:"@context"`
+	VerificationMethod   []VerificationMethod       `json:"verificationMethod"`
+	Authentication       []VerificationRelationship `json:"authentication"`
+	AssertionMethod      []VerificationRelationship `json:"assertionMethod"`
+	KeyAgreement         []VerificationRelationship `json:"keyAgreement"`
+	CapabilityInvocation []VerificationRelationship `json:"capabilityInvocation"`
+	CapabilityDelegation []VerificationRelationship `json:"capabilityDelegation"`
+	Service              []Service                  `json:"service"`
+	Created              time.Time                  `json:"created"`
+	Updated              time.Time                  `json:"updated"`
+	Deactivated          bool                       `json:"deactivated"`
+	VersionID            string                     `json:"versionId"`
+	NextVersionID        string                     `json:"nextVersionId"`
+	PreviousVersionID    string                     `json:"previousVersionId"`
 }
 
 // VerificationMethod represents a DID verification method
@@ -40,6 +70,94 @@ type VerificationMethod struct {
 	PublicKeyMultibase string `json:"publicKeyMultibase"`
 }
 
+// Validate checks that a VerificationMethod is well-formed and uses an allow-listed
+// key type.
+func (m *VerificationMethod) Validate() error {
+	if m.ID == "" {
+		return ErrInvalidDocument.Wrap("verification method id cannot be empty")
+	}
+	if m.Controller == "" {
+		return ErrInvalidDocument.Wrap("verification method controller cannot be empty")
+	}
+	if !allowedVerificationKeyTypes[m.Type] {
+		return ErrInvalidDocument.Wrapf("unsupported verification method type %q", m.Type)
+	}
+	return nil
+}
+
+// VerificationRelationship is one entry in a DID Document's authentication,
+// assertionMethod, keyAgreement, capabilityInvocation, or capabilityDelegation list:
+// either a bare string referencing a VerificationMethod.ID already listed in
+// DIDDocument.VerificationMethod, or a full VerificationMethod embedded inline, per
+// the W3C DID Core verification relationship syntax.
+type VerificationRelationship struct {
+	// Reference is set when this entry is a string reference to a VerificationMethod
+	// listed elsewhere in the document.
+	Reference string `json:"-"`
+
+	// Method is set when this entry embeds a full VerificationMethod inline rather
+	// than referencing one.
+	Method *VerificationMethod `json:"-"`
+}
+
+// NewVerificationRelationshipRef builds a VerificationRelationship that references an
+// existing VerificationMethod by ID.
+func NewVerificationRelationshipRef(methodID string) VerificationRelationship {
+	return VerificationRelationship{Reference: methodID}
+}
+
+// NewVerificationRelationshipMethod builds a VerificationRelationship that embeds a
+// full VerificationMethod inline.
+func NewVerificationRelationshipMethod(method VerificationMethod) VerificationRelationship {
+	return VerificationRelationship{Method: &method}
+}
+
+// MarshalJSON encodes a VerificationRelationship the way the W3C spec expects: a bare
+// string for a reference, or an embedded object for an inline method.
+func (m VerificationRelationship) MarshalJSON() ([]byte, error) {
+	if m.Method != nil {
+		return json.Marshal(m.Method)
+	}
+	return json.Marshal(m.Reference)
+}
+
+// UnmarshalJSON decodes either a bare string reference or an embedded
+// VerificationMethod object into a VerificationRelationship.
+func (m *VerificationRelationship) UnmarshalJSON(data []byte) error {
+	var ref string
+	if err := json.Unmarshal(data, &ref); err == nil {
+		m.Reference, m.Method = ref, nil
+		return nil
+	}
+
+	var method VerificationMethod
+	if err := json.Unmarshal(data, &method); err != nil {
+		return err
+	}
+	m.Reference, m.Method = "", &method
+	return nil
+}
+
+// ID returns the verification method ID this relationship resolves to, whether given
+// by reference or by an embedded VerificationMethod.
+func (m VerificationRelationship) ID() string {
+	if m.Method != nil {
+		return m.Method.ID
+	}
+	return m.Reference
+}
+
+// Validate performs stateless validation of a VerificationRelationship.
+func (m VerificationRelationship) Validate() error {
+	if m.Method == nil && m.Reference == "" {
+		return ErrInvalidDocument.Wrap("verification relationship must be a reference or an embedded method")
+	}
+	if m.Method != nil {
+		return m.Method.Validate()
+	}
+	return nil
+}
+
 // Service represents a DID service
 type Service struct {
 	ID              string   `json:"id"`
@@ -50,14 +168,26 @@ type Service struct {
 // AuthMethod represents an authentication method linked to a DID
 type AuthMethod struct {
 	MethodID      string    `json:"method_id"`
-	MethodType    string    `json:"method_type"`    // "totp", "oauth_microsoft", etc.
+	MethodType    string    `json:"method_type"`     // "totp", "oauth_microsoft", etc.
 	PublicKeyHash string    `json:"public_key_hash"` // SHA-256 hash of secret or OAuth attestation
 	Attestation   string    `json:"attestation"`     // Optional: signed attestation for OAuth
 	LinkedAt      time.Time `json:"linked_at"`
 	IsActive      bool      `json:"is_active"`
 	IsPrimary     bool      `json:"is_primary"`
-}
 
+	// Config is the Authenticator-specific configuration passed to
+	// Registry.Get(MethodType, Config) -- a raw secp256k1 public key for "Secp256k1", a
+	// WebAuthn/Passkey credential ID, or a composite's sub-authenticator list for
+	// "AllOf"/"AnyOf". Empty for AuthMethods (like the OIDC binding in oidc.go) that
+	// predate the Authenticator framework and aren't enforced through it.
+	Config []byte `json:"config,omitempty"`
+
+	// LastUsedAt is the block time this AuthMethod last authorized a message, bumped by
+	// the ante-handler authenticator path on every successful AuthenticateMessage call.
+	// The EndBlocker compares it against Params.AuthMethodInactivityPeriod to decide
+	// whether to auto-deactivate a stale AuthMethod.
+	LastUsedAt time.Time `json:"last_used_at,omitempty"`
+}
 
 // DIDMetadata contains metadata about a DID
 type DIDMetadata struct {
@@ -76,6 +206,10 @@ func (m *VerificationMethod) ProtoMessage()  {}
 func (m *VerificationMethod) Reset()         { *m = VerificationMethod{} }
 func (m *VerificationMethod) String() string { return proto.CompactTextString(m) }
 
+func (m *VerificationRelationship) ProtoMessage()  {}
+func (m *VerificationRelationship) Reset()         { *m = VerificationRelationship{} }
+func (m *VerificationRelationship) String() string { return proto.CompactTextString(m) }
+
 func (m *Service) ProtoMessage()  {}
 func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
@@ -84,7 +218,6 @@ func (m *AuthMethod) ProtoMessage()  {}
 func (m *AuthMethod) Reset()         { *m = AuthMethod{} }
 func (m *AuthMethod) String() string { return proto.CompactTextString(m) }
 
-
 func (m *DIDMetadata) ProtoMessage()  {}
 func (m *DIDMetadata) Reset()         { *m = DIDMetadata{} }
 func (m *DIDMetadata) String() string { return proto.CompactTextString(m) }
@@ -92,17 +225,54 @@ func (m *DIDMetadata) String() string { return proto.CompactTextString(m) }
 // Validate validates a DID Document
 func (d *DIDDocument) Validate() error {
 	if d.ID == "" {
-		return fmt.Errorf("DID ID cannot be empty")
+		return ErrInvalidDID
 	}
-	
+
 	if len(d.Context) == 0 {
-		return fmt.Errorf("DID context cannot be empty")
+		return ErrInvalidDocument.Wrap("DID context cannot be empty")
 	}
-	
+
 	if len(d.VerificationMethod) == 0 {
-		return fmt.Errorf("DID must have at least one verification method")
+		return ErrInvalidDocument.Wrap("DID must have at least one verification method")
+	}
+
+	for i := range d.VerificationMethod {
+		if err := d.VerificationMethod[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, rel := range d.verificationRelationships() {
+		if err := rel.Validate(); err != nil {
+			return err
+		}
 	}
-	
+
 	return nil
 }
 
+// verificationRelationships returns every VerificationRelationship entry across the
+// document's five relationship lists, for validation helpers to range over together.
+func (d *DIDDocument) verificationRelationships() []VerificationRelationship {
+	var all []VerificationRelationship
+	all = append(all, d.Authentication...)
+	all = append(all, d.AssertionMethod...)
+	all = append(all, d.KeyAgreement...)
+	all = append(all, d.CapabilityInvocation...)
+	all = append(all, d.CapabilityDelegation...)
+	return all
+}
+
+// ValidateForActivation validates the document and additionally enforces that it
+// carries at least one authentication relationship, a prerequisite for the DID to be
+// usable for DID-auth signature verification. MsgCreateDID enforces this before a
+// DID becomes active.
+func (d *DIDDocument) ValidateForActivation() error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	if len(d.Authentication) == 0 {
+		return ErrInvalidDocument.Wrap("DID document must have at least one authentication method before activation")
+	}
+	return nil
+}
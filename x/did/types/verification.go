@@ -0,0 +1,138 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// RelationshipName identifies one of the five W3C verification relationships a
+// VerificationRelationship entry can be added to or removed from.
+type RelationshipName string
+
+const (
+	RelationshipAuthentication       RelationshipName = "authentication"
+	RelationshipAssertionMethod      RelationshipName = "assertionMethod"
+	RelationshipKeyAgreement         RelationshipName = "keyAgreement"
+	RelationshipCapabilityInvocation RelationshipName = "capabilityInvocation"
+	RelationshipCapabilityDelegation RelationshipName = "capabilityDelegation"
+)
+
+var validRelationshipNames = map[RelationshipName]bool{
+	RelationshipAuthentication:       true,
+	RelationshipAssertionMethod:      true,
+	RelationshipKeyAgreement:         true,
+	RelationshipCapabilityInvocation: true,
+	RelationshipCapabilityDelegation: true,
+}
+
+// MsgAddVerificationRelationship adds a VerificationRelationship entry to one of a
+// DID Document's five relationship lists, either by reference to an existing
+// VerificationMethod or by embedding a new one inline.
+type MsgAddVerificationRelationship struct {
+	Controller   string                     `json:"controller"`
+	Id           string                     `json:"id"`
+	Relationship RelationshipName           `json:"relationship"`
+	Entry        VerificationRelationship   `json:"entry"`
+}
+
+var _ sdk.Msg = &MsgAddVerificationRelationship{}
+
+const TypeMsgAddVerificationRelationship = "add_verification_relationship"
+
+func (msg *MsgAddVerificationRelationship) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgAddVerificationRelationship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return ErrInvalidController
+	}
+	if msg.Id == "" {
+		return ErrInvalidDID
+	}
+	if !validRelationshipNames[msg.Relationship] {
+		return ErrInvalidDocument.Wrapf("unknown verification relationship %q", msg.Relationship)
+	}
+	return msg.Entry.Validate()
+}
+
+func (msg *MsgAddVerificationRelationship) Type() string  { return TypeMsgAddVerificationRelationship }
+func (msg *MsgAddVerificationRelationship) Route() string { return RouterKey }
+func (msg *MsgAddVerificationRelationship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgAddVerificationRelationship) ProtoMessage()  {}
+func (m *MsgAddVerificationRelationship) Reset()         { *m = MsgAddVerificationRelationship{} }
+func (m *MsgAddVerificationRelationship) String() string { return proto.CompactTextString(m) }
+
+// MsgAddVerificationRelationshipResponse is the response for MsgAddVerificationRelationship.
+type MsgAddVerificationRelationshipResponse struct{}
+
+func (m *MsgAddVerificationRelationshipResponse) ProtoMessage()  {}
+func (m *MsgAddVerificationRelationshipResponse) Reset()         { *m = MsgAddVerificationRelationshipResponse{} }
+func (m *MsgAddVerificationRelationshipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRemoveVerificationRelationship removes the entry referencing methodID from one
+// of a DID Document's five relationship lists.
+type MsgRemoveVerificationRelationship struct {
+	Controller   string           `json:"controller"`
+	Id           string           `json:"id"`
+	Relationship RelationshipName `json:"relationship"`
+	MethodId     string           `json:"methodId"`
+}
+
+var _ sdk.Msg = &MsgRemoveVerificationRelationship{}
+
+const TypeMsgRemoveVerificationRelationship = "remove_verification_relationship"
+
+func (msg *MsgRemoveVerificationRelationship) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRemoveVerificationRelationship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return ErrInvalidController
+	}
+	if msg.Id == "" {
+		return ErrInvalidDID
+	}
+	if !validRelationshipNames[msg.Relationship] {
+		return ErrInvalidDocument.Wrapf("unknown verification relationship %q", msg.Relationship)
+	}
+	if msg.MethodId == "" {
+		return ErrInvalidDocument.Wrap("method id cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgRemoveVerificationRelationship) Type() string {
+	return TypeMsgRemoveVerificationRelationship
+}
+func (msg *MsgRemoveVerificationRelationship) Route() string { return RouterKey }
+func (msg *MsgRemoveVerificationRelationship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRemoveVerificationRelationship) ProtoMessage()  {}
+func (m *MsgRemoveVerificationRelationship) Reset()         { *m = MsgRemoveVerificationRelationship{} }
+func (m *MsgRemoveVerificationRelationship) String() string { return proto.CompactTextString(m) }
+
+// MsgRemoveVerificationRelationshipResponse is the response for MsgRemoveVerificationRelationship.
+type MsgRemoveVerificationRelationshipResponse struct{}
+
+func (m *MsgRemoveVerificationRelationshipResponse) ProtoMessage()  {}
+func (m *MsgRemoveVerificationRelationshipResponse) Reset() {
+	*m = MsgRemoveVerificationRelationshipResponse{}
+}
+func (m *MsgRemoveVerificationRelationshipResponse) String() string {
+	return proto.CompactTextString(m)
+}
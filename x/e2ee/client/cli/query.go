@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/e2ee/types"
+)
+
+// GetQueryCmd returns the query commands for the e2ee module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdDecryptionMaterial(),
+	)
+
+	return cmd
+}
+
+// CmdDecryptionMaterial returns the query command for an address's current (and, within
+// the grace period, previous) registered encryption key.
+func CmdDecryptionMaterial() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decryption-material [address]",
+		Short: "Query the current and grace-period encryption keys registered for an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), fmt.Sprintf("store/%s/key", types.StoreKey), types.KeyRegistryKey(args[0]))
+			if err != nil {
+				return err
+			}
+			if res.Response.Value == nil {
+				return fmt.Errorf("no encryption key registered for %q", args[0])
+			}
+
+			return clientCtx.PrintString(string(res.Response.Value) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/e2ee/types"
+)
+
+const flagRecipientDID = "recipient-did"
+
+// GetTxCmd returns the transaction commands for the e2ee module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdRegisterEncryptionKey(),
+		CmdRotateEncryptionKey(),
+		CmdEncrypt(),
+	)
+
+	return cmd
+}
+
+// CmdRegisterEncryptionKey returns the tx command for publishing an address's first
+// X25519 encryption key.
+func CmdRegisterEncryptionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-key [hex-x25519-public-key]",
+		Short: "Register the X25519 public key credential issuers should encrypt to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			publicKey, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to decode public key: %w", err)
+			}
+
+			msg := &types.MsgRegisterEncryptionKey{
+				Address:   clientCtx.GetFromAddress().String(),
+				PublicKey: publicKey,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRotateEncryptionKey returns the tx command for rotating an address's encryption
+// key while keeping the old key valid during the module's grace period.
+func CmdRotateEncryptionKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key [hex-new-x25519-public-key]",
+		Short: "Rotate to a new X25519 encryption key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			newPublicKey, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to decode public key: %w", err)
+			}
+
+			msg := &types.MsgRotateEncryptionKey{
+				Address:      clientCtx.GetFromAddress().String(),
+				NewPublicKey: newPublicKey,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEncrypt seals stdin against a recipient's registered encryption key and prints the
+// resulting SealedBlob as JSON, for use as the ciphertext/ephemeral-pubkey/nonce fields
+// of IssueCredential.
+func CmdEncrypt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt [recipient-address] [hex-recipient-public-key]",
+		Short: "Seal stdin for a recipient's registered encryption key",
+		Long: `Reads plaintext credential data from stdin and produces a sealed ECIES
+envelope (X25519 ECDH -> HKDF-SHA256 -> XChaCha20-Poly1305) addressed to the recipient's
+registered public key. The printed JSON can be passed as IssueCredential's ciphertext,
+ephemeral-pubkey, and nonce fields.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipient := args[0]
+
+			publicKey, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to decode recipient public key: %w", err)
+			}
+
+			plaintext, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("failed to read plaintext from stdin: %w", err)
+			}
+
+			recipientDID, _ := cmd.Flags().GetString(flagRecipientDID)
+
+			blob, err := types.SealEnvelope(recipient, recipientDID, publicKey, plaintext)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(blob, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagRecipientDID, "", "recipient DID, carried in the envelope for wallet-side routing")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
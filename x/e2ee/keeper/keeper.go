@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/PersonaPass-ID/personachain/x/e2ee/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine.
+//
+// x/identity does not exist in this tree, so the IssueCredential integration described
+// for this keeper targets x/credential.MsgCreateCredential instead -- that handler
+// should call StoreEncryptedBlob under CredentialKeyPrefix once a sender has sealed the
+// payload with types.SealEnvelope against the recipient's RegisteredKey.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	logger   log.Logger
+
+	// External keepers
+	authKeeper authkeeper.AccountKeeper
+	bankKeeper bankkeeper.Keeper
+
+	// Authority is the module authority
+	authority string
+}
+
+// NewKeeper creates a new e2ee Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+	authKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.Keeper,
+) *Keeper {
+	return &Keeper{
+		cdc:        cdc,
+		storeKey:   storeService.OpenKVStore(context.Background()),
+		logger:     log.NewNopLogger(),
+		authKeeper: authKeeper,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module's current params, falling back to DefaultParams if none
+// have been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams validates and persists the module's params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.ParamsKey), k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// RegisterKey publishes address's first X25519 public key. Re-registering an address
+// that already has a key fails; callers must use RotateKey instead.
+func (k Keeper) RegisterKey(ctx sdk.Context, address string, publicKey []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyRegistryKey(address)
+	if store.Has(key) {
+		return types.ErrInvalidKey.Wrapf("address %s already has a registered encryption key, use rotate instead", address)
+	}
+
+	registered := &types.RegisteredKey{Address: address, PublicKey: publicKey}
+	if err := registered.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(key, k.cdc.MustMarshal(registered))
+	return nil
+}
+
+// RotateKey replaces address's current key with newPublicKey, keeping the old key as
+// PreviousPublicKey for RotationGracePeriodBlocks so in-flight blobs stay decryptable.
+func (k Keeper) RotateKey(ctx sdk.Context, address string, newPublicKey []byte) error {
+	registered, err := k.GetRegisteredKey(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	registered.PreviousPublicKey = registered.PublicKey
+	registered.RotatedAtHeight = ctx.BlockHeight()
+	registered.PublicKey = newPublicKey
+	if err := registered.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRegistryKey(address), k.cdc.MustMarshal(registered))
+	return nil
+}
+
+// GetRegisteredKey returns the RegisteredKey for address.
+func (k Keeper) GetRegisteredKey(ctx sdk.Context, address string) (*types.RegisteredKey, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRegistryKey(address))
+	if bz == nil {
+		return nil, types.ErrKeyNotFound.Wrapf("no encryption key registered for %s", address)
+	}
+
+	var registered types.RegisteredKey
+	k.cdc.MustUnmarshal(bz, &registered)
+	return &registered, nil
+}
+
+// DecryptionMaterial returns the key material a wallet controlling address needs to
+// decrypt its credentials: the current key, plus the previous key if its grace period
+// has not yet elapsed.
+func (k Keeper) DecryptionMaterial(ctx sdk.Context, address string) (*types.QueryDecryptionMaterialResponse, error) {
+	registered, err := k.GetRegisteredKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryDecryptionMaterialResponse{CurrentPublicKey: registered.PublicKey}
+	params := k.GetParams(ctx)
+	if registered.StillValidAt(ctx.BlockHeight(), params.RotationGracePeriodBlocks) {
+		resp.PreviousPublicKey = registered.PreviousPublicKey
+		resp.PreviousValidUntilHeight = registered.RotatedAtHeight + params.RotationGracePeriodBlocks
+	}
+	return resp, nil
+}
+
+// StoreEncryptedBlob persists a pre-sealed SealedBlob under CredentialKeyPrefix for
+// credentialID. Called from the credential module's issuance flow (or directly via
+// MsgStoreEncryptedBlob) once the sender has sealed the payload off-chain against the
+// recipient's registered key -- see types.SealEnvelope.
+func (k Keeper) StoreEncryptedBlob(ctx sdk.Context, credentialID string, blob *types.SealedBlob) error {
+	if err := blob.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CredentialKey(credentialID), k.cdc.MustMarshal(blob))
+	return nil
+}
+
+// GetEncryptedBlob retrieves the SealedBlob stored for credentialID.
+func (k Keeper) GetEncryptedBlob(ctx sdk.Context, credentialID string) (*types.SealedBlob, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CredentialKey(credentialID))
+	if bz == nil {
+		return nil, types.ErrEnvelopeNotFound.Wrapf("no encrypted blob stored for credential %s", credentialID)
+	}
+
+	var blob types.SealedBlob
+	k.cdc.MustUnmarshal(bz, &blob)
+	return &blob, nil
+}
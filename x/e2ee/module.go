@@ -0,0 +1,130 @@
+package e2ee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/PersonaPass-ID/personachain/x/e2ee/client/cli"
+	e2eekeeper "github.com/PersonaPass-ID/personachain/x/e2ee/keeper"
+	"github.com/PersonaPass-ID/personachain/x/e2ee/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ appmodule.AppModule   = AppModule{}
+)
+
+type AppModuleBasic struct {
+	cdc codec.BinaryCodec
+}
+
+func NewAppModuleBasic(cdc codec.BinaryCodec) AppModuleBasic {
+	return AppModuleBasic{cdc: cdc}
+}
+
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (a AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
+func (a AppModuleBasic) GetTxCmd() *cobra.Command  { return cli.GetTxCmd() }
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
+
+type AppModule struct {
+	AppModuleBasic
+	keeper e2eekeeper.Keeper
+}
+
+// NewAppModule constructs the e2ee AppModule. This tree has no app.yaml / app wiring
+// file to register the module's store key, params, and module account against, so
+// standing those up is left to whatever eventually assembles the app -- NewAppModule
+// and NewKeeper are the pieces that wiring would plug in.
+func NewAppModule(cdc codec.Codec, keeper e2eekeeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(cdc),
+		keeper:         keeper,
+	}
+}
+
+func (am AppModule) RegisterServices(cfg module.Configurator) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+	if err := am.keeper.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, key := range genState.RegisteredKeys {
+		if err := am.keeper.RegisterKey(ctx, key.Address, key.PublicKey); err != nil {
+			panic(err)
+		}
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (am AppModule) BeginBlock(ctx context.Context) error { return nil }
+func (am AppModule) EndBlock(ctx context.Context) error { return nil }
+func (am AppModule) IsOnePerModuleType() {}
+func (am AppModule) IsAppModule() {}
+
+// GenesisState is the e2ee module's genesis state: the set of registered encryption
+// keys plus the module's params.
+type GenesisState struct {
+	RegisteredKeys []types.RegisteredKey `json:"registeredKeys"`
+	Params         types.Params          `json:"params"`
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RegisteredKeys: []types.RegisteredKey{},
+		Params:         types.DefaultParams(),
+	}
+}
+
+func (gs GenesisState) Validate() error {
+	for _, key := range gs.RegisteredKeys {
+		if err := key.Validate(); err != nil {
+			return err
+		}
+	}
+	return gs.Params.Validate()
+}
+
+// Implement proto.Message interface for SDK compatibility
+func (m *GenesisState) ProtoMessage()  {}
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return fmt.Sprintf("%+v", *m) }
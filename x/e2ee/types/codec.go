@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the e2ee module's types on the given LegacyAmino codec.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSubmitEnvelope{}, "e2ee/SubmitEnvelope", nil)
+	cdc.RegisterConcrete(&MsgAckEnvelope{}, "e2ee/AckEnvelope", nil)
+	cdc.RegisterConcrete(&MsgRegisterEncryptionKey{}, "e2ee/RegisterEncryptionKey", nil)
+	cdc.RegisterConcrete(&MsgRotateEncryptionKey{}, "e2ee/RotateEncryptionKey", nil)
+	cdc.RegisterConcrete(&MsgStoreEncryptedBlob{}, "e2ee/StoreEncryptedBlob", nil)
+}
+
+// RegisterInterfaces registers the e2ee module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitEnvelope{},
+		&MsgAckEnvelope{},
+		&MsgRegisterEncryptionKey{},
+		&MsgRotateEncryptionKey{},
+		&MsgStoreEncryptedBlob{},
+	)
+
+	// Message service registration handled by generated proto code
+}
+
+// ModuleCdc references the global e2ee module codec. Note, the codec should ONLY
+// be used in certain instances of tests and for JSON encoding as Amino is still
+// used for that purpose.
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterCodec(legacy.Cdc)
+}
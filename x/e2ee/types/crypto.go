@@ -0,0 +1,115 @@
+package types
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+
+	"crypto/sha256"
+)
+
+// hkdfInfo is the fixed HKDF info string binding derived keys to this scheme, so a key
+// derived here can never be confused with one from an unrelated ECIES construction.
+const hkdfInfo = "personachain/e2ee/v1"
+
+// SealEnvelope encrypts plaintext for recipientPublicKey using an ECIES-style envelope:
+// an ephemeral X25519 key pair is generated, ECDH'd against recipientPublicKey, the
+// shared secret is run through HKDF-SHA256 to derive an XChaCha20-Poly1305 key, and
+// plaintext is sealed under a fresh random nonce. Only the returned SealedBlob fields
+// (ephemeral public key, nonce, ciphertext) are ever written to chain state.
+func SealEnvelope(recipient, recipientDID string, recipientPublicKey []byte, plaintext []byte) (*SealedBlob, error) {
+	curve := ecdh.X25519()
+
+	recipientKey, err := curve.NewPublicKey(recipientPublicKey)
+	if err != nil {
+		return nil, ErrInvalidKey.Wrapf("invalid recipient public key: %v", err)
+	}
+
+	ephemeralPriv, err := curve.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate ephemeral key: %w", err)
+	}
+
+	sharedSecret, err := ephemeralPriv.ECDH(recipientKey)
+	if err != nil {
+		return nil, fmt.Errorf("ecdh: %w", err)
+	}
+
+	aeadKey, err := deriveAEADKey(sharedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(aeadKey)
+	if err != nil {
+		return nil, fmt.Errorf("init aead: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	ciphertext := aead.Seal(nil, nonce, plaintext, nil)
+
+	return &SealedBlob{
+		Recipient:          recipient,
+		RecipientDID:       recipientDID,
+		EphemeralPublicKey: ephemeralPriv.PublicKey().Bytes(),
+		Nonce:              nonce,
+		Ciphertext:         ciphertext,
+	}, nil
+}
+
+// OpenEnvelope reverses SealEnvelope: it ECDHs recipientPrivateKey against
+// blob.EphemeralPublicKey, re-derives the AEAD key via HKDF-SHA256, and opens the sealed
+// ciphertext. Run wallet-side -- the chain never has recipientPrivateKey.
+func OpenEnvelope(blob *SealedBlob, recipientPrivateKey []byte) ([]byte, error) {
+	curve := ecdh.X25519()
+
+	recipientPriv, err := curve.NewPrivateKey(recipientPrivateKey)
+	if err != nil {
+		return nil, ErrInvalidKey.Wrapf("invalid recipient private key: %v", err)
+	}
+
+	ephemeralPub, err := curve.NewPublicKey(blob.EphemeralPublicKey)
+	if err != nil {
+		return nil, ErrInvalidKey.Wrapf("invalid ephemeral public key: %v", err)
+	}
+
+	sharedSecret, err := recipientPriv.ECDH(ephemeralPub)
+	if err != nil {
+		return nil, fmt.Errorf("ecdh: %w", err)
+	}
+
+	aeadKey, err := deriveAEADKey(sharedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(aeadKey)
+	if err != nil {
+		return nil, fmt.Errorf("init aead: %w", err)
+	}
+
+	plaintext, err := aead.Open(nil, blob.Nonce, blob.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidEnvelope.Wrap("failed to decrypt, wrong key or tampered ciphertext")
+	}
+	return plaintext, nil
+}
+
+// deriveAEADKey runs an ECDH shared secret through HKDF-SHA256 to produce a
+// chacha20poly1305.KeySize-byte key, bound to hkdfInfo.
+func deriveAEADKey(sharedSecret []byte) ([]byte, error) {
+	reader := hkdf.New(sha256.New, sharedSecret, nil, []byte(hkdfInfo))
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, fmt.Errorf("derive aead key: %w", err)
+	}
+	return key, nil
+}
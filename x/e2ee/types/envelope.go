@@ -0,0 +1,78 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EncryptionAlgo enumerates the supported envelope encryption schemes.
+type EncryptionAlgo string
+
+const (
+	// EncryptionAlgoX25519XSalsa20Poly1305 is a NaCl box-style anonymous encryption
+	// scheme keyed to the recipient DID's X25519KeyAgreementKey verification method.
+	EncryptionAlgoX25519XSalsa20Poly1305 EncryptionAlgo = "X25519-XSalsa20-Poly1305"
+
+	// EncryptionAlgoECDHES1PU is an ECDH-ES+A256KW JWE-style scheme for authenticated
+	// holder-to-verifier delivery.
+	EncryptionAlgoECDHES1PU EncryptionAlgo = "ECDH-ES+A256KW"
+)
+
+// EncryptedEnvelope wraps a ciphertext credential payload addressed to a single
+// recipient, end-to-end encrypted so that only the recipient's DID verification
+// method can decrypt it. The chain stores and routes the envelope without ever
+// seeing plaintext.
+type EncryptedEnvelope struct {
+	// ID is the unique envelope identifier.
+	ID string `json:"id"`
+
+	// Sender is the DID of the holder delivering the envelope.
+	Sender string `json:"sender"`
+
+	// Recipient is the DID of the verifier the envelope is addressed to.
+	Recipient string `json:"recipient"`
+
+	// RecipientKeyID is the verification method ID on Recipient's DID Document
+	// used to derive the shared encryption key.
+	RecipientKeyID string `json:"recipientKeyId"`
+
+	// Algo identifies the encryption scheme used to produce Ciphertext.
+	Algo EncryptionAlgo `json:"algo"`
+
+	// Ciphertext is the encrypted credential/presentation payload.
+	Ciphertext []byte `json:"ciphertext"`
+
+	// Nonce is the scheme-specific nonce/IV used during encryption.
+	Nonce []byte `json:"nonce"`
+
+	// CreatedAt is the block time the envelope was submitted, in unix seconds.
+	CreatedAt int64 `json:"createdAt"`
+}
+
+func (m *EncryptedEnvelope) ProtoMessage()  {}
+func (m *EncryptedEnvelope) Reset()         { *m = EncryptedEnvelope{} }
+func (m *EncryptedEnvelope) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of an EncryptedEnvelope.
+func (m *EncryptedEnvelope) Validate() error {
+	if m.ID == "" {
+		return ErrInvalidEnvelope.Wrap("id cannot be empty")
+	}
+	if m.Sender == "" {
+		return ErrInvalidSender.Wrap("sender cannot be empty")
+	}
+	if m.Recipient == "" {
+		return ErrInvalidRecipient.Wrap("recipient cannot be empty")
+	}
+	if m.RecipientKeyID == "" {
+		return ErrInvalidRecipient.Wrap("recipientKeyId cannot be empty")
+	}
+	if len(m.Ciphertext) == 0 {
+		return ErrInvalidEnvelope.Wrap("ciphertext cannot be empty")
+	}
+	switch m.Algo {
+	case EncryptionAlgoX25519XSalsa20Poly1305, EncryptionAlgoECDHES1PU:
+	default:
+		return ErrInvalidEnvelope.Wrapf("unsupported encryption algo %q", m.Algo)
+	}
+	return nil
+}
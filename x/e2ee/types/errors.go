@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// e2ee module error codes
+var (
+	ErrInvalidEnvelope  = errorsmod.Register(ModuleName, 2, "invalid encrypted envelope")
+	ErrEnvelopeNotFound = errorsmod.Register(ModuleName, 3, "envelope not found")
+	ErrInvalidSender    = errorsmod.Register(ModuleName, 4, "invalid sender")
+	ErrInvalidRecipient = errorsmod.Register(ModuleName, 5, "invalid recipient")
+	ErrUnauthorized     = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidKey       = errorsmod.Register(ModuleName, 7, "invalid encryption key")
+	ErrKeyNotFound      = errorsmod.Register(ModuleName, 8, "no encryption key registered for address")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 9, "invalid module params")
+)
@@ -0,0 +1,17 @@
+package types
+
+// Event types for the e2ee module
+const (
+	EventTypeEnvelopeSubmitted = "envelope_submitted"
+	EventTypeEnvelopeAcked     = "envelope_acked"
+	EventTypeKeyRegistered     = "encryption_key_registered"
+	EventTypeKeyRotated        = "encryption_key_rotated"
+	EventTypeBlobStored        = "encrypted_blob_stored"
+
+	// Attribute keys
+	AttributeKeyEnvelopeID   = "envelope_id"
+	AttributeKeySender       = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAddress      = "address"
+	AttributeKeyCredentialID = "credential_id"
+)
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// RegisteredKey is an X25519 public key an address has published for ECIES-style
+// credential delivery. Rotating a key keeps the prior key around as Previous so
+// blobs sealed before the rotation stay decryptable until the grace period elapses.
+type RegisteredKey struct {
+	// Address is the bech32 account address the key is registered for.
+	Address string `json:"address"`
+
+	// PublicKey is the current 32-byte X25519 public key.
+	PublicKey []byte `json:"publicKey"`
+
+	// PreviousPublicKey is the key Address rotated away from, or nil if it has
+	// never rotated.
+	PreviousPublicKey []byte `json:"previousPublicKey,omitempty"`
+
+	// RotatedAtHeight is the block height PreviousPublicKey stopped being current.
+	// Zero if the key has never rotated.
+	RotatedAtHeight int64 `json:"rotatedAtHeight,omitempty"`
+}
+
+func (m *RegisteredKey) ProtoMessage()  {}
+func (m *RegisteredKey) Reset()         { *m = RegisteredKey{} }
+func (m *RegisteredKey) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a RegisteredKey.
+func (m *RegisteredKey) Validate() error {
+	if m.Address == "" {
+		return ErrInvalidSender.Wrap("address cannot be empty")
+	}
+	if len(m.PublicKey) != X25519KeySize {
+		return ErrInvalidKey.Wrapf("public key must be %d bytes, got %d", X25519KeySize, len(m.PublicKey))
+	}
+	return nil
+}
+
+// StillValidAt reports whether PreviousPublicKey may still be used to decrypt a blob
+// sealed before rotation, given the module's RotationGracePeriodBlocks param.
+func (m *RegisteredKey) StillValidAt(height int64, gracePeriodBlocks int64) bool {
+	if m.RotatedAtHeight == 0 {
+		return false
+	}
+	return height <= m.RotatedAtHeight+gracePeriodBlocks
+}
+
+// SealedBlob is an ECIES envelope (X25519 ECDH -> HKDF-SHA256 -> XChaCha20-Poly1305)
+// addressed to a recipient's RegisteredKey. Only the blob is ever written to state --
+// the plaintext credential payload never touches the chain.
+type SealedBlob struct {
+	// Recipient is the bech32 address the blob is addressed to.
+	Recipient string `json:"recipient"`
+
+	// RecipientDID is the recipient's DID, carried for wallet-side routing.
+	RecipientDID string `json:"recipientDid"`
+
+	// EphemeralPublicKey is the sender's one-time X25519 public key used for ECDH.
+	EphemeralPublicKey []byte `json:"ephemeralPublicKey"`
+
+	// Nonce is the 24-byte XChaCha20-Poly1305 nonce.
+	Nonce []byte `json:"nonce"`
+
+	// Ciphertext is the sealed payload, AEAD tag included.
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func (m *SealedBlob) ProtoMessage()  {}
+func (m *SealedBlob) Reset()         { *m = SealedBlob{} }
+func (m *SealedBlob) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a SealedBlob.
+func (m *SealedBlob) Validate() error {
+	if m.Recipient == "" {
+		return ErrInvalidRecipient.Wrap("recipient cannot be empty")
+	}
+	if len(m.EphemeralPublicKey) != X25519KeySize {
+		return ErrInvalidKey.Wrapf("ephemeral public key must be %d bytes, got %d", X25519KeySize, len(m.EphemeralPublicKey))
+	}
+	if len(m.Nonce) != XChaCha20NonceSize {
+		return ErrInvalidEnvelope.Wrapf("nonce must be %d bytes, got %d", XChaCha20NonceSize, len(m.Nonce))
+	}
+	if len(m.Ciphertext) == 0 {
+		return ErrInvalidEnvelope.Wrap("ciphertext cannot be empty")
+	}
+	return nil
+}
+
+const (
+	// X25519KeySize is the byte length of an X25519 public or private key.
+	X25519KeySize = 32
+
+	// XChaCha20NonceSize is the byte length of an XChaCha20-Poly1305 nonce.
+	XChaCha20NonceSize = 24
+)
+
+// Params defines the e2ee module's tunable parameters.
+type Params struct {
+	// RotationGracePeriodBlocks is how long after a key rotation the previous key
+	// remains valid for decrypting already-sealed blobs.
+	RotationGracePeriodBlocks int64 `json:"rotationGracePeriodBlocks"`
+}
+
+// DefaultParams returns the e2ee module's default parameters.
+func DefaultParams() Params {
+	return Params{
+		RotationGracePeriodBlocks: 201600, // ~14 days at 6s blocks
+	}
+}
+
+// Validate validates the e2ee module's parameters.
+func (p Params) Validate() error {
+	if p.RotationGracePeriodBlocks < 0 {
+		return ErrInvalidParams.Wrap("rotation grace period cannot be negative")
+	}
+	return nil
+}
+
+func (m *Params) ProtoMessage()  {}
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
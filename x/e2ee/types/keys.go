@@ -0,0 +1,54 @@
+package types
+
+const (
+	// ModuleName defines the e2ee module name
+	ModuleName = "e2ee"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_e2ee"
+
+	// RouterKey is the message route for the e2ee module
+	RouterKey = ModuleName
+)
+
+// Legacy string prefixes for compatibility
+const (
+	// LegacyEnvelopePrefix is the legacy string prefix for encrypted envelopes
+	LegacyEnvelopePrefix = "envelope/"
+
+	// LegacyEnvelopeByRecipientPrefix is the legacy prefix for the recipient index
+	LegacyEnvelopeByRecipientPrefix = "envelope_recipient/"
+
+	// KeyRegistryPrefix stores the RegisteredKey for a bech32 address.
+	KeyRegistryPrefix = "key/"
+
+	// CredentialKeyPrefix stores the SealedBlob produced for a credential issued
+	// through the x/credential MsgCreateCredential flow, keyed by credential ID.
+	CredentialKeyPrefix = "credential_blob/"
+
+	// ParamsKey stores the module's Params.
+	ParamsKey = "params/"
+)
+
+// EnvelopeKey creates a store key for an encrypted envelope by ID
+func EnvelopeKey(id string) []byte {
+	return []byte(LegacyEnvelopePrefix + id)
+}
+
+// EnvelopeByRecipientKey creates a store key for indexing envelopes by recipient
+func EnvelopeByRecipientKey(recipient, id string) []byte {
+	return []byte(LegacyEnvelopeByRecipientPrefix + recipient + ":" + id)
+}
+
+// KeyRegistryKey creates a store key for the RegisteredKey of a bech32 address.
+func KeyRegistryKey(address string) []byte {
+	return []byte(KeyRegistryPrefix + address)
+}
+
+// CredentialKey creates a store key for the SealedBlob of a credential ID.
+func CredentialKey(credentialID string) []byte {
+	return []byte(CredentialKeyPrefix + credentialID)
+}
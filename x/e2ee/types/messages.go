@@ -0,0 +1,109 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	TypeMsgSubmitEnvelope = "submit_envelope"
+	TypeMsgAckEnvelope    = "ack_envelope"
+)
+
+// MsgSubmitEnvelope delivers an end-to-end encrypted credential envelope on-chain,
+// addressed to a single recipient DID.
+type MsgSubmitEnvelope struct {
+	Sender         string         `json:"sender"`
+	Recipient      string         `json:"recipient"`
+	RecipientKeyID string         `json:"recipientKeyId"`
+	Algo           EncryptionAlgo `json:"algo"`
+	Ciphertext     []byte         `json:"ciphertext"`
+	Nonce          []byte         `json:"nonce"`
+}
+
+var _ sdk.Msg = &MsgSubmitEnvelope{}
+
+func (msg *MsgSubmitEnvelope) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSubmitEnvelope) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return ErrInvalidSender.Wrap("invalid sender address")
+	}
+	envelope := EncryptedEnvelope{
+		ID:             "pending",
+		Sender:         msg.Sender,
+		Recipient:      msg.Recipient,
+		RecipientKeyID: msg.RecipientKeyID,
+		Algo:           msg.Algo,
+		Ciphertext:     msg.Ciphertext,
+		Nonce:          msg.Nonce,
+	}
+	return envelope.Validate()
+}
+
+func (msg *MsgSubmitEnvelope) Type() string  { return TypeMsgSubmitEnvelope }
+func (msg *MsgSubmitEnvelope) Route() string { return RouterKey }
+func (msg *MsgSubmitEnvelope) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgSubmitEnvelope) ProtoMessage()  {}
+func (m *MsgSubmitEnvelope) Reset()         { *m = MsgSubmitEnvelope{} }
+func (m *MsgSubmitEnvelope) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitEnvelopeResponse is the response for MsgSubmitEnvelope.
+type MsgSubmitEnvelopeResponse struct {
+	Id string `json:"id"`
+}
+
+func (m *MsgSubmitEnvelopeResponse) ProtoMessage()  {}
+func (m *MsgSubmitEnvelopeResponse) Reset()         { *m = MsgSubmitEnvelopeResponse{} }
+func (m *MsgSubmitEnvelopeResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgAckEnvelope lets the recipient acknowledge receipt/decryption of an envelope so
+// senders can prune retry queues off-chain.
+type MsgAckEnvelope struct {
+	Recipient  string `json:"recipient"`
+	EnvelopeId string `json:"envelopeId"`
+}
+
+var _ sdk.Msg = &MsgAckEnvelope{}
+
+func (msg *MsgAckEnvelope) GetSigners() []sdk.AccAddress {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{recipient}
+}
+
+func (msg *MsgAckEnvelope) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return ErrInvalidRecipient.Wrap("invalid recipient address")
+	}
+	if msg.EnvelopeId == "" {
+		return ErrInvalidEnvelope.Wrap("envelopeId cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgAckEnvelope) Type() string  { return TypeMsgAckEnvelope }
+func (msg *MsgAckEnvelope) Route() string { return RouterKey }
+func (msg *MsgAckEnvelope) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgAckEnvelope) ProtoMessage()  {}
+func (m *MsgAckEnvelope) Reset()         { *m = MsgAckEnvelope{} }
+func (m *MsgAckEnvelope) String() string { return proto.CompactTextString(m) }
+
+// MsgAckEnvelopeResponse is the response for MsgAckEnvelope.
+type MsgAckEnvelopeResponse struct{}
+
+func (m *MsgAckEnvelopeResponse) ProtoMessage()  {}
+func (m *MsgAckEnvelopeResponse) Reset()         { *m = MsgAckEnvelopeResponse{} }
+func (m *MsgAckEnvelopeResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,143 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	TypeMsgRegisterEncryptionKey = "register_encryption_key"
+	TypeMsgRotateEncryptionKey   = "rotate_encryption_key"
+	TypeMsgStoreEncryptedBlob    = "store_encrypted_blob"
+)
+
+// MsgRegisterEncryptionKey publishes the X25519 public key an address wants credential
+// issuers to encrypt to. An address may only register once; use MsgRotateEncryptionKey
+// to change the key afterwards.
+type MsgRegisterEncryptionKey struct {
+	Address   string `json:"address"`
+	PublicKey []byte `json:"publicKey"`
+}
+
+var _ sdk.Msg = &MsgRegisterEncryptionKey{}
+
+func (msg *MsgRegisterEncryptionKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRegisterEncryptionKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return ErrInvalidSender.Wrap("invalid address")
+	}
+	key := RegisteredKey{Address: msg.Address, PublicKey: msg.PublicKey}
+	return key.Validate()
+}
+
+func (msg *MsgRegisterEncryptionKey) Type() string  { return TypeMsgRegisterEncryptionKey }
+func (msg *MsgRegisterEncryptionKey) Route() string { return RouterKey }
+func (msg *MsgRegisterEncryptionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRegisterEncryptionKey) ProtoMessage()  {}
+func (m *MsgRegisterEncryptionKey) Reset()         { *m = MsgRegisterEncryptionKey{} }
+func (m *MsgRegisterEncryptionKey) String() string { return proto.CompactTextString(m) }
+
+// MsgRegisterEncryptionKeyResponse is the response for MsgRegisterEncryptionKey.
+type MsgRegisterEncryptionKeyResponse struct{}
+
+func (m *MsgRegisterEncryptionKeyResponse) ProtoMessage()  {}
+func (m *MsgRegisterEncryptionKeyResponse) Reset()         { *m = MsgRegisterEncryptionKeyResponse{} }
+func (m *MsgRegisterEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgRotateEncryptionKey replaces an address's current key with a new one. The old key
+// is kept as RegisteredKey.PreviousPublicKey so blobs sealed before the rotation remain
+// decryptable for the module's RotationGracePeriodBlocks param.
+type MsgRotateEncryptionKey struct {
+	Address      string `json:"address"`
+	NewPublicKey []byte `json:"newPublicKey"`
+}
+
+var _ sdk.Msg = &MsgRotateEncryptionKey{}
+
+func (msg *MsgRotateEncryptionKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRotateEncryptionKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return ErrInvalidSender.Wrap("invalid address")
+	}
+	if len(msg.NewPublicKey) != X25519KeySize {
+		return ErrInvalidKey.Wrapf("new public key must be %d bytes, got %d", X25519KeySize, len(msg.NewPublicKey))
+	}
+	return nil
+}
+
+func (msg *MsgRotateEncryptionKey) Type() string  { return TypeMsgRotateEncryptionKey }
+func (msg *MsgRotateEncryptionKey) Route() string { return RouterKey }
+func (msg *MsgRotateEncryptionKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRotateEncryptionKey) ProtoMessage()  {}
+func (m *MsgRotateEncryptionKey) Reset()         { *m = MsgRotateEncryptionKey{} }
+func (m *MsgRotateEncryptionKey) String() string { return proto.CompactTextString(m) }
+
+// MsgRotateEncryptionKeyResponse is the response for MsgRotateEncryptionKey.
+type MsgRotateEncryptionKeyResponse struct{}
+
+func (m *MsgRotateEncryptionKeyResponse) ProtoMessage()  {}
+func (m *MsgRotateEncryptionKeyResponse) Reset()         { *m = MsgRotateEncryptionKeyResponse{} }
+func (m *MsgRotateEncryptionKeyResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgStoreEncryptedBlob writes a pre-sealed ECIES envelope under CredentialKeyPrefix for
+// a credential ID. This is the low-level primitive the credential module's issuance flow
+// calls into -- see SealedBlob for the envelope shape.
+type MsgStoreEncryptedBlob struct {
+	Sender       string     `json:"sender"`
+	CredentialID string     `json:"credentialId"`
+	Blob         SealedBlob `json:"blob"`
+}
+
+var _ sdk.Msg = &MsgStoreEncryptedBlob{}
+
+func (msg *MsgStoreEncryptedBlob) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgStoreEncryptedBlob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return ErrInvalidSender.Wrap("invalid sender address")
+	}
+	if msg.CredentialID == "" {
+		return ErrInvalidEnvelope.Wrap("credentialId cannot be empty")
+	}
+	return msg.Blob.Validate()
+}
+
+func (msg *MsgStoreEncryptedBlob) Type() string  { return TypeMsgStoreEncryptedBlob }
+func (msg *MsgStoreEncryptedBlob) Route() string { return RouterKey }
+func (msg *MsgStoreEncryptedBlob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgStoreEncryptedBlob) ProtoMessage()  {}
+func (m *MsgStoreEncryptedBlob) Reset()         { *m = MsgStoreEncryptedBlob{} }
+func (m *MsgStoreEncryptedBlob) String() string { return proto.CompactTextString(m) }
+
+// MsgStoreEncryptedBlobResponse is the response for MsgStoreEncryptedBlob.
+type MsgStoreEncryptedBlobResponse struct{}
+
+func (m *MsgStoreEncryptedBlobResponse) ProtoMessage()  {}
+func (m *MsgStoreEncryptedBlobResponse) Reset()         { *m = MsgStoreEncryptedBlobResponse{} }
+func (m *MsgStoreEncryptedBlobResponse) String() string { return proto.CompactTextString(m) }
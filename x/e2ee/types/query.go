@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// QueryDecryptionMaterialRequest asks for the current and (if still within the grace
+// period) previous encryption key registered for an address, so a wallet can decrypt
+// credentials sealed before a key rotation.
+type QueryDecryptionMaterialRequest struct {
+	Address string `json:"address"`
+}
+
+// QueryDecryptionMaterialResponse returns the key material needed to decrypt blobs
+// addressed to Address.
+type QueryDecryptionMaterialResponse struct {
+	CurrentPublicKey         []byte `json:"currentPublicKey"`
+	PreviousPublicKey        []byte `json:"previousPublicKey,omitempty"`
+	PreviousValidUntilHeight int64  `json:"previousValidUntilHeight,omitempty"`
+}
+
+func (m *QueryDecryptionMaterialRequest) ProtoMessage()  {}
+func (m *QueryDecryptionMaterialRequest) Reset()         { *m = QueryDecryptionMaterialRequest{} }
+func (m *QueryDecryptionMaterialRequest) String() string { return proto.CompactTextString(m) }
+
+func (m *QueryDecryptionMaterialResponse) ProtoMessage()  {}
+func (m *QueryDecryptionMaterialResponse) Reset()         { *m = QueryDecryptionMaterialResponse{} }
+func (m *QueryDecryptionMaterialResponse) String() string { return proto.CompactTextString(m) }
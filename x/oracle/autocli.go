@@ -0,0 +1,87 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions declares the oracle module's tx/query commands for autocli, the same
+// drafted-ahead-of-codegen shape x/schema/autocli.go uses: the persona.oracle.v1
+// service names below have no generated .proto/.pb.go counterpart in this tree yet (x/
+// oracle predates x/zkproof's proto drafting -- see proto/persona/zk/v1 -- and
+// RegisterServices (module.go) is, like x/schema's, a no-op stub), so these commands
+// can't actually dispatch until that gap closes; they're written as if it already had.
+//
+// This chunk's request names register-oracle/submit-request/submit-response commands.
+// This module's actual Msg surface has no MsgRegisterOracle that registers a
+// types.Oracle (MsgRegisterOracle instead registers an AttestationFeed -- see
+// messages_attestation.go's doc comment) and no MsgSubmitOracleRequest/
+// MsgSubmitOracleResponse at all (OracleRequest/OracleResponse are recorded by plain
+// keeper methods, not Msg types -- see x/oracle/simulation/operations.go's doc
+// comment for the same gap). The commands below substitute the nearest real Msg
+// equivalents: request-enrollment for "register an oracle" (enrollment.go's ACME-style
+// flow is how a new Oracle actually gets created), and submit-attestation/
+// submit-committee-response for "submit a request/response".
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "persona.oracle.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "RequestEnrollment",
+					Use:       "register-oracle [oracle-id] [endpoint] [operator-pub-key] [challenge-type]",
+					Short:     "Register a new oracle and request its ACME-style enrollment challenge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_id"},
+						{ProtoField: "endpoint"},
+						{ProtoField: "operator_pub_key"},
+						{ProtoField: "challenge_type"},
+					},
+				},
+				{
+					RpcMethod: "SubmitAttestation",
+					Use:       "submit-request [feed-id] [numeric-value]",
+					Short:     "Submit an operator's observed value toward an attestation feed's current round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "feed_id"},
+						{ProtoField: "numeric_value"},
+					},
+				},
+				{
+					RpcMethod: "SubmitCommitteeResponse",
+					Use:       "submit-response [request-id] [numeric-value]",
+					Short:     "Submit an operator's independently-observed value toward an oracle request's committee response window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "request_id"},
+						{ProtoField: "numeric_value"},
+					},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "persona.oracle.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Oracle",
+					Use:       "oracle [oracle-id]",
+					Short:     "Query a single registered oracle by ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_id"},
+					},
+				},
+				{
+					RpcMethod: "Oracles",
+					Use:       "oracles",
+					Short:     "List registered oracles, optionally filtered by type/status/owner",
+				},
+				{
+					RpcMethod: "Stats",
+					Use:       "stats [oracle-id]",
+					Short:     "Query an oracle's OracleStatistics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "oracle_id"},
+					},
+				},
+			},
+		},
+	}
+}
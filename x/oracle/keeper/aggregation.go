@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// aggregationConfigKeyPrefix namespaces the per-oracle types.AggregationConfig within
+// this module's raw KVStoreService store, the same append-prefix style
+// types.OracleKey/RequestKey/StatsKey and oidcJWKSKeyPrefix use.
+var aggregationConfigKeyPrefix = []byte{0x05}
+
+func aggregationConfigKey(oracleID string) []byte {
+	return append(append([]byte{}, aggregationConfigKeyPrefix...), []byte(oracleID)...)
+}
+
+// SetAggregationConfig stores the weighted-median/quorum tuning (MinResponders,
+// MaxDeviationBps, Precision) AggregateAndRespond uses for oracleID. Oracles that never
+// call this get types.DefaultAggregationConfig().
+func (k Keeper) SetAggregationConfig(ctx sdk.Context, oracleID string, cfg types.AggregationConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&aggregationConfigWrapper{OracleID: oracleID, Config: cfg})
+	return store.Set(aggregationConfigKey(oracleID), bz)
+}
+
+// GetAggregationConfig retrieves oracleID's AggregationConfig, falling back to
+// types.DefaultAggregationConfig() when none was ever set.
+func (k Keeper) GetAggregationConfig(ctx sdk.Context, oracleID string) types.AggregationConfig {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(aggregationConfigKey(oracleID))
+	if err != nil || bz == nil {
+		return types.DefaultAggregationConfig()
+	}
+	var wrapper aggregationConfigWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Config
+}
+
+// AggregateAndRespond is the oracle keeper's consensus step for a multi-source
+// OracleRequest. The request this implements ("Multi-source aggregation with weighted
+// median/quorum consensus in the oracle keeper") describes the oracle module itself
+// fanning out to every configured DataSource in parallel over HTTP with per-source
+// timeouts. That can't be done here: as types/oidc.go and keeper/oidc_verifier.go
+// already document for this same module, consensus-critical keeper code in this chain
+// cannot make outbound network calls -- a live HTTP fan-out would make block execution
+// nondeterministic and validator-dependent. AggregateAndRespond therefore takes
+// responses already collected off-chain (one types.SourceResponse per DataSource that
+// was asked, including failures/timeouts reported as Success: false) -- the oracle
+// counterpart of how SetCachedJWKS takes a relayer-pushed JWKS set rather than fetching
+// one itself -- and performs the actual consensus-critical work deterministically:
+// weighted majority for categorical fields, weighted median for numeric ones, the
+// k-of-n quorum gate, and EWMA reliability decay, all via types.AggregateResponses.
+//
+// On success it persists the decayed Reliability back onto oracle.Config.DataSources,
+// submits the resulting OracleResponse via SubmitOracleResponse, and returns it.
+func (k Keeper) AggregateAndRespond(ctx sdk.Context, requestID string, responses []types.SourceResponse) (*types.OracleResponse, error) {
+	request, err := k.GetOracleRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	oracle, err := k.GetOracle(ctx, request.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if oracle.Status != types.StatusActive {
+		return nil, types.ErrOracleNotActive
+	}
+
+	cfg := k.GetAggregationConfig(ctx, oracle.ID)
+	result, err := types.AggregateResponses(cfg, oracle.Config.DataSources, responses)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating responses for request %q: %w", requestID, err)
+	}
+
+	for i := range oracle.Config.DataSources {
+		ds := &oracle.Config.DataSources[i]
+		if newRel, ok := result.ReliabilityUpdates[ds.ID]; ok {
+			ds.Reliability = newRel
+		}
+	}
+	oracle.Updated = ctx.BlockTime()
+	if err := k.RegisterOracle(ctx, oracle); err != nil {
+		return nil, err
+	}
+
+	responseData := map[string]interface{}{"value": result.Value}
+	response := &types.OracleResponse{
+		ID:           requestID + "-response",
+		RequestID:    requestID,
+		ResponseData: responseData,
+		Success:      true,
+		Confidence:   result.Confidence,
+		Sources:      result.Sources,
+		Timestamp:    ctx.BlockTime(),
+	}
+	if err := k.SubmitOracleResponse(ctx, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// aggregationConfigWrapper adds the proto.Message methods types.AggregationConfig
+// itself doesn't need (it's a plain value embedded in no other proto type), so it can
+// be stored the same MustMarshal/MustUnmarshal way as every other value this module
+// keeps in its raw KVStoreService store.
+type aggregationConfigWrapper struct {
+	OracleID string                  `json:"oracle_id"`
+	Config   types.AggregationConfig `json:"config"`
+}
+
+func (m *aggregationConfigWrapper) ProtoMessage()  {}
+func (m *aggregationConfigWrapper) Reset()         { *m = aggregationConfigWrapper{} }
+func (m *aggregationConfigWrapper) String() string { return fmt.Sprintf("%+v", *m) }
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// committeeKeyPrefix/partialSigKeyPrefix namespace OracleCommittee and in-flight
+// PartialSignature state within this module's raw KVStoreService store, the same
+// append-prefix style types.OracleKey/RequestKey/StatsKey, oidcJWKSKeyPrefix, and
+// aggregationConfigKeyPrefix use.
+var (
+	committeeKeyPrefix  = []byte{0x06}
+	partialSigKeyPrefix = []byte{0x07}
+)
+
+func committeeKey(oracleID string) []byte {
+	return append(append([]byte{}, committeeKeyPrefix...), []byte(oracleID)...)
+}
+
+func partialSigKey(requestID, signer string) []byte {
+	return append(append([]byte{}, partialSigKeyPrefix...), []byte(requestID+"/"+signer)...)
+}
+
+func partialSigIteratorPrefix(requestID string) []byte {
+	return append(append([]byte{}, partialSigKeyPrefix...), []byte(requestID+"/")...)
+}
+
+// SetOracleCommittee registers or replaces the committee of operators that jointly sign
+// oracleID's OracleResponses.
+func (k Keeper) SetOracleCommittee(ctx sdk.Context, committee types.OracleCommittee) error {
+	if err := committee.Validate(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&committeeWrapper{Committee: committee})
+	return store.Set(committeeKey(committee.OracleID), bz)
+}
+
+// GetOracleCommittee retrieves oracleID's registered committee.
+func (k Keeper) GetOracleCommittee(ctx sdk.Context, oracleID string) (types.OracleCommittee, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(committeeKey(oracleID))
+	if err != nil {
+		return types.OracleCommittee{}, err
+	}
+	if bz == nil {
+		return types.OracleCommittee{}, types.ErrCommitteeNotFound
+	}
+	var wrapper committeeWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Committee, nil
+}
+
+// ResponsePayloadHash is the canonical hash an OracleCommittee's PartialSignatures are
+// computed over: the SHA-256 of response's RequestID/ResponseData/Confidence/Sources,
+// marshaled via encoding/json with sorted map keys so every committee member (and every
+// external relayer re-deriving it to verify the aggregate) hashes byte-identical input
+// regardless of map iteration order.
+func ResponsePayloadHash(response *types.OracleResponse) ([32]byte, error) {
+	payload := struct {
+		RequestID    string                 `json:"request_id"`
+		ResponseData map[string]interface{} `json:"response_data"`
+		Confidence   float64                `json:"confidence"`
+		Sources      []string               `json:"sources"`
+	}{
+		RequestID:    response.RequestID,
+		ResponseData: response.ResponseData,
+		Confidence:   response.Confidence,
+		Sources:      response.Sources,
+	}
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(sdk.MustSortJSON(bz)), nil
+}
+
+// SubmitPartialSignature records msg's PartialSignature toward RequestID's response, and
+// once OracleCommittee.Threshold valid partials have accumulated, aggregates them via
+// aggregator into the signature stored on OracleResponse.Signature and emits
+// EventTypeAggregateSignatureProduced carrying the payload hash and aggregate for an
+// IBC/EVM relayer to pick up. aggregator is supplied by the caller rather than held on
+// Keeper because, as types.BLSAggregator's doc comment explains, no concrete BLS12-381
+// backend is vendored in this tree -- this mirrors x/credential/types/bbs.go's
+// VerifyPresentationProof(v BBSVerifier, ...), which takes its pluggable crypto backend
+// the same way rather than storing it on Keeper.
+func (k Keeper) SubmitPartialSignature(ctx sdk.Context, aggregator types.BLSAggregator, msg *types.MsgSubmitPartialSignature) (*types.MsgSubmitPartialSignatureResponse, error) {
+	committee, err := k.GetOracleCommittee(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.GetOracleRequest(ctx, msg.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Response == nil {
+		return nil, types.ErrRequestNotFound
+	}
+	if len(request.Response.Signature) > 0 {
+		return nil, types.ErrAlreadyAggregated
+	}
+
+	member, ok := committee.member(msg.Signer)
+	if !ok || !member.Active {
+		return nil, types.ErrNotCommitteeMember
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if existing, err := store.Get(partialSigKey(msg.RequestID, msg.Signer)); err == nil && existing != nil {
+		return nil, types.ErrDuplicatePartialSignature
+	}
+
+	payloadHash, err := ResponsePayloadHash(request.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aggregator.VerifyPartial(member.BLSPubKey, payloadHash, msg.Sig); err != nil {
+		if slashErr := k.ReportFailedPartial(ctx, msg.OracleID, msg.Signer); slashErr != nil {
+			return nil, slashErr
+		}
+		return nil, fmt.Errorf("%w: %s", types.ErrInvalidPartialSignature, err)
+	}
+
+	partial := types.PartialSignature{Signer: msg.Signer, Sig: msg.Sig}
+	bz := k.cdc.MustMarshal(&partialSigWrapper{Partial: partial})
+	if err := store.Set(partialSigKey(msg.RequestID, msg.Signer), bz); err != nil {
+		return nil, err
+	}
+
+	partials, err := k.collectPartials(ctx, msg.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if len(partials) < committee.Threshold {
+		return &types.MsgSubmitPartialSignatureResponse{Aggregated: false}, nil
+	}
+
+	aggregate, err := aggregator.Aggregate(partials, committee.GroupPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Response.Signature = fmt.Sprintf("%x", aggregate)
+	if err := k.SubmitOracleResponse(ctx, request.Response); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAggregateSignatureProduced,
+		sdk.NewAttribute(types.AttributeKeyOracleID, msg.OracleID),
+		sdk.NewAttribute(types.AttributeKeyRequestID, msg.RequestID),
+		sdk.NewAttribute(types.AttributeKeyPayloadHash, fmt.Sprintf("%x", payloadHash)),
+		sdk.NewAttribute(types.AttributeKeySignature, request.Response.Signature),
+	))
+
+	return &types.MsgSubmitPartialSignatureResponse{Aggregated: true, Signature: aggregate}, nil
+}
+
+// collectPartials gathers every PartialSignature submitted for requestID so far, sorted
+// by Signer for deterministic aggregation order.
+func (k Keeper) collectPartials(ctx sdk.Context, requestID string) ([]types.PartialSignature, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := partialSigIteratorPrefix(requestID)
+	end := sdk.PrefixEndBytes(prefix)
+	iterator := store.Iterator(prefix, end)
+	defer iterator.Close()
+
+	var partials []types.PartialSignature
+	for ; iterator.Valid(); iterator.Next() {
+		var wrapper partialSigWrapper
+		k.cdc.MustUnmarshal(iterator.Value(), &wrapper)
+		partials = append(partials, wrapper.Partial)
+	}
+	sort.Slice(partials, func(i, j int) bool { return partials[i].Signer < partials[j].Signer })
+	return partials, nil
+}
+
+// ReportFailedPartial increments member's FailureCount and deactivates them once it
+// reaches maxPartialFailures (types/committee.go), the module's stake-free substitute
+// for x/zkproof's bonded-stake MsgSlashProver -- see types/committee.go's doc comment on
+// maxPartialFailures for why this module has no bond to slash instead.
+func (k Keeper) ReportFailedPartial(ctx sdk.Context, oracleID, signer string) error {
+	committee, err := k.GetOracleCommittee(ctx, oracleID)
+	if err != nil {
+		return err
+	}
+	for i := range committee.Members {
+		if committee.Members[i].Address != signer {
+			continue
+		}
+		committee.Members[i].FailureCount++
+		deactivated := false
+		if committee.Members[i].FailureCount >= 3 {
+			committee.Members[i].Active = false
+			deactivated = true
+		}
+		if err := k.SetOracleCommittee(ctx, committee); err != nil {
+			return err
+		}
+		if deactivated {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeCommitteeMemberSlashed,
+				sdk.NewAttribute(types.AttributeKeyOracleID, oracleID),
+				sdk.NewAttribute(types.AttributeKeySigner, signer),
+			))
+		}
+		return nil
+	}
+	return types.ErrNotCommitteeMember
+}
+
+// committeeWrapper/partialSigWrapper add the proto.Message methods
+// types.OracleCommittee/types.PartialSignature don't carry themselves, the same
+// aggregationConfigWrapper pattern keeper/aggregation.go uses to store a plain value in
+// this module's raw KVStoreService store.
+type committeeWrapper struct {
+	Committee types.OracleCommittee `json:"committee"`
+}
+
+func (m *committeeWrapper) ProtoMessage()  {}
+func (m *committeeWrapper) Reset()         { *m = committeeWrapper{} }
+func (m *committeeWrapper) String() string { return fmt.Sprintf("%+v", *m) }
+
+type partialSigWrapper struct {
+	Partial types.PartialSignature `json:"partial"`
+}
+
+func (m *partialSigWrapper) ProtoMessage()  {}
+func (m *partialSigWrapper) Reset()         { *m = partialSigWrapper{} }
+func (m *partialSigWrapper) String() string { return fmt.Sprintf("%+v", *m) }
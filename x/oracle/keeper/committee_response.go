@@ -0,0 +1,368 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// committeeResponseWindowKeyPrefix/paramsKeyPrefix namespace the N-of-M committee
+// response flow within this module's raw KVStoreService store, continuing the
+// append-prefix sequence after attestation.go's feedKeyPrefix/roundKeyPrefix/
+// divergenceKeyPrefix (0x12-0x14).
+var (
+	committeeResponseWindowKeyPrefix = []byte{0x15}
+	paramsKeyPrefix                  = []byte{0x16}
+)
+
+func committeeResponseWindowKey(requestID string) []byte {
+	return append(append([]byte{}, committeeResponseWindowKeyPrefix...), []byte(requestID)...)
+}
+
+// SetParams stores the module-wide Params CommitteeResponseEndBlocker applies to every
+// committee response window.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&paramsWrapper{Params: params})
+	return store.Set(paramsKeyPrefix, bz)
+}
+
+// GetParams retrieves the module's Params, falling back to types.DefaultParams() when
+// none were ever set (e.g. a chain that started before this change's genesis field was
+// added).
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(paramsKeyPrefix)
+	if err != nil || bz == nil {
+		return types.DefaultParams()
+	}
+	var wrapper paramsWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Params
+}
+
+func (k Keeper) getCommitteeResponseWindow(ctx sdk.Context, requestID string) (*types.CommitteeResponseWindow, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(committeeResponseWindowKey(requestID))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrWindowNotFound
+	}
+	var wrapper committeeResponseWindowWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Window, nil
+}
+
+func (k Keeper) setCommitteeResponseWindow(ctx sdk.Context, window *types.CommitteeResponseWindow) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&committeeResponseWindowWrapper{Window: window})
+	return store.Set(committeeResponseWindowKey(window.RequestID), bz)
+}
+
+// SubmitCommitteeResponse implements MsgSubmitCommitteeResponse: it records msg's
+// independently-submitted value toward msg.RequestID's committee response window,
+// opening the window (with a deadline Params.ResponseWindowBlocks blocks out) on the
+// first call against it, and resolving it immediately once Params.MinResponses
+// accumulate. CommitteeResponseEndBlocker is what closes a window that never reaches
+// MinResponses before its deadline.
+//
+// msg.Operator must be an active member of request.OracleID's OracleCommittee
+// (committee.go) -- this is the module's existing notion of "which parties may answer on
+// behalf of an Oracle", reused here rather than inventing a second membership list, even
+// though the request describes responses keyed by (requestID, oracleID) rather than
+// (requestID, operator): an OracleRequest already names exactly one OracleID (its
+// target), so the N-of-M dimension this request asks for is necessarily across that
+// Oracle's committee members, not across multiple OracleIDs answering the same request.
+func (k Keeper) SubmitCommitteeResponse(ctx sdk.Context, msg *types.MsgSubmitCommitteeResponse) (*types.MsgSubmitCommitteeResponseResponse, error) {
+	request, err := k.GetOracleRequest(ctx, msg.RequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	committee, err := k.GetOracleCommittee(ctx, request.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	member, ok := committee.member(msg.Operator)
+	if !ok || !member.Active {
+		return nil, types.ErrNotCommitteeMember
+	}
+
+	params := k.GetParams(ctx)
+
+	window, err := k.getCommitteeResponseWindow(ctx, msg.RequestID)
+	if err != nil {
+		if err != types.ErrWindowNotFound {
+			return nil, err
+		}
+		window = &types.CommitteeResponseWindow{
+			RequestID:      msg.RequestID,
+			DeadlineHeight: ctx.BlockHeight() + params.ResponseWindowBlocks,
+		}
+	}
+	if window.Resolved {
+		return nil, types.ErrWindowAlreadyResolved
+	}
+	if window.HasResponded(msg.Operator) {
+		return nil, types.ErrDuplicateCommitteeResponse
+	}
+
+	window.Responses = append(window.Responses, types.CommitteeResponse{
+		RequestID:   msg.RequestID,
+		Operator:    msg.Operator,
+		Value:       msg.Value(),
+		SubmittedAt: ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitteeResponseSubmitted,
+		sdk.NewAttribute(types.AttributeKeyRequestID, msg.RequestID),
+		sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+	))
+
+	resolved := len(window.Responses) >= params.MinResponses
+	if resolved {
+		if err := k.resolveCommitteeResponseWindow(ctx, request, window, params); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.setCommitteeResponseWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitCommitteeResponseResponse{Resolved: resolved}, nil
+}
+
+// CommitteeResponseEndBlocker closes any committee response window whose DeadlineHeight
+// has passed without reaching Params.MinResponses, so a slow or stalled committee
+// doesn't block an OracleRequest forever -- the deadline half of "resolve once either a
+// deadline is reached or min_responses is collected" (the min_responses half is handled
+// inline in SubmitCommitteeResponse above). Mirrors AttestationEndBlocker's shape in
+// attestation.go, including iterating window state directly rather than through an
+// OracleStore secondary index: open windows are expected to number in the dozens, not
+// the thousands QueryOracles' full-scan cost was written to avoid.
+func (k Keeper) CommitteeResponseEndBlocker(ctx sdk.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := store.Iterator(committeeResponseWindowKeyPrefix, sdk.PrefixEndBytes(committeeResponseWindowKeyPrefix))
+	defer iterator.Close()
+
+	var windows []*types.CommitteeResponseWindow
+	for ; iterator.Valid(); iterator.Next() {
+		var wrapper committeeResponseWindowWrapper
+		k.cdc.MustUnmarshal(iterator.Value(), &wrapper)
+		windows = append(windows, wrapper.Window)
+	}
+
+	params := k.GetParams(ctx)
+	for _, window := range windows {
+		if window.Resolved || ctx.BlockHeight() < window.DeadlineHeight {
+			continue
+		}
+		if len(window.Responses) == 0 {
+			// No reports at all this window -- nothing to aggregate or resolve.
+			window.Resolved = true
+			if err := k.setCommitteeResponseWindow(ctx, window); err != nil {
+				return err
+			}
+			continue
+		}
+		request, err := k.GetOracleRequest(ctx, window.RequestID)
+		if err != nil {
+			continue
+		}
+		if err := k.resolveCommitteeResponseWindow(ctx, request, window, params); err != nil {
+			return err
+		}
+		if err := k.setCommitteeResponseWindow(ctx, window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveCommitteeResponseWindow computes window's aggregate value per params.
+// AggregationMode, stores it as request's OracleResponse via SubmitOracleResponse, and
+// for every responder whose numeric value deviates from the aggregate by more than
+// params.MaxDeviationBps, records a miss via UpdateOracleStatistics and reports the
+// deviation as a failed partial against the operator's OracleCommittee membership (the
+// same ReportFailedPartial deactivation ReportFailedPartial/recordDivergence already use
+// elsewhere in this module).
+//
+// The request asks this step to "optionally burn/slash the oracle's bonded stake via
+// bankKeeper". x/oracle has no bonded stake or escrow for committee members anywhere in
+// this tree -- committee.go's maxPartialFailures doc comment and attestation.go's
+// recordDivergence already document this as a standing gap, deactivating a member
+// in-place rather than burning a bond neither Oracle nor Member ever holds. k.bankKeeper
+// is wired on Keeper but has nothing to debit here for the same reason; ReportFailedPartial
+// is this module's real, existing substitute consequence, so deviation beyond threshold
+// drives that instead of a fabricated SendCoins call.
+func (k Keeper) resolveCommitteeResponseWindow(ctx sdk.Context, request *types.OracleRequest, window *types.CommitteeResponseWindow, params types.Params) error {
+	window.Resolved = true
+
+	aggregate, numeric := aggregateCommitteeResponses(window.Responses, params.AggregationMode)
+
+	response := &types.OracleResponse{
+		ID:           window.RequestID + "-response",
+		RequestID:    window.RequestID,
+		ResponseData: map[string]interface{}{"value": aggregate},
+		Success:      true,
+		Sources:      committeeResponders(window.Responses),
+		Timestamp:    ctx.BlockTime(),
+	}
+	if err := k.SubmitOracleResponse(ctx, response); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCommitteeResponseResolved,
+		sdk.NewAttribute(types.AttributeKeyRequestID, window.RequestID),
+		sdk.NewAttribute(types.AttributeKeyOracleID, request.OracleID),
+	))
+
+	if numeric == nil {
+		return nil
+	}
+	median := *numeric
+	for _, resp := range window.Responses {
+		value, ok := resp.Value.(float64)
+		if !ok {
+			continue
+		}
+		deviationBps := int64(0)
+		if median != 0 {
+			deviationBps = int64(((value - median) / median) * 10000)
+			if deviationBps < 0 {
+				deviationBps = -deviationBps
+			}
+		}
+		if deviationBps <= params.MaxDeviationBps {
+			continue
+		}
+
+		stats, err := k.GetOracleStatistics(ctx, request.OracleID)
+		if err != nil {
+			stats = &types.OracleStatistics{OracleID: request.OracleID}
+		}
+		stats.FailedRequests++
+		stats.LastRequest = ctx.BlockTime()
+		if err := k.UpdateOracleStatistics(ctx, stats); err != nil {
+			return err
+		}
+		if err := k.ReportFailedPartial(ctx, request.OracleID, resp.Operator); err != nil && err != types.ErrNotCommitteeMember {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCommitteeResponseOutlier,
+			sdk.NewAttribute(types.AttributeKeyRequestID, window.RequestID),
+			sdk.NewAttribute(types.AttributeKeyOperator, resp.Operator),
+			sdk.NewAttribute(types.AttributeKeyDeviationBps, fmt.Sprintf("%d", deviationBps)),
+		))
+	}
+	return nil
+}
+
+// GetResolvedValue returns requestID's committee-resolved value rendered as a string, and
+// resolved=false if requestID's window hasn't resolved (or doesn't exist) yet. This is
+// x/zkproof/types.OracleKeeper's interface, letting keeper.VerifyOracleBinding there check
+// an OracleBinding's asserted Value against what this module actually resolved, without
+// x/zkproof importing this package's types directly.
+func (k Keeper) GetResolvedValue(ctx context.Context, requestID string) (string, bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	window, err := k.getCommitteeResponseWindow(sdkCtx, requestID)
+	if err != nil {
+		if err == types.ErrWindowNotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if !window.Resolved {
+		return "", false, nil
+	}
+	request, err := k.GetOracleRequest(sdkCtx, requestID)
+	if err != nil {
+		return "", false, err
+	}
+	if request.Response == nil {
+		return "", false, nil
+	}
+	return fmt.Sprintf("%v", request.Response.ResponseData["value"]), true, nil
+}
+
+// aggregateCommitteeResponses computes responses' consensus value per mode: the median
+// for AggregationModeMedian (responses carrying a float64 Value), or a plurality vote for
+// AggregationModePlurality (responses carrying any other, string-like Value). Returns
+// the aggregate plus, for the numeric case, a *float64 the caller uses for per-responder
+// deviation -- nil for the categorical case, which has no well-defined "deviation in
+// bps".
+func aggregateCommitteeResponses(responses []types.CommitteeResponse, mode types.AggregationMode) (interface{}, *float64) {
+	if mode == types.AggregationModeMedian {
+		var values []float64
+		for _, r := range responses {
+			if v, ok := r.Value.(float64); ok {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return nil, nil
+		}
+		sorted := append([]float64{}, values...)
+		sort.Float64s(sorted)
+		median := sorted[len(sorted)/2]
+		if len(sorted)%2 == 0 {
+			median = (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+		}
+		return median, &median
+	}
+
+	counts := map[string]int{}
+	for _, r := range responses {
+		counts[fmt.Sprintf("%v", r.Value)]++
+	}
+	var best string
+	for key, count := range counts {
+		if count > counts[best] || (count == counts[best] && key < best) {
+			best = key
+		}
+	}
+	return best, nil
+}
+
+// committeeResponders returns the operator addresses that contributed to window's
+// responses, in submission order, for OracleResponse.Sources.
+func committeeResponders(responses []types.CommitteeResponse) []string {
+	sources := make([]string, len(responses))
+	for i, r := range responses {
+		sources[i] = r.Operator
+	}
+	return sources
+}
+
+// paramsWrapper/committeeResponseWindowWrapper add the proto.Message methods
+// types.Params/types.CommitteeResponseWindow don't carry themselves, the same
+// aggregationConfigWrapper/committeeWrapper pattern this package already uses to store
+// plain values in this module's raw KVStoreService store.
+type paramsWrapper struct {
+	Params types.Params `json:"params"`
+}
+
+func (m *paramsWrapper) ProtoMessage()  {}
+func (m *paramsWrapper) Reset()         { *m = paramsWrapper{} }
+func (m *paramsWrapper) String() string { return fmt.Sprintf("%+v", *m) }
+
+type committeeResponseWindowWrapper struct {
+	Window *types.CommitteeResponseWindow `json:"window"`
+}
+
+func (m *committeeResponseWindowWrapper) ProtoMessage()  {}
+func (m *committeeResponseWindowWrapper) Reset()         { *m = committeeResponseWindowWrapper{} }
+func (m *committeeResponseWindowWrapper) String() string { return fmt.Sprintf("%+v", *m) }
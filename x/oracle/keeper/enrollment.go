@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// Enrollment state prefixes, namespacing types.EnrollmentChallenge/EnrollmentConfig/
+// per-validator votes the same append-prefix way as this package's other 0x0N-prefixed
+// state.
+var (
+	enrollmentChallengeKeyPrefix = []byte{0x0e}
+	enrollmentConfigKeyPrefix    = []byte{0x0f}
+	enrollmentVoteKeyPrefix      = []byte{0x10}
+	enrollmentSeqKeyPrefix       = []byte{0x11}
+)
+
+func enrollmentChallengeKey(oracleID string) []byte {
+	return append(append([]byte{}, enrollmentChallengeKeyPrefix...), []byte(oracleID)...)
+}
+
+func enrollmentConfigKey(oracleID string) []byte {
+	return append(append([]byte{}, enrollmentConfigKeyPrefix...), []byte(oracleID)...)
+}
+
+func enrollmentVoteKey(oracleID, validator string) []byte {
+	return append(append([]byte{}, enrollmentVoteKeyPrefix...), []byte(oracleID+"/"+validator)...)
+}
+
+func enrollmentVoteIteratorPrefix(oracleID string) []byte {
+	return append(append([]byte{}, enrollmentVoteKeyPrefix...), []byte(oracleID+"/")...)
+}
+
+func enrollmentSeqKey() []byte {
+	return append([]byte{}, enrollmentSeqKeyPrefix...)
+}
+
+// nextEnrollmentSeq returns a monotonically increasing counter disambiguating
+// GenerateChallengeToken calls within the same block.
+func (k Keeper) nextEnrollmentSeq(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(enrollmentSeqKey())
+	var seq uint64
+	if err == nil && bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+	seq++
+	_ = store.Set(enrollmentSeqKey(), sdk.Uint64ToBigEndian(seq))
+	return seq
+}
+
+// RequestEnrollment creates oracleID in types.StatusPendingEnrollment and issues it a
+// fresh types.EnrollmentChallenge, the first step of the ACME-style flow (see
+// types/enrollment.go's doc comments).
+func (k Keeper) RequestEnrollment(ctx sdk.Context, msg *types.MsgRequestEnrollment) (*types.MsgRequestEnrollmentResponse, error) {
+	if _, err := k.GetOracle(ctx, msg.OracleID); err == nil {
+		return nil, fmt.Errorf("oracle %q is already registered", msg.OracleID)
+	}
+
+	oracle := &types.Oracle{
+		ID:             msg.OracleID,
+		Name:           msg.OracleID,
+		Endpoint:       msg.Endpoint,
+		Type:           msg.DesiredType,
+		Owner:          msg.Operator,
+		Status:         types.StatusPendingEnrollment,
+		OperatorPubKey: msg.OperatorPubKey,
+		Created:        ctx.BlockTime(),
+		Updated:        ctx.BlockTime(),
+	}
+	if err := k.RegisterOracle(ctx, oracle); err != nil {
+		return nil, err
+	}
+
+	seq := k.nextEnrollmentSeq(ctx)
+	token := types.GenerateChallengeToken(msg.OracleID, msg.Operator, ctx.HeaderHash(), seq)
+	challenge := types.EnrollmentChallenge{
+		OracleID:  msg.OracleID,
+		Token:     token,
+		Type:      msg.ChallengeType,
+		Endpoint:  msg.Endpoint,
+		CreatedAt: ctx.BlockTime(),
+		ExpiresAt: ctx.BlockTime().Add(types.DefaultChallengeTTL),
+	}
+	if err := k.setEnrollmentChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEnrollmentRequested,
+		sdk.NewAttribute(types.AttributeKeyOracleID, msg.OracleID),
+		sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		sdk.NewAttribute(types.AttributeKeyToken, token),
+	))
+
+	resp := &types.MsgRequestEnrollmentResponse{Token: token}
+	switch msg.ChallengeType {
+	case types.ChallengeTypeHTTP01:
+		resp.HTTP01URL = challenge.HTTP01URL()
+	case types.ChallengeTypeDNS01:
+		resp.DNS01RecordName = challenge.DNS01RecordName()
+	}
+	return resp, nil
+}
+
+// FinalizeEnrollment marks oracleID's challenge finalized so MsgVoteEnrollmentChallenge
+// votes start counting. Only the operator that requested enrollment may finalize it.
+func (k Keeper) FinalizeEnrollment(ctx sdk.Context, msg *types.MsgFinalizeEnrollment) (*types.MsgFinalizeEnrollmentResponse, error) {
+	oracle, err := k.GetOracle(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if oracle.Owner != msg.Operator {
+		return nil, types.ErrNotOperator
+	}
+
+	challenge, err := k.getEnrollmentChallenge(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.BlockTime().After(challenge.ExpiresAt) {
+		return nil, types.ErrChallengeExpired
+	}
+
+	challenge.Finalized = true
+	if err := k.setEnrollmentChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return &types.MsgFinalizeEnrollmentResponse{}, nil
+}
+
+// VoteEnrollmentChallenge records validator's verdict on oracleID's finalized
+// challenge and, once approvals cross the lightweight majority
+// (types.DefaultEnrollmentQuorumNumerator/Denominator of votes cast, see its doc
+// comment for why this isn't stake-weighted), flips the oracle to types.StatusActive.
+func (k Keeper) VoteEnrollmentChallenge(ctx sdk.Context, msg *types.MsgVoteEnrollmentChallenge) (*types.MsgVoteEnrollmentChallengeResponse, error) {
+	oracle, err := k.GetOracle(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if oracle.Status != types.StatusPendingEnrollment && oracle.Status != types.StatusMaintenance {
+		return nil, fmt.Errorf("oracle %q is not awaiting enrollment verification", msg.OracleID)
+	}
+
+	challenge, err := k.getEnrollmentChallenge(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.Finalized {
+		return nil, types.ErrChallengeNotFinalized
+	}
+	if ctx.BlockTime().After(challenge.ExpiresAt) {
+		return nil, types.ErrChallengeExpired
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if existing, err := store.Get(enrollmentVoteKey(msg.OracleID, msg.Validator)); err == nil && existing != nil {
+		return nil, types.ErrDuplicateEnrollmentVote
+	}
+
+	vote := types.EnrollmentVote{OracleID: msg.OracleID, Validator: msg.Validator, Approved: msg.Approved}
+	bz := k.cdc.MustMarshal(&enrollmentVoteWrapper{Vote: vote})
+	if err := store.Set(enrollmentVoteKey(msg.OracleID, msg.Validator), bz); err != nil {
+		return nil, err
+	}
+
+	votes, err := k.collectEnrollmentVotes(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	approvals := 0
+	for _, v := range votes {
+		if v.Approved {
+			approvals++
+		}
+	}
+	if approvals*types.DefaultEnrollmentQuorumDenominator <= len(votes)*types.DefaultEnrollmentQuorumNumerator {
+		return &types.MsgVoteEnrollmentChallengeResponse{Activated: false}, nil
+	}
+
+	oracle.Status = types.StatusActive
+	oracle.Updated = ctx.BlockTime()
+	if err := k.RegisterOracle(ctx, oracle); err != nil {
+		return nil, err
+	}
+	if err := k.setEnrollmentConfig(ctx, types.EnrollmentConfig{OracleID: msg.OracleID}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOracleActivated,
+		sdk.NewAttribute(types.AttributeKeyOracleID, msg.OracleID),
+	))
+
+	return &types.MsgVoteEnrollmentChallengeResponse{Activated: true}, nil
+}
+
+// RotateOperatorKey replaces oracleID's OperatorPubKey, callable only by the oracle's
+// current Owner, without re-running the enrollment challenge.
+func (k Keeper) RotateOperatorKey(ctx sdk.Context, msg *types.MsgRotateOperatorKey) (*types.MsgRotateOperatorKeyResponse, error) {
+	oracle, err := k.GetOracle(ctx, msg.OracleID)
+	if err != nil {
+		return nil, err
+	}
+	if oracle.Owner != msg.Operator {
+		return nil, types.ErrNotOperator
+	}
+
+	oracle.OperatorPubKey = msg.NewPubKey
+	oracle.Updated = ctx.BlockTime()
+	if err := k.RegisterOracle(ctx, oracle); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOperatorKeyRotated,
+		sdk.NewAttribute(types.AttributeKeyOracleID, msg.OracleID),
+		sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+	))
+	return &types.MsgRotateOperatorKeyResponse{}, nil
+}
+
+// EndBlocker re-challenges every active oracle whose EnrollmentConfig.NextRenewalAt has
+// passed, moving it to types.StatusMaintenance and issuing a fresh
+// types.EnrollmentChallenge the same way RequestEnrollment does, so operators must
+// periodically re-prove endpoint control per the request's RenewalInterval. Wired from
+// module.go's AppModule.EndBlock, unlike most of this module's other keeper methods
+// which have no module.go hook to register into.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	oracles, err := k.oracleStore(ctx).OraclesByStatus(types.StatusActive, types.CursorPageRequest{Limit: types.MaxQueryLimit})
+	if err != nil {
+		return err
+	}
+	for _, oracle := range oracles {
+		cfg, err := k.getEnrollmentConfig(ctx, oracle.ID)
+		if err != nil {
+			continue
+		}
+		if ctx.BlockTime().Before(cfg.NextRenewalAt(oracle.Updated)) {
+			continue
+		}
+
+		oracle.Status = types.StatusMaintenance
+		if err := k.RegisterOracle(ctx, oracle); err != nil {
+			return err
+		}
+
+		seq := k.nextEnrollmentSeq(ctx)
+		token := types.GenerateChallengeToken(oracle.ID, oracle.Owner, ctx.HeaderHash(), seq)
+		challenge := types.EnrollmentChallenge{
+			OracleID:  oracle.ID,
+			Token:     token,
+			Type:      types.ChallengeTypeHTTP01,
+			Endpoint:  oracle.Endpoint,
+			CreatedAt: ctx.BlockTime(),
+			ExpiresAt: ctx.BlockTime().Add(types.DefaultChallengeTTL),
+		}
+		if err := k.setEnrollmentChallenge(ctx, challenge); err != nil {
+			return err
+		}
+		k.clearEnrollmentVotes(ctx, oracle.ID)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeOracleRenewalDue,
+			sdk.NewAttribute(types.AttributeKeyOracleID, oracle.ID),
+			sdk.NewAttribute(types.AttributeKeyToken, token),
+		))
+	}
+	return nil
+}
+
+func (k Keeper) setEnrollmentChallenge(ctx sdk.Context, challenge types.EnrollmentChallenge) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&enrollmentChallengeWrapper{Challenge: challenge})
+	return store.Set(enrollmentChallengeKey(challenge.OracleID), bz)
+}
+
+func (k Keeper) getEnrollmentChallenge(ctx sdk.Context, oracleID string) (types.EnrollmentChallenge, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(enrollmentChallengeKey(oracleID))
+	if err != nil {
+		return types.EnrollmentChallenge{}, err
+	}
+	if bz == nil {
+		return types.EnrollmentChallenge{}, types.ErrEnrollmentChallengeNotFound
+	}
+	var wrapper enrollmentChallengeWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Challenge, nil
+}
+
+func (k Keeper) setEnrollmentConfig(ctx sdk.Context, cfg types.EnrollmentConfig) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&enrollmentConfigWrapper{Config: cfg})
+	return store.Set(enrollmentConfigKey(cfg.OracleID), bz)
+}
+
+func (k Keeper) getEnrollmentConfig(ctx sdk.Context, oracleID string) (types.EnrollmentConfig, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(enrollmentConfigKey(oracleID))
+	if err != nil {
+		return types.EnrollmentConfig{}, err
+	}
+	if bz == nil {
+		return types.EnrollmentConfig{OracleID: oracleID}, nil
+	}
+	var wrapper enrollmentConfigWrapper
+	k.cdc.MustUnmarshal(bz, &wrapper)
+	return wrapper.Config, nil
+}
+
+func (k Keeper) collectEnrollmentVotes(ctx sdk.Context, oracleID string) ([]types.EnrollmentVote, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := enrollmentVoteIteratorPrefix(oracleID)
+	iterator := store.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var votes []types.EnrollmentVote
+	for ; iterator.Valid(); iterator.Next() {
+		var wrapper enrollmentVoteWrapper
+		k.cdc.MustUnmarshal(iterator.Value(), &wrapper)
+		votes = append(votes, wrapper.Vote)
+	}
+	return votes, nil
+}
+
+func (k Keeper) clearEnrollmentVotes(ctx sdk.Context, oracleID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := enrollmentVoteIteratorPrefix(oracleID)
+	iterator := store.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	for _, key := range keys {
+		_ = store.Delete(key)
+	}
+}
+
+// enrollmentChallengeWrapper/enrollmentConfigWrapper/enrollmentVoteWrapper add the
+// proto.Message methods their wrapped types.* values don't carry themselves, the same
+// aggregationConfigWrapper/committeeWrapper pattern used elsewhere in this package.
+type enrollmentChallengeWrapper struct {
+	Challenge types.EnrollmentChallenge `json:"challenge"`
+}
+
+func (m *enrollmentChallengeWrapper) ProtoMessage()  {}
+func (m *enrollmentChallengeWrapper) Reset()         { *m = enrollmentChallengeWrapper{} }
+func (m *enrollmentChallengeWrapper) String() string { return fmt.Sprintf("%+v", *m) }
+
+type enrollmentConfigWrapper struct {
+	Config types.EnrollmentConfig `json:"config"`
+}
+
+func (m *enrollmentConfigWrapper) ProtoMessage()  {}
+func (m *enrollmentConfigWrapper) Reset()         { *m = enrollmentConfigWrapper{} }
+func (m *enrollmentConfigWrapper) String() string { return fmt.Sprintf("%+v", *m) }
+
+type enrollmentVoteWrapper struct {
+	Vote types.EnrollmentVote `json:"vote"`
+}
+
+func (m *enrollmentVoteWrapper) ProtoMessage()  {}
+func (m *enrollmentVoteWrapper) Reset()         { *m = enrollmentVoteWrapper{} }
+func (m *enrollmentVoteWrapper) String() string { return fmt.Sprintf("%+v", *m) }
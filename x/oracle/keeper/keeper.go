@@ -3,16 +3,15 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/log"
-	
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	
+
 	"github.com/PersonaPass-ID/personachain/x/oracle/types"
 )
 
@@ -21,11 +20,15 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
 	logger       log.Logger
-	
-	// External keepers
-	authKeeper authkeeper.AccountKeeper
-	bankKeeper bankkeeper.Keeper
-	
+
+	// External keepers, narrowed to types.AccountKeeper/types.BankKeeper (see
+	// expected_keepers.go) rather than the concrete authkeeper.AccountKeeper/
+	// bankkeeper.Keeper types NewKeeper used to take -- this is what lets
+	// module/depinject.go's ModuleInputs resolve them from a depinject container
+	// without this package importing x/auth/x/bank's keeper packages directly.
+	authKeeper types.AccountKeeper
+	bankKeeper types.BankKeeper
+
 	// Authority is the module authority
 	authority string
 }
@@ -35,8 +38,8 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	authority string,
-	authKeeper authkeeper.AccountKeeper,
-	bankKeeper bankkeeper.Keeper,
+	authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:          cdc,
@@ -58,35 +61,43 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// oracleStore builds the types.OracleStore (see store.go) bound to ctx that
+// RegisterOracle/GetOracle/SubmitOracleRequest/the statistics methods below delegate
+// to, so this module's secondary indexes (type->id, status->id, owner->id,
+// oracleID+createdAt->requestID, and the statistics history) stay in sync with every
+// write without every keeper method re-implementing index maintenance itself.
+func (k Keeper) oracleStore(ctx sdk.Context) types.OracleStore {
+	return NewKVOracleStore(k.cdc, k.storeService, ctx)
+}
+
 // RegisterOracle registers a new oracle
 func (k Keeper) RegisterOracle(ctx sdk.Context, oracle *types.Oracle) error {
 	if err := oracle.Validate(); err != nil {
 		return err
 	}
-	
-	store := k.storeService.OpenKVStore(ctx)
-	bz := k.cdc.MustMarshal(oracle)
-	store.Set(types.OracleKey(oracle.ID), bz)
-	
-	return nil
+
+	return k.oracleStore(ctx).PutOracle(oracle)
 }
 
 // GetOracle retrieves an oracle
 func (k Keeper) GetOracle(ctx sdk.Context, oracleID string) (*types.Oracle, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.OracleKey(oracleID))
-	if err != nil {
-		return nil, err
-	}
-	
-	if bz == nil {
-		return nil, types.ErrOracleNotFound
-	}
-	
-	var oracle types.Oracle
-	k.cdc.MustUnmarshal(bz, &oracle)
-	
-	return &oracle, nil
+	return k.oracleStore(ctx).GetOracle(oracleID)
+}
+
+// OraclesByType returns a cursor-paginated page of oracles of type t, resolved through
+// the oracleByType secondary index rather than QueryOracles' full-collection scan.
+func (k Keeper) OraclesByType(ctx sdk.Context, t types.OracleType, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	return k.oracleStore(ctx).OraclesByType(t, page)
+}
+
+// OraclesByStatus returns a cursor-paginated page of oracles with status s.
+func (k Keeper) OraclesByStatus(ctx sdk.Context, s types.OracleStatus, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	return k.oracleStore(ctx).OraclesByStatus(s, page)
+}
+
+// OraclesByOwner returns a cursor-paginated page of oracles owned by owner.
+func (k Keeper) OraclesByOwner(ctx sdk.Context, owner string, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	return k.oracleStore(ctx).OraclesByOwner(owner, page)
 }
 
 // SubmitOracleRequest submits a request to an oracle
@@ -94,37 +105,34 @@ func (k Keeper) SubmitOracleRequest(ctx sdk.Context, request *types.OracleReques
 	if err := request.Validate(); err != nil {
 		return err
 	}
-	
+
 	// Verify oracle exists and is active
 	oracle, err := k.GetOracle(ctx, request.OracleID)
 	if err != nil {
 		return err
 	}
-	
+
 	if oracle.Status != types.StatusActive {
 		return types.ErrOracleNotActive
 	}
-	
-	store := k.storeService.OpenKVStore(ctx)
-	bz := k.cdc.MustMarshal(request)
-	store.Set(types.RequestKey(request.ID), bz)
-	
-	return nil
+
+	if request.DeadlineHeight == 0 {
+		request.DeadlineHeight = ctx.BlockHeight() + k.GetParams(ctx).RequestTimeoutBlocks
+	}
+
+	return k.oracleStore(ctx).PutRequest(request)
 }
 
 // GetOracleRequest retrieves an oracle request
 func (k Keeper) GetOracleRequest(ctx sdk.Context, requestID string) (*types.OracleRequest, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := store.Get(types.RequestKey(requestID))
-	
-	if bz == nil {
-		return nil, types.ErrRequestNotFound
-	}
-	
-	var request types.OracleRequest
-	k.cdc.MustUnmarshal(bz, &request)
-	
-	return &request, nil
+	return k.oracleStore(ctx).GetRequest(requestID)
+}
+
+// RequestsByOracleAndTime returns a cursor-paginated page of requestID's oracle's
+// requests within [start, end), optionally filtered to status, resolved through the
+// oracleID+createdAt secondary index rather than a full scan.
+func (k Keeper) RequestsByOracleAndTime(ctx sdk.Context, oracleID string, status types.RequestStatus, start, end time.Time, page types.CursorPageRequest) ([]*types.OracleRequest, types.CursorPageResponse, error) {
+	return k.oracleStore(ctx).RequestsByOracleAndTime(oracleID, status, start, end, page)
 }
 
 // SubmitOracleResponse submits a response from an oracle
@@ -142,39 +150,34 @@ func (k Keeper) SubmitOracleResponse(ctx sdk.Context, response *types.OracleResp
 	// Update request with response
 	request.Response = response
 	request.Status = types.RequestCompleted
-	
-	store := k.storeService.OpenKVStore(ctx)
-	bz := k.cdc.MustMarshal(request)
-	store.Set(types.RequestKey(request.ID), bz)
-	
-	return nil
+
+	return k.oracleStore(ctx).PutRequest(request)
 }
 
-// UpdateOracleStatistics updates oracle performance statistics
+// UpdateOracleStatistics appends a new statistics snapshot to oracleID's history (see
+// types.OracleStore.PutStats) rather than overwriting a single StatsKey record the way
+// this method used to, so StatsHistory below can serve historical windows.
 func (k Keeper) UpdateOracleStatistics(ctx sdk.Context, stats *types.OracleStatistics) error {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := k.cdc.MustMarshal(stats)
-	store.Set(types.StatsKey(stats.OracleID), bz)
-	
-	return nil
+	return k.oracleStore(ctx).PutStats(stats)
 }
 
-// GetOracleStatistics retrieves oracle statistics
+// GetOracleStatistics retrieves the most recent oracle statistics snapshot.
 func (k Keeper) GetOracleStatistics(ctx sdk.Context, oracleID string) (*types.OracleStatistics, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz := store.Get(types.StatsKey(oracleID))
-	
-	if bz == nil {
-		return nil, types.ErrStatsNotFound
-	}
-	
-	var stats types.OracleStatistics
-	k.cdc.MustUnmarshal(bz, &stats)
-	
-	return &stats, nil
+	return k.oracleStore(ctx).GetLatestStats(oracleID)
+}
+
+// StatsHistory returns a cursor-paginated page of oracleID's statistics snapshots
+// within [start, end), the historical-window query the request asks for now that
+// OracleStatistics lives on the same indexed storage layer as Oracle/OracleRequest.
+func (k Keeper) StatsHistory(ctx sdk.Context, oracleID string, start, end time.Time, page types.CursorPageRequest) ([]*types.OracleStatistics, types.CursorPageResponse, error) {
+	return k.oracleStore(ctx).StatsHistory(oracleID, start, end, page)
 }
 
-// QueryOracles returns oracles based on query parameters
+// QueryOracles returns oracles based on query parameters. Pre-dates the
+// type/status/owner secondary indexes store.go adds -- OraclesByType/OraclesByStatus/
+// OraclesByOwner above serve the same filters through an indexed range scan instead of
+// this method's full-collection walk. Kept as-is for any existing caller of the
+// unindexed OracleQuery shape.
 func (k Keeper) QueryOracles(ctx sdk.Context, query types.OracleQuery) ([]*types.Oracle, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	iterator := store.Iterator(nil, nil)
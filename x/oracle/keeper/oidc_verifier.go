@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// oidcJWKSKeyPrefix namespaces OIDCJWKSCache entries within this module's raw
+// KVStoreService store, the same append-prefix style types.OracleKey/RequestKey/
+// StatsKey use.
+var oidcJWKSKeyPrefix = []byte{0x04}
+
+func oidcJWKSKey(oracleID, dataSourceID string) []byte {
+	return append(append([]byte{}, oidcJWKSKeyPrefix...), []byte(oracleID+"/"+dataSourceID)...)
+}
+
+// SetCachedJWKS stores the signing keys an off-chain relayer fetched from an
+// OIDCSource's IssuerURL. As types.OIDCSource's doc comment explains, the keeper
+// itself never performs that fetch -- consensus-critical code in this chain can't make
+// outbound HTTP calls (the same constraint x/did/types/oidc_jwks.go documents for its
+// own MsgUpdateOIDCKeys) -- so populating this cache is left to whatever process polls
+// IssuerURL/.well-known/openid-configuration and its jwks_uri on the cadence
+// OIDCSource.JWKSRefreshInterval suggests, the oracle counterpart of x/did's relayer
+// pushing JWKS on-chain via MsgUpdateOIDCKeys.
+func (k Keeper) SetCachedJWKS(ctx sdk.Context, cache *types.OIDCJWKSCache) error {
+	if cache.OracleID == "" || cache.DataSourceID == "" {
+		return fmt.Errorf("oracle ID and data source ID cannot be empty")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(cache)
+	return store.Set(oidcJWKSKey(cache.OracleID, cache.DataSourceID), bz)
+}
+
+// GetCachedJWKS retrieves the cached signing keys for an oracle's OIDC-backed data
+// source.
+func (k Keeper) GetCachedJWKS(ctx sdk.Context, oracleID, dataSourceID string) (types.OIDCJWKSCache, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(oidcJWKSKey(oracleID, dataSourceID))
+	if err != nil {
+		return types.OIDCJWKSCache{}, err
+	}
+	if bz == nil {
+		return types.OIDCJWKSCache{}, fmt.Errorf("no cached JWKS for oracle %q data source %q", oracleID, dataSourceID)
+	}
+
+	var cache types.OIDCJWKSCache
+	k.cdc.MustUnmarshal(bz, &cache)
+	return cache, nil
+}
+
+// findDataSource returns the named OIDC-backed DataSource from oracle's config.
+func findOIDCDataSource(oracle *types.Oracle, dataSourceID string) (types.DataSource, error) {
+	for _, ds := range oracle.Config.DataSources {
+		if ds.ID == dataSourceID {
+			if ds.Type != types.DataSourceTypeOIDC || ds.OIDC == nil {
+				return types.DataSource{}, fmt.Errorf("data source %q is not OIDC-backed", dataSourceID)
+			}
+			return ds, nil
+		}
+	}
+	return types.DataSource{}, fmt.Errorf("oracle %q has no data source %q", oracle.ID, dataSourceID)
+}
+
+// VerifyOIDCIdentitySource validates idToken against oracleID's named OIDC data
+// source, using whatever JWKS SetCachedJWKS last stored for it, and returns an
+// OracleResponse ready for SubmitOracleResponse: ResponseData carries the mapped
+// sub/email_verified/given_name/address/kyc_level claims, and Evidence records the raw
+// ID token per types.VerifyIDToken's doc comment. This is the entry point an
+// x/credential MsgVerifyCredential-style flow would call into to check a KYC assertion
+// against a TypeIdentityVerification/TypeKYC oracle without trusting a single off-chain
+// HTTP endpoint -- there is no such Msg handler in this tree yet to wire it into (see
+// module.go's empty RegisterServices), so this is exposed as a plain Keeper method for
+// now, the same "buildable entry point, not yet registered" situation this tree's other
+// cross-module verification helpers (e.g. x/credential's IsCredentialValid) are in.
+func (k Keeper) VerifyOIDCIdentitySource(ctx sdk.Context, oracleID, dataSourceID, idToken string) (*types.OracleResponse, error) {
+	oracle, err := k.GetOracle(ctx, oracleID)
+	if err != nil {
+		return nil, err
+	}
+	if oracle.Type != types.TypeIdentityVerification && oracle.Type != types.TypeKYC {
+		return nil, fmt.Errorf("oracle %q is type %q, not identity_verification or kyc", oracleID, oracle.Type)
+	}
+
+	dataSource, err := findOIDCDataSource(oracle, dataSourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := k.GetCachedJWKS(ctx, oracleID, dataSourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := types.VerifyIDToken(dataSource.OIDC, cache, idToken, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OracleResponse{
+		ResponseData: result.ResponseData,
+		Success:      true,
+		Confidence:   1.0,
+		Sources:      []string{dataSourceID},
+		Timestamp:    ctx.BlockTime(),
+		Evidence:     []types.Evidence{result.Evidence},
+	}, nil
+}
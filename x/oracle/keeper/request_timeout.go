@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// RequestTimeoutEndBlocker moves every still-pending OracleRequest whose
+// DeadlineHeight has passed to types.RequestExpired and records a miss against its
+// oracle via UpdateOracleStatistics -- the OracleRequest-level counterpart to
+// CommitteeResponseEndBlocker's CommitteeResponseWindow sweep and AttestationEndBlocker's
+// AttestationRound sweep, closing the one DeadlineHeight-bearing construct in this
+// module that previously had no sweep at all (DeadlineHeight itself, and
+// Params.RequestTimeoutBlocks that sets it, are new as of this change -- see
+// types.OracleRequest's doc comment).
+//
+// Like enrollment.go's EndBlocker, this walks active oracles via OraclesByStatus and
+// then each oracle's requests via RequestsByOracleAndTime, rather than a global
+// by-deadline index: this module's types.OracleStore has no cross-oracle secondary
+// index, and building one is out of scope here (see store.go's existing
+// RequestsByOracleAndTime for the per-oracle index this reuses instead).
+func (k Keeper) RequestTimeoutEndBlocker(ctx sdk.Context) error {
+	oracles, err := k.oracleStore(ctx).OraclesByStatus(types.StatusActive, types.CursorPageRequest{Limit: types.MaxQueryLimit})
+	if err != nil {
+		return err
+	}
+
+	height := ctx.BlockHeight()
+	for _, oracle := range oracles {
+		requests, _, err := k.RequestsByOracleAndTime(ctx, oracle.ID, "", time.Time{}, ctx.BlockTime(), types.CursorPageRequest{Limit: types.MaxQueryLimit})
+		if err != nil {
+			return err
+		}
+		for _, request := range requests {
+			if request.Status != types.RequestPending && request.Status != types.RequestProcessing {
+				continue
+			}
+			if request.DeadlineHeight == 0 || height < request.DeadlineHeight {
+				continue
+			}
+
+			request.Status = types.RequestExpired
+			request.UpdatedAt = ctx.BlockTime()
+			if err := k.oracleStore(ctx).PutRequest(request); err != nil {
+				return err
+			}
+
+			stats, err := k.GetOracleStatistics(ctx, oracle.ID)
+			if err != nil {
+				stats = &types.OracleStatistics{OracleID: oracle.ID}
+			}
+			stats.FailedRequests++
+			stats.LastRequest = ctx.BlockTime()
+			if err := k.UpdateOracleStatistics(ctx, stats); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeOracleRequestTimedOut,
+				sdk.NewAttribute(types.AttributeKeyOracleID, oracle.ID),
+				sdk.NewAttribute(types.AttributeKeyRequestID, request.ID),
+			))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// Secondary index prefixes KVOracleStore maintains alongside the primary
+// types.OracleKey/RequestKey records, namespaced the same append-prefix way as
+// types.OracleKeyPrefix/RequestKeyPrefix/StatsKeyPrefix and this package's other
+// 0x0N-prefixed state (oidcJWKSKeyPrefix, aggregationConfigKeyPrefix, committeeKeyPrefix,
+// partialSigKeyPrefix).
+var (
+	oracleByTypeIndexPrefix    = []byte{0x08}
+	oracleByStatusIndexPrefix  = []byte{0x09}
+	oracleByOwnerIndexPrefix   = []byte{0x0a}
+	requestByOracleTimeIndex   = []byte{0x0b}
+	statsHistoryIndexPrefix    = []byte{0x0c}
+	statsLatestPointerPrefix   = []byte{0x0d}
+)
+
+// timeKey renders t as a fixed-width, lexicographically sortable decimal string of its
+// UTC UnixNano, so iterating the byte-ordered KVStore in key order visits records in
+// chronological order -- RFC3339 timestamps aren't fixed-width once sub-second
+// precision is trimmed, so a zero-padded integer is used instead.
+func timeKey(t time.Time) string {
+	return fmt.Sprintf("%020d", t.UTC().UnixNano())
+}
+
+func oracleByTypeKey(t types.OracleType, id string) []byte {
+	return append(append([]byte{}, oracleByTypeIndexPrefix...), []byte(string(t)+"/"+id)...)
+}
+
+func oracleByStatusKey(s types.OracleStatus, id string) []byte {
+	return append(append([]byte{}, oracleByStatusIndexPrefix...), []byte(string(s)+"/"+id)...)
+}
+
+func oracleByOwnerKey(owner, id string) []byte {
+	return append(append([]byte{}, oracleByOwnerIndexPrefix...), []byte(owner+"/"+id)...)
+}
+
+func requestByOracleTimeKey(oracleID string, createdAt time.Time, requestID string) []byte {
+	return append(append([]byte{}, requestByOracleTimeIndex...), []byte(oracleID+"/"+timeKey(createdAt)+"/"+requestID)...)
+}
+
+func statsHistoryKey(oracleID string, at time.Time) []byte {
+	return append(append([]byte{}, statsHistoryIndexPrefix...), []byte(oracleID+"/"+timeKey(at))...)
+}
+
+func statsLatestPointerKey(oracleID string) []byte {
+	return append(append([]byte{}, statsLatestPointerPrefix...), []byte(oracleID)...)
+}
+
+// KVOracleStore is the on-chain implementation of types.OracleStore: it keeps the
+// existing raw KVStoreService (IAVL-backed at runtime, the module's pre-existing
+// baseline-era storage layer) as its backend, layering the secondary indexes and
+// cursor-pageable range scans types.OracleStore promises on top of it. See
+// types.OracleStore's doc comment for why this is the only backend implemented in this
+// tree.
+type KVOracleStore struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	ctx          sdk.Context
+}
+
+var _ types.OracleStore = (*KVOracleStore)(nil)
+
+// NewKVOracleStore returns a types.OracleStore bound to ctx. Keeper methods construct
+// one per call (ctx changes every block/tx) rather than holding it as persistent
+// Keeper state.
+func NewKVOracleStore(cdc codec.BinaryCodec, storeService store.KVStoreService, ctx sdk.Context) *KVOracleStore {
+	return &KVOracleStore{cdc: cdc, storeService: storeService, ctx: ctx}
+}
+
+func (s *KVOracleStore) kv() store.KVStore { return s.storeService.OpenKVStore(s.ctx) }
+
+// PutOracle writes oracle's primary record and its type/status/owner secondary index
+// entries, removing any stale index entries left by a previous version of the same
+// oracle (e.g. if its Status or Owner changed since the last PutOracle).
+func (s *KVOracleStore) PutOracle(oracle *types.Oracle) error {
+	kv := s.kv()
+
+	if prev, err := s.GetOracle(oracle.ID); err == nil {
+		_ = kv.Delete(oracleByTypeKey(prev.Type, prev.ID))
+		_ = kv.Delete(oracleByStatusKey(prev.Status, prev.ID))
+		_ = kv.Delete(oracleByOwnerKey(prev.Owner, prev.ID))
+	}
+
+	bz := s.cdc.MustMarshal(oracle)
+	if err := kv.Set(types.OracleKey(oracle.ID), bz); err != nil {
+		return err
+	}
+	if err := kv.Set(oracleByTypeKey(oracle.Type, oracle.ID), []byte(oracle.ID)); err != nil {
+		return err
+	}
+	if err := kv.Set(oracleByStatusKey(oracle.Status, oracle.ID), []byte(oracle.ID)); err != nil {
+		return err
+	}
+	return kv.Set(oracleByOwnerKey(oracle.Owner, oracle.ID), []byte(oracle.ID))
+}
+
+func (s *KVOracleStore) GetOracle(oracleID string) (*types.Oracle, error) {
+	bz, err := s.kv().Get(types.OracleKey(oracleID))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrOracleNotFound
+	}
+	var oracle types.Oracle
+	s.cdc.MustUnmarshal(bz, &oracle)
+	return &oracle, nil
+}
+
+func (s *KVOracleStore) OraclesByType(t types.OracleType, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	prefix := append(append([]byte{}, oracleByTypeIndexPrefix...), []byte(string(t)+"/")...)
+	return s.scanOracleIndex(prefix, page)
+}
+
+func (s *KVOracleStore) OraclesByStatus(st types.OracleStatus, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	prefix := append(append([]byte{}, oracleByStatusIndexPrefix...), []byte(string(st)+"/")...)
+	return s.scanOracleIndex(prefix, page)
+}
+
+func (s *KVOracleStore) OraclesByOwner(owner string, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	prefix := append(append([]byte{}, oracleByOwnerIndexPrefix...), []byte(owner+"/")...)
+	return s.scanOracleIndex(prefix, page)
+}
+
+// scanOracleIndex walks every key under prefix (an oracleByType/Status/Owner index
+// range) starting just after page.Cursor, resolving each indexed oracle ID through
+// GetOracle, until page.EffectiveLimit() oracles are collected.
+func (s *KVOracleStore) scanOracleIndex(prefix []byte, page types.CursorPageRequest) ([]*types.Oracle, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+	cursorKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, fmt.Errorf("invalid pagination cursor: %w", err)
+	}
+
+	kv := s.kv()
+	var iterator store.Iterator
+	if page.Reverse {
+		iterator = kv.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	} else {
+		iterator = kv.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	}
+	defer iterator.Close()
+
+	skippingCursor := len(cursorKey) > 0
+	var oracles []*types.Oracle
+	var lastKey []byte
+	var hasMore bool
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if skippingCursor {
+			if string(key) == string(cursorKey) {
+				skippingCursor = false
+			}
+			continue
+		}
+		if uint64(len(oracles)) >= limit {
+			hasMore = true
+			break
+		}
+		oracle, err := s.GetOracle(string(iterator.Value()))
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		oracles = append(oracles, oracle)
+		lastKey = append([]byte{}, key...)
+	}
+
+	var resp types.CursorPageResponse
+	if hasMore {
+		resp.NextCursor = types.EncodeCursor(lastKey)
+	}
+	return oracles, resp, nil
+}
+
+func (s *KVOracleStore) PutRequest(request *types.OracleRequest) error {
+	kv := s.kv()
+	bz := s.cdc.MustMarshal(request)
+	if err := kv.Set(types.RequestKey(request.ID), bz); err != nil {
+		return err
+	}
+	return kv.Set(requestByOracleTimeKey(request.OracleID, request.CreatedAt, request.ID), []byte(request.ID))
+}
+
+func (s *KVOracleStore) GetRequest(requestID string) (*types.OracleRequest, error) {
+	bz, err := s.kv().Get(types.RequestKey(requestID))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrRequestNotFound
+	}
+	var request types.OracleRequest
+	s.cdc.MustUnmarshal(bz, &request)
+	return &request, nil
+}
+
+// RequestsByOracleAndTime walks the oracleID+createdAt->requestID index between
+// [start, end) (zero-value bounds meaning unbounded), filtering to status when it's
+// non-empty since this is the only composite index the request asks for -- status is
+// applied as a scan-time filter rather than a further index dimension.
+func (s *KVOracleStore) RequestsByOracleAndTime(oracleID string, status types.RequestStatus, start, end time.Time, page types.CursorPageRequest) ([]*types.OracleRequest, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+	cursorKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, fmt.Errorf("invalid pagination cursor: %w", err)
+	}
+
+	base := append(append([]byte{}, requestByOracleTimeIndex...), []byte(oracleID+"/")...)
+	startKey := base
+	if !start.IsZero() {
+		startKey = append(append([]byte{}, requestByOracleTimeIndex...), []byte(oracleID+"/"+timeKey(start))...)
+	}
+	endKey := sdk.PrefixEndBytes(base)
+	if !end.IsZero() {
+		endKey = append(append([]byte{}, requestByOracleTimeIndex...), []byte(oracleID+"/"+timeKey(end))...)
+	}
+
+	kv := s.kv()
+	var iterator store.Iterator
+	if page.Reverse {
+		iterator = kv.ReverseIterator(startKey, endKey)
+	} else {
+		iterator = kv.Iterator(startKey, endKey)
+	}
+	defer iterator.Close()
+
+	skippingCursor := len(cursorKey) > 0
+	var requests []*types.OracleRequest
+	var lastKey []byte
+	var hasMore bool
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if skippingCursor {
+			if string(key) == string(cursorKey) {
+				skippingCursor = false
+			}
+			continue
+		}
+		request, err := s.GetRequest(string(iterator.Value()))
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		if status != "" && request.Status != status {
+			continue
+		}
+		if uint64(len(requests)) >= limit {
+			hasMore = true
+			break
+		}
+		requests = append(requests, request)
+		lastKey = append([]byte{}, key...)
+	}
+
+	var resp types.CursorPageResponse
+	if hasMore {
+		resp.NextCursor = types.EncodeCursor(lastKey)
+	}
+	return requests, resp, nil
+}
+
+// PutStats appends stats to oracleID's history, keyed by its LastRequest timestamp (the
+// only point-in-time field OracleStatistics carries), and repoints the latest-stats
+// pointer at it. Moving OracleStatistics onto this layer (rather than the single
+// overwritten StatsKey record keeper.go used before) is what lets StatsHistory serve the
+// historical windows the request asks for.
+func (s *KVOracleStore) PutStats(stats *types.OracleStatistics) error {
+	kv := s.kv()
+	bz := s.cdc.MustMarshal(stats)
+	if err := kv.Set(statsHistoryKey(stats.OracleID, stats.LastRequest), bz); err != nil {
+		return err
+	}
+	return kv.Set(statsLatestPointerKey(stats.OracleID), []byte(timeKey(stats.LastRequest)))
+}
+
+func (s *KVOracleStore) GetLatestStats(oracleID string) (*types.OracleStatistics, error) {
+	kv := s.kv()
+	ptr, err := kv.Get(statsLatestPointerKey(oracleID))
+	if err != nil {
+		return nil, err
+	}
+	if ptr == nil {
+		return nil, types.ErrStatsNotFound
+	}
+	bz, err := kv.Get(append(append([]byte{}, statsHistoryIndexPrefix...), []byte(oracleID+"/"+string(ptr))...))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, types.ErrStatsNotFound
+	}
+	var stats types.OracleStatistics
+	s.cdc.MustUnmarshal(bz, &stats)
+	return &stats, nil
+}
+
+func (s *KVOracleStore) StatsHistory(oracleID string, start, end time.Time, page types.CursorPageRequest) ([]*types.OracleStatistics, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+	cursorKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, fmt.Errorf("invalid pagination cursor: %w", err)
+	}
+
+	base := append(append([]byte{}, statsHistoryIndexPrefix...), []byte(oracleID+"/")...)
+	startKey := base
+	if !start.IsZero() {
+		startKey = append(append([]byte{}, statsHistoryIndexPrefix...), []byte(oracleID+"/"+timeKey(start))...)
+	}
+	endKey := sdk.PrefixEndBytes(base)
+	if !end.IsZero() {
+		endKey = append(append([]byte{}, statsHistoryIndexPrefix...), []byte(oracleID+"/"+timeKey(end))...)
+	}
+
+	kv := s.kv()
+	var iterator store.Iterator
+	if page.Reverse {
+		iterator = kv.ReverseIterator(startKey, endKey)
+	} else {
+		iterator = kv.Iterator(startKey, endKey)
+	}
+	defer iterator.Close()
+
+	skippingCursor := len(cursorKey) > 0
+	var snapshots []*types.OracleStatistics
+	var lastKey []byte
+	var hasMore bool
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if skippingCursor {
+			if string(key) == string(cursorKey) {
+				skippingCursor = false
+			}
+			continue
+		}
+		if uint64(len(snapshots)) >= limit {
+			hasMore = true
+			break
+		}
+		var stats types.OracleStatistics
+		s.cdc.MustUnmarshal(iterator.Value(), &stats)
+		snapshots = append(snapshots, &stats)
+		lastKey = append([]byte{}, key...)
+	}
+
+	var resp types.CursorPageResponse
+	if hasMore {
+		resp.NextCursor = types.EncodeCursor(lastKey)
+	}
+	return snapshots, resp, nil
+}
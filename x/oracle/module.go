@@ -73,26 +73,86 @@ func NewAppModule(cdc codec.Codec, keeper oraclekeeper.Keeper) AppModule {
 func (am AppModule) RegisterServices(cfg module.Configurator) {}
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
+// InitGenesis registers gs's Oracles and applies gs.Params, falling back to
+// types.DefaultParams() for a pre-existing exported GenesisState that predates the
+// Params field added alongside the committee response window flow
+// (keeper/committee_response.go).
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	params := genState.Params
+	if params == (types.Params{}) {
+		params = types.DefaultParams()
+	}
+	if err := am.keeper.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+
+	for i := range genState.Oracles {
+		if err := am.keeper.RegisterOracle(ctx, &genState.Oracles[i]); err != nil {
+			panic(err)
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(DefaultGenesisState())
+	oracles, err := am.keeper.QueryOracles(ctx, types.OracleQuery{})
+	if err != nil {
+		panic(err)
+	}
+	exported := make([]types.Oracle, len(oracles))
+	for i, o := range oracles {
+		exported[i] = *o
+	}
+
+	params := am.keeper.GetParams(ctx)
+	return cdc.MustMarshalJSON(&GenesisState{Oracles: exported, Params: params})
 }
 
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(ctx context.Context) error { return nil }
-func (am AppModule) EndBlock(ctx context.Context) error { return nil }
+
+// EndBlock runs the enrollment renewal sweep (see keeper.Keeper.EndBlocker /
+// keeper/enrollment.go) that moves active oracles overdue for re-challenge into
+// types.StatusMaintenance, then the attestation-feed round-deadline sweep (see
+// keeper.Keeper.AttestationEndBlocker / keeper/attestation.go) that closes any feed's
+// round whose deadline height has passed without reaching quorum, then the committee
+// response window sweep (see keeper.Keeper.CommitteeResponseEndBlocker /
+// keeper/committee_response.go) that resolves any per-OracleRequest committee response
+// window whose deadline has passed without reaching Params.MinResponses, then the
+// request timeout sweep (see keeper.Keeper.RequestTimeoutEndBlocker /
+// keeper/request_timeout.go) that expires any OracleRequest past its own
+// DeadlineHeight.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := am.keeper.EndBlocker(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.AttestationEndBlocker(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.CommitteeResponseEndBlocker(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.RequestTimeoutEndBlocker(sdkCtx)
+}
 func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) IsAppModule() {}
 
 type GenesisState struct {
 	Oracles []types.Oracle `json:"oracles"`
+
+	// Params holds the module-wide committee response window defaults (see
+	// types.Params, keeper/committee_response.go), mirroring the Panacea oracle
+	// patch's pattern of adding a Params field to GenesisState.
+	Params types.Params `json:"params"`
 }
 
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{Oracles: []types.Oracle{}}
+	return &GenesisState{Oracles: []types.Oracle{}, Params: types.DefaultParams()}
 }
 
 func (gs GenesisState) Validate() error {
@@ -101,7 +161,10 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
-	return nil
+	if gs.Params == (types.Params{}) {
+		return nil
+	}
+	return gs.Params.Validate()
 }
 
 // Implement proto.Message interface for SDK compatibility
@@ -0,0 +1,73 @@
+// Package module holds x/oracle's depinject wiring, split out of app/depinject.go (which
+// used to define OracleModuleInputs/OracleModuleOutputs/ProvideOracleModule directly)
+// so that x/registry/x/zkproof/x/token's module/depinject.go siblings added alongside
+// this one have a single, per-module place to live rather than all four crowding one
+// app-level file.
+package module
+
+import (
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	oracle "github.com/PersonaPass-ID/personachain/x/oracle"
+	oraclekeeper "github.com/PersonaPass-ID/personachain/x/oracle/keeper"
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// ModuleInputs is the depinject.In struct ProvideModule receives -- everything
+// oraclekeeper.NewKeeper needs, resolved from the app's own container. AccountKeeper and
+// BankKeeper are typed to the narrowed types.AccountKeeper/types.BankKeeper interfaces
+// (types/expected_keepers.go) rather than the concrete authkeeper.AccountKeeper/
+// bankkeeper.Keeper types NewKeeper used to take, so this package depends only on the
+// account/bank method sets it actually calls, not on x/auth's or x/bank's keeper
+// packages.
+type ModuleInputs struct {
+	depinject.In
+
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+	Authority    string
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+// ModuleOutputs is what ProvideModule contributes back to the container: the
+// constructed Keeper (for other modules' ProvideModule functions to depend on, e.g. the
+// OracleKeeper expected-keeper interfaces x/zkproof's and x/did's expected_keepers.go
+// already declare) and the appmodule.AppModule runtime wires into
+// SetOrderBeginBlockers/SetOrderEndBlockers.
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper    oraclekeeper.Keeper
+	AppModule appmodule.AppModule
+}
+
+// ProvideModule is x/oracle's depinject provider, replacing the manual
+//
+//	oracleKeeper := oraclekeeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[oracletypes.StoreKey]), authority, accountKeeper, bankKeeper)
+//	oracleModule := oracle.NewAppModule(cdc, oracleKeeper)
+//
+// construction (and the hand-ordered SetOrderInitGenesis/BeginBlockers/EndBlockers entry
+// for it) a non-depinject NewPersonaChainAppNew would otherwise need.
+//
+// This request also asks for an init() registering a modulev1.Module proto with
+// appmodule.Register, the way runtime's own built-in modules self-register for
+// app.yaml/appconfig.Compose assembly. There is no cosmos.app.v1alpha1-style
+// modulev1.Module generated anywhere in this tree (no protoc-gen-go-cosmos pipeline
+// output exists for this module, or for any module in this tree), so that init() isn't
+// fabricated here -- x/zkproof/router/depinject.go's ProviderSet already established
+// this same boundary for the one other depinject provider in this tree: a real,
+// usable depinject.Provide binding, stopping short of a fake generated proto type.
+// ProvideModule is provided for whoever adds that pipeline to depinject.Inject this into
+// the container's ModuleConfigs.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := oraclekeeper.NewKeeper(in.Cdc, in.StoreService, in.Authority, in.AccountKeeper, in.BankKeeper)
+	return ModuleOutputs{
+		Keeper:    *k,
+		AppModule: oracle.NewAppModule(in.Cdc, *k),
+	}
+}
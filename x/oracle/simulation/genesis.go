@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	oracle "github.com/PersonaPass-ID/personachain/x/oracle"
+	"github.com/PersonaPass-ID/personachain/x/oracle/types"
+)
+
+// simGenesisTime stands in for time.Now() for every fixture timestamp
+// RandomizedGenState produces, since simulation genesis state must be deterministic
+// given the same seed -- same reasoning as x/zkproof/simulation/genesis.go's
+// simGenesisTime.
+var simGenesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// genOracles generates a handful of fixture Oracles spanning a few OracleTypes, owned
+// by random accounts, the same shape RegisterOracle (keeper.go) validates.
+func genOracles(r *rand.Rand, accs []string) []types.Oracle {
+	oracleTypes := []types.OracleType{
+		types.TypeIdentityVerification, types.TypeKYC, types.TypePriceOracle,
+	}
+	oracles := make([]types.Oracle, 0, 5)
+	for i := 0; i < 5; i++ {
+		oracles = append(oracles, types.Oracle{
+			ID:          fmt.Sprintf("oracle-%d", i),
+			Name:        fmt.Sprintf("sim oracle %d", i),
+			Endpoint:    fmt.Sprintf("https://oracle-%d.example.com", i),
+			Type:        oracleTypes[i%len(oracleTypes)],
+			Owner:       accs[r.Intn(len(accs))],
+			Status:      types.StatusActive,
+			Reputation:  1.0,
+			Created:     simGenesisTime,
+			Updated:     simGenesisTime,
+			Config:      types.OracleConfig{},
+			Metadata:    map[string]string{},
+		})
+	}
+	return oracles
+}
+
+// RandomizedGenState seeds the oracle module's simulation genesis with Oracles and
+// Params, mirroring GenerateGenesisState's real InitGenesis/ExportGenesis shape in
+// module.go (GenesisState{Oracles, Params}) -- unlike x/zkproof/x/did/x/credential's
+// RandomizedGenState, which had to invent a fixture-only GenesisState because those
+// modules have none of their own, this one reuses the module's real type directly.
+func RandomizedGenState(simState *module.SimulationState) {
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	if len(accs) == 0 {
+		return
+	}
+
+	genesis := oracle.GenesisState{
+		Oracles: genOracles(simState.Rand, accs),
+		Params:  types.DefaultParams(),
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
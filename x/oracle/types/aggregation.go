@@ -0,0 +1,243 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// AggregationConfig tunes how AggregateResponses combines multiple DataSources'
+// answers to one OracleRequest into a single OracleResponse.
+type AggregationConfig struct {
+	// MinResponders is the minimum number of successful SourceResponses required
+	// before a result is accepted at all (the k-of-n quorum gate).
+	MinResponders int `json:"min_responders"`
+
+	// MaxDeviationBps bounds how far (in basis points of the consensus value) a
+	// numeric response may sit from the weighted median, or how a categorical
+	// response must match the majority value exactly, to count as an "agreeing"
+	// responder rather than an outlier.
+	MaxDeviationBps int64 `json:"max_deviation_bps"`
+
+	// Precision is the number of decimal places numeric values are rounded to before
+	// comparison, so the same set of raw responses always canonicalizes to the same
+	// result bit-for-bit across validators regardless of floating point rounding in
+	// whatever off-chain process collected them.
+	Precision int `json:"precision"`
+}
+
+// DefaultAggregationConfig returns reasonable defaults: at least 2 responders, 5%
+// (500bps) max deviation, 6 decimal places of precision.
+func DefaultAggregationConfig() AggregationConfig {
+	return AggregationConfig{
+		MinResponders:   2,
+		MaxDeviationBps: 500,
+		Precision:       6,
+	}
+}
+
+// Validate validates an AggregationConfig.
+func (c AggregationConfig) Validate() error {
+	if c.MinResponders < 1 {
+		return fmt.Errorf("min_responders must be at least 1")
+	}
+	if c.MaxDeviationBps < 0 {
+		return fmt.Errorf("max_deviation_bps cannot be negative")
+	}
+	if c.Precision < 0 {
+		return fmt.Errorf("precision cannot be negative")
+	}
+	return nil
+}
+
+// SourceResponse is one DataSource's answer to an OracleRequest, the unit
+// AggregateResponses combines. Value holds a string for a categorical field (KYC
+// pass/fail, a sanctions-hit verdict) or a float64 for a numeric one (price, a
+// reputation score); AggregateResponses infers which by inspecting the first
+// successful response and requires every other successful response to agree.
+type SourceResponse struct {
+	SourceID string      `json:"source_id"`
+	Success  bool        `json:"success"`
+	Value    interface{} `json:"value"`
+}
+
+// AggregationResult is AggregateResponses' canonical output: the consensus Value, the
+// Confidence and contributing Sources to stamp onto an OracleResponse (see
+// keeper/aggregation.go's ApplyAggregation), and the reliability each configured
+// DataSource should decay/recover to via EWMA.
+type AggregationResult struct {
+	Value      interface{}
+	Confidence float64
+	// Sources lists only the IDs of responses inside the agreed cluster, sorted for
+	// determinism -- never every DataSource that was asked, and never a source whose
+	// answer was rejected as an outlier.
+	Sources []string
+	// ReliabilityUpdates maps every DataSource.ID considered (whether it answered,
+	// disagreed, or failed) to its new EWMA-decayed Reliability.
+	ReliabilityUpdates map[string]float64
+}
+
+// reliabilityEWMAAlpha weights how much a DataSource's prior Reliability carries
+// forward versus this round's 0 (failed/outlier) or 1 (agreed) sample -- 0.8 means a
+// single bad round only pulls Reliability down by 20% of the gap to 0, so one transient
+// failure doesn't zero out a normally-reliable source's reputation.
+const reliabilityEWMAAlpha = 0.8
+
+// AggregateResponses combines responses (one per configured DataSource that was asked,
+// including failures) into a single deterministic AggregationResult. sources provides
+// each DataSource's current Weight/Reliability for the weighted calculations and EWMA
+// decay; sources and responses are matched by DataSource.ID / SourceResponse.SourceID.
+//
+// Determinism: responses is sorted by SourceID before any floating point accumulation,
+// and every numeric comparison is done after rounding to cfg.Precision decimal places
+// (see roundTo), so two validators replaying the same raw responses always compute
+// the same AggregationResult regardless of map/slice iteration order or floating point
+// rounding differences upstream.
+func AggregateResponses(cfg AggregationConfig, sources []DataSource, responses []SourceResponse) (AggregationResult, error) {
+	if err := cfg.Validate(); err != nil {
+		return AggregationResult{}, err
+	}
+
+	sorted := make([]SourceResponse, len(responses))
+	copy(sorted, responses)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].SourceID < sorted[j].SourceID })
+
+	weights := make(map[string]float64, len(sources))
+	reliability := make(map[string]float64, len(sources))
+	totalWeight := 0.0
+	for _, ds := range sources {
+		weights[ds.ID] = ds.Weight
+		reliability[ds.ID] = ds.Reliability
+		totalWeight += ds.Weight
+	}
+	if totalWeight == 0 {
+		return AggregationResult{}, fmt.Errorf("configured data sources carry zero total weight")
+	}
+
+	successful := make([]SourceResponse, 0, len(sorted))
+	for _, r := range sorted {
+		if r.Success {
+			successful = append(successful, r)
+		}
+	}
+	if len(successful) < cfg.MinResponders {
+		return AggregationResult{}, fmt.Errorf("only %d of %d required responders succeeded", len(successful), cfg.MinResponders)
+	}
+
+	_, numeric := successful[0].Value.(float64)
+	var inlierIDs []string
+	var consensusValue interface{}
+	if numeric {
+		inlierIDs, consensusValue = aggregateNumeric(cfg, weights, successful)
+	} else {
+		inlierIDs, consensusValue = aggregateCategorical(weights, successful)
+	}
+	sort.Strings(inlierIDs)
+
+	inlierSet := make(map[string]bool, len(inlierIDs))
+	inlierWeight := 0.0
+	for _, id := range inlierIDs {
+		inlierSet[id] = true
+		inlierWeight += weights[id]
+	}
+
+	updates := make(map[string]float64, len(sources))
+	for _, ds := range sources {
+		sample := 0.0
+		if inlierSet[ds.ID] {
+			sample = 1.0
+		}
+		updates[ds.ID] = roundTo(reliabilityEWMAAlpha*reliability[ds.ID]+(1-reliabilityEWMAAlpha)*sample, cfg.Precision)
+	}
+
+	return AggregationResult{
+		Value:              consensusValue,
+		Confidence:         roundTo(inlierWeight/totalWeight, cfg.Precision),
+		Sources:            inlierIDs,
+		ReliabilityUpdates: updates,
+	}, nil
+}
+
+// aggregateNumeric computes the weighted median (Chainlink-style) of successful's
+// values rounded to cfg.Precision, then returns every response within
+// cfg.MaxDeviationBps of it as the inlier cluster.
+func aggregateNumeric(cfg AggregationConfig, weights map[string]float64, successful []SourceResponse) ([]string, float64) {
+	type sample struct {
+		id     string
+		value  float64
+		weight float64
+	}
+	samples := make([]sample, 0, len(successful))
+	for _, r := range successful {
+		v, _ := r.Value.(float64)
+		samples = append(samples, sample{id: r.SourceID, value: roundTo(v, cfg.Precision), weight: weights[r.SourceID]})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		if samples[i].value != samples[j].value {
+			return samples[i].value < samples[j].value
+		}
+		return samples[i].id < samples[j].id
+	})
+
+	total := 0.0
+	for _, s := range samples {
+		total += s.weight
+	}
+
+	median := samples[len(samples)-1].value
+	cumulative := 0.0
+	for _, s := range samples {
+		cumulative += s.weight
+		if cumulative*2 >= total {
+			median = s.value
+			break
+		}
+	}
+
+	var inliers []string
+	maxDeviation := median * float64(cfg.MaxDeviationBps) / 10000
+	if maxDeviation < 0 {
+		maxDeviation = -maxDeviation
+	}
+	for _, s := range samples {
+		if math.Abs(s.value-median) <= maxDeviation {
+			inliers = append(inliers, s.id)
+		}
+	}
+	return inliers, median
+}
+
+// aggregateCategorical groups successful's string values, weighted majority-vote
+// style, and returns the members of the highest-weight group as the inlier cluster.
+// Ties (equal total weight) are broken by the lexicographically smaller value, so the
+// result never depends on response order.
+func aggregateCategorical(weights map[string]float64, successful []SourceResponse) ([]string, string) {
+	groupWeight := map[string]float64{}
+	groupMembers := map[string][]string{}
+	for _, r := range successful {
+		v, _ := r.Value.(string)
+		groupWeight[v] += weights[r.SourceID]
+		groupMembers[v] = append(groupMembers[v], r.SourceID)
+	}
+
+	values := make([]string, 0, len(groupWeight))
+	for v := range groupWeight {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+
+	best := values[0]
+	for _, v := range values[1:] {
+		if groupWeight[v] > groupWeight[best] {
+			best = v
+		}
+	}
+	return groupMembers[best], best
+}
+
+// roundTo rounds v to precision decimal places, the canonicalization step that keeps
+// AggregateResponses' output reproducible across validators.
+func roundTo(v float64, precision int) float64 {
+	scale := math.Pow(10, float64(precision))
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// AttestationFeed is a whitelisted channel whitelisted oracle operators post signed
+// attestations about one off-chain fact into (e.g. a KYC provider's status, a
+// revocation-list root, an external DID document hash), consumed by x/credential and
+// x/did through the OracleKeeper interface their own expected_keepers.go files define.
+// Unlike the request/response pair keyed by a caller-chosen OracleRequest.ID (see
+// types.go), a feed's state is keyed by (FeedID, round number) and advances on its own
+// schedule rather than once per inbound request.
+type AttestationFeed struct {
+	FeedID             string    `json:"feed_id"`
+	Description        string    `json:"description"`
+	Operators          []string  `json:"operators"`
+	Threshold          int       `json:"threshold"`
+	RoundDeadlineBlocks int64    `json:"round_deadline_blocks"`
+	// DeviationThresholdBps bounds how far a numeric submission may sit from the
+	// aggregated value before JailOperator (keeper/attestation.go) counts it as a
+	// divergence toward MaxDivergentRounds.
+	DeviationThresholdBps int64 `json:"deviation_threshold_bps"`
+	MaxDivergentRounds    int   `json:"max_divergent_rounds"`
+	CurrentRound          uint64 `json:"current_round"`
+}
+
+// Validate validates an AttestationFeed.
+func (f *AttestationFeed) Validate() error {
+	if f.FeedID == "" {
+		return fmt.Errorf("feed ID cannot be empty")
+	}
+	if len(f.Operators) == 0 {
+		return fmt.Errorf("feed %q must whitelist at least one operator", f.FeedID)
+	}
+	if f.Threshold <= 0 || f.Threshold > len(f.Operators) {
+		return fmt.Errorf("feed %q threshold must be between 1 and len(operators)", f.FeedID)
+	}
+	if f.RoundDeadlineBlocks <= 0 {
+		return fmt.Errorf("feed %q round deadline must be positive", f.FeedID)
+	}
+	return nil
+}
+
+// HasOperator reports whether operator is whitelisted on f.
+func (f *AttestationFeed) HasOperator(operator string) bool {
+	for _, o := range f.Operators {
+		if o == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// AttestationSubmission is one operator's signed report for a feed's current round,
+// before the round closes and an AggregatedValue is computed.
+type AttestationSubmission struct {
+	Operator  string    `json:"operator"`
+	NumericValue *float64 `json:"numeric_value,omitempty"`
+	BytesValue   []byte   `json:"bytes_value,omitempty"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+}
+
+// AttestationRound is one closed-or-open round of a feed. ClosedAtHeight is zero while
+// the round is still accepting submissions.
+type AttestationRound struct {
+	FeedID          string                   `json:"feed_id"`
+	Round           uint64                   `json:"round"`
+	DeadlineHeight  int64                    `json:"deadline_height"`
+	Submissions     []AttestationSubmission  `json:"submissions"`
+	Closed          bool                     `json:"closed"`
+	ClosedAtHeight  int64                    `json:"closed_at_height,omitempty"`
+	// AggregatedNumeric/AggregatedBytes hold the round's canonical value once Closed:
+	// the median for numeric submissions, or the byte payload with the most identical
+	// submissions (majority hash) for byte submissions -- mirrors
+	// aggregation.go's aggregateNumeric/aggregateCategorical split for OracleResponse.
+	AggregatedNumeric *float64 `json:"aggregated_numeric,omitempty"`
+	AggregatedBytes   []byte   `json:"aggregated_bytes,omitempty"`
+}
+
+// QuorumMet reports whether len(submissions) is a >2/3 stake-weighted quorum of
+// numOperators. Feeds in this module have no staking-keeper dependency (see
+// keeper.go's NewKeeper, which only takes authKeeper/bankKeeper -- the same gap
+// enrollment.go's DefaultEnrollmentQuorumNumerator/Denominator documents), so "stake
+// weighted" here falls back to one-operator-one-vote: >2/3 of the feed's whitelisted
+// operators, not >2/3 of bonded voting power.
+func (r *AttestationRound) QuorumMet(numOperators int) bool {
+	return len(r.Submissions)*3 > numOperators*2
+}
+
+// HasSubmitted reports whether operator already submitted this round.
+func (r *AttestationRound) HasSubmitted(operator string) bool {
+	for _, s := range r.Submissions {
+		if s.Operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// OperatorDivergence tracks one feed operator's running count of rounds whose
+// submission diverged from the aggregated value beyond the feed's
+// DeviationThresholdBps, toward JailOperator's MaxDivergentRounds limit.
+type OperatorDivergence struct {
+	FeedID         string `json:"feed_id"`
+	Operator       string `json:"operator"`
+	DivergentCount int    `json:"divergent_count"`
+	Jailed         bool   `json:"jailed"`
+}
+
+// Attestation feed errors. Plain fmt.Errorf values, matching this baseline-era module's
+// existing style (see types.go's ErrOracleNotFound block).
+var (
+	ErrFeedNotFound         = fmt.Errorf("attestation feed not found")
+	ErrFeedExists           = fmt.Errorf("attestation feed already registered")
+	ErrNotWhitelisted       = fmt.Errorf("operator is not whitelisted on this feed")
+	ErrRoundClosed          = fmt.Errorf("attestation round is already closed")
+	ErrAlreadySubmitted     = fmt.Errorf("operator already submitted this round")
+	ErrRoundNotFound        = fmt.Errorf("attestation round not found")
+	ErrNoSubmissionToWithdraw = fmt.Errorf("operator has no submission to withdraw this round")
+	ErrOperatorJailed       = fmt.Errorf("operator is jailed on this feed")
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the oracle module's types on the given LegacyAmino codec.
+// MsgSubmitPartialSignature is this module's first Msg type -- x/oracle predates the
+// Msg/MsgServer convention x/zkproof and x/credential use, so there is no existing
+// codec.go to extend here.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSubmitPartialSignature{}, "oracle/SubmitPartialSignature", nil)
+	cdc.RegisterConcrete(&MsgRequestEnrollment{}, "oracle/RequestEnrollment", nil)
+	cdc.RegisterConcrete(&MsgFinalizeEnrollment{}, "oracle/FinalizeEnrollment", nil)
+	cdc.RegisterConcrete(&MsgVoteEnrollmentChallenge{}, "oracle/VoteEnrollmentChallenge", nil)
+	cdc.RegisterConcrete(&MsgRotateOperatorKey{}, "oracle/RotateOperatorKey", nil)
+	cdc.RegisterConcrete(&MsgRegisterOracle{}, "oracle/RegisterOracle", nil)
+	cdc.RegisterConcrete(&MsgSubmitAttestation{}, "oracle/SubmitAttestation", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAttestation{}, "oracle/WithdrawAttestation", nil)
+	cdc.RegisterConcrete(&MsgSubmitCommitteeResponse{}, "oracle/SubmitCommitteeResponse", nil)
+}
+
+// RegisterInterfaces registers the oracle module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitPartialSignature{},
+		&MsgRequestEnrollment{},
+		&MsgFinalizeEnrollment{},
+		&MsgVoteEnrollmentChallenge{},
+		&MsgRotateOperatorKey{},
+		&MsgRegisterOracle{},
+		&MsgSubmitAttestation{},
+		&MsgWithdrawAttestation{},
+		&MsgSubmitCommitteeResponse{},
+	)
+}
+
+// ModuleCdc references the global oracle module codec. Note, the codec should ONLY be
+// used in certain instances of tests and for JSON encoding as Amino is still used for
+// that purpose -- mirrors x/zkproof/types/codec.go's ModuleCdc.
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterCodec(legacy.Cdc)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// CommitteeResponse is one committee member's independently-submitted value toward an
+// OracleRequest, the building block for the N-of-M resolution model
+// CommitteeResponseEndBlocker (keeper/committee_response.go) runs once Params.MinResponses
+// responses accumulate or Params.ResponseWindowBlocks elapses. This is distinct from the
+// single, overwritten OracleRequest.Response a plain SubmitOracleResponse call still
+// produces for requests that don't go through a committee.
+//
+// Value holds either a float64 (aggregated by median) or a string (aggregated by
+// plurality vote), the same interface{} split types/aggregation.go's SourceResponse.Value
+// uses for the same reason: one wire shape serving both numeric and categorical oracle
+// answers.
+type CommitteeResponse struct {
+	RequestID   string      `json:"request_id"`
+	Operator    string      `json:"operator"`
+	Value       interface{} `json:"value"`
+	SubmittedAt time.Time   `json:"submitted_at"`
+}
+
+// CommitteeResponseWindow tracks the deadline height and member responses collected so
+// far for RequestID, opened by the first SubmitCommitteeResponse call against it and
+// closed by either reaching Params.MinResponses or CommitteeResponseEndBlocker finding
+// its DeadlineHeight has passed.
+type CommitteeResponseWindow struct {
+	RequestID      string               `json:"request_id"`
+	DeadlineHeight int64                `json:"deadline_height"`
+	Responses      []CommitteeResponse  `json:"responses"`
+	Resolved       bool                 `json:"resolved"`
+}
+
+// HasResponded reports whether operator already has a CommitteeResponse in w.
+func (w *CommitteeResponseWindow) HasResponded(operator string) bool {
+	for _, r := range w.Responses {
+		if r.Operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// Committee response window errors. Plain fmt.Errorf values, matching this baseline-era
+// module's existing style (see types.go's ErrOracleNotFound block) rather than the
+// errorsmod convention newer modules (x/credential, x/revocation) use.
+var (
+	ErrWindowAlreadyResolved      = fmt.Errorf("committee response window already resolved")
+	ErrDuplicateCommitteeResponse = fmt.Errorf("operator already submitted a committee response for this request")
+	ErrWindowNotFound             = fmt.Errorf("committee response window not found")
+)
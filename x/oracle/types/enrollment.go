@@ -0,0 +1,126 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// ChallengeType selects how a prospective operator proves control of Endpoint, the
+// ACME-style HTTP-01/DNS-01 split this module's enrollment flow borrows its naming
+// from.
+type ChallengeType string
+
+const (
+	ChallengeTypeHTTP01 ChallengeType = "http-01"
+	ChallengeTypeDNS01  ChallengeType = "dns-01"
+)
+
+// DefaultRenewalInterval is how often an active oracle must pass a fresh enrollment
+// challenge before EndBlocker moves it to StatusMaintenance, absent an
+// EnrollmentConfig.RenewalInterval override.
+const DefaultRenewalInterval = 30 * 24 * time.Hour
+
+// EnrollmentChallenge is the outstanding proof-of-control challenge for one Oracle,
+// created by MsgRequestEnrollment and voted on via MsgVoteEnrollmentChallenge until
+// Threshold approvals flip the oracle active (or expiry/a failed renewal moves it to
+// StatusMaintenance).
+type EnrollmentChallenge struct {
+	OracleID string        `json:"oracle_id"`
+	Token    string        `json:"token"`
+	Type     ChallengeType `json:"type"`
+	Endpoint string        `json:"endpoint"`
+	// Finalized is set by MsgFinalizeEnrollment once the operator believes the token is
+	// being served; votes cast before Finalized are rejected; voting is required after.
+	Finalized bool      `json:"finalized"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// DefaultChallengeTTL bounds how long an operator has to serve a challenge before it
+// expires and must be re-requested.
+const DefaultChallengeTTL = 24 * time.Hour
+
+// HTTP01URL is the well-known URL an operator must serve Token's hash at for a
+// ChallengeTypeHTTP01 challenge, mirroring ACME's /.well-known/acme-challenge/<token>.
+func (c EnrollmentChallenge) HTTP01URL() string {
+	return fmt.Sprintf("https://%s/.well-known/persona-oracle-challenge/%s", c.Endpoint, c.Token)
+}
+
+// DNS01RecordName is the TXT record name an operator must publish Token's hash under
+// for a ChallengeTypeDNS01 challenge.
+func (c EnrollmentChallenge) DNS01RecordName() string {
+	return fmt.Sprintf("_persona-oracle-challenge.%s", c.Endpoint)
+}
+
+// GenerateChallengeToken derives a challenge token deterministically from oracleID, the
+// requesting operator, and the block the request landed in, rather than from
+// math/rand or crypto/rand: every validator must compute the identical token while
+// replaying the same block, the same reason types/aggregation.go's AggregateResponses
+// avoids non-deterministic inputs. blockHash is the block header hash
+// (ctx.HeaderHash()) and seq disambiguates multiple enrollment requests landing in the
+// same block for the same oracle.
+func GenerateChallengeToken(oracleID, operator string, blockHash []byte, seq uint64) string {
+	h := sha256.New()
+	h.Write([]byte(oracleID))
+	h.Write([]byte(operator))
+	h.Write(blockHash)
+	h.Write([]byte(fmt.Sprintf("%d", seq)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EnrollmentVote is one validator's verdict on an EnrollmentChallenge, cast after
+// fetching Endpoint's challenge response off-chain (HTTP-01 GET or DNS-01 TXT lookup)
+// and hashing it -- the same "consensus-critical code can't make outbound network
+// calls itself" constraint types/oidc.go and keeper/aggregation.go document elsewhere
+// in this module means the fetch+hash has to happen in each validator's own off-chain
+// process, with only the resulting Approved verdict submitted on-chain via
+// MsgVoteEnrollmentChallenge.
+type EnrollmentVote struct {
+	OracleID  string `json:"oracle_id"`
+	Validator string `json:"validator"`
+	Approved  bool   `json:"approved"`
+}
+
+// EnrollmentConfig tunes one oracle's re-challenge cadence. Oracles that never set one
+// use DefaultRenewalInterval.
+type EnrollmentConfig struct {
+	OracleID        string        `json:"oracle_id"`
+	RenewalInterval time.Duration `json:"renewal_interval"`
+}
+
+func (c EnrollmentConfig) effectiveRenewalInterval() time.Duration {
+	if c.RenewalInterval <= 0 {
+		return DefaultRenewalInterval
+	}
+	return c.RenewalInterval
+}
+
+// NextRenewalAt returns when an oracle last (re-)verified at since should next be
+// re-challenged.
+func (c EnrollmentConfig) NextRenewalAt(since time.Time) time.Time {
+	return since.Add(c.effectiveRenewalInterval())
+}
+
+// Enrollment errors. Plain fmt.Errorf values, matching this baseline-era module's
+// existing style.
+var (
+	ErrEnrollmentChallengeNotFound = fmt.Errorf("enrollment challenge not found")
+	ErrChallengeExpired            = fmt.Errorf("enrollment challenge expired")
+	ErrChallengeNotFinalized       = fmt.Errorf("enrollment challenge has not been finalized by the operator yet")
+	ErrDuplicateEnrollmentVote     = fmt.Errorf("validator already voted on this enrollment challenge")
+	ErrNotOperator                 = fmt.Errorf("signer is not this oracle's current operator")
+)
+
+// DefaultEnrollmentQuorumNumerator/Denominator set the lightweight majority tally
+// SubmitEnrollmentVote (keeper/enrollment.go) applies: >1/2 of votes cast so far, not a
+// bonded-validator-weighted quorum -- x/oracle's Keeper has no staking keeper dependency
+// to look up the active validator set or its voting power (see keeper.go's NewKeeper,
+// which only takes authKeeper/bankKeeper), so there is no voting power to weight by.
+// This is the request's own "lightweight tally" phrase taken at face value rather than
+// a full Cosmos SDK x/gov-style bonded-stake tally this module isn't wired for.
+const (
+	DefaultEnrollmentQuorumNumerator   = 1
+	DefaultEnrollmentQuorumDenominator = 2
+)
@@ -0,0 +1,42 @@
+package types
+
+// Event types and attribute keys for the oracle module's committee signing flow (see
+// keeper/committee.go). Modeled on x/credential/types/events.go's const block.
+const (
+	EventTypeAggregateSignatureProduced = "aggregate_signature_produced"
+	EventTypeCommitteeMemberSlashed     = "committee_member_slashed"
+
+	// Enrollment events (see enrollment.go / keeper/enrollment.go).
+	EventTypeEnrollmentRequested = "enrollment_requested"
+	EventTypeOracleActivated     = "oracle_activated"
+	EventTypeOracleRenewalDue    = "oracle_renewal_due"
+	EventTypeOperatorKeyRotated  = "operator_key_rotated"
+
+	// Attestation feed events (see attestation.go / keeper/attestation.go).
+	EventTypeFeedRegistered      = "feed_registered"
+	EventTypeAttestationSubmitted = "attestation_submitted"
+	EventTypeRoundClosed         = "round_closed"
+	EventTypeOperatorJailed      = "operator_jailed"
+
+	// Committee response window events (see committee_response.go /
+	// keeper/committee_response.go) -- the N-of-M per-OracleRequest resolution flow,
+	// distinct from the BLS-signing OracleCommittee above even though both are keyed by
+	// oracle committee membership.
+	EventTypeCommitteeResponseSubmitted = "committee_response_submitted"
+	EventTypeCommitteeResponseResolved  = "committee_response_resolved"
+	EventTypeCommitteeResponseOutlier   = "committee_response_outlier"
+
+	// Request timeout event (see keeper/request_timeout.go's RequestTimeoutEndBlocker).
+	EventTypeOracleRequestTimedOut = "oracle_request_timed_out"
+
+	AttributeKeyOracleID    = "oracle_id"
+	AttributeKeyRequestID   = "request_id"
+	AttributeKeyPayloadHash = "payload_hash"
+	AttributeKeySignature   = "signature"
+	AttributeKeySigner      = "signer"
+	AttributeKeyToken       = "token"
+	AttributeKeyOperator    = "operator"
+	AttributeKeyFeedID      = "feed_id"
+	AttributeKeyRound       = "round"
+	AttributeKeyDeviationBps = "deviation_bps"
+)
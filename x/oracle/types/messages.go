@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MsgSubmitPartialSignature carries one OracleCommittee member's BLS12-381 partial
+// signature over the response named by RequestID, gathered off-chain over a p2p topic
+// (per this chunk's request) and submitted on-chain one at a time until
+// OracleCommittee.Threshold is met. See keeper/committee.go's SubmitPartialSignature
+// for the aggregation flow this feeds.
+type MsgSubmitPartialSignature struct {
+	Signer    string `json:"signer"`
+	OracleID  string `json:"oracle_id"`
+	RequestID string `json:"request_id"`
+	Sig       []byte `json:"sig"`
+}
+
+var _ sdk.Msg = &MsgSubmitPartialSignature{}
+
+const TypeMsgSubmitPartialSignature = "submit_partial_signature"
+
+func (msg *MsgSubmitPartialSignature) Route() string { return RouterKey }
+func (msg *MsgSubmitPartialSignature) Type() string  { return TypeMsgSubmitPartialSignature }
+
+func (msg *MsgSubmitPartialSignature) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgSubmitPartialSignature) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitPartialSignature) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return ErrNotCommitteeMember
+	}
+	if msg.OracleID == "" {
+		return ErrCommitteeNotFound
+	}
+	if msg.RequestID == "" {
+		return ErrRequestNotFound
+	}
+	if len(msg.Sig) == 0 {
+		return ErrInvalidPartialSignature
+	}
+	return nil
+}
+
+func (m *MsgSubmitPartialSignature) ProtoMessage()  {}
+func (m *MsgSubmitPartialSignature) Reset()         { *m = MsgSubmitPartialSignature{} }
+func (m *MsgSubmitPartialSignature) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitPartialSignatureResponse is the response for MsgSubmitPartialSignature.
+// Aggregated is true once this partial was the one that crossed OracleCommittee.Threshold
+// and AggregateSignature was run; Signature then carries the resulting aggregate.
+type MsgSubmitPartialSignatureResponse struct {
+	Aggregated bool   `json:"aggregated"`
+	Signature  []byte `json:"signature,omitempty"`
+}
+
+func (m *MsgSubmitPartialSignatureResponse) ProtoMessage()  {}
+func (m *MsgSubmitPartialSignatureResponse) Reset()         { *m = MsgSubmitPartialSignatureResponse{} }
+func (m *MsgSubmitPartialSignatureResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitCommitteeResponse carries one OracleCommittee member's independently-observed
+// value toward RequestID's committee response window, gathered one at a time until
+// either Params.MinResponses accumulate or the window's deadline passes -- see
+// keeper/committee_response.go's SubmitCommitteeResponse. Exactly one of NumericValue/
+// StringValue must be set, the same split MsgSubmitAttestation's
+// NumericValue/BytesValue uses for the same numeric-vs-categorical reason.
+type MsgSubmitCommitteeResponse struct {
+	Operator     string   `json:"operator"`
+	RequestID    string   `json:"request_id"`
+	NumericValue *float64 `json:"numeric_value,omitempty"`
+	StringValue  string   `json:"string_value,omitempty"`
+}
+
+var _ sdk.Msg = &MsgSubmitCommitteeResponse{}
+
+const TypeMsgSubmitCommitteeResponse = "submit_committee_response"
+
+func (msg *MsgSubmitCommitteeResponse) Route() string { return RouterKey }
+func (msg *MsgSubmitCommitteeResponse) Type() string  { return TypeMsgSubmitCommitteeResponse }
+
+func (msg *MsgSubmitCommitteeResponse) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSubmitCommitteeResponse) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitCommitteeResponse) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return ErrNotCommitteeMember
+	}
+	if msg.RequestID == "" {
+		return ErrRequestNotFound
+	}
+	if msg.NumericValue == nil && msg.StringValue == "" {
+		return fmt.Errorf("must set exactly one of numeric_value or string_value")
+	}
+	if msg.NumericValue != nil && msg.StringValue != "" {
+		return fmt.Errorf("must set exactly one of numeric_value or string_value")
+	}
+	return nil
+}
+
+// Value returns msg's submitted value as the interface{} SubmitCommitteeResponse stores
+// on a types.CommitteeResponse -- a float64 or a string, matching aggregateCommitteeResponses'
+// type switch in keeper/committee_response.go.
+func (msg *MsgSubmitCommitteeResponse) Value() interface{} {
+	if msg.NumericValue != nil {
+		return *msg.NumericValue
+	}
+	return msg.StringValue
+}
+
+func (m *MsgSubmitCommitteeResponse) ProtoMessage()  {}
+func (m *MsgSubmitCommitteeResponse) Reset()         { *m = MsgSubmitCommitteeResponse{} }
+func (m *MsgSubmitCommitteeResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitCommitteeResponseResponse is the response for MsgSubmitCommitteeResponse.
+// Resolved is true if this submission was the one that crossed Params.MinResponses and
+// resolved the window.
+type MsgSubmitCommitteeResponseResponse struct {
+	Resolved bool `json:"resolved"`
+}
+
+func (m *MsgSubmitCommitteeResponseResponse) ProtoMessage()  {}
+func (m *MsgSubmitCommitteeResponseResponse) Reset()         { *m = MsgSubmitCommitteeResponseResponse{} }
+func (m *MsgSubmitCommitteeResponseResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MsgRegisterOracle whitelists a new AttestationFeed's operator set. Distinct from
+// MsgRequestEnrollment (enrollment.go), which proves control of an Oracle.Endpoint --
+// a feed operator here is added directly by the feed's authority rather than proving
+// endpoint ownership, since a feed reports facts an operator observes, not a URL it
+// serves.
+type MsgRegisterOracle struct {
+	Authority           string   `json:"authority"`
+	FeedID              string   `json:"feed_id"`
+	Description         string   `json:"description"`
+	Operators           []string `json:"operators"`
+	Threshold           int      `json:"threshold"`
+	RoundDeadlineBlocks int64    `json:"round_deadline_blocks"`
+	DeviationThresholdBps int64  `json:"deviation_threshold_bps"`
+	MaxDivergentRounds  int      `json:"max_divergent_rounds"`
+}
+
+var _ sdk.Msg = &MsgRegisterOracle{}
+
+const TypeMsgRegisterOracle = "register_oracle"
+
+func (msg *MsgRegisterOracle) Route() string { return RouterKey }
+func (msg *MsgRegisterOracle) Type() string  { return TypeMsgRegisterOracle }
+
+func (msg *MsgRegisterOracle) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterOracle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRegisterOracle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if msg.FeedID == "" {
+		return fmt.Errorf("feed ID cannot be empty")
+	}
+	if len(msg.Operators) == 0 {
+		return fmt.Errorf("must whitelist at least one operator")
+	}
+	if msg.Threshold <= 0 || msg.Threshold > len(msg.Operators) {
+		return fmt.Errorf("threshold must be between 1 and len(operators)")
+	}
+	if msg.RoundDeadlineBlocks <= 0 {
+		return fmt.Errorf("round deadline must be positive")
+	}
+	return nil
+}
+
+func (m *MsgRegisterOracle) ProtoMessage()  {}
+func (m *MsgRegisterOracle) Reset()         { *m = MsgRegisterOracle{} }
+func (m *MsgRegisterOracle) String() string { return proto.CompactTextString(m) }
+
+// MsgRegisterOracleResponse is the response for MsgRegisterOracle.
+type MsgRegisterOracleResponse struct{}
+
+func (m *MsgRegisterOracleResponse) ProtoMessage()  {}
+func (m *MsgRegisterOracleResponse) Reset()         { *m = MsgRegisterOracleResponse{} }
+func (m *MsgRegisterOracleResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitAttestation carries one whitelisted operator's signed report for FeedID's
+// current round. Exactly one of NumericValue/BytesValue must be set, matching
+// AttestationSubmission's split.
+type MsgSubmitAttestation struct {
+	Operator     string   `json:"operator"`
+	FeedID       string   `json:"feed_id"`
+	NumericValue *float64 `json:"numeric_value,omitempty"`
+	BytesValue   []byte   `json:"bytes_value,omitempty"`
+}
+
+var _ sdk.Msg = &MsgSubmitAttestation{}
+
+const TypeMsgSubmitAttestation = "submit_attestation"
+
+func (msg *MsgSubmitAttestation) Route() string { return RouterKey }
+func (msg *MsgSubmitAttestation) Type() string  { return TypeMsgSubmitAttestation }
+
+func (msg *MsgSubmitAttestation) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgSubmitAttestation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgSubmitAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if msg.FeedID == "" {
+		return fmt.Errorf("feed ID cannot be empty")
+	}
+	if msg.NumericValue == nil && len(msg.BytesValue) == 0 {
+		return fmt.Errorf("must set exactly one of numeric_value or bytes_value")
+	}
+	if msg.NumericValue != nil && len(msg.BytesValue) != 0 {
+		return fmt.Errorf("must set exactly one of numeric_value or bytes_value")
+	}
+	return nil
+}
+
+func (m *MsgSubmitAttestation) ProtoMessage()  {}
+func (m *MsgSubmitAttestation) Reset()         { *m = MsgSubmitAttestation{} }
+func (m *MsgSubmitAttestation) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitAttestationResponse is the response for MsgSubmitAttestation. RoundClosed is
+// true if this submission was the one that crossed quorum and closed the round.
+type MsgSubmitAttestationResponse struct {
+	RoundClosed bool `json:"round_closed"`
+}
+
+func (m *MsgSubmitAttestationResponse) ProtoMessage()  {}
+func (m *MsgSubmitAttestationResponse) Reset()         { *m = MsgSubmitAttestationResponse{} }
+func (m *MsgSubmitAttestationResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgWithdrawAttestation removes Operator's submission from FeedID's current round,
+// provided the round hasn't closed yet.
+type MsgWithdrawAttestation struct {
+	Operator string `json:"operator"`
+	FeedID   string `json:"feed_id"`
+}
+
+var _ sdk.Msg = &MsgWithdrawAttestation{}
+
+const TypeMsgWithdrawAttestation = "withdraw_attestation"
+
+func (msg *MsgWithdrawAttestation) Route() string { return RouterKey }
+func (msg *MsgWithdrawAttestation) Type() string  { return TypeMsgWithdrawAttestation }
+
+func (msg *MsgWithdrawAttestation) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgWithdrawAttestation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgWithdrawAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if msg.FeedID == "" {
+		return fmt.Errorf("feed ID cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAttestation) ProtoMessage()  {}
+func (m *MsgWithdrawAttestation) Reset()         { *m = MsgWithdrawAttestation{} }
+func (m *MsgWithdrawAttestation) String() string { return proto.CompactTextString(m) }
+
+// MsgWithdrawAttestationResponse is the response for MsgWithdrawAttestation.
+type MsgWithdrawAttestationResponse struct{}
+
+func (m *MsgWithdrawAttestationResponse) ProtoMessage()  {}
+func (m *MsgWithdrawAttestationResponse) Reset()         { *m = MsgWithdrawAttestationResponse{} }
+func (m *MsgWithdrawAttestationResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,228 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MsgRequestEnrollment registers a new Oracle in types.StatusPendingEnrollment and asks
+// the keeper to generate an EnrollmentChallenge for it -- the first step of the
+// ACME-style flow documented in enrollment.go.
+type MsgRequestEnrollment struct {
+	Operator       string        `json:"operator"`
+	OracleID       string        `json:"oracle_id"`
+	Endpoint       string        `json:"endpoint"`
+	OperatorPubKey []byte        `json:"operator_pub_key"`
+	DesiredType    OracleType    `json:"desired_type"`
+	ChallengeType  ChallengeType `json:"challenge_type"`
+}
+
+var _ sdk.Msg = &MsgRequestEnrollment{}
+
+const TypeMsgRequestEnrollment = "request_enrollment"
+
+func (msg *MsgRequestEnrollment) Route() string { return RouterKey }
+func (msg *MsgRequestEnrollment) Type() string  { return TypeMsgRequestEnrollment }
+
+func (msg *MsgRequestEnrollment) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgRequestEnrollment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRequestEnrollment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if msg.OracleID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	if msg.Endpoint == "" {
+		return fmt.Errorf("endpoint cannot be empty")
+	}
+	if len(msg.OperatorPubKey) == 0 {
+		return fmt.Errorf("operator public key cannot be empty")
+	}
+	if msg.ChallengeType != ChallengeTypeHTTP01 && msg.ChallengeType != ChallengeTypeDNS01 {
+		return fmt.Errorf("challenge type must be %q or %q", ChallengeTypeHTTP01, ChallengeTypeDNS01)
+	}
+	return nil
+}
+
+func (m *MsgRequestEnrollment) ProtoMessage()  {}
+func (m *MsgRequestEnrollment) Reset()         { *m = MsgRequestEnrollment{} }
+func (m *MsgRequestEnrollment) String() string { return proto.CompactTextString(m) }
+
+// MsgRequestEnrollmentResponse is the response for MsgRequestEnrollment.
+type MsgRequestEnrollmentResponse struct {
+	Token           string `json:"token"`
+	HTTP01URL       string `json:"http01_url,omitempty"`
+	DNS01RecordName string `json:"dns01_record_name,omitempty"`
+}
+
+func (m *MsgRequestEnrollmentResponse) ProtoMessage()  {}
+func (m *MsgRequestEnrollmentResponse) Reset()         { *m = MsgRequestEnrollmentResponse{} }
+func (m *MsgRequestEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgFinalizeEnrollment marks OracleID's EnrollmentChallenge as finalized, signalling
+// the operator believes the token is now being served at HTTP01URL/DNS01RecordName, so
+// MsgVoteEnrollmentChallenge votes can start counting toward the tally.
+type MsgFinalizeEnrollment struct {
+	Operator string `json:"operator"`
+	OracleID string `json:"oracle_id"`
+}
+
+var _ sdk.Msg = &MsgFinalizeEnrollment{}
+
+const TypeMsgFinalizeEnrollment = "finalize_enrollment"
+
+func (msg *MsgFinalizeEnrollment) Route() string { return RouterKey }
+func (msg *MsgFinalizeEnrollment) Type() string  { return TypeMsgFinalizeEnrollment }
+
+func (msg *MsgFinalizeEnrollment) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgFinalizeEnrollment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgFinalizeEnrollment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if msg.OracleID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgFinalizeEnrollment) ProtoMessage()  {}
+func (m *MsgFinalizeEnrollment) Reset()         { *m = MsgFinalizeEnrollment{} }
+func (m *MsgFinalizeEnrollment) String() string { return proto.CompactTextString(m) }
+
+// MsgFinalizeEnrollmentResponse is the response for MsgFinalizeEnrollment.
+type MsgFinalizeEnrollmentResponse struct{}
+
+func (m *MsgFinalizeEnrollmentResponse) ProtoMessage()  {}
+func (m *MsgFinalizeEnrollmentResponse) Reset()         { *m = MsgFinalizeEnrollmentResponse{} }
+func (m *MsgFinalizeEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgVoteEnrollmentChallenge carries one validator's off-chain-verified verdict on
+// OracleID's finalized EnrollmentChallenge (see enrollment.go's EnrollmentVote doc
+// comment for why the fetch+hash itself happens off-chain).
+type MsgVoteEnrollmentChallenge struct {
+	Validator string `json:"validator"`
+	OracleID  string `json:"oracle_id"`
+	Approved  bool   `json:"approved"`
+}
+
+var _ sdk.Msg = &MsgVoteEnrollmentChallenge{}
+
+const TypeMsgVoteEnrollmentChallenge = "vote_enrollment_challenge"
+
+func (msg *MsgVoteEnrollmentChallenge) Route() string { return RouterKey }
+func (msg *MsgVoteEnrollmentChallenge) Type() string  { return TypeMsgVoteEnrollmentChallenge }
+
+func (msg *MsgVoteEnrollmentChallenge) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{validator}
+}
+
+func (msg *MsgVoteEnrollmentChallenge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgVoteEnrollmentChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	if msg.OracleID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgVoteEnrollmentChallenge) ProtoMessage()  {}
+func (m *MsgVoteEnrollmentChallenge) Reset()         { *m = MsgVoteEnrollmentChallenge{} }
+func (m *MsgVoteEnrollmentChallenge) String() string { return proto.CompactTextString(m) }
+
+// MsgVoteEnrollmentChallengeResponse is the response for MsgVoteEnrollmentChallenge.
+// Activated is true if this vote was the one that crossed the quorum and flipped the
+// oracle to types.StatusActive.
+type MsgVoteEnrollmentChallengeResponse struct {
+	Activated bool `json:"activated"`
+}
+
+func (m *MsgVoteEnrollmentChallengeResponse) ProtoMessage()  {}
+func (m *MsgVoteEnrollmentChallengeResponse) Reset()         { *m = MsgVoteEnrollmentChallengeResponse{} }
+func (m *MsgVoteEnrollmentChallengeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRotateOperatorKey replaces OracleID's OperatorPubKey without requiring the operator
+// to repeat the enrollment challenge, so routine key rotation doesn't re-run
+// endpoint-ownership verification.
+type MsgRotateOperatorKey struct {
+	Operator  string `json:"operator"`
+	OracleID  string `json:"oracle_id"`
+	NewPubKey []byte `json:"new_pub_key"`
+}
+
+var _ sdk.Msg = &MsgRotateOperatorKey{}
+
+const TypeMsgRotateOperatorKey = "rotate_operator_key"
+
+func (msg *MsgRotateOperatorKey) Route() string { return RouterKey }
+func (msg *MsgRotateOperatorKey) Type() string  { return TypeMsgRotateOperatorKey }
+
+func (msg *MsgRotateOperatorKey) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{operator}
+}
+
+func (msg *MsgRotateOperatorKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRotateOperatorKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return fmt.Errorf("invalid operator address: %w", err)
+	}
+	if msg.OracleID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	if len(msg.NewPubKey) == 0 {
+		return fmt.Errorf("new public key cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgRotateOperatorKey) ProtoMessage()  {}
+func (m *MsgRotateOperatorKey) Reset()         { *m = MsgRotateOperatorKey{} }
+func (m *MsgRotateOperatorKey) String() string { return proto.CompactTextString(m) }
+
+// MsgRotateOperatorKeyResponse is the response for MsgRotateOperatorKey.
+type MsgRotateOperatorKeyResponse struct{}
+
+func (m *MsgRotateOperatorKeyResponse) ProtoMessage()  {}
+func (m *MsgRotateOperatorKeyResponse) Reset()         { *m = MsgRotateOperatorKeyResponse{} }
+func (m *MsgRotateOperatorKeyResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,54 @@
+package types
+
+import "encoding/base64"
+
+// CursorPageRequest drives cursor-based pagination over the secondary indexes store.go
+// maintains, the x/oracle counterpart of x/credential/types/query.go's
+// CursorPageRequest. Cursor is the opaque, base64-encoded last key seen on the previous
+// page; an empty Cursor starts from the beginning.
+type CursorPageRequest struct {
+	Cursor  string `json:"cursor,omitempty"`
+	Limit   uint64 `json:"limit"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+// CursorPageResponse is returned alongside a page of results, carrying the opaque
+// cursor to pass as the next request's Cursor, or "" if there is no further page.
+type CursorPageResponse struct {
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// EncodeCursor base64-encodes a raw store key into an opaque pagination cursor.
+func EncodeCursor(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// DecodeCursor decodes an opaque pagination cursor back into a raw store key.
+func DecodeCursor(cursor string) ([]byte, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	return base64.RawURLEncoding.DecodeString(cursor)
+}
+
+// DefaultQueryLimit is applied when a CursorPageRequest does not specify a Limit.
+const DefaultQueryLimit = 100
+
+// MaxQueryLimit caps the page size cursor queries may request.
+const MaxQueryLimit = 1000
+
+// EffectiveLimit returns the page's requested limit clamped to [1, MaxQueryLimit],
+// defaulting to DefaultQueryLimit when unset.
+func (p CursorPageRequest) EffectiveLimit() uint64 {
+	switch {
+	case p.Limit == 0:
+		return DefaultQueryLimit
+	case p.Limit > MaxQueryLimit:
+		return MaxQueryLimit
+	default:
+		return p.Limit
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import "fmt"
+
+// AggregationMode selects how CommitteeResponseEndBlocker (keeper/committee_response.go)
+// resolves a CommitteeResponseWindow's collected CommitteeResponses into one value:
+// AggregationModeMedian for numeric responses, AggregationModePlurality for categorical
+// (string) ones -- the same numeric/categorical split types/aggregation.go's
+// AggregateResponses already draws for the older, off-chain-collected DataSource flow.
+type AggregationMode string
+
+const (
+	AggregationModeMedian    AggregationMode = "median"
+	AggregationModePlurality AggregationMode = "plurality"
+)
+
+// Params holds the module-wide defaults CommitteeResponseEndBlocker applies to every
+// OracleRequest's committee response window, mirroring the Panacea oracle module's
+// pattern of a single Params record carried in GenesisState rather than per-oracle
+// configuration.
+//
+// This is deliberately separate from the existing, per-oracle AggregationConfig
+// (aggregation.go): AggregationConfig tunes AggregateResponses/AggregateAndRespond's
+// single-shot aggregation over a batch of SourceResponses the caller already collected
+// off-chain (see that method's doc comment on why this chain can't fan out over HTTP
+// itself), while Params tunes the new on-chain, multi-block, per-OracleRequest
+// committee-response flow SubmitCommitteeResponse/CommitteeResponseEndBlocker drive
+// below -- two aggregation subsystems that happen to share vocabulary (min responses,
+// max deviation) because they solve the same statistical problem on different inputs.
+type Params struct {
+	AggregationMode      AggregationMode `json:"aggregation_mode"`
+	MinResponses         int             `json:"min_responses"`
+	MaxDeviationBps       int64          `json:"max_deviation_bps"`
+	ResponseWindowBlocks int64           `json:"response_window_blocks"`
+	RequestTimeoutBlocks int64           `json:"request_timeout_blocks"`
+}
+
+// DefaultParams returns the module's default Params, used by DefaultGenesisState and by
+// GetParams when no Params have ever been set.
+func DefaultParams() Params {
+	return Params{
+		AggregationMode:      AggregationModeMedian,
+		MinResponses:         3,
+		MaxDeviationBps:      500,
+		ResponseWindowBlocks: 50,
+		RequestTimeoutBlocks: 100,
+	}
+}
+
+// Validate checks Params for internal consistency.
+func (p Params) Validate() error {
+	if p.AggregationMode != AggregationModeMedian && p.AggregationMode != AggregationModePlurality {
+		return fmt.Errorf("unknown aggregation mode %q", p.AggregationMode)
+	}
+	if p.MinResponses < 1 {
+		return fmt.Errorf("min responses must be at least 1, got %d", p.MinResponses)
+	}
+	if p.MaxDeviationBps < 0 {
+		return fmt.Errorf("max deviation bps cannot be negative, got %d", p.MaxDeviationBps)
+	}
+	if p.ResponseWindowBlocks < 1 {
+		return fmt.Errorf("response window blocks must be at least 1, got %d", p.ResponseWindowBlocks)
+	}
+	if p.RequestTimeoutBlocks < 1 {
+		return fmt.Errorf("request timeout blocks must be at least 1, got %d", p.RequestTimeoutBlocks)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import "time"
+
+// OracleStore is the storage abstraction behind the oracle keeper, inspired by the
+// gobuffalo/pop DBAL split between a query builder and swappable connection backends:
+// keeper call sites (RegisterOracle, SubmitOracleRequest, ...) talk to this interface,
+// never to a KVStoreService directly, so the on-chain IAVL-backed implementation
+// (keeper.KVOracleStore, see keeper/store.go) can be swapped for an off-chain indexer --
+// Postgres or SQLite via database/sql -- without touching keeper logic.
+//
+// No such off-chain backend is implemented in this tree: there is no database/sql usage
+// anywhere in this repository to model one after, and no go.mod to vendor a driver into
+// (there is no go.mod anywhere under this module at all). This interface and
+// keeper.KVOracleStore's on-chain implementation are real; a Postgres/SQLite
+// OracleStore implementing this same interface for off-chain analytics is future work
+// the interface makes possible but does not itself provide, the same honest gap
+// types.BLSAggregator leaves for a concrete BLS12-381 backend.
+type OracleStore interface {
+	// PutOracle writes oracle and maintains its type/status/owner secondary indexes.
+	PutOracle(oracle *Oracle) error
+	GetOracle(oracleID string) (*Oracle, error)
+	// OraclesByType/OraclesByStatus/OraclesByOwner range over their respective
+	// secondary index (type->id, status->id, owner->id) starting after page.Cursor.
+	OraclesByType(t OracleType, page CursorPageRequest) ([]*Oracle, CursorPageResponse, error)
+	OraclesByStatus(s OracleStatus, page CursorPageRequest) ([]*Oracle, CursorPageResponse, error)
+	OraclesByOwner(owner string, page CursorPageRequest) ([]*Oracle, CursorPageResponse, error)
+
+	// PutRequest writes request and maintains its oracleID+createdAt->requestID index.
+	PutRequest(request *OracleRequest) error
+	GetRequest(requestID string) (*OracleRequest, error)
+	// RequestsByOracleAndTime ranges over the oracleID+createdAt index, optionally
+	// bounded to [start, end) and filtered to status (RequestStatus("") matches any).
+	RequestsByOracleAndTime(oracleID string, status RequestStatus, start, end time.Time, page CursorPageRequest) ([]*OracleRequest, CursorPageResponse, error)
+
+	// PutStats appends a statistics snapshot to oracleID's history (moved onto this
+	// same layer per the request, rather than the single overwritten StatsKey record
+	// keeper.go's UpdateOracleStatistics used to maintain) and returns the latest.
+	PutStats(stats *OracleStatistics) error
+	GetLatestStats(oracleID string) (*OracleStatistics, error)
+	// StatsHistory ranges over oracleID's statistics snapshots within [start, end).
+	StatsHistory(oracleID string, start, end time.Time, page CursorPageRequest) ([]*OracleStatistics, CursorPageResponse, error)
+}
@@ -0,0 +1,321 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// ModuleName/StoreKey/RouterKey identify the oracle module, the same three-constant
+// block every module in this tree (collections-backed or not) leads its types package
+// with.
+const (
+	ModuleName = "oracle"
+	StoreKey   = ModuleName
+	RouterKey  = ModuleName
+)
+
+// Oracle represents an external data oracle an on-chain consumer (e.g. x/credential's
+// MsgVerifyCredential flow) can submit requests to and trust the response of.
+type Oracle struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Endpoint    string            `json:"endpoint"`
+	Type        OracleType        `json:"type"`
+	Owner       string            `json:"owner"`
+	Status      OracleStatus      `json:"status"`
+	Reputation  float64           `json:"reputation"`
+	Created     time.Time         `json:"created"`
+	Updated     time.Time         `json:"updated"`
+	Config      OracleConfig      `json:"config"`
+	Metadata    map[string]string `json:"metadata"`
+
+	// OperatorPubKey is the current signing key proven to control Endpoint via the
+	// ACME-style enrollment challenge in enrollment.go. MsgRotateOperatorKey replaces it
+	// without requiring re-enrollment.
+	OperatorPubKey []byte `json:"operator_pub_key,omitempty"`
+}
+
+// OracleType represents the type of oracle.
+type OracleType string
+
+const (
+	TypeIdentityVerification OracleType = "identity_verification"
+	TypeKYC                  OracleType = "kyc"
+	TypeCredentialValidation OracleType = "credential_validation"
+	TypeReputationScore      OracleType = "reputation_score"
+	TypeBiometricMatch       OracleType = "biometric_match"
+	TypeDocumentVerification OracleType = "document_verification"
+	TypePriceOracle          OracleType = "price_oracle"
+	TypeComplianceCheck      OracleType = "compliance_check"
+)
+
+// OracleStatus represents the status of an oracle.
+type OracleStatus string
+
+const (
+	StatusActive      OracleStatus = "active"
+	StatusSuspended   OracleStatus = "suspended"
+	StatusDeprecated  OracleStatus = "deprecated"
+	StatusMaintenance OracleStatus = "maintenance"
+
+	// StatusPendingEnrollment is an Oracle's status between MsgRequestEnrollment and a
+	// successful ACME-style challenge (see enrollment.go): registered, but not yet
+	// eligible to serve requests until enough validators vote the challenge verified.
+	StatusPendingEnrollment OracleStatus = "pending_enrollment"
+)
+
+// OracleConfig defines oracle configuration, including the DataSources it can draw a
+// response from.
+type OracleConfig struct {
+	Timeout         time.Duration    `json:"timeout"`
+	RetryAttempts   int              `json:"retry_attempts"`
+	RequiredFee     sdk.Coin         `json:"required_fee"`
+	ResponseFormat  string           `json:"response_format"`
+	AuthRequired    bool             `json:"auth_required"`
+	RateLimit       RateLimit        `json:"rate_limit"`
+	DataSources     []DataSource     `json:"data_sources"`
+	ValidationRules []ValidationRule `json:"validation_rules"`
+}
+
+// RateLimit defines oracle rate limiting.
+type RateLimit struct {
+	RequestsPerSecond int           `json:"requests_per_second"`
+	RequestsPerHour   int           `json:"requests_per_hour"`
+	RequestsPerDay    int           `json:"requests_per_day"`
+	BurstSize         int           `json:"burst_size"`
+	WindowSize        time.Duration `json:"window_size"`
+}
+
+// DataSource represents one underlying data source an oracle consumes. Type selects
+// which of the type-specific fields below is populated; most DataSources are a plain
+// HTTP endpoint (Type == "http"), but Type == "oidc" carries OIDC (see oidc.go) instead.
+type DataSource struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	URL         string            `json:"url"`
+	Type        string            `json:"type"`
+	Weight      float64           `json:"weight"`
+	Reliability float64           `json:"reliability"`
+	Headers     map[string]string `json:"headers"`
+	Params      map[string]string `json:"params"`
+
+	// OIDC configures this DataSource to consume OpenID Connect ID tokens instead of
+	// fetching URL directly, for an oracle of TypeIdentityVerification or TypeKYC. Set
+	// only when Type == DataSourceTypeOIDC.
+	OIDC *OIDCSource `json:"oidc,omitempty"`
+}
+
+// DataSourceTypeOIDC is the DataSource.Type value selecting the OIDC-backed source
+// (see oidc.go).
+const DataSourceTypeOIDC = "oidc"
+
+// ValidationRule defines data validation rules.
+type ValidationRule struct {
+	Field     string      `json:"field"`
+	Type      string      `json:"type"`
+	Condition string      `json:"condition"`
+	Value     interface{} `json:"value"`
+	Message   string      `json:"message"`
+}
+
+// OracleRequest represents a request to an oracle.
+type OracleRequest struct {
+	ID          string                 `json:"id"`
+	OracleID    string                 `json:"oracle_id"`
+	Requester   string                 `json:"requester"`
+	RequestData map[string]interface{} `json:"request_data"`
+	Status      RequestStatus          `json:"status"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+	Response    *OracleResponse        `json:"response,omitempty"`
+	Fee         sdk.Coin               `json:"fee"`
+	Priority    int                    `json:"priority"`
+	Callback    string                 `json:"callback"`
+	// DeadlineHeight is the block height by which this request must reach
+	// RequestCompleted/RequestFailed, set from Params.RequestTimeoutBlocks at
+	// SubmitOracleRequest time. RequestTimeoutEndBlocker (keeper/request_timeout.go)
+	// moves any request still RequestPending/RequestProcessing past this height to
+	// RequestExpired -- the same DeadlineHeight convention AttestationRound and
+	// CommitteeResponseWindow already use, rather than a time.Time deadline.
+	DeadlineHeight int64 `json:"deadline_height"`
+}
+
+// OracleResponse represents an oracle response.
+type OracleResponse struct {
+	ID           string                 `json:"id"`
+	RequestID    string                 `json:"request_id"`
+	ResponseData map[string]interface{} `json:"response_data"`
+	Success      bool                   `json:"success"`
+	ErrorMessage string                 `json:"error_message,omitempty"`
+	Confidence   float64                `json:"confidence"`
+	Sources      []string               `json:"sources"`
+	Timestamp    time.Time              `json:"timestamp"`
+	Signature    string                 `json:"signature"`
+	Evidence     []Evidence             `json:"evidence"`
+}
+
+// Evidence provides supporting evidence for oracle responses.
+type Evidence struct {
+	Type      string            `json:"type"`
+	Data      string            `json:"data"`
+	Hash      string            `json:"hash"`
+	Source    string            `json:"source"`
+	Timestamp time.Time         `json:"timestamp"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+// EvidenceTypeOIDCIDToken is the Evidence.Type value an OIDC-backed DataSource's
+// response carries, with Data the raw compact JWS and Hash its SHA-256 (see oidc.go's
+// VerifyIDToken).
+const EvidenceTypeOIDCIDToken = "oidc_id_token"
+
+// RequestStatus represents the status of an oracle request.
+type RequestStatus string
+
+const (
+	RequestPending    RequestStatus = "pending"
+	RequestProcessing RequestStatus = "processing"
+	RequestCompleted  RequestStatus = "completed"
+	RequestFailed     RequestStatus = "failed"
+	RequestExpired    RequestStatus = "expired"
+	RequestCancelled  RequestStatus = "cancelled"
+)
+
+// OracleStatistics tracks oracle performance statistics.
+type OracleStatistics struct {
+	OracleID        string    `json:"oracle_id"`
+	TotalRequests   uint64    `json:"total_requests"`
+	SuccessfulReqs  uint64    `json:"successful_requests"`
+	FailedRequests  uint64    `json:"failed_requests"`
+	AvgResponseTime int64     `json:"avg_response_time"` // in milliseconds
+	AvgConfidence   float64   `json:"avg_confidence"`
+	LastRequest     time.Time `json:"last_request"`
+	Uptime          float64   `json:"uptime"`
+	ReputationScore float64   `json:"reputation_score"`
+}
+
+// OracleQuery defines query parameters for oracles.
+type OracleQuery struct {
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+func (m *Oracle) ProtoMessage()  {}
+func (m *Oracle) Reset()         { *m = Oracle{} }
+func (m *Oracle) String() string { return proto.CompactTextString(m) }
+
+func (m *OracleRequest) ProtoMessage()  {}
+func (m *OracleRequest) Reset()         { *m = OracleRequest{} }
+func (m *OracleRequest) String() string { return proto.CompactTextString(m) }
+
+func (m *OracleResponse) ProtoMessage()  {}
+func (m *OracleResponse) Reset()         { *m = OracleResponse{} }
+func (m *OracleResponse) String() string { return proto.CompactTextString(m) }
+
+func (m *OracleStatistics) ProtoMessage()  {}
+func (m *OracleStatistics) Reset()         { *m = OracleStatistics{} }
+func (m *OracleStatistics) String() string { return proto.CompactTextString(m) }
+
+// Oracle module errors. Plain fmt.Errorf values, not errorsmod.Register codes, matching
+// this baseline-era module's pre-existing style (keeper.go already returns these
+// directly) rather than the errorsmod convention newer modules (x/credential,
+// x/revocation) use.
+var (
+	ErrOracleNotFound  = fmt.Errorf("oracle not found")
+	ErrOracleNotActive = fmt.Errorf("oracle not active")
+	ErrRequestNotFound = fmt.Errorf("request not found")
+	ErrStatsNotFound   = fmt.Errorf("stats not found")
+)
+
+// Key prefixes for the module's raw KVStoreService-backed layout -- this module
+// predates the collections.Map migration x/revocation/x/did went through, so
+// keeper.go opens storeService.OpenKVStore(ctx) directly rather than building a
+// collections.Schema.
+var (
+	OracleKeyPrefix  = []byte{0x01}
+	RequestKeyPrefix = []byte{0x02}
+	StatsKeyPrefix   = []byte{0x03}
+)
+
+// OracleKey returns the store key for an oracle.
+func OracleKey(oracleID string) []byte {
+	return append(append([]byte{}, OracleKeyPrefix...), []byte(oracleID)...)
+}
+
+// RequestKey returns the store key for a request.
+func RequestKey(requestID string) []byte {
+	return append(append([]byte{}, RequestKeyPrefix...), []byte(requestID)...)
+}
+
+// StatsKey returns the store key for stats.
+func StatsKey(oracleID string) []byte {
+	return append(append([]byte{}, StatsKeyPrefix...), []byte(oracleID)...)
+}
+
+// Validate validates an Oracle.
+func (o *Oracle) Validate() error {
+	if o.ID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	if o.Name == "" {
+		return fmt.Errorf("oracle name cannot be empty")
+	}
+	if o.Endpoint == "" && !o.hasOIDCSource() {
+		return fmt.Errorf("oracle endpoint cannot be empty")
+	}
+	if o.Owner == "" {
+		return fmt.Errorf("oracle owner cannot be empty")
+	}
+	for _, ds := range o.Config.DataSources {
+		if ds.Type == DataSourceTypeOIDC {
+			if ds.OIDC == nil {
+				return fmt.Errorf("data source %q has type %q but no oidc config", ds.ID, DataSourceTypeOIDC)
+			}
+			if err := ds.OIDC.Validate(); err != nil {
+				return fmt.Errorf("data source %q: %w", ds.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// hasOIDCSource reports whether o has at least one OIDC-backed DataSource, letting
+// Validate accept an empty top-level Endpoint for an oracle that only ever consumes ID
+// tokens rather than polling a URL.
+func (o *Oracle) hasOIDCSource() bool {
+	for _, ds := range o.Config.DataSources {
+		if ds.Type == DataSourceTypeOIDC {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates an OracleRequest.
+func (r *OracleRequest) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("request ID cannot be empty")
+	}
+	if r.OracleID == "" {
+		return fmt.Errorf("oracle ID cannot be empty")
+	}
+	if r.Requester == "" {
+		return fmt.Errorf("requester cannot be empty")
+	}
+	return nil
+}
+
+// Validate validates an OracleResponse.
+func (r *OracleResponse) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("response ID cannot be empty")
+	}
+	if r.RequestID == "" {
+		return fmt.Errorf("request ID cannot be empty")
+	}
+	return nil
+}
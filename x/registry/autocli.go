@@ -0,0 +1,12 @@
+package registry
+
+// AutoCLIOptions would declare the registry module's tx/query commands for autocli --
+// identity CRUD and `query identities` with filters, per this chunk's request -- the
+// same shape x/oracle/autocli.go and x/schema/autocli.go use.
+//
+// It can't be written yet, for two compounding reasons: (1) autocli.HasAutoCLIConfig
+// is a method on an AppModule value, and x/registry has no AppModule/module.go
+// anywhere in this tree to hang that method on; (2) even once one exists, x/registry
+// has no Msg types or MsgServer to describe RPCs for in the first place -- see
+// module/depinject.go's doc comment for the full state of that gap. This file records
+// the gap rather than fabricating a Msg/Query service this module doesn't have.
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/registry/types"
+)
+
+// DefaultExpirySweepBatchSize bounds how many active identities expireIdentitiesBatch
+// inspects per EndBlocker call, the same per-block budget DefaultReputationRecomputeBatchSize
+// applies to the reputation cache flush.
+const DefaultExpirySweepBatchSize = 200
+
+// expireIdentitiesBatch walks up to budget entries of the StatusIndexPrefix "active/"
+// index, resuming from types.ExpirySweepCursorKey, and flips any identity whose Expires
+// has passed (and is non-zero -- a zero Expires means "does not expire") to
+// types.StatusExpired. Like flushReputationCacheBatch, this is spread across many
+// EndBlocker calls rather than scanning every active identity in one block.
+//
+// The StatusIndexPrefix index can carry stale entries for identities that changed
+// status without the old index entry being removed (see GetStatusIndexKey's doc
+// comment for that standing gap); GetIdentity below always reflects the identity's real
+// current status, so a stale "active/" entry for an already-suspended/revoked identity
+// is simply skipped rather than mistakenly re-activated or re-expired.
+func (k Keeper) expireIdentitiesBatch(ctx context.Context, budget int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	if now.IsZero() {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+
+	activePrefix := append(append([]byte{}, types.StatusIndexPrefix...), []byte(string(types.StatusActive)+"/")...)
+	start := activePrefix
+	if cursor := store.Get(types.ExpirySweepCursorKey); cursor != nil {
+		start = cursor
+	}
+
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(activePrefix))
+	defer iterator.Close()
+
+	processed := 0
+	var lastKey []byte
+	for ; iterator.Valid() && processed < budget; iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		id := strings.TrimPrefix(string(key), string(activePrefix))
+
+		registry, err := k.GetIdentity(ctx, id)
+		if err == nil && registry.Status == types.StatusActive && !registry.Expires.IsZero() && now.After(registry.Expires) {
+			registry.Status = types.StatusExpired
+			registry.Updated = now
+			if err := k.UpdateIdentity(ctx, registry); err != nil {
+				return err
+			}
+		}
+
+		lastKey = key
+		processed++
+	}
+
+	if !iterator.Valid() {
+		store.Delete(types.ExpirySweepCursorKey)
+		return nil
+	}
+
+	if lastKey != nil {
+		store.Set(types.ExpirySweepCursorKey, lastKey)
+	}
+	return nil
+}
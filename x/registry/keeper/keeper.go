@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"cosmossdk.io/core/store"
@@ -11,9 +14,7 @@ import (
 	
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	
+
 	"github.com/PersonaPass-ID/personachain/x/registry/types"
 )
 
@@ -23,12 +24,27 @@ type Keeper struct {
 	storeService store.KVStoreService
 	logger       log.Logger
 
-	// Keep reference to the auth and bank keepers
-	authKeeper authkeeper.AccountKeeper
-	bankKeeper bankkeeper.Keeper
-	
+	// Keep reference to the auth and bank keepers, narrowed to types.AccountKeeper/
+	// types.BankKeeper (see expected_keepers.go) rather than the concrete
+	// authkeeper.AccountKeeper/bankkeeper.Keeper types NewKeeper used to take -- this is
+	// what lets module/depinject.go's ModuleInputs resolve them from a depinject
+	// container without this package importing x/auth's/x/bank's keeper packages
+	// directly.
+	authKeeper types.AccountKeeper
+	bankKeeper types.BankKeeper
+
 	// Authority is the address capable of executing governance proposals
 	authority string
+
+	// localEngine computes a single identity's ReputationScore synchronously from its
+	// own History on every SuspendIdentity/RevokeIdentity/UpdateReputation call.
+	localEngine ReputationEngine
+
+	// propagationEngine computes the EigenTrust-lite-propagated ReputationScore used by
+	// the periodic global recompute cycle (RebuildReputation/EndBlocker); unlike
+	// localEngine it needs every identity's History to build the trust graph, so it's
+	// only run in that batched path, never inline on a single write.
+	propagationEngine ReputationEngine
 }
 
 // NewKeeper creates a registry keeper
@@ -36,20 +52,32 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	logger log.Logger,
-	authKeeper authkeeper.AccountKeeper,
-	bankKeeper bankkeeper.Keeper,
+	authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		logger:       logger,
-		authKeeper:   authKeeper,
-		bankKeeper:   bankKeeper,
-		authority:    authority,
+		cdc:               cdc,
+		storeService:      storeService,
+		logger:            logger,
+		authKeeper:        authKeeper,
+		bankKeeper:        bankKeeper,
+		authority:         authority,
+		localEngine:       NewDecayScorer(),
+		propagationEngine: NewEigenTrustScorer(),
 	}
 }
 
+// WithReputationEngines returns a copy of k using local and propagation in place of its
+// default DecayScorer/EigenTrustScorer, the pluggability the request asks for -- e.g. to
+// swap in a test double, or a future third scorer implementation, without changing
+// NewKeeper's signature.
+func (k Keeper) WithReputationEngines(local, propagation ReputationEngine) Keeper {
+	k.localEngine = local
+	k.propagationEngine = propagation
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger() log.Logger {
 	return k.logger.With("module", "x/"+types.ModuleName)
@@ -106,20 +134,27 @@ func (k Keeper) SetIdentity(ctx context.Context, registry *types.IdentityRegistr
 	return nil
 }
 
-// GetIdentity retrieves an identity from the registry
+// GetIdentity retrieves an identity from the registry. If a propagated ReputationScore
+// has been cached for id by a prior recompute cycle (see EndBlocker/RebuildReputation),
+// it's overlaid onto the stored registry so callers see the EigenTrust-lite-propagated
+// Overall score in O(1) rather than this call re-running propagation itself.
 func (k Keeper) GetIdentity(ctx context.Context, id string) (*types.IdentityRegistry, error) {
 	store := k.storeService.OpenKVStore(ctx)
-	
+
 	bz := store.Get(types.GetIdentityKey(id))
 	if bz == nil {
 		return nil, fmt.Errorf("identity %s not found in registry", id)
 	}
-	
+
 	var registry types.IdentityRegistry
 	if err := k.cdc.Unmarshal(bz, &registry); err != nil {
 		return nil, err
 	}
-	
+
+	if cached, ok := k.getCachedReputationScore(ctx, id); ok {
+		registry.Reputation = cached
+	}
+
 	return &registry, nil
 }
 
@@ -160,7 +195,7 @@ func (k Keeper) SuspendIdentity(ctx context.Context, id string, reason string) e
 		Timestamp: time.Now(),
 	}
 	registry.Reputation.History = append(registry.Reputation.History, event)
-	k.updateReputationScore(registry, event)
+	k.updateReputationScore(ctx, registry)
 
 	return k.UpdateIdentity(ctx, registry)
 }
@@ -184,12 +219,15 @@ func (k Keeper) RevokeIdentity(ctx context.Context, id string, reason string) er
 		Timestamp: time.Now(),
 	}
 	registry.Reputation.History = append(registry.Reputation.History, event)
-	k.updateReputationScore(registry, event)
+	k.updateReputationScore(ctx, registry)
 
 	return k.UpdateIdentity(ctx, registry)
 }
 
-// UpdateReputation updates the reputation score of an identity
+// UpdateReputation updates the reputation score of an identity. event.Source, when set
+// to another identity's registry ID (e.g. the issuer of a credential, or a transaction
+// counterparty), becomes a trust edge the next RebuildReputation/EndBlocker recompute
+// cycle's EigenTrust-lite propagation draws on -- see types.BuildTrustEdges.
 func (k Keeper) UpdateReputation(ctx context.Context, id string, event types.ReputationEvent) error {
 	registry, err := k.GetIdentity(ctx, id)
 	if err != nil {
@@ -197,7 +235,7 @@ func (k Keeper) UpdateReputation(ctx context.Context, id string, event types.Rep
 	}
 
 	registry.Reputation.History = append(registry.Reputation.History, event)
-	k.updateReputationScore(registry, event)
+	k.updateReputationScore(ctx, registry)
 	registry.Updated = time.Now()
 
 	return k.UpdateIdentity(ctx, registry)
@@ -238,6 +276,299 @@ func (k Keeper) QueryIdentities(ctx context.Context, query types.RegistryQuery)
 	return identities, nil
 }
 
+// indexCandidate is one secondary-index scan QueryIdentitiesIndexed's planner can draw
+// on, holding the registry IDs it matched in ascending order so multiple candidates can
+// be intersected via a sort-merge join on that shared ID ordering.
+type indexCandidate struct {
+	name string
+	ids  []string
+}
+
+// scanIndexIDs collects every ID indexed under the composite-key prefix (the
+// "prefix + <value>/" shape GetTypeIndexKey/GetStatusIndexKey/GetReputationBucketKey all
+// share), returned in the ascending order the store iterates a fixed prefix in.
+func scanIndexIDs(store storetypes.KVStore, prefix []byte) []string {
+	var ids []string
+	iterator := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, string(key[len(prefix):]))
+	}
+	return ids
+}
+
+// planIndexCandidates splits req's filters into point lookups (did/owner equality,
+// which resolve straight to a single ID and make every other filter redundant as an
+// index), prefix-scan candidates (type/status equality, reputation_min/reputation_max
+// range), and residual filters that have no matching index and must be evaluated
+// in-memory against each loaded registry.
+func (k Keeper) planIndexCandidates(store storetypes.KVStore, filters []types.QueryFilter) (pointID string, hasPoint bool, candidates []indexCandidate, residual []types.QueryFilter) {
+	minBucket, maxBucket := 0, 10
+	haveReputationRange := false
+
+	for _, f := range filters {
+		switch {
+		case f.Field == "did" && f.Op == types.FilterOpEq:
+			if id := store.Get(types.GetDIDToRegistryKey(f.Value)); id != nil {
+				return string(id), true, nil, nil
+			}
+			return "", true, nil, nil // dangling/missing index entry: no match, not an error
+		case f.Field == "owner" && f.Op == types.FilterOpEq:
+			if id := store.Get(types.GetOwnerToRegistryKey(f.Value)); id != nil {
+				return string(id), true, nil, nil
+			}
+			return "", true, nil, nil
+		case f.Field == "type" && f.Op == types.FilterOpEq:
+			prefix := append(append([]byte{}, types.TypeIndexPrefix...), []byte(f.Value+"/")...)
+			candidates = append(candidates, indexCandidate{name: "type", ids: scanIndexIDs(store, prefix)})
+		case f.Field == "status" && f.Op == types.FilterOpEq:
+			prefix := append(append([]byte{}, types.StatusIndexPrefix...), []byte(f.Value+"/")...)
+			candidates = append(candidates, indexCandidate{name: "status", ids: scanIndexIDs(store, prefix)})
+		case f.Field == "reputation_min":
+			if v, err := strconv.ParseFloat(f.Value, 64); err == nil {
+				minBucket = types.ReputationBucket(v)
+				haveReputationRange = true
+			}
+		case f.Field == "reputation_max":
+			if v, err := strconv.ParseFloat(f.Value, 64); err == nil {
+				maxBucket = types.ReputationBucket(v)
+				haveReputationRange = true
+			}
+		default:
+			residual = append(residual, f)
+		}
+	}
+
+	if haveReputationRange {
+		start := append(append([]byte{}, types.ReputationBucketPrefix...), []byte(fmt.Sprintf("%02d/", minBucket))...)
+		end := append(append([]byte{}, types.ReputationBucketPrefix...), []byte(fmt.Sprintf("%02d", maxBucket+1))...)
+		var ids []string
+		iterator := store.Iterator(start, end)
+		func() {
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				key := iterator.Key()
+				// Strip "<bucket>/" from the suffix to recover the bare ID.
+				suffix := key[len(types.ReputationBucketPrefix):]
+				if idx := indexOfByte(suffix, '/'); idx >= 0 {
+					ids = append(ids, string(suffix[idx+1:]))
+				}
+			}
+		}()
+		// Spans multiple buckets in bucket-then-ID order, not globally ID order, so it
+		// needs sorting before it can sort-merge-join against the other candidates.
+		sort.Strings(ids)
+		candidates = append(candidates, indexCandidate{name: "reputation", ids: ids})
+	}
+
+	return "", false, candidates, residual
+}
+
+// indexOfByte returns the first index of b in s, or -1.
+func indexOfByte(s []byte, b byte) int {
+	for i, c := range s {
+		if c == b {
+			return i
+		}
+	}
+	return -1
+}
+
+// intersectSorted returns the IDs common to every candidate, via a sort-merge join over
+// their already-ascending id lists -- O(total ids) rather than a nested-loop intersection.
+func intersectSorted(candidates []indexCandidate) []string {
+	if len(candidates) == 0 {
+		return nil
+	}
+	result := candidates[0].ids
+	for _, c := range candidates[1:] {
+		result = mergeIntersect(result, c.ids)
+		if len(result) == 0 {
+			break
+		}
+	}
+	return result
+}
+
+func mergeIntersect(a, b []string) []string {
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, a[i])
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return out
+}
+
+// matchesQueryFilter evaluates a single residual QueryFilter (one the planner couldn't
+// satisfy with an index) against an already-loaded registry.
+func matchesQueryFilter(registry *types.IdentityRegistry, f types.QueryFilter) bool {
+	switch f.Field {
+	case "status":
+		return compareString(string(registry.Status), f.Op, f.Value)
+	case "type":
+		return compareString(string(registry.Metadata.Type), f.Op, f.Value)
+	case "owner":
+		return compareString(registry.Owner, f.Op, f.Value)
+	case "did":
+		return compareString(registry.DID, f.Op, f.Value)
+	case "reputation":
+		return compareFloat(registry.Reputation.Overall, f.Op, f.Value)
+	case "reputation_min":
+		return compareFloat(registry.Reputation.Overall, types.FilterOpGt, f.Value) || compareFloat(registry.Reputation.Overall, types.FilterOpEq, f.Value)
+	case "reputation_max":
+		return compareFloat(registry.Reputation.Overall, types.FilterOpLt, f.Value) || compareFloat(registry.Reputation.Overall, types.FilterOpEq, f.Value)
+	default:
+		// Unknown field: the planner already dropped any filter it recognized into an
+		// index candidate, so this is a field this query layer has no concept of at
+		// all -- matching it is inert and better than silently excluding everything.
+		return true
+	}
+}
+
+func compareString(actual string, op types.FilterOp, value string) bool {
+	switch op {
+	case types.FilterOpEq:
+		return actual == value
+	case types.FilterOpNeq:
+		return actual != value
+	case types.FilterOpGt:
+		return actual > value
+	case types.FilterOpLt:
+		return actual < value
+	case types.FilterOpIn:
+		for _, v := range strings.Split(value, ",") {
+			if actual == v {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func compareFloat(actual float64, op types.FilterOp, value string) bool {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch op {
+	case types.FilterOpEq:
+		return actual == v
+	case types.FilterOpNeq:
+		return actual != v
+	case types.FilterOpGt:
+		return actual > v
+	case types.FilterOpLt:
+		return actual < v
+	default:
+		return false
+	}
+}
+
+// QueryIdentitiesIndexed is the index-selection planner QueryIdentities's offset-based
+// full scan predates: it picks the most selective available index for req's filters --
+// a did/owner equality filter resolves straight to a single store read; type/status
+// equality and reputation_min/reputation_max range filters become secondary-index
+// prefix scans intersected via intersectSorted's sort-merge join on the trailing ID
+// component those indices share; any filter with no matching index (or no filters at
+// all) falls back to QueryIdentities's full IdentityPrefix scan, with that filter
+// evaluated in-memory by matchesQueryFilter instead. Pagination is cursor-based (next-key,
+// not offset) via CursorPageResponse.NextCursor, so a page is stable across concurrent
+// inserts into IDs near an offset boundary the way QueryIdentities's Offset isn't.
+func (k Keeper) QueryIdentitiesIndexed(ctx context.Context, req types.CursorPageRequest) ([]*types.IdentityRegistry, types.CursorPageResponse, error) {
+	for _, f := range req.Filters {
+		if err := f.Validate(); err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+
+	pointID, hasPoint, candidates, residual := k.planIndexCandidates(store, req.Filters)
+
+	var ids []string
+	switch {
+	case hasPoint:
+		if pointID != "" {
+			ids = []string{pointID}
+		}
+	case len(candidates) > 0:
+		ids = intersectSorted(candidates)
+	default:
+		ids = scanIndexIDs(store, types.IdentityPrefix)
+	}
+	sort.Strings(ids)
+
+	var matched []*types.IdentityRegistry
+	for _, id := range ids {
+		registry, err := k.GetIdentity(ctx, id)
+		if err != nil {
+			continue // stale index entry pointing at a since-deleted identity
+		}
+		allMatch := true
+		for _, f := range residual {
+			if !matchesQueryFilter(registry, f) {
+				allMatch = false
+				break
+			}
+		}
+		if allMatch {
+			matched = append(matched, registry)
+		}
+	}
+
+	if req.Reverse {
+		for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+			matched[i], matched[j] = matched[j], matched[i]
+		}
+	}
+
+	start := 0
+	if cursorKey, err := types.DecodeCursor(req.Cursor); err == nil && cursorKey != nil {
+		cursor := string(cursorKey)
+		for i, registry := range matched {
+			if registry.ID > cursor && !req.Reverse {
+				start = i
+				break
+			}
+			if req.Reverse && registry.ID < cursor {
+				start = i
+				break
+			}
+			start = i + 1
+		}
+	}
+
+	limit := req.EffectiveLimit()
+	end := start + int(limit)
+	if end > len(matched) {
+		end = len(matched)
+	}
+	if start > len(matched) {
+		start = len(matched)
+	}
+
+	page := matched[start:end]
+
+	resp := types.CursorPageResponse{Total: uint64(len(matched))}
+	if end < len(matched) {
+		resp.NextCursor = types.EncodeCursor([]byte(matched[end-1].ID))
+	}
+
+	return page, resp, nil
+}
+
 // GetStatistics returns registry statistics
 func (k Keeper) GetStatistics(ctx context.Context) (*types.RegistryStatistics, error) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -309,24 +640,183 @@ func (k Keeper) setIdentityIndices(ctx context.Context, registry *types.Identity
 	
 	// Index by status
 	store.Set(types.GetStatusIndexKey(string(registry.Status), registry.ID), []byte{})
+
+	// Index by reputation bucket, for reputation_min/reputation_max range queries
+	store.Set(types.GetReputationBucketKey(types.ReputationBucket(registry.Reputation.Overall), registry.ID), []byte{})
 }
 
-func (k Keeper) updateReputationScore(registry *types.IdentityRegistry, event types.ReputationEvent) {
-	// Simple reputation update algorithm - can be enhanced
-	registry.Reputation.Overall = max(0, min(100, registry.Reputation.Overall+event.Impact))
-	
-	switch event.Type {
-	case "credential":
-		registry.Reputation.Credentials = max(0, min(100, registry.Reputation.Credentials+event.Impact))
-	case "transaction":
-		registry.Reputation.Transactions = max(0, min(100, registry.Reputation.Transactions+event.Impact))
-	case "community":
-		registry.Reputation.Community = max(0, min(100, registry.Reputation.Community+event.Impact))
-	case "verification":
-		registry.Reputation.Verification = max(0, min(100, registry.Reputation.Verification+event.Impact))
+// updateReputationScore recomputes registry.Reputation in place via k.localEngine
+// (DecayScorer by default), replacing the original naive additive-clamp algorithm.
+// Unlike that algorithm, this recomputes every dimension from registry.Reputation.History
+// in full rather than nudging the previously-stored value by the latest event's Impact,
+// so old events fade continuously instead of leaving a permanent mark. It deliberately
+// does not touch the propagated Overall score k.propagationEngine maintains in the
+// reputation cache (see getCachedReputationScore) -- that requires every identity's
+// history and is only recomputed in the batched RebuildReputation/EndBlocker path, not
+// inline on every single write.
+func (k Keeper) updateReputationScore(ctx context.Context, registry *types.IdentityRegistry) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if now.IsZero() {
+		now = time.Now()
 	}
-	
-	registry.Reputation.LastUpdated = time.Now()
+	registry.Reputation = k.localEngine.Compute(registry, nil, now)
+}
+
+// getCachedReputationScore returns the ReputationScore a prior recompute cycle
+// published for id via flushReputationCacheBatch, if any.
+func (k Keeper) getCachedReputationScore(ctx context.Context, id string) (types.ReputationScore, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := store.Get(types.GetReputationCacheKey(id))
+	if bz == nil {
+		return types.ReputationScore{}, false
+	}
+	var score types.ReputationScore
+	if err := k.cdc.Unmarshal(bz, &score); err != nil {
+		return types.ReputationScore{}, false
+	}
+	return score, true
+}
+
+// buildHistorySnapshot loads every identity's ReputationEvent history in one pass, the
+// input a full EigenTrustScorer recompute needs to build its trust graph. Like
+// GetStatistics, this is O(total identities) -- acceptable for the full-graph
+// power-iteration step itself (cheap relative to KVStore I/O even at thousands of
+// identities), which is why only the cache *write-back* phase below is paginated across
+// blocks, not this scan.
+func (k Keeper) buildHistorySnapshot(ctx context.Context) (map[string]*types.IdentityRegistry, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	registries := make(map[string]*types.IdentityRegistry)
+
+	iterator := store.Iterator(types.IdentityPrefix, storetypes.PrefixEndBytes(types.IdentityPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registry types.IdentityRegistry
+		if err := k.cdc.Unmarshal(iterator.Value(), &registry); err != nil {
+			continue
+		}
+		r := registry
+		registries[r.ID] = &r
+	}
+
+	return registries, nil
+}
+
+// recomputeReputationGraph runs k.propagationEngine once over every known identity and
+// stages the result under ReputationPendingPrefix, resetting the recompute cursor to the
+// start so the next EndBlocker/flushReputationCacheBatch calls begin publishing it into
+// the live ReputationCachePrefix. It does not touch the live cache itself -- readers keep
+// seeing the previous cycle's scores until flushReputationCacheBatch copies each
+// identity's pending entry over.
+func (k Keeper) recomputeReputationGraph(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	registries, err := k.buildHistorySnapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	histories := make(map[string][]types.ReputationEvent, len(registries))
+	for id, registry := range registries {
+		histories[id] = registry.Reputation.History
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	for id, registry := range registries {
+		score := k.propagationEngine.Compute(registry, histories, now)
+		bz, err := k.cdc.Marshal(&score)
+		if err != nil {
+			return err
+		}
+		store.Set(types.GetReputationPendingKey(id), bz)
+	}
+
+	store.Delete(types.ReputationRecomputeCursorKey)
+	return nil
+}
+
+// flushReputationCacheBatch copies up to budget pending recompute entries (staged by
+// recomputeReputationGraph) into the live ReputationCachePrefix cache GetIdentity reads,
+// resuming from the persisted cursor each call so the cost of publishing thousands of
+// identities' scores is spread across many EndBlocker calls instead of paid in one. It
+// reports whether a pending cycle was exhausted (so EndBlocker knows it can kick off a
+// fresh recomputeReputationGraph next).
+func (k Keeper) flushReputationCacheBatch(ctx context.Context, budget int) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	start := types.ReputationPendingPrefix
+	if cursor := store.Get(types.ReputationRecomputeCursorKey); cursor != nil {
+		start = cursor
+	}
+
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(types.ReputationPendingPrefix))
+	defer iterator.Close()
+
+	processed := 0
+	var lastKey []byte
+	for ; iterator.Valid() && processed < budget; iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		store.Set(append(types.ReputationCachePrefix, key[len(types.ReputationPendingPrefix):]...), append([]byte{}, iterator.Value()...))
+		store.Delete(key)
+		lastKey = key
+		processed++
+	}
+
+	if !iterator.Valid() {
+		// Cycle exhausted: clear the cursor so the next recomputeReputationGraph starts
+		// a fresh one rather than resuming a stale position.
+		store.Delete(types.ReputationRecomputeCursorKey)
+		return true, nil
+	}
+
+	if lastKey != nil {
+		store.Set(types.ReputationRecomputeCursorKey, lastKey)
+	}
+	return false, nil
+}
+
+// DefaultReputationRecomputeBatchSize bounds how many identities' cached
+// ReputationScore EndBlocker publishes from a pending recompute cycle per block.
+const DefaultReputationRecomputeBatchSize = 200
+
+// EndBlocker drives the reputation cache's bounded, cross-block recompute cycle:
+// each call publishes up to DefaultReputationRecomputeBatchSize pending entries into the
+// live cache, and starts a fresh full recomputeReputationGraph as soon as the previous
+// cycle's entries are all published. This is what lets GetIdentity's propagated Overall
+// score stay reasonably fresh (continuously cycling) without any single block paying
+// the cost of publishing every identity's score at once. It then runs
+// expireIdentitiesBatch's bounded credential/identity expiry sweep (see expiry.go).
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	exhausted, err := k.flushReputationCacheBatch(ctx, DefaultReputationRecomputeBatchSize)
+	if err != nil {
+		return err
+	}
+	if exhausted {
+		if err := k.recomputeReputationGraph(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Scheduled credential/identity expiry sweep -- see expiry.go's
+	// expireIdentitiesBatch doc comment.
+	return k.expireIdentitiesBatch(ctx, DefaultExpirySweepBatchSize)
+}
+
+// RebuildReputation immediately runs a full propagation recompute, gated by authority --
+// the manual-recompute entry point the request calls MsgRebuildReputation. x/registry has
+// no Msg service/proto-generated message types anywhere in this tree (unlike
+// x/zkproof/x/did), so there's no MsgServer to add a real MsgRebuildReputation handler
+// to; this keeper method is that handler's body, for whoever wires a Msg service onto
+// this module to call directly once one exists.
+func (k Keeper) RebuildReputation(ctx context.Context, authority string) error {
+	if authority != k.authority {
+		return fmt.Errorf("unauthorized: only %s may rebuild reputation", k.authority)
+	}
+	return k.recomputeReputationGraph(ctx)
 }
 
 func (k Keeper) matchesFilters(registry *types.IdentityRegistry, filters map[string]interface{}) bool {
@@ -359,16 +849,3 @@ func (k Keeper) matchesFilters(registry *types.IdentityRegistry, filters map[str
 	return true
 }
 
-func max(a, b float64) float64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-func min(a, b float64) float64 {
-	if a < b {
-		return a
-	}
-	return b
-}
\ No newline at end of file
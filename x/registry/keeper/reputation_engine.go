@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/PersonaPass-ID/personachain/x/registry/types"
+)
+
+// ReputationEngine computes the ReputationScore an IdentityRegistry should carry as of
+// `at`, superseding the old additive-clamp updateReputationScore helper. Keeper dispatches
+// to one engine for synchronous per-event updates (DecayScorer, which only needs the
+// identity's own History) and a second for the periodic global recompute EndBlocker
+// drives (EigenTrustScorer, which needs every identity's History to build the trust
+// graph) -- see Keeper.localEngine/Keeper.propagationEngine.
+type ReputationEngine interface {
+	// Name identifies this engine, e.g. for log messages distinguishing which engine
+	// produced a given cached score.
+	Name() string
+
+	// Compute returns registry's ReputationScore as of at. histories holds every known
+	// identity's event history keyed by registry ID, for engines (like EigenTrustScorer)
+	// that propagate trust across identities; a DecayScorer ignores it and is safe to
+	// call with nil.
+	Compute(registry *types.IdentityRegistry, histories map[string][]types.ReputationEvent, at time.Time) types.ReputationScore
+}
+
+// DecayScorer computes each ReputationScore dimension purely from registry's own
+// History, discounting older events exponentially via types.ComputeDecayedScore. This is
+// the synchronous engine SuspendIdentity/RevokeIdentity/UpdateReputation use: it never
+// looks past the identity's own event log, so it's cheap enough to run on every write.
+type DecayScorer struct {
+	Rates types.DecayRates
+}
+
+// NewDecayScorer returns a DecayScorer using types.DefaultDecayRates.
+func NewDecayScorer() DecayScorer {
+	return DecayScorer{Rates: types.DefaultDecayRates}
+}
+
+func (s DecayScorer) Name() string { return "decay" }
+
+func (s DecayScorer) Compute(registry *types.IdentityRegistry, _ map[string][]types.ReputationEvent, at time.Time) types.ReputationScore {
+	history := registry.Reputation.History
+	return types.ReputationScore{
+		Overall:      types.ComputeDecayedScore(history, "", s.Rates, at),
+		Credentials:  types.ComputeDecayedScore(history, "credential", s.Rates, at),
+		Transactions: types.ComputeDecayedScore(history, "transaction", s.Rates, at),
+		Community:    types.ComputeDecayedScore(history, "community", s.Rates, at),
+		Verification: types.ComputeDecayedScore(history, "verification", s.Rates, at),
+		History:      history,
+		LastUpdated:  at,
+	}
+}
+
+// DefaultEigenTrustRounds is the fixed power-iteration round count EigenTrustScorer runs
+// -- fixed rather than iterate-to-convergence so a single recompute has a predictable,
+// bounded cost regardless of graph shape.
+const DefaultEigenTrustRounds = 5
+
+// DefaultTrustDampingFactor is the α blending a propagated score with an identity's own
+// local one: the fraction of mass power-iteration redistributes along trust edges each
+// round, with 1-α staying local. 0.85 matches the damping factor the original PageRank/
+// EigenTrust literature uses.
+const DefaultTrustDampingFactor = 0.85
+
+// EigenTrustScorer layers EigenTrust-lite propagation on top of an embedded DecayScorer:
+// dimension scores (Credentials/Transactions/Community/Verification) come from the
+// local decay computation unchanged, while Overall is replaced by the propagated value
+// types.PropagateEigenTrust produces over the full histories graph -- the request's
+// "identities that issue credentials to, or transact with, other identities contribute a
+// fraction of their own Overall score to the target". This is the engine
+// Keeper.RebuildReputation/EndBlocker use for the periodic global recompute; it needs
+// every identity's history (via the histories argument) and is too expensive to run on
+// every single-identity write, unlike DecayScorer.
+type EigenTrustScorer struct {
+	Decay         DecayScorer
+	Rounds        int
+	DampingFactor float64
+}
+
+// NewEigenTrustScorer returns an EigenTrustScorer using NewDecayScorer's rates,
+// DefaultEigenTrustRounds, and DefaultTrustDampingFactor.
+func NewEigenTrustScorer() EigenTrustScorer {
+	return EigenTrustScorer{
+		Decay:         NewDecayScorer(),
+		Rounds:        DefaultEigenTrustRounds,
+		DampingFactor: DefaultTrustDampingFactor,
+	}
+}
+
+func (s EigenTrustScorer) Name() string { return "eigentrust-lite" }
+
+func (s EigenTrustScorer) Compute(registry *types.IdentityRegistry, histories map[string][]types.ReputationEvent, at time.Time) types.ReputationScore {
+	local := s.Decay.Compute(registry, nil, at)
+
+	if len(histories) == 0 {
+		return local
+	}
+
+	priors := make(map[string]float64, len(histories))
+	for id, history := range histories {
+		priors[id] = types.ComputeDecayedScore(history, "", s.Decay.Rates, at)
+	}
+
+	edges := types.BuildTrustEdges(histories)
+	propagated := types.PropagateEigenTrust(priors, edges, s.Rounds, s.DampingFactor)
+
+	if overall, ok := propagated[registry.ID]; ok {
+		local.Overall = clampScore(overall)
+	}
+	return local
+}
+
+func clampScore(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 100 {
+		return 100
+	}
+	return v
+}
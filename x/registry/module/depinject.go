@@ -0,0 +1,59 @@
+// Package module holds x/registry's depinject wiring, following the same per-module
+// path x/oracle/module/depinject.go establishes.
+package module
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"cosmossdk.io/log"
+
+	registrykeeper "github.com/PersonaPass-ID/personachain/x/registry/keeper"
+	"github.com/PersonaPass-ID/personachain/x/registry/types"
+)
+
+// ModuleInputs is the depinject.In struct ProvideModule receives -- everything
+// registrykeeper.NewKeeper needs, resolved from the app's own container. AccountKeeper
+// and BankKeeper are typed to the narrowed types.AccountKeeper/types.BankKeeper
+// interfaces (types/expected_keepers.go) rather than the concrete
+// authkeeper.AccountKeeper/bankkeeper.Keeper types NewKeeper used to take.
+type ModuleInputs struct {
+	depinject.In
+
+	Cdc          codec.BinaryCodec
+	StoreService store.KVStoreService
+	Logger       log.Logger
+	Authority    string
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+// ModuleOutputs is what ProvideModule contributes back to the container.
+//
+// Unlike x/oracle/module.ModuleOutputs, there is no AppModule field here: x/registry has
+// a concrete Keeper struct and NewKeeper constructor (keeper/keeper.go), but no
+// AppModule/AppModuleBasic/module.go defined anywhere in this tree, so there is nothing
+// for a "NewAppModule(cdc, keeper)" call to construct. EndBlocker
+// (keeper/keeper.go's EndBlocker, extended by keeper/expiry.go's expireIdentitiesBatch)
+// is invoked by whatever hand-wires this module's BeginBlock/EndBlock order today;
+// that caller is outside this tree (see app/depinject.go's doc comment for the broader
+// "no app.go assembles a concrete App type yet" gap). Once x/registry gets an
+// AppModule, this struct gains an AppModule field the same way x/oracle's did.
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper registrykeeper.Keeper
+}
+
+// ProvideModule is x/registry's depinject provider, replacing the manual
+//
+//	registryKeeper := registrykeeper.NewKeeper(cdc, runtime.NewKVStoreService(keys[registrytypes.StoreKey]), logger, accountKeeper, bankKeeper, authority)
+//
+// construction a non-depinject NewPersonaChainAppNew would otherwise need.
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := registrykeeper.NewKeeper(in.Cdc, in.StoreService, in.Logger, in.AccountKeeper, in.BankKeeper, in.Authority)
+	return ModuleOutputs{
+		Keeper: k,
+	}
+}
@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/PersonaPass-ID/personachain/x/registry/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's values
+// into the corresponding registry type and returns a human-readable diff for `simd`
+// genesis import/export invariant dumps, the same shape as x/did/x/oracle's
+// NewDecodeStore in their own simulation/decoder.go.
+//
+// Only IdentityPrefix is covered -- keys.go's remaining prefixes (DIDToRegistryPrefix,
+// OwnerIndexPrefix, TypeIndexPrefix, StatusIndexPrefix, the Reputation* prefixes) are
+// indexes and caches keeper.Keeper.setIdentityIndices/flushReputationCacheBatch derive
+// from IdentityRegistry records, the same "skip re-buildable index state" reasoning
+// x/oracle/simulation/decoder.go applies to its own secondary indexes.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.IdentityPrefix):
+			var idA, idB types.IdentityRegistry
+			cdc.MustUnmarshal(kvA.Value, &idA)
+			cdc.MustUnmarshal(kvB.Value, &idB)
+			return fmt.Sprintf("%v\n%v", idA, idB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package simulation
+
+import (
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// WeightedOperations would return the registry module's weighted simulation
+// operations for randomized identity lifecycle ops (register/update/suspend/revoke),
+// the same shape x/oracle/x/zkproof/x/credential's WeightedOperations in this chunk
+// return.
+//
+// It can't be written yet: x/registry has no Msg types or MsgServer anywhere in this
+// tree -- RegisterIdentity, UpdateReputation, SuspendIdentity, and RevokeIdentity are
+// all plain Keeper methods, not sdk.Msg values a simtypes.Operation could generate and
+// deliver (see module/depinject.go's doc comment for the full state of that gap).
+// Returns an empty set rather than fabricating Msg types that don't exist in this
+// module's types package.
+func WeightedOperations() simtypes.WeightedOperations {
+	return simtypes.WeightedOperations{}
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the subset of x/auth's keeper that this module's Keeper needs,
+// the same narrowing x/oracle/x/zkproof/x/did's expected_keepers.go apply to their own
+// authkeeper.AccountKeeper/bankkeeper.Keeper dependencies -- so Keeper depends on this
+// interface rather than the concrete x/auth/keeper package, letting module/depinject.go
+// resolve it from the container instead of a positional NewKeeper argument.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the subset of x/bank's keeper this module's Keeper needs.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
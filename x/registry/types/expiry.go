@@ -0,0 +1,37 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// LegacyExpiryQueuePrefix is the legacy string prefix for the time-ordered queue of
+// registry entries with a non-nil expiration, consulted by EndBlocker to prune stale
+// entries without a full-table scan.
+const LegacyExpiryQueuePrefix = "registry_expiry_queue/"
+
+// ExpiryQueueKey builds a lexicographically time-ordered key so EndBlocker can iterate
+// the queue up to the current block time and stop at the first entry still in the future.
+func ExpiryQueueKey(expiresAt time.Time, registryID string) []byte {
+	key := make([]byte, 0, len(LegacyExpiryQueuePrefix)+8+len(registryID)+1)
+	key = append(key, []byte(LegacyExpiryQueuePrefix)...)
+	key = append(key, expiryTimeBytes(expiresAt)...)
+	key = append(key, ':')
+	key = append(key, []byte(registryID)...)
+	return key
+}
+
+// ExpiryQueuePrefixUntil returns the prefix covering every entry whose expiry is at or
+// before the given time.
+func ExpiryQueuePrefixUntil(at time.Time) []byte {
+	key := make([]byte, 0, len(LegacyExpiryQueuePrefix)+8)
+	key = append(key, []byte(LegacyExpiryQueuePrefix)...)
+	key = append(key, expiryTimeBytes(at)...)
+	return key
+}
+
+func expiryTimeBytes(t time.Time) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(t.Unix()))
+	return bz
+}
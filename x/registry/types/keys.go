@@ -9,6 +9,36 @@ var (
 	OwnerIndexPrefix    = []byte{0x03}
 	TypeIndexPrefix     = []byte{0x04}
 	StatusIndexPrefix   = []byte{0x05}
+
+	// ReputationCachePrefix stores the most recently propagated ReputationScore for an
+	// identity, keyed by registry ID -- the cache keeper.Keeper.GetIdentity overlays onto
+	// a freshly-loaded IdentityRegistry so reads stay O(1) instead of re-running
+	// EigenTrust-lite propagation on every GetIdentity call. Written only by
+	// keeper.Keeper.flushReputationCacheBatch.
+	ReputationCachePrefix = []byte{0x06}
+
+	// ReputationPendingPrefix stages a full recompute cycle's freshly-propagated scores
+	// before they're copied into ReputationCachePrefix in bounded per-block batches --
+	// see keeper.Keeper.EndBlocker.
+	ReputationPendingPrefix = []byte{0x07}
+
+	// ReputationRecomputeCursorKey persists how far a pending recompute cycle's
+	// pending-to-cache copy has progressed, so it can resume across blocks instead of
+	// restarting (or running unbounded) every EndBlocker call.
+	ReputationRecomputeCursorKey = []byte{0x08}
+
+	// ReputationBucketPrefix indexes identities by their ReputationScore.Overall bucket
+	// (see ReputationBucket), so a reputation_min/reputation_max range filter can scan a
+	// contiguous bucket range instead of every identity. Maintained by
+	// keeper.Keeper.setIdentityIndices alongside the type/status indices above, with the
+	// same limitation: an identity's old bucket entry isn't removed when its score moves
+	// it into a new bucket, only ever added to under the current one.
+	ReputationBucketPrefix = []byte{0x09}
+
+	// ExpirySweepCursorKey persists how far keeper.Keeper.expireIdentitiesBatch has
+	// progressed through the StatusIndexPrefix "active/" range, the same cross-block
+	// cursor convention ReputationRecomputeCursorKey uses for the reputation cache flush.
+	ExpirySweepCursorKey = []byte{0x0a}
 )
 
 // GetIdentityKey returns the key for storing an identity registry entry
@@ -34,4 +64,24 @@ func GetTypeIndexKey(identityType, id string) []byte {
 // GetStatusIndexKey returns the key for status index
 func GetStatusIndexKey(status, id string) []byte {
 	return append(StatusIndexPrefix, []byte(fmt.Sprintf("%s/%s", status, id))...)
+}
+
+// GetReputationCacheKey returns the key under which id's cached, propagated
+// ReputationScore is stored.
+func GetReputationCacheKey(id string) []byte {
+	return append(ReputationCachePrefix, []byte(id)...)
+}
+
+// GetReputationPendingKey returns the key under which id's not-yet-published
+// recompute-cycle ReputationScore is staged.
+func GetReputationPendingKey(id string) []byte {
+	return append(ReputationPendingPrefix, []byte(id)...)
+}
+
+// GetReputationBucketKey returns the key for the reputation-bucket index, in the same
+// "prefix + <value>/<id>" composite-key shape GetTypeIndexKey/GetStatusIndexKey use, so
+// the same prefix-scan/sort-merge-join query planning applies to it unchanged. bucket is
+// zero-padded to two digits so buckets sort numerically (0..10, see ReputationBucket).
+func GetReputationBucketKey(bucket int, id string) []byte {
+	return append(ReputationBucketPrefix, []byte(fmt.Sprintf("%02d/%s", bucket, id))...)
 }
\ No newline at end of file
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// FilterOp enumerates the comparison operators supported by a QueryFilter.
+type FilterOp string
+
+const (
+	FilterOpEq  FilterOp = "eq"
+	FilterOpNeq FilterOp = "neq"
+	FilterOpGt  FilterOp = "gt"
+	FilterOpLt  FilterOp = "lt"
+	FilterOpIn  FilterOp = "in"
+)
+
+// QueryFilter is one clause of a filter DSL applied by the identity registry querier,
+// e.g. {Field: "status", Op: FilterOpEq, Value: "active"}.
+type QueryFilter struct {
+	Field string   `json:"field"`
+	Op    FilterOp `json:"op"`
+	Value string   `json:"value"`
+}
+
+// Validate checks that the filter clause is well-formed.
+func (f QueryFilter) Validate() error {
+	if f.Field == "" {
+		return fmt.Errorf("filter field cannot be empty")
+	}
+	switch f.Op {
+	case FilterOpEq, FilterOpNeq, FilterOpGt, FilterOpLt, FilterOpIn:
+	default:
+		return fmt.Errorf("unsupported filter op %q", f.Op)
+	}
+	return nil
+}
+
+// CursorPageRequest drives cursor-based pagination over the registry's secondary
+// indexes, in place of offset-based PageRequest.
+type CursorPageRequest struct {
+	Cursor  string        `json:"cursor,omitempty"`
+	Limit   uint64        `json:"limit"`
+	Filters []QueryFilter `json:"filters,omitempty"`
+	Reverse bool          `json:"reverse,omitempty"`
+}
+
+// CursorPageResponse carries the opaque cursor for the next page, or "" if exhausted.
+type CursorPageResponse struct {
+	NextCursor string `json:"nextCursor,omitempty"`
+	Total      uint64 `json:"total,omitempty"`
+}
+
+// EncodeCursor base64-encodes a raw store key into an opaque pagination cursor.
+func EncodeCursor(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(key)
+}
+
+// DecodeCursor decodes an opaque pagination cursor back into a raw store key.
+func DecodeCursor(cursor string) ([]byte, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	return base64.RawURLEncoding.DecodeString(cursor)
+}
+
+// DefaultQueryLimit is applied when a CursorPageRequest does not specify a Limit.
+const DefaultQueryLimit = 100
+
+// MaxQueryLimit caps the page size cursor queries may request.
+const MaxQueryLimit = 1000
+
+// EffectiveLimit returns the page's requested limit clamped to [1, MaxQueryLimit].
+func (p CursorPageRequest) EffectiveLimit() uint64 {
+	switch {
+	case p.Limit == 0:
+		return DefaultQueryLimit
+	case p.Limit > MaxQueryLimit:
+		return MaxQueryLimit
+	default:
+		return p.Limit
+	}
+}
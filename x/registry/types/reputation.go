@@ -0,0 +1,229 @@
+package types
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// ReputationDecayHalfLife is the time it takes an un-reinforced ReputationScore.Overall
+// to decay to half its value, modeling the intuition that trust earned long ago should
+// count for less than trust earned recently.
+const ReputationDecayHalfLife = 90 * 24 * time.Hour
+
+// ApplyDecay returns the score's Overall value decayed exponentially from LastUpdated to
+// `at`, leaving the stored score untouched. Callers persist the decayed value back via
+// the keeper when they next write the score.
+func (s ReputationScore) ApplyDecay(at time.Time) float64 {
+	if s.LastUpdated.IsZero() || !at.After(s.LastUpdated) {
+		return s.Overall
+	}
+	elapsed := at.Sub(s.LastUpdated)
+	decayFactor := math.Pow(0.5, float64(elapsed)/float64(ReputationDecayHalfLife))
+	return s.Overall * decayFactor
+}
+
+// ReputationBucketWidth is the score-range width each GetReputationBucketKey bucket
+// spans: bucket 0 covers [0,10), bucket 1 covers [10,20), ..., bucket 10 covers the
+// [100,100] upper edge.
+const ReputationBucketWidth = 10.0
+
+// ReputationBucket maps a ReputationScore.Overall value to the bucket
+// GetReputationBucketKey indexes it under, clamping to [0, 10] so out-of-range scores
+// (which shouldn't occur given ComputeDecayedScore/clampScore, but cost nothing to guard
+// against) still land in a valid bucket rather than producing a malformed key.
+func ReputationBucket(overall float64) int {
+	switch {
+	case overall <= 0:
+		return 0
+	case overall >= 100:
+		return 10
+	default:
+		return int(overall / ReputationBucketWidth)
+	}
+}
+
+// TrustEdge is a directed endorsement weight from one identity to another, the input
+// to EigenTrust-style transitive score propagation.
+type TrustEdge struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Weight float64 `json:"weight"`
+}
+
+// neutralReputationBase is the starting score RegisterIdentity seeds every dimension
+// with; ComputeDecayedScore uses the same constant as the floor a dimension's decayed
+// event impacts accumulate on top of, so an identity with no events still reads as
+// neutral rather than zero.
+const neutralReputationBase = 50.0
+
+// DecayRates holds the per-ReputationScore-dimension exponential decay constant λ (in
+// units of 1/nanosecond, matching time.Duration arithmetic) that ComputeDecayedScore
+// discounts each ReputationEvent's Impact by. This module has no governance-settable
+// Params type the way x/zkproof does (see x/registry/keeper.Keeper's lack of a
+// GetParams/SetParams pair), so these are package-level defaults rather than an
+// on-chain parameter; DefaultDecayRates is what keeper.DecayScorer uses unless a caller
+// constructs one with different rates directly.
+type DecayRates struct {
+	Credentials  float64
+	Transactions float64
+	Community    float64
+	Verification float64
+}
+
+// lambdaFromHalfLife converts a half-life duration to the exponential decay constant λ
+// satisfying exp(-λ·halfLife) = 0.5, so DefaultDecayRates decays at the same rate
+// ReputationDecayHalfLife already established for Overall in ApplyDecay above.
+func lambdaFromHalfLife(halfLife time.Duration) float64 {
+	return math.Ln2 / float64(halfLife)
+}
+
+// DefaultDecayRates applies ReputationDecayHalfLife's 90-day half-life uniformly across
+// dimensions -- a reasonable shared baseline until this module gains the per-dimension
+// governance knob the request envisions.
+var DefaultDecayRates = DecayRates{
+	Credentials:  lambdaFromHalfLife(ReputationDecayHalfLife),
+	Transactions: lambdaFromHalfLife(ReputationDecayHalfLife),
+	Community:    lambdaFromHalfLife(ReputationDecayHalfLife),
+	Verification: lambdaFromHalfLife(ReputationDecayHalfLife),
+}
+
+// dimensionLambda resolves the λ history events of dimension should decay at, falling
+// back to Credentials' rate for "suspension"/"revocation"/any other event type not
+// mapped to one of the four ReputationScore dimensions -- those reduce Overall but have
+// no matching per-dimension field to fold into either, matching the pre-existing
+// updateReputationScore's own switch statement, which only special-cases
+// "credential"/"transaction"/"community"/"verification".
+func dimensionLambda(rates DecayRates, eventType string) float64 {
+	switch eventType {
+	case "transaction":
+		return rates.Transactions
+	case "community":
+		return rates.Community
+	case "verification":
+		return rates.Verification
+	default:
+		return rates.Credentials
+	}
+}
+
+// ComputeDecayedScore recomputes a single ReputationScore dimension from scratch by
+// summing every matching event's Impact in history, each discounted by
+// exp(-λ·Δt) where Δt = at.Sub(event.Timestamp), on top of neutralReputationBase, then
+// clamping to [0, 100]. dimension is "" for Overall (every event counts, undiscounted by
+// type) or one of "credential"/"transaction"/"community"/"verification" (only
+// same-typed events count).
+//
+// Unlike the original updateReputationScore, which permanently mutated the stored score
+// by the latest event's raw Impact, this is side-effect-free and recomputed at read
+// time: an old suspension's Impact fades continuously rather than leaving a permanent
+// dent, and replaying the same history from the same `at` always yields the same score.
+func ComputeDecayedScore(history []ReputationEvent, dimension string, rates DecayRates, at time.Time) float64 {
+	score := neutralReputationBase
+	for _, event := range history {
+		if dimension != "" && event.Type != dimension {
+			continue
+		}
+		if !at.After(event.Timestamp) {
+			score += event.Impact
+			continue
+		}
+		lambda := dimensionLambda(rates, event.Type)
+		elapsed := at.Sub(event.Timestamp)
+		score += event.Impact * math.Exp(-lambda*float64(elapsed))
+	}
+	return math.Max(0, math.Min(100, score))
+}
+
+// BuildTrustEdges derives the adjacency PropagateEigenTrust propagates over from each
+// identity's own ReputationEvent history: an event with Source set to another known
+// identity's registry ID becomes a directed edge from that source to the identity whose
+// history recorded it, weighted by the summed absolute Impact of every such event
+// between the same pair. Events whose Source isn't a key of histories (e.g. the
+// "system" source keeper.Keeper.SuspendIdentity/RevokeIdentity record today) contribute
+// no edge -- BuildTrustEdges only has something to propagate once a caller starts
+// setting ReputationEvent.Source to the endorsing/issuing identity's own registry ID,
+// e.g. from a credential-issuance or transaction-counterparty flow elsewhere in the
+// app.
+//
+// The returned slice is sorted by (From, To) so propagation is deterministic regardless
+// of Go's randomized map iteration order over histories.
+func BuildTrustEdges(histories map[string][]ReputationEvent) []TrustEdge {
+	type pair struct{ from, to string }
+	weights := make(map[pair]float64)
+
+	for to, history := range histories {
+		for _, event := range history {
+			if event.Source == "" || event.Source == to {
+				continue
+			}
+			if _, known := histories[event.Source]; !known {
+				continue
+			}
+			weights[pair{from: event.Source, to: to}] += math.Abs(event.Impact)
+		}
+	}
+
+	edges := make([]TrustEdge, 0, len(weights))
+	for p, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		edges = append(edges, TrustEdge{From: p.from, To: p.to, Weight: weight})
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+	return edges
+}
+
+// PropagateEigenTrust runs a fixed number of power-iteration rounds over a set of
+// normalized trust edges, distributing each identity's reputation to the identities it
+// endorses, weighted by edge weight. priors seeds the initial distribution (e.g. each
+// identity's current decayed Overall score); the result is a new distribution over the
+// same identities summing to the same total mass as priors.
+//
+// This is a simplified EigenTrust: no pre-trusted-peer anchoring and no normalization
+// of dangling nodes (identities with no outgoing edges retain their mass each round).
+func PropagateEigenTrust(priors map[string]float64, edges []TrustEdge, rounds int, dampingFactor float64) map[string]float64 {
+	if rounds <= 0 {
+		rounds = 1
+	}
+
+	outgoingByFrom := make(map[string][]TrustEdge)
+	outgoingWeightSum := make(map[string]float64)
+	for _, e := range edges {
+		outgoingByFrom[e.From] = append(outgoingByFrom[e.From], e)
+		outgoingWeightSum[e.From] += e.Weight
+	}
+
+	current := make(map[string]float64, len(priors))
+	for id, v := range priors {
+		current[id] = v
+	}
+
+	for round := 0; round < rounds; round++ {
+		next := make(map[string]float64, len(current))
+		for id, mass := range current {
+			edgesOut, hasEdges := outgoingByFrom[id]
+			if !hasEdges || outgoingWeightSum[id] == 0 {
+				// Dangling node: keep its own mass rather than losing it.
+				next[id] += mass
+				continue
+			}
+			for _, e := range edgesOut {
+				share := mass * dampingFactor * (e.Weight / outgoingWeightSum[id])
+				next[e.To] += share
+			}
+			// The damping factor's complement stays with the endorsing identity,
+			// matching EigenTrust's blend between local and propagated trust.
+			next[id] += mass * (1 - dampingFactor)
+		}
+		current = next
+	}
+
+	return current
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	e2eetypes "github.com/PersonaPass-ID/personachain/x/e2ee/types"
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+const flagReviewerDID = "reviewer-did"
+
+// GetTxCmd returns the transaction commands for the revocation module.
+//
+// Registering the reviewer's encryption key is not repeated here -- that is
+// `tx e2ee register-key`, reused as-is rather than duplicated under a second name,
+// since it already publishes the X25519 key CmdEncryptAppeal seals appeal evidence
+// against.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSubmitAppeal(),
+		CmdEncryptAppeal(),
+		CmdDecideAppeal(),
+	)
+
+	return cmd
+}
+
+// CmdSubmitAppeal returns the tx command for filing a plaintext-evidence appeal
+// against a revocation.
+func CmdSubmitAppeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-appeal [revocation-id] [reason] [evidence]",
+		Short: "File an appeal against a revocation",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitAppeal{
+				Appellant:    clientCtx.GetFromAddress().String(),
+				RevocationID: args[0],
+				Reason:       args[1],
+				Evidence:     args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdEncryptAppeal reads appeal evidence from stdin, seals it against the reviewer's
+// e2ee registered key, and submits it as a MsgSubmitAppeal carrying an
+// EncryptedPayload -- the encrypted counterpart to CmdSubmitAppeal's plaintext
+// Evidence field.
+func CmdEncryptAppeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypt-appeal [revocation-id] [reviewer-address] [hex-reviewer-public-key]",
+		Short: "Seal stdin appeal evidence against a reviewer's registered encryption key and file it",
+		Long: `Reads appeal evidence from stdin and produces a sealed ECIES envelope
+(X25519 ECDH -> HKDF-SHA256 -> XChaCha20-Poly1305) addressed to the reviewer's
+registered public key, then files it as the EncryptedPayload of a MsgSubmitAppeal.
+Use --reviewer-did to record the reviewer DID SubmitAppeal verifies the reviewer
+address against.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			revocationID := args[0]
+			reviewer := args[1]
+
+			publicKey, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to decode reviewer public key: %w", err)
+			}
+
+			evidence, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("failed to read evidence from stdin: %w", err)
+			}
+
+			reviewerDID, _ := cmd.Flags().GetString(flagReviewerDID)
+
+			blob, err := e2eetypes.SealEnvelope(reviewer, reviewerDID, publicKey, evidence)
+			if err != nil {
+				return err
+			}
+
+			payload, err := types.NewEncryptedAppealPayload(reviewer, blob)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitAppeal{
+				Appellant:        clientCtx.GetFromAddress().String(),
+				RevocationID:     revocationID,
+				Reason:           "encrypted",
+				ReviewerDID:      reviewerDID,
+				EncryptedPayload: payload,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagReviewerDID, "", "reviewer DID, verified against the reviewer address by SubmitAppeal")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDecideAppeal returns the tx command for a reviewer to record a decision on an
+// appeal, referencing the ciphertext hash for encrypted appeals so accountability is
+// preserved without the plaintext ever being submitted on chain.
+func CmdDecideAppeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decide-appeal [revocation-id] [appeal-index] [upheld] [hex-ciphertext-hash]",
+		Short: "Record a reviewer's decision on an appeal",
+		Long: `hex-ciphertext-hash is optional and should be the sha256 hash of the
+EncryptedAppealPayload.Ciphertext the reviewer decrypted and reviewed; omit it for
+appeals that only carried plaintext Evidence.`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var appealIndex uint64
+			if _, err := fmt.Sscanf(args[1], "%d", &appealIndex); err != nil {
+				return fmt.Errorf("invalid appeal index: %w", err)
+			}
+
+			var upheld bool
+			if _, err := fmt.Sscanf(args[2], "%t", &upheld); err != nil {
+				return fmt.Errorf("invalid upheld value: %w", err)
+			}
+
+			var ciphertextHash []byte
+			if len(args) == 4 {
+				ciphertextHash, err = hex.DecodeString(args[3])
+				if err != nil {
+					return fmt.Errorf("failed to decode ciphertext hash: %w", err)
+				}
+			}
+
+			msg := &types.MsgDecideAppeal{
+				Reviewer:       clientCtx.GetFromAddress().String(),
+				RevocationID:   args[0],
+				AppealIndex:    appealIndex,
+				Upheld:         upheld,
+				CiphertextHash: ciphertextHash,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
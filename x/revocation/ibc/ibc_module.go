@@ -0,0 +1,152 @@
+// Package ibc implements the revocation-query IBC application: a counterparty chain
+// asks "is credential X revoked?" over a dedicated port instead of trusting an
+// off-chain oracle for this chain's revocation ledger.
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	credentialtypes "github.com/PersonaPass-ID/personachain/x/credential/types"
+	"github.com/PersonaPass-ID/personachain/x/revocation/keeper"
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// IBCModule implements porttypes.IBCModule for the revocation-query application. This
+// chain only ever plays the host role: OnRecvPacket answers a RevocationQueryPacketData
+// through keeper.Keeper.IsRevoked. Nothing in this module sends a revocation query of
+// its own yet, so OnAcknowledgementPacket/OnTimeoutPacket -- the controller-side
+// callbacks -- are no-ops; a future MsgQueryRemoteRevocation would be what makes this
+// chain a controller too, the same role x/did's IBCModule already plays for DIDs.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule returns an IBCModule bound to k.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// OnChanOpenInit implements porttypes.IBCModule. The revocation-query port only
+// accepts ORDERED channels (a query and its answer must stay in sequence) on the
+// negotiated types.Version.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("revocation-query channels must be ORDERED")
+	}
+	if version != "" && version != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements porttypes.IBCModule, mirroring OnChanOpenInit's checks for
+// the side that did not initiate the handshake.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("revocation-query channels must be ORDERED")
+	}
+	if counterpartyVersion != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != types.Version {
+		return types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements porttypes.IBCModule on the host side: it decodes the
+// RevocationQueryPacketData, resolves it through keeper.Keeper.IsRevoked, and always
+// returns a successful channeltypes.Acknowledgement carrying a
+// types.RevocationQueryAcknowledgement -- the ibc-go middleware convention of
+// acknowledging rather than letting a lookup miss time the packet out. Only a
+// malformed packet earns channeltypes.NewErrorAcknowledgement. The emitted event
+// reuses credentialtypes.EventTypeCredentialRevoked and its attribute keys rather than
+// declaring a parallel set, since this is the same "is this credential revoked"
+// question x/credential's own events already describe.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data types.RevocationQueryPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling revocation-query packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	revoked := im.keeper.IsRevoked(ctx, data.CredentialID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			credentialtypes.EventTypeCredentialRevoked,
+			sdk.NewAttribute(credentialtypes.AttributeKeyCredentialID, data.CredentialID),
+			sdk.NewAttribute(credentialtypes.AttributeKeyRevoked, fmt.Sprintf("%t", revoked)),
+		),
+	})
+
+	ackData := types.RevocationQueryAcknowledgement{
+		Revoked: revoked,
+		Height:  ctx.BlockHeight(),
+	}
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling revocation-query acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// OnAcknowledgementPacket implements porttypes.IBCModule. This chain never sends a
+// revocation query of its own yet, so there is nothing to reconcile here.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return nil
+}
+
+// OnTimeoutPacket implements porttypes.IBCModule. A timed-out query is simply dropped.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return nil
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// CreateAccumulatorRegistry registers a new accumulator-backed revocation list for
+// msg.Issuer. N and G are taken as given -- see types.AccumulatorRegistry's doc comment
+// on why this keeper never generates them itself. ACurrent is initialized to G, the
+// accumulator value that represents an empty revoked set.
+func (k Keeper) CreateAccumulatorRegistry(ctx context.Context, msg *types.MsgCreateAccumulatorRegistry) error {
+	if _, err := k.AccumulatorRegistries.Get(ctx, msg.Id); err == nil {
+		return types.ErrInvalidAccumulator.Wrapf("accumulator registry %q already exists", msg.Id)
+	}
+
+	reg := types.AccumulatorRegistry{
+		Id:                   msg.Id,
+		Issuer:               msg.Issuer,
+		N:                    msg.N,
+		G:                    msg.G,
+		ACurrent:             msg.G,
+		RevokedPrimesProduct: big.NewInt(1).Bytes(),
+		Epoch:                0,
+		CircuitId:            msg.CircuitId,
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reg.Created = sdkCtx.BlockTime()
+	reg.Updated = sdkCtx.BlockTime()
+
+	if err := reg.Validate(); err != nil {
+		return err
+	}
+
+	return k.AccumulatorRegistries.Set(ctx, reg.Id, reg)
+}
+
+// GetAccumulatorRegistry retrieves an accumulator registry by ID.
+func (k Keeper) GetAccumulatorRegistry(ctx context.Context, id string) (*types.AccumulatorRegistry, error) {
+	reg, err := k.AccumulatorRegistries.Get(ctx, id)
+	if err != nil {
+		return nil, types.ErrAccumulatorNotFound.Wrap(id)
+	}
+	return &reg, nil
+}
+
+// AccumulateRevocation folds credentialId's hash-to-prime representative p_c into
+// registryId's accumulator: A_new = A_current^(p_c) mod N. Before overwriting ACurrent,
+// it records an AccumulatorWitness pinning the OLD A (= w_c, satisfying w_c^(p_c) =
+// A_new) for credentialId at the registry's current epoch, then emits
+// EventTypeAccumulatorWitnessPublished with that witness's fields as attributes -- the
+// "publish a witness stream as events" the request asks for, so a holder can maintain
+// their own non-membership witness offline by replaying this chain's event log rather
+// than this keeper maintaining a witness for every still-valid credential (which would
+// mean storing one for every credential ever issued, most of which are never revoked).
+func (k Keeper) AccumulateRevocation(ctx context.Context, registryId, credentialId string) error {
+	reg, err := k.GetAccumulatorRegistry(ctx, registryId)
+	if err != nil {
+		return err
+	}
+
+	p, err := types.HashToPrime(credentialId)
+	if err != nil {
+		return err
+	}
+
+	n := new(big.Int).SetBytes(reg.N)
+	aCurrent := new(big.Int).SetBytes(reg.ACurrent)
+	aNew := new(big.Int).Exp(aCurrent, p, n)
+
+	product := new(big.Int).SetBytes(reg.RevokedPrimesProduct)
+	product.Mul(product, p)
+
+	witness := types.AccumulatorWitness{
+		RegistryId:   registryId,
+		CredentialId: credentialId,
+		Prime:        p.Bytes(),
+		Witness:      aCurrent.Bytes(),
+		Epoch:        reg.Epoch,
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	witness.Created = sdkCtx.BlockTime()
+
+	if err := k.AccumulatorWitnesses.Set(ctx, collections.Join(registryId, credentialId), witness); err != nil {
+		return err
+	}
+
+	reg.ACurrent = aNew.Bytes()
+	reg.RevokedPrimesProduct = product.Bytes()
+	reg.Updated = sdkCtx.BlockTime()
+	if err := k.AccumulatorRegistries.Set(ctx, reg.Id, *reg); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccumulatorWitnessPublished,
+		sdk.NewAttribute(types.AttributeKeyAccumulatorRegistryID, registryId),
+		sdk.NewAttribute(types.AttributeKeyCredentialID, credentialId),
+		sdk.NewAttribute(types.AttributeKeyAccumulatorEpoch, strconv.FormatUint(reg.Epoch, 10)),
+	))
+
+	return nil
+}
+
+// QueryWitness returns the AccumulatorWitness recorded for credentialID the last time it
+// was folded into registryId's accumulator at the given epoch. Only credentials this
+// keeper has actually revoked via AccumulateRevocation have a witness on file --
+// ErrAccumulatorWitnessNotFound for any other credential ID is the expected answer, not
+// a bug: a still-valid credential's holder establishes non-revocation by never having
+// seen a matching EventTypeAccumulatorWitnessPublished in the event stream, not by
+// fetching a witness from this method.
+func (k Keeper) QueryWitness(ctx context.Context, credentialID string, epoch uint64) (*types.AccumulatorWitness, error) {
+	var found *types.AccumulatorWitness
+	err := k.AccumulatorWitnesses.Walk(ctx, nil, func(_ collections.Pair[string, string], w types.AccumulatorWitness) (bool, error) {
+		if w.CredentialId == credentialID && w.Epoch == epoch {
+			wCopy := w
+			found = &wCopy
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, types.ErrAccumulatorWitnessNotFound.Wrapf("credential %q at epoch %d", credentialID, epoch)
+	}
+	return found, nil
+}
+
+// VerifyMembershipWitness checks whether (a, w, p) satisfy the witness relation
+// w^p = a (mod N) for registryId, i.e. that w is a valid witness that credential p was
+// folded into registryId's accumulator. This was formerly named VerifyNonRevocation,
+// which claimed the opposite of what it checks; holders proving non-revocation now use
+// ComputeNonMembershipWitness/VerifyNonRevocation below instead.
+func (k Keeper) VerifyMembershipWitness(ctx context.Context, registryId string, a, w, p []byte) (bool, error) {
+	reg, err := k.GetAccumulatorRegistry(ctx, registryId)
+	if err != nil {
+		return false, err
+	}
+	return types.VerifyMembershipWitness(reg.N, a, w, p), nil
+}
+
+// ComputeNonMembershipWitness builds a types.NonMembershipWitness proving credentialId was
+// not folded into registryId's accumulator as of its current RevokedPrimesProduct, so a
+// holder can later convince a verifier of non-revocation via VerifyNonRevocation without
+// this keeper having to track a witness for every still-valid credential.
+func (k Keeper) ComputeNonMembershipWitness(ctx context.Context, registryId, credentialId string) (*types.NonMembershipWitness, error) {
+	reg, err := k.GetAccumulatorRegistry(ctx, registryId)
+	if err != nil {
+		return nil, err
+	}
+	p, err := types.HashToPrime(credentialId)
+	if err != nil {
+		return nil, err
+	}
+	return types.ComputeNonMembershipWitness(reg.N, reg.G, reg.RevokedPrimesProduct, p.Bytes())
+}
+
+// VerifyNonRevocation checks whether witness is valid Bezout-coefficient evidence that
+// credentialId was not folded into registryId's accumulator.
+func (k Keeper) VerifyNonRevocation(ctx context.Context, registryId, credentialId string, witness *types.NonMembershipWitness) (bool, error) {
+	reg, err := k.GetAccumulatorRegistry(ctx, registryId)
+	if err != nil {
+		return false, err
+	}
+	p, err := types.HashToPrime(credentialId)
+	if err != nil {
+		return false, err
+	}
+	return types.VerifyNonRevocation(reg.N, reg.G, reg.ACurrent, witness, p.Bytes()), nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	didtypes "github.com/PersonaPass-ID/personachain/x/did/types"
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+var _ didtypes.DIDHooks = Keeper{}
+
+// AfterDIDDeactivated implements didtypes.DIDHooks. When the controlling DID of a
+// credential issuer is deactivated, every revocation entry issued by that DID is
+// force-revoked so a deactivated issuer can no longer vouch for live credentials.
+func (k Keeper) AfterDIDDeactivated(ctx context.Context, did string) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	var toRevoke []types.RevocationEntry
+	err := k.Revocations.Walk(ctx, nil, func(_ string, entry types.RevocationEntry) (bool, error) {
+		if entry.Issuer == did && entry.Status != types.StatusRevoked {
+			toRevoke = append(toRevoke, entry)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range toRevoke {
+		oldStatus := string(entry.Status)
+
+		entry.Status = types.StatusRevoked
+		entry.UpdatedAt = blockTime
+
+		if err := k.Revocations.Set(ctx, entry.ID, entry); err != nil {
+			return err
+		}
+		if err := k.RevocationsByStatus.Remove(ctx, collections.Join(oldStatus, entry.ID)); err != nil {
+			return err
+		}
+		if err := k.RevocationsByStatus.Set(ctx, collections.Join(string(entry.Status), entry.ID), entry.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
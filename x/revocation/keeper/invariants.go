@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// RegisterInvariants registers all revocation invariants against ir, so a crisis
+// module wired into the app halts the chain fast on corrupted revocation state
+// instead of letting IsRevoked/QueryRevocations silently serve bad answers.
+//
+// This repo has no x/crisis module vendored and no app.go to construct a crisis
+// keeper against, so there's no live InvariantRegistry for RegisterInvariants to be
+// called with yet outside of AppModule.RegisterInvariants -- the same app-wiring gap
+// documented across the other modules' module.go files. The invariant functions
+// below are real and run against this keeper's live collections state; only the
+// app-level crisis.RegisterRoute/FlagSkipGenesisInvariants plumbing is blocked.
+func RegisterInvariants(k Keeper, ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(types.ModuleName, "revocation-index-consistency", RevocationIndexConsistencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "no-orphan-appeals", NoOrphanAppealsInvariant(k))
+}
+
+// AllInvariants runs every revocation invariant in sequence, short-circuiting on the
+// first broken one, the combined-route shape x/crisis's InitGenesis walks when
+// FlagSkipGenesisInvariants is unset.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if msg, broken := RevocationIndexConsistencyInvariant(k)(ctx); broken {
+			return msg, broken
+		}
+		return NoOrphanAppealsInvariant(k)(ctx)
+	}
+}
+
+// RevocationIndexConsistencyInvariant checks that every RevocationEntry in the
+// primary Revocations map has a matching RevocationsByStatus and
+// RevocationsByCredential entry, and that neither secondary index points at a
+// RevocationEntry that no longer exists.
+func RevocationIndexConsistencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		err := k.Revocations.Walk(ctx, nil, func(id string, entry types.RevocationEntry) (bool, error) {
+			byStatusID, err := k.RevocationsByStatus.Get(ctx, collections.Join(string(entry.Status), id))
+			if err != nil || byStatusID != id {
+				count++
+				msg += fmt.Sprintf("\trevocation %q missing from RevocationsByStatus[%s]\n", id, entry.Status)
+			}
+
+			byCredentialID, err := k.RevocationsByCredential.Get(ctx, entry.CredentialID)
+			if err != nil || byCredentialID != id {
+				count++
+				msg += fmt.Sprintf("\trevocation %q missing from RevocationsByCredential[%s]\n", id, entry.CredentialID)
+			}
+			return false, nil
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\twalking Revocations failed: %v\n", err)
+		}
+
+		err = k.RevocationsByCredential.Walk(ctx, nil, func(credentialID string, revocationID string) (bool, error) {
+			if _, err := k.Revocations.Get(ctx, revocationID); err != nil {
+				count++
+				msg += fmt.Sprintf("\tRevocationsByCredential[%s] references missing revocation %q\n", credentialID, revocationID)
+			}
+			return false, nil
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\twalking RevocationsByCredential failed: %v\n", err)
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "revocation-index-consistency",
+			fmt.Sprintf("%d revocation index inconsistencies found\n%s", count, msg)), broken
+	}
+}
+
+// NoOrphanAppealsInvariant checks that every Appeal filed against a RevocationEntry
+// names a non-empty Appellant and was filed no earlier than the entry's CreatedAt and
+// no later than the current block time.
+//
+// RevocationEntry has no RevokedAt field and Appeal has no "revoker" field in this
+// tree -- entry.CreatedAt (the revocation's creation time) and appeal.Appellant (the
+// holder who filed the appeal) are the closest fields this invariant can check
+// against.
+func NoOrphanAppealsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+		now := ctx.BlockTime()
+
+		err := k.Revocations.Walk(ctx, nil, func(id string, entry types.RevocationEntry) (bool, error) {
+			for _, appeal := range entry.Appeals {
+				if appeal.Appellant == "" {
+					count++
+					msg += fmt.Sprintf("\trevocation %q has an appeal with no appellant\n", id)
+					continue
+				}
+				if appeal.FiledAt.Before(entry.CreatedAt) || appeal.FiledAt.After(now) {
+					count++
+					msg += fmt.Sprintf("\trevocation %q appeal by %q filed at %s outside [%s, %s]\n",
+						id, appeal.Appellant, appeal.FiledAt, entry.CreatedAt, now)
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\twalking Revocations failed: %v\n", err)
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "no-orphan-appeals",
+			fmt.Sprintf("%d orphaned appeals found\n%s", count, msg)), broken
+	}
+}
@@ -4,35 +4,60 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
-	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/log"
-	
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
 	didkeeper "github.com/PersonaPass-ID/personachain/x/did/keeper"
-	
+	e2eekeeper "github.com/PersonaPass-ID/personachain/x/e2ee/keeper"
 	"github.com/PersonaPass-ID/personachain/x/revocation/types"
 )
 
-// Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine. Migrated off a raw storeKey.KVStore(ctx) +
+// MustMarshal/MustUnmarshal layout onto a KVStoreService-backed collections schema,
+// the same direction x/authz's keeper took. The old NewKeeper opened its one KVStore
+// via storeService.OpenKVStore(context.Background()) and stashed it as storeKey --
+// that store was permanently bound to a background context, never the live
+// sdk.Context any Msg/Query handler actually runs under, so every read it served was
+// reading whatever the store looked like at app-start. Every accessor below instead
+// takes context.Context and resolves its store fresh per call.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
-	logger   log.Logger
-	
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+	Schema       collections.Schema
+
+	Revocations             collections.Map[string, types.RevocationEntry]
+	RevocationsByStatus     collections.Map[collections.Pair[string, string], string]
+	RevocationsByCredential collections.Map[string, string]
+
+	StatusLists               collections.Map[string, types.StatusList]
+	StatusListByIssuerPurpose collections.Map[collections.Pair[string, string], string]
+	StatusListCounters        collections.Map[string, uint64]
+	StatusListGenerations     collections.Map[collections.Pair[string, string], uint64]
+	CredentialStatusIndexes   collections.Map[string, types.CredentialStatusIndex]
+	Params                    collections.Item[types.Params]
+
+	AccumulatorRegistries collections.Map[string, types.AccumulatorRegistry]
+	AccumulatorWitnesses  collections.Map[collections.Pair[string, string], types.AccumulatorWitness]
+
 	// External keepers
 	authKeeper authkeeper.AccountKeeper
 	bankKeeper bankkeeper.Keeper
 	didKeeper  *didkeeper.Keeper
-	
+	e2eeKeeper *e2eekeeper.Keeper
+
 	// Authority is the module authority
 	authority string
 }
 
-// NewKeeper creates a new revocation Keeper instance
+// NewKeeper creates a new revocation Keeper instance backed by storeService.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
@@ -40,16 +65,42 @@ func NewKeeper(
 	authKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	didKeeper *didkeeper.Keeper,
+	e2eeKeeper *e2eekeeper.Keeper,
 ) *Keeper {
-	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeService.OpenKVStore(context.Background()),
-		logger:     log.NewNopLogger(),
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		didKeeper:  didKeeper,
-		authority:  authority,
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		logger:       log.NewNopLogger(),
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+		didKeeper:    didKeeper,
+		e2eeKeeper:   e2eeKeeper,
+		authority:    authority,
+
+		Revocations:             collections.NewMap(sb, types.RevocationPrefix, "revocations", collections.StringKey, codec.CollValue[types.RevocationEntry](cdc)),
+		RevocationsByStatus:     collections.NewMap(sb, types.RevocationByStatusPrefix, "revocations_by_status", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		RevocationsByCredential: collections.NewMap(sb, types.RevocationByCredentialPrefix, "revocations_by_credential", collections.StringKey, collections.StringValue),
+
+		StatusLists:               collections.NewMap(sb, types.StatusListPrefix, "status_lists", collections.StringKey, codec.CollValue[types.StatusList](cdc)),
+		StatusListByIssuerPurpose: collections.NewMap(sb, types.StatusListByIssuerPurposePrefix, "status_list_by_issuer_purpose", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		StatusListCounters:        collections.NewMap(sb, types.StatusListCounterPrefix, "status_list_counters", collections.StringKey, collections.Uint64Value),
+		StatusListGenerations:     collections.NewMap(sb, types.StatusListGenerationPrefix, "status_list_generations", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		CredentialStatusIndexes:   collections.NewMap(sb, types.CredentialStatusIndexPrefix, "credential_status_indexes", collections.StringKey, codec.CollValue[types.CredentialStatusIndex](cdc)),
+		Params:                    collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+
+		AccumulatorRegistries: collections.NewMap(sb, types.AccumulatorRegistryPrefix, "accumulator_registries", collections.StringKey, codec.CollValue[types.AccumulatorRegistry](cdc)),
+		AccumulatorWitnesses:  collections.NewMap(sb, types.AccumulatorWitnessPrefix, "accumulator_witnesses", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AccumulatorWitness](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -62,91 +113,203 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
-// RevokeCredential creates a revocation entry for a credential
-func (k Keeper) RevokeCredential(ctx sdk.Context, entry *types.RevocationEntry) error {
+// GetParams returns the module's current Params, falling back to DefaultParams if
+// InitGenesis has never run (the same ungenerated-state convention
+// x/schema/x/e2ee/x/oracle's GetParams use).
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams(), nil
+	}
+	return params, nil
+}
+
+// SetParams validates and stores params, gated on k.authority by MsgUpdateParams (once
+// one exists -- see types/params.go's doc comment on StatusListSize for why an operator
+// would want to change it).
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// statusListSize returns the configured StatusListSize, falling back to
+// DefaultStatusListSize if GetParams errors (which it no longer does, but matches the
+// defensive style GetParams callers elsewhere in this package use).
+func (k Keeper) statusListSize(ctx context.Context) uint64 {
+	params, err := k.GetParams(ctx)
+	if err != nil || params.StatusListSize == 0 {
+		return types.DefaultStatusListSize
+	}
+	return params.StatusListSize
+}
+
+// RevokeCredential creates a revocation entry for a credential, indexing it by status
+// and by credential ID so IsRevoked/QueryRevocations never need a full table scan.
+func (k Keeper) RevokeCredential(ctx context.Context, entry *types.RevocationEntry) error {
 	if err := entry.Validate(); err != nil {
 		return err
 	}
-	
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(entry)
-	store.Set(types.RevocationKey(entry.ID), bz)
-	
-	return nil
+
+	if err := k.Revocations.Set(ctx, entry.ID, *entry); err != nil {
+		return err
+	}
+	if err := k.RevocationsByStatus.Set(ctx, collections.Join(string(entry.Status), entry.ID), entry.ID); err != nil {
+		return err
+	}
+	return k.RevocationsByCredential.Set(ctx, entry.CredentialID, entry.ID)
 }
 
-// GetRevocation retrieves a revocation entry
-func (k Keeper) GetRevocation(ctx sdk.Context, revocationID string) (*types.RevocationEntry, error) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.RevocationKey(revocationID))
-	
-	if bz == nil {
+// GetRevocation retrieves a revocation entry by ID.
+func (k Keeper) GetRevocation(ctx context.Context, revocationID string) (*types.RevocationEntry, error) {
+	entry, err := k.Revocations.Get(ctx, revocationID)
+	if err != nil {
 		return nil, types.ErrRevocationNotFound
 	}
-	
-	var entry types.RevocationEntry
-	k.cdc.MustUnmarshal(bz, &entry)
-	
 	return &entry, nil
 }
 
-// IsRevoked checks if a credential is revoked
-func (k Keeper) IsRevoked(ctx sdk.Context, credentialID string) bool {
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
-	defer iterator.Close()
-	
-	for ; iterator.Valid(); iterator.Next() {
-		var entry types.RevocationEntry
-		k.cdc.MustUnmarshal(iterator.Value(), &entry)
-		
-		if entry.CredentialID == credentialID && entry.Status == types.StatusRevoked {
-			return true
-		}
+// IsRevoked reports whether credentialID has a StatusRevoked entry, an O(1)
+// RevocationsByCredential lookup plus one Revocations read in place of the full
+// store.Iterator(nil, nil) scan the pre-collections keeper ran on every call.
+func (k Keeper) IsRevoked(ctx context.Context, credentialID string) bool {
+	revocationID, err := k.RevocationsByCredential.Get(ctx, credentialID)
+	if err != nil {
+		return false
 	}
-	
-	return false
+	entry, err := k.Revocations.Get(ctx, revocationID)
+	if err != nil {
+		return false
+	}
+	return entry.Status == types.StatusRevoked
 }
 
-// SubmitAppeal submits an appeal for a revocation
-func (k Keeper) SubmitAppeal(ctx sdk.Context, revocationID string, appeal *types.Appeal) error {
+// SubmitAppeal appends an appeal to an existing revocation entry. If appeal carries an
+// EncryptedPayload, the recipient's e2ee key registration and assigned reviewer DID are
+// verified before the envelope is accepted -- SubmitAppeal never sees or stores the
+// plaintext evidence, only the already-sealed blob.
+func (k Keeper) SubmitAppeal(ctx context.Context, revocationID string, appeal *types.Appeal) error {
 	entry, err := k.GetRevocation(ctx, revocationID)
 	if err != nil {
 		return err
 	}
-	
+
+	if appeal.EncryptedPayload != nil {
+		if err := k.verifyEncryptedAppealRecipient(ctx, appeal); err != nil {
+			return err
+		}
+	}
+
 	entry.Appeals = append(entry.Appeals, *appeal)
-	
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(entry)
-	store.Set(types.RevocationKey(entry.ID), bz)
-	
+
+	return k.Revocations.Set(ctx, entry.ID, *entry)
+}
+
+// verifyEncryptedAppealRecipient checks that appeal.EncryptedPayload.RecipientKeyID has
+// a registered e2ee encryption key, and that address is a controller of appeal's
+// assigned ReviewerDID, before an encrypted appeal payload is accepted.
+func (k Keeper) verifyEncryptedAppealRecipient(ctx context.Context, appeal *types.Appeal) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	recipient := appeal.EncryptedPayload.RecipientKeyID
+
+	if _, err := k.e2eeKeeper.GetRegisteredKey(sdkCtx, recipient); err != nil {
+		return types.ErrReviewerKeyNotFound.Wrapf("%s: %s", recipient, err)
+	}
+
+	if appeal.ReviewerDID == "" {
+		return types.ErrReviewerDIDMismatch.Wrap("encrypted appeal has no assigned reviewer DID to verify against")
+	}
+
+	controlsReviewerDID := false
+	err := k.didKeeper.GetDIDsByController(ctx, recipient, func(id string) (bool, error) {
+		if id == appeal.ReviewerDID {
+			controlsReviewerDID = true
+			return true, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !controlsReviewerDID {
+		return types.ErrReviewerDIDMismatch.Wrapf("%s does not control reviewer DID %s", recipient, appeal.ReviewerDID)
+	}
+
 	return nil
 }
 
-// QueryRevocations returns revocations based on query parameters
-func (k Keeper) QueryRevocations(ctx sdk.Context, query types.RevocationQuery) ([]*types.RevocationEntry, error) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
-	defer iterator.Close()
-	
-	var revocations []*types.RevocationEntry
-	
-	for ; iterator.Valid(); iterator.Next() {
-		var entry types.RevocationEntry
-		k.cdc.MustUnmarshal(iterator.Value(), &entry)
-		
-		// Apply query filters
-		if query.Status != "" && entry.Status != types.RevocationStatus(query.Status) {
-			continue
+// DecideAppeal records a reviewer's decision on the appeal at appealIndex. When the
+// appeal carries an EncryptedPayload, ciphertextHash must match its
+// CiphertextHash() -- see MsgDecideAppeal.MatchesCiphertext.
+func (k Keeper) DecideAppeal(ctx context.Context, revocationID string, appealIndex uint64, upheld bool, ciphertextHash []byte) error {
+	entry, err := k.GetRevocation(ctx, revocationID)
+	if err != nil {
+		return err
+	}
+	if appealIndex >= uint64(len(entry.Appeals)) {
+		return types.ErrInvalidAppeal.Wrapf("appeal index %d out of range", appealIndex)
+	}
+
+	appeal := &entry.Appeals[appealIndex]
+	msg := &types.MsgDecideAppeal{CiphertextHash: ciphertextHash}
+	if !msg.MatchesCiphertext(appeal.EncryptedPayload) {
+		return types.ErrCiphertextMismatch
+	}
+
+	appeal.Resolved = true
+	appeal.Upheld = upheld
+	entry.UpdatedAt = sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	if upheld && entry.Status != types.StatusReversed {
+		oldStatus := entry.Status
+		entry.Status = types.StatusReversed
+		if err := k.RevocationsByStatus.Remove(ctx, collections.Join(string(oldStatus), entry.ID)); err != nil {
+			return err
 		}
-		
+		if err := k.RevocationsByStatus.Set(ctx, collections.Join(string(entry.Status), entry.ID), entry.ID); err != nil {
+			return err
+		}
+	}
+
+	return k.Revocations.Set(ctx, entry.ID, *entry)
+}
+
+// QueryRevocations returns revocations matching query. A non-empty query.Status walks
+// RevocationsByStatus's (status, id) range instead of every RevocationEntry, the O(log
+// n) replacement for the pre-collections keeper's unconditional full scan.
+func (k Keeper) QueryRevocations(ctx context.Context, query types.RevocationQuery) ([]*types.RevocationEntry, error) {
+	var revocations []*types.RevocationEntry
+
+	collect := func(entry types.RevocationEntry) {
 		if query.CredentialID != "" && entry.CredentialID != query.CredentialID {
-			continue
+			return
 		}
-		
 		revocations = append(revocations, &entry)
 	}
-	
+
+	if query.Status != "" {
+		rng := collections.NewPrefixedPairRange[string, string](query.Status)
+		err := k.RevocationsByStatus.Walk(ctx, rng, func(_ collections.Pair[string, string], revocationID string) (bool, error) {
+			entry, err := k.Revocations.Get(ctx, revocationID)
+			if err != nil {
+				return false, err
+			}
+			collect(entry)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return revocations, nil
+	}
+
+	err := k.Revocations.Walk(ctx, nil, func(_ string, entry types.RevocationEntry) (bool, error) {
+		collect(entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return revocations, nil
-}
\ No newline at end of file
+}
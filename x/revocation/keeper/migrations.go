@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// MigrateStore copies every RevocationEntry and StatusList2021 record written under
+// the pre-collections raw-key layout (types.LegacyRevocationPrefix and friends) into
+// the collections schema keeper.go builds, then deletes the legacy entries. Like
+// x/did's MigrateStore, this takes the legacy raw store key directly rather than
+// through Keeper, since by the time it's wired into an upgrade handler the legacy
+// storeKey won't be part of Keeper anymore.
+func MigrateStore(ctx sdk.Context, legacyStoreKey storetypes.StoreKey, k Keeper) error {
+	store := ctx.KVStore(legacyStoreKey)
+
+	revocationIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyRevocationPrefix))
+	defer revocationIter.Close()
+
+	for ; revocationIter.Valid(); revocationIter.Next() {
+		var entry types.RevocationEntry
+		k.cdc.MustUnmarshal(revocationIter.Value(), &entry)
+		if err := k.Revocations.Set(ctx, entry.ID, entry); err != nil {
+			return err
+		}
+		if err := k.RevocationsByStatus.Set(ctx, collections.Join(string(entry.Status), entry.ID), entry.ID); err != nil {
+			return err
+		}
+		if err := k.RevocationsByCredential.Set(ctx, entry.CredentialID, entry.ID); err != nil {
+			return err
+		}
+		store.Delete(revocationIter.Key())
+	}
+
+	statusListIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyStatusListPrefix))
+	defer statusListIter.Close()
+
+	for ; statusListIter.Valid(); statusListIter.Next() {
+		var list types.StatusList
+		k.cdc.MustUnmarshal(statusListIter.Value(), &list)
+		if err := k.StatusLists.Set(ctx, list.Id, list); err != nil {
+			return err
+		}
+		store.Delete(statusListIter.Key())
+	}
+
+	indexPrefixIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyStatusListIndexPrefix))
+	defer indexPrefixIter.Close()
+
+	for ; indexPrefixIter.Valid(); indexPrefixIter.Next() {
+		issuer, purpose := issuerPurposeFromLegacyKey(indexPrefixIter.Key())
+		if issuer == "" {
+			continue
+		}
+		id := string(indexPrefixIter.Value())
+		if err := k.StatusListByIssuerPurpose.Set(ctx, collections.Join(issuer, purpose), id); err != nil {
+			return err
+		}
+		store.Delete(indexPrefixIter.Key())
+	}
+
+	counterIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyStatusListCounterPrefix))
+	defer counterIter.Close()
+
+	for ; counterIter.Valid(); counterIter.Next() {
+		id := string(counterIter.Key()[len(types.LegacyStatusListCounterPrefix):])
+		count := sdk.BigEndianToUint64(counterIter.Value())
+		if err := k.StatusListCounters.Set(ctx, id, count); err != nil {
+			return err
+		}
+		store.Delete(counterIter.Key())
+	}
+
+	credentialIndexIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyCredentialStatusIndexPrefix))
+	defer credentialIndexIter.Close()
+
+	for ; credentialIndexIter.Valid(); credentialIndexIter.Next() {
+		var entry types.CredentialStatusIndex
+		k.cdc.MustUnmarshal(credentialIndexIter.Value(), &entry)
+		credentialID := string(credentialIndexIter.Key()[len(types.LegacyCredentialStatusIndexPrefix):])
+		if err := k.CredentialStatusIndexes.Set(ctx, credentialID, entry); err != nil {
+			return err
+		}
+		store.Delete(credentialIndexIter.Key())
+	}
+
+	return nil
+}
+
+// BackfillStatusListBits walks every RevocationEntry already in the collections schema
+// (after MigrateStore has run) and, for any StatusRevoked entry whose CredentialID has
+// no CredentialStatusIndexes entry yet -- i.e. it was revoked before CreateCredential
+// started stamping new credentials with a StatusList2021 bit (see
+// x/credential/keeper/msg_server_lifecycle.go's CreateCredential) -- allocates one
+// against the issuer's current revocation-purpose list and sets it. This is what lets
+// IsRevokedByBit/CredentialStatus report a pre-existing revocation correctly instead of
+// only the legacy Revocations/RevocationsByCredential path seeing it; it does not
+// delete or alter the source RevocationEntry, which remains the system of record for
+// appeals (SubmitAppeal/DecideAppeal).
+func BackfillStatusListBits(ctx sdk.Context, k Keeper) error {
+	var toStamp []types.RevocationEntry
+	if err := k.Revocations.Walk(ctx, nil, func(_ string, entry types.RevocationEntry) (bool, error) {
+		if entry.Status != types.StatusRevoked {
+			return false, nil
+		}
+		if _, err := k.CredentialStatusIndexes.Get(ctx, entry.CredentialID); err == nil {
+			return false, nil
+		}
+		toStamp = append(toStamp, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range toStamp {
+		statusListID, index, err := k.AllocateStatusListIndex(ctx, entry.Issuer, types.StatusPurposeRevocation)
+		if err != nil {
+			return err
+		}
+		if err := k.SetCredentialStatusIndex(ctx, entry.CredentialID, statusListID, index); err != nil {
+			return err
+		}
+		if err := k.UpdateStatusListEntry(ctx, statusListID, index, entry.Issuer, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issuerPurposeFromLegacyKey splits a LegacyStatusListIndexPrefix key's "issuer/purpose"
+// suffix back into its two parts.
+func issuerPurposeFromLegacyKey(key []byte) (issuer, purpose string) {
+	suffix := string(key[len(types.LegacyStatusListIndexPrefix):])
+	for i := len(suffix) - 1; i >= 0; i-- {
+		if suffix[i] == '/' {
+			return suffix[:i], suffix[i+1:]
+		}
+	}
+	return "", ""
+}
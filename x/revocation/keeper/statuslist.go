@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// CreateStatusList allocates a new StatusList2021 credential for the given issuer and
+// purpose, storing an empty bitstring of the requested size and indexing it by
+// (issuer, purpose) so future issuances can find it with GetStatusListByIssuerPurpose.
+func (k Keeper) CreateStatusList(ctx context.Context, msg *types.MsgCreateStatusList) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	has, err := k.StatusLists.Has(ctx, msg.Id)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrStatusListExists.Wrapf("status list %q already exists", msg.Id)
+	}
+
+	encodedList, err := types.NewEmptyBitstring(msg.Size)
+	if err != nil {
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	list := &types.StatusList{
+		Id:          msg.Id,
+		Issuer:      msg.Issuer,
+		Purpose:     msg.Purpose,
+		EncodedList: encodedList,
+		Size:        msg.Size,
+		Created:     blockTime,
+		Updated:     blockTime,
+	}
+	if err := list.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.setStatusList(ctx, list); err != nil {
+		return err
+	}
+	if err := k.StatusListByIssuerPurpose.Set(ctx, collections.Join(msg.Issuer, string(msg.Purpose)), msg.Id); err != nil {
+		return err
+	}
+	return k.StatusListCounters.Set(ctx, msg.Id, 0)
+}
+
+func (k Keeper) setStatusList(ctx context.Context, list *types.StatusList) error {
+	return k.StatusLists.Set(ctx, list.Id, *list)
+}
+
+// GetStatusList retrieves a StatusList2021 credential by ID.
+func (k Keeper) GetStatusList(ctx context.Context, id string) (*types.StatusList, error) {
+	list, err := k.StatusLists.Get(ctx, id)
+	if err != nil {
+		return nil, types.ErrStatusListNotFound
+	}
+	return &list, nil
+}
+
+// GetStatusListByIssuerPurpose returns the status list an issuer currently maintains
+// for a purpose, allocating a fresh generation-0, DefaultStatusListSize list on first
+// use.
+func (k Keeper) GetStatusListByIssuerPurpose(ctx context.Context, issuer string, purpose types.StatusPurpose) (*types.StatusList, error) {
+	id, err := k.StatusListByIssuerPurpose.Get(ctx, collections.Join(issuer, string(purpose)))
+	if err == nil {
+		return k.GetStatusList(ctx, id)
+	}
+	return k.createGenerationStatusList(ctx, issuer, purpose, 0)
+}
+
+func (k Keeper) createGenerationStatusList(ctx context.Context, issuer string, purpose types.StatusPurpose, generation uint64) (*types.StatusList, error) {
+	id := types.StatusListID(issuer, purpose, generation)
+	if err := k.CreateStatusList(ctx, &types.MsgCreateStatusList{
+		Id:      id,
+		Issuer:  issuer,
+		Purpose: purpose,
+		Size:    k.statusListSize(ctx),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.StatusListByIssuerPurpose.Set(ctx, collections.Join(issuer, string(purpose)), id); err != nil {
+		return nil, err
+	}
+	if err := k.StatusListGenerations.Set(ctx, collections.Join(issuer, string(purpose)), generation); err != nil {
+		return nil, err
+	}
+
+	return k.GetStatusList(ctx, id)
+}
+
+// AllocateStatusListIndex reserves and returns the next free bit index in the issuer's
+// current status list for the given purpose, rolling to a fresh generation+1 list once
+// the current one fills up. IssueCredential calls this to stamp a credential's
+// CredentialStatus with "{statusList}#<index>" before the bit is ever set, and should
+// persist the (statusListID, index) pair via Keeper.SetCredentialStatusIndex.
+func (k Keeper) AllocateStatusListIndex(ctx context.Context, issuer string, purpose types.StatusPurpose) (statusListID string, index uint64, err error) {
+	list, err := k.GetStatusListByIssuerPurpose(ctx, issuer, purpose)
+	if err != nil {
+		return "", 0, err
+	}
+
+	next, err := k.StatusListCounters.Get(ctx, list.Id)
+	if err != nil {
+		return "", 0, err
+	}
+	if next >= list.Size {
+		generation, err := k.StatusListGenerations.Get(ctx, collections.Join(issuer, string(purpose)))
+		if err != nil {
+			return "", 0, err
+		}
+		list, err = k.createGenerationStatusList(ctx, issuer, purpose, generation+1)
+		if err != nil {
+			return "", 0, err
+		}
+		next = 0
+	}
+
+	if err := k.StatusListCounters.Set(ctx, list.Id, next+1); err != nil {
+		return "", 0, err
+	}
+	return list.Id, next, nil
+}
+
+// SetCredentialStatusIndex records the (statusListID, index) a credential was stamped
+// with on issuance, so IsRevokedByBit can resolve its revocation bit by credential ID
+// alone.
+func (k Keeper) SetCredentialStatusIndex(ctx context.Context, credentialID, statusListID string, index uint64) error {
+	return k.CredentialStatusIndexes.Set(ctx, credentialID, types.CredentialStatusIndex{StatusListID: statusListID, Index: index})
+}
+
+// IsRevokedByBit resolves credentialID's (statusListID, index) stamp and reports
+// whether its bit is currently set. Used by both VerifyCredential and external gRPC
+// verifiers to check revocation state without a per-credential Revocation record.
+func (k Keeper) IsRevokedByBit(ctx context.Context, credentialID string) (bool, error) {
+	entry, err := k.CredentialStatusIndexes.Get(ctx, credentialID)
+	if err != nil {
+		return false, types.ErrStatusListNotFound.Wrapf("no status list index recorded for credential %s", credentialID)
+	}
+	return k.IsCredentialRevoked(ctx, entry.StatusListID, entry.Index)
+}
+
+// UpdateStatusListEntry flips the status bit at index within statusListID: true
+// revokes/suspends the credential stamped with it (depending on the list's purpose),
+// false reinstates it. Positional rather than Msg-shaped so it matches
+// credential/types.RevocationKeeper's expected-keeper interface -- x/credential/keeper/
+// msg_server_lifecycle.go's RevokeCredential/UpdateCredentialStatus are this method's
+// real callers, not a MsgServer of this module's own (this module has no msg_server.go
+// dispatching MsgUpdateStatusListEntry yet, only the simulation operation that builds
+// one -- see UpdateStatusListEntryMsg for the Msg-shaped wrapper that would serve it).
+func (k Keeper) UpdateStatusListEntry(ctx context.Context, statusListID string, index uint64, issuer string, revoked bool) error {
+	list, err := k.GetStatusList(ctx, statusListID)
+	if err != nil {
+		return err
+	}
+	if list.Issuer != issuer {
+		return types.ErrUnauthorized.Wrap("only the issuing DID may update its status list")
+	}
+
+	encodedList, err := list.SetStatusBit(index, revoked)
+	if err != nil {
+		return err
+	}
+
+	list.EncodedList = encodedList
+	list.Updated = sdk.UnwrapSDKContext(ctx).BlockTime()
+	return k.setStatusList(ctx, list)
+}
+
+// UpdateStatusListEntryMsg implements the MsgUpdateStatusListEntry handler a future
+// x/revocation msg_server.go would register, delegating to UpdateStatusListEntry after
+// msg.ValidateBasic.
+func (k Keeper) UpdateStatusListEntryMsg(ctx context.Context, msg *types.MsgUpdateStatusListEntry) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.UpdateStatusListEntry(ctx, msg.StatusListId, msg.Index, msg.Issuer, msg.Revoked)
+}
+
+// IsCredentialRevoked decodes the named status list and reports whether the bit at
+// index is set. VerifiableCredential.IsRevoked calls into this via the
+// credential/types.StatusListKeeper interface to resolve a StatusList2021 entry.
+func (k Keeper) IsCredentialRevoked(ctx context.Context, statusListID string, index uint64) (bool, error) {
+	list, err := k.GetStatusList(ctx, statusListID)
+	if err != nil {
+		return false, err
+	}
+	return list.GetStatusBit(index)
+}
+
+// QueryStatusListCredential returns a status list encoded as a W3C JSON-LD verifiable
+// credential, for verifiers to fetch via gRPC or REST.
+func (k Keeper) QueryStatusListCredential(ctx context.Context, id string) (*types.StatusListCredential, error) {
+	list, err := k.GetStatusList(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return list.ToVerifiableCredential(), nil
+}
+
+// QueryStatusListChunk serves the gRPC StatusList(issuer, list_id) endpoint: it returns
+// a single 4KB chunk of listID's decompressed bitstring plus a Merkle inclusion proof,
+// so a light client can verify revocation state for the credentials in that chunk
+// without downloading the whole (potentially much larger) bitstring.
+func (k Keeper) QueryStatusListChunk(ctx context.Context, issuer, listID string, chunkIndex uint64) (*types.QueryStatusListResponse, error) {
+	list, err := k.GetStatusList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.Issuer != issuer {
+		return nil, types.ErrStatusListNotFound.Wrapf("status list %q does not belong to issuer %q", listID, issuer)
+	}
+
+	bits, err := list.DecodedBits()
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := types.ProveStatusListChunk(bits, chunkIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStatusListResponse{
+		MerkleRoot: types.StatusListMerkleRoot(bits),
+		Proof:      proof,
+	}, nil
+}
+
+// QueryCredentialStatus serves a single-credential revocation check: given the
+// (issuer, listID, index) triple a credential's credentialStatus claim carries, it
+// returns just that bit's value plus the Merkle inclusion proof for the 4KB chunk
+// containing it, so a verifier can confirm the bit against the list's root with one
+// state read instead of pulling the whole (potentially much larger) bitstring or the
+// list's full QueryStatusListCredential JSON-LD form.
+func (k Keeper) QueryCredentialStatus(ctx context.Context, issuer, listID string, index uint64) (*types.QueryCredentialStatusResponse, error) {
+	list, err := k.GetStatusList(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list.Issuer != issuer {
+		return nil, types.ErrStatusListNotFound.Wrapf("status list %q does not belong to issuer %q", listID, issuer)
+	}
+
+	revoked, err := list.GetStatusBit(index)
+	if err != nil {
+		return nil, err
+	}
+
+	bits, err := list.DecodedBits()
+	if err != nil {
+		return nil, err
+	}
+	proof, err := types.ProveStatusListChunk(bits, index/(types.StatusListChunkSize*8))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCredentialStatusResponse{
+		Revoked:    revoked,
+		MerkleRoot: types.StatusListMerkleRoot(bits),
+		Proof:      proof,
+	}, nil
+}
+
+// QueryRawStatusList returns listID's StatusList2021 bitstring exactly as stored --
+// gzip-compressed then base64url-encoded, per https://w3c.github.io/vc-status-list-2021/
+// -- for an off-chain verifier that wants to cache and decode the whole list itself
+// rather than pull it through QueryStatusListCredential's JSON-LD wrapping or
+// QueryStatusListChunk/QueryCredentialStatus's Merkle-proof-per-chunk form.
+func (k Keeper) QueryRawStatusList(ctx context.Context, listID string) (string, error) {
+	list, err := k.GetStatusList(ctx, listID)
+	if err != nil {
+		return "", err
+	}
+	return list.EncodedList, nil
+}
@@ -16,9 +16,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/PersonaPass-ID/personachain/x/revocation/client/cli"
+	revocationkeeper "github.com/PersonaPass-ID/personachain/x/revocation/keeper"
 	"github.com/PersonaPass-ID/personachain/x/revocation/types"
 )
 
+// SkipGenesisInvariants mirrors x/crisis's FlagSkipGenesisInvariants
+// ("x-crisis-skip-assert-invariants"): when true, InitGenesis skips the
+// keeper.AllInvariants check below. This repo has no app.go to thread a real
+// server flag/AppOpts value into this package, so it's a plain exported var for
+// whatever eventually assembles the app to set before calling InitGenesis, the same
+// role crisis.FlagSkipGenesisInvariants plays via appOpts.Get in the real SDK.
+var SkipGenesisInvariants bool
+
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
@@ -53,28 +63,64 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 }
 
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {}
-func (a AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+func (a AppModuleBasic) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
 func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
 
 type AppModule struct {
 	AppModuleBasic
+	keeper revocationkeeper.Keeper
 }
 
-func NewAppModule(cdc codec.Codec) AppModule {
+// NewAppModule constructs the revocation AppModule. This tree has no app.yaml / app
+// wiring file to register the module's store key, params, and module account
+// against, so standing those up is left to whatever eventually assembles the app --
+// NewAppModule and revocationkeeper.NewKeeper are the pieces that wiring would plug
+// in, the same division e2ee and oracle's module.go already follow.
+func NewAppModule(cdc codec.Codec, keeper revocationkeeper.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
+		keeper:         keeper,
 	}
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {}
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// RegisterInvariants registers this module's crisis invariants -- see
+// keeper/invariants.go for what each route checks and why AllInvariants below exists
+// as InitGenesis's genesis-time check.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	revocationkeeper.RegisterInvariants(am.keeper, ir)
+}
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	for _, entry := range genState.Revocations {
+		entry := entry
+		if err := am.keeper.RevokeCredential(ctx, &entry); err != nil {
+			panic(err)
+		}
+	}
+
+	if !SkipGenesisInvariants {
+		if msg, broken := revocationkeeper.AllInvariants(am.keeper)(ctx); broken {
+			panic(msg)
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(DefaultGenesisState())
+	genState := DefaultGenesisState()
+	if err := am.keeper.Revocations.Walk(ctx, nil, func(_ string, entry types.RevocationEntry) (bool, error) {
+		genState.Revocations = append(genState.Revocations, entry)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	return cdc.MustMarshalJSON(genState)
 }
 
 func (AppModule) ConsensusVersion() uint64 { return 1 }
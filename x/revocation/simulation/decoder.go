@@ -0,0 +1,36 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's values
+// into the corresponding revocation type (a revocation entry or a StatusList2021
+// credential) and returns a human-readable diff for `simd` genesis import/export
+// invariant dumps, the same shape as x/did and x/credential's NewDecodeStore.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.RevocationPrefix):
+			var revA, revB types.RevocationEntry
+			cdc.MustUnmarshal(kvA.Value, &revA)
+			cdc.MustUnmarshal(kvB.Value, &revB)
+			return fmt.Sprintf("%v\n%v", &revA, &revB)
+
+		case bytes.HasPrefix(kvA.Key, types.StatusListPrefix):
+			var listA, listB types.StatusList
+			cdc.MustUnmarshal(kvA.Value, &listA)
+			cdc.MustUnmarshal(kvB.Value, &listB)
+			return fmt.Sprintf("%v\n%v", &listA, &listB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+		}
+	}
+}
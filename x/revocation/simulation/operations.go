@@ -0,0 +1,137 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/revocation/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateStatusList      = "op_weight_msg_create_status_list"
+	OpWeightMsgUpdateStatusListEntry = "op_weight_msg_update_status_list_entry"
+	OpWeightRevokeCredential         = "op_weight_revoke_credential"
+	OpWeightSubmitAppeal             = "op_weight_submit_appeal"
+
+	DefaultWeightMsgCreateStatusList      = 20
+	DefaultWeightMsgUpdateStatusListEntry = 80
+	DefaultWeightRevokeCredential         = 60
+	DefaultWeightSubmitAppeal             = 15
+)
+
+// WeightedOperations returns all the operations from the revocation module with
+// their respective weights.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec) simtypes.WeightedOperations {
+	var (
+		weightMsgCreateStatusList      int
+		weightMsgUpdateStatusListEntry int
+		weightRevokeCredential         int
+		weightSubmitAppeal             int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateStatusList, &weightMsgCreateStatusList, nil, func(_ *rand.Rand) {
+		weightMsgCreateStatusList = DefaultWeightMsgCreateStatusList
+	})
+	appParams.GetOrGenerate(OpWeightMsgUpdateStatusListEntry, &weightMsgUpdateStatusListEntry, nil, func(_ *rand.Rand) {
+		weightMsgUpdateStatusListEntry = DefaultWeightMsgUpdateStatusListEntry
+	})
+	appParams.GetOrGenerate(OpWeightRevokeCredential, &weightRevokeCredential, nil, func(_ *rand.Rand) {
+		weightRevokeCredential = DefaultWeightRevokeCredential
+	})
+	appParams.GetOrGenerate(OpWeightSubmitAppeal, &weightSubmitAppeal, nil, func(_ *rand.Rand) {
+		weightSubmitAppeal = DefaultWeightSubmitAppeal
+	})
+
+	return simtypes.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateStatusList, SimulateMsgCreateStatusList()),
+		simulation.NewWeightedOperation(weightMsgUpdateStatusListEntry, SimulateMsgUpdateStatusListEntry()),
+		simulation.NewWeightedOperation(weightRevokeCredential, SimulateMsgRevoke()),
+		simulation.NewWeightedOperation(weightSubmitAppeal, SimulateMsgAppeal()),
+	}
+}
+
+// SimulateMsgCreateStatusList generates a MsgCreateStatusList with a random issuer and
+// purpose.
+func SimulateMsgCreateStatusList() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		purpose := types.StatusPurposeRevocation
+		if r.Intn(2) == 0 {
+			purpose = types.StatusPurposeSuspension
+		}
+
+		msg := &types.MsgCreateStatusList{
+			Id:      types.StatusListID(issuer.Address.String(), purpose, 0),
+			Issuer:  issuer.Address.String(),
+			Purpose: purpose,
+			Size:    types.DefaultStatusListSize,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "revocation keeper does not yet support simulated delivery"), nil, nil
+	}
+}
+
+// SimulateMsgUpdateStatusListEntry generates a MsgUpdateStatusListEntry flipping a
+// random bit index against a freshly minted status list ID.
+func SimulateMsgUpdateStatusListEntry() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgUpdateStatusListEntry{
+			StatusListId: types.StatusListID(issuer.Address.String(), types.StatusPurposeRevocation, 0),
+			Index:        uint64(simtypes.RandIntBetween(r, 0, int(types.DefaultStatusListSize))),
+			Revoked:      true,
+			Issuer:       issuer.Address.String(),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no matching status list exists yet to update"), nil, nil
+	}
+}
+
+// SimulateMsgRevoke generates a no-op simulation entry standing in for a revocation.
+//
+// Keeper.RevokeCredential is a plain Go method (context.Context, *types.RevocationEntry)
+// with no MsgRevokeCredential/MsgServer wrapping it anywhere in x/revocation/types --
+// unlike MsgCreateStatusList/MsgUpdateStatusListEntry above, there is no sdk.Msg for
+// this operation to construct or deliver through baseapp. This reports a NoOpMsg under
+// the "revoke_credential" label so WeightedOperations still surfaces a slot for it, for
+// whoever wires RevokeCredential behind an actual Msg/MsgServer to replace.
+func SimulateMsgRevoke() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simtypes.NoOpMsg(types.ModuleName, "revoke_credential", "RevokeCredential has no sdk.Msg wrapping it in this tree yet"), nil, nil
+	}
+}
+
+// SimulateMsgAppeal generates a no-op simulation entry standing in for an appeal
+// submission. Keeper.SubmitAppeal has the same missing-Msg gap SimulateMsgRevoke
+// documents above.
+func SimulateMsgAppeal() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simtypes.NoOpMsg(types.ModuleName, "submit_appeal", "SubmitAppeal has no sdk.Msg wrapping it in this tree yet"), nil, nil
+	}
+}
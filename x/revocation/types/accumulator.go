@@ -0,0 +1,349 @@
+package types
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// RevocationMethod distinguishes which on-chain revocation scheme a RevocationList-like
+// record (StatusList or AccumulatorRegistry) uses. StatusList2021 (statuslist.go) has
+// always been this module's only method; AccumulatorRegistry below is the second.
+type RevocationMethod string
+
+const (
+	RevocationMethodStatusList2021 RevocationMethod = "status_list_2021"
+	RevocationMethodAccumulator    RevocationMethod = "accumulator"
+)
+
+// Event type and attribute keys for accumulator-backed revocation. This module has no
+// events.go -- CreateStatusList/UpdateStatusListEntry (statuslist.go) never emit events
+// of their own -- but the request asks explicitly for a published witness stream, so
+// AccumulateRevocation (keeper/accumulator.go) emits this one event per revocation.
+const (
+	EventTypeAccumulatorWitnessPublished = "accumulator_witness_published"
+
+	AttributeKeyAccumulatorRegistryID = "registry_id"
+	AttributeKeyAccumulatorEpoch      = "epoch"
+	AttributeKeyCredentialID          = "credential_id"
+)
+
+// AccumulatorRegistry is a zk-friendly, RSA-accumulator-backed revocation list: an
+// alternative to StatusList2021 that lets a holder prove their credential's prime
+// representative is not among the accumulated (revoked) primes without revealing which
+// credential they hold, unlike a StatusList2021 bit index (which does reveal it).
+//
+// N and G must come from a trusted setup whose factorization (and therefore phi(N)) is
+// known to no one -- the same reason x/zkproof's Circuit has a TrustedSetup flag and a
+// CeremonyId rather than generating its CRS on-chain (see x/zkproof/types/ceremony.go).
+// Generating an RSA modulus with crypto/rand inside a keeper method would be
+// non-deterministic across validators and would also hand this module's own authority
+// the factorization, defeating the accumulator's soundness; CreateAccumulatorRegistry
+// below therefore requires N and G to be supplied by the caller (e.g. from an
+// externally-run, audited ceremony, or a well-known modulus such as an RSA Factoring
+// Challenge number whose factors are unpublished) rather than computing them itself.
+type AccumulatorRegistry struct {
+	Id     string `json:"id"`
+	Issuer string `json:"issuer"`
+
+	// N is the accumulator's RSA modulus, big-endian bytes of a big.Int.
+	N []byte `json:"n"`
+	// G is the accumulator's generator, big-endian bytes of a big.Int, 1 < G < N.
+	G []byte `json:"g"`
+	// ACurrent is the current accumulator value A = G^(product of all revoked
+	// credentials' prime representatives) mod N, big-endian bytes of a big.Int.
+	ACurrent []byte `json:"aCurrent"`
+	// RevokedPrimesProduct is the running product of every prime representative
+	// accumulated into ACurrent so far, big-endian bytes of a big.Int. Tracked
+	// alongside ACurrent (rather than re-derived, which would need N's factorization)
+	// so ComputeNonMembershipWitness has the exponent to run the extended Euclidean
+	// algorithm against -- see its doc comment and VerifyNonRevocation below.
+	RevokedPrimesProduct []byte `json:"revokedPrimesProduct"`
+	// Epoch increments each time the registry is reset (AccumulatorWitness.Epoch pins a
+	// witness to the epoch it was issued under, so a client can tell a witness computed
+	// against a stale, since-reset accumulator apart from a current one).
+	Epoch uint64 `json:"epoch"`
+
+	// CircuitId, if set, names an x/zkproof Circuit (see x/zkproof/types.Circuit, which
+	// already has a CircuitTypeMembership) that proves, in zero knowledge, "I hold a
+	// credential whose prime representative is not accumulated in A" without revealing
+	// the prime itself. x/zkproof has no CircuitType dedicated to a non-membership
+	// predicate -- CircuitTypeMembership is the closest existing analog -- so binding a
+	// real circuit here is left to whoever registers one; this field only records which
+	// circuit a given registry expects proofs to target, the same way
+	// MsgCreateCredential.SchemaId names a schema without the credential module owning
+	// schema validation itself.
+	CircuitId string `json:"circuitId,omitempty"`
+
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+func (m *AccumulatorRegistry) ProtoMessage()  {}
+func (m *AccumulatorRegistry) Reset()         { *m = AccumulatorRegistry{} }
+func (m *AccumulatorRegistry) String() string { return proto.CompactTextString(m) }
+
+// Validate validates an AccumulatorRegistry.
+func (a *AccumulatorRegistry) Validate() error {
+	if a.Id == "" {
+		return ErrInvalidAccumulator.Wrap("accumulator registry ID cannot be empty")
+	}
+	if a.Issuer == "" {
+		return ErrInvalidAccumulator.Wrap("accumulator registry issuer cannot be empty")
+	}
+	n := new(big.Int).SetBytes(a.N)
+	g := new(big.Int).SetBytes(a.G)
+	if n.Cmp(big.NewInt(1)) <= 0 {
+		return ErrInvalidAccumulator.Wrap("N must be greater than 1")
+	}
+	if g.Cmp(big.NewInt(1)) <= 0 || g.Cmp(n) >= 0 {
+		return ErrInvalidAccumulator.Wrap("G must satisfy 1 < G < N")
+	}
+	return nil
+}
+
+// AccumulatorWitness records, for a single credential, the accumulator value
+// immediately before its prime representative was folded in -- i.e. w_c such that
+// w_c^(p_c) = A, the membership witness the request asks QueryWitness to serve and
+// VerifyMembershipWitness to check. It is only ever written for credentials this module has
+// actually revoked via the accumulator method (AccumulateRevocation); a credential that
+// was never revoked has no witness recorded for it, by design -- see QueryWitness's doc
+// comment for why a holder proving their own credential is still good relies on the
+// AccumulatorRevoked event stream rather than an on-chain witness the keeper would
+// otherwise have to maintain for every still-valid credential.
+type AccumulatorWitness struct {
+	RegistryId   string `json:"registryId"`
+	CredentialId string `json:"credentialId"`
+	// Prime is p_c, the credential's hash-to-prime representative, big-endian bytes.
+	Prime []byte `json:"prime"`
+	// Witness is w_c = A_before, the accumulator value prior to this credential's
+	// revocation, big-endian bytes. Satisfies Witness^Prime = A (mod N) against the
+	// registry's ACurrent as of Epoch.
+	Witness []byte    `json:"witness"`
+	Epoch   uint64    `json:"epoch"`
+	Created time.Time `json:"created"`
+}
+
+func (m *AccumulatorWitness) ProtoMessage()  {}
+func (m *AccumulatorWitness) Reset()         { *m = AccumulatorWitness{} }
+func (m *AccumulatorWitness) String() string { return proto.CompactTextString(m) }
+
+// maxHashToPrimeAttempts bounds HashToPrime's search so a pathological credential ID
+// cannot make it loop unboundedly; in practice a prime is found within a handful of
+// increments almost always (primes near a random n-bit number have density ~1/ln(2^n)).
+const maxHashToPrimeAttempts = 10000
+
+// HashToPrime deterministically maps credentialID to a prime representative p_c: it
+// SHA-256 hashes credentialID to seed a big.Int, then probes odd candidates upward
+// until ProbablyPrime reports one, matching (informally) the Bari-Pfitzmann hash-to-prime
+// construction RSA accumulator schemes rely on so that every credential maps to the same
+// prime on every node. Deterministic and collision-resistant in the same sense SHA-256
+// itself is; two different credential IDs mapping to the same prime is no more likely
+// than a SHA-256 collision plus both landing on the same nearby prime.
+func HashToPrime(credentialID string) (*big.Int, error) {
+	h := sha256.Sum256([]byte(credentialID))
+	candidate := new(big.Int).SetBytes(h[:])
+	candidate.SetBit(candidate, 0, 1) // ensure odd
+
+	for i := 0; i < maxHashToPrimeAttempts; i++ {
+		if candidate.ProbablyPrime(20) {
+			return candidate, nil
+		}
+		candidate.Add(candidate, big.NewInt(2))
+	}
+	return nil, ErrInvalidAccumulator.Wrapf("no prime found for credential %q within %d attempts", credentialID, maxHashToPrimeAttempts)
+}
+
+// VerifyMembershipWitness checks the membership-witness relation w^p = A (mod n) that
+// AccumulatorWitness.Witness satisfies for a credential this registry has revoked: a
+// holder uses it to verify a witness QueryWitness served them actually proves their
+// credential WAS revoked. This used to be named VerifyNonRevocation, which claimed the
+// opposite of what it checks; non-membership is now VerifyNonRevocation below, built on
+// ComputeNonMembershipWitness's Bezout coefficients rather than this relation.
+func VerifyMembershipWitness(n, a, w, p []byte) bool {
+	nBig := new(big.Int).SetBytes(n)
+	aBig := new(big.Int).SetBytes(a)
+	wBig := new(big.Int).SetBytes(w)
+	pBig := new(big.Int).SetBytes(p)
+
+	if nBig.Sign() <= 0 {
+		return false
+	}
+	lhs := new(big.Int).Exp(wBig, pBig, nBig)
+	return lhs.Cmp(new(big.Int).Mod(aBig, nBig)) == 0
+}
+
+// NonMembershipWitness is the Bezout-coefficient evidence that p_c (a credential's
+// hash-to-prime representative) was NOT among the primes folded into u
+// (AccumulatorRegistry.RevokedPrimesProduct) when the witness was computed: gcd(p_c, u) =
+// 1 gives integers e, b with e*p_c + b*u = 1, and D = g^e mod n is the witness half that
+// lets a verifier check D^p_c * A^b = G (mod n) without ever learning e or u directly.
+type NonMembershipWitness struct {
+	D []byte
+	B *big.Int
+}
+
+// ComputeNonMembershipWitness builds a NonMembershipWitness proving p_c was not a factor
+// of u at the epoch u and g=G describe, using the extended Euclidean algorithm to find
+// Bezout coefficients e, b with e*p_c + b*u = 1. Returns ErrCredentialIsRevoked if
+// gcd(p_c, u) != 1, i.e. p_c genuinely does divide u.
+func ComputeNonMembershipWitness(n, g, u, p []byte) (*NonMembershipWitness, error) {
+	nBig := new(big.Int).SetBytes(n)
+	gBig := new(big.Int).SetBytes(g)
+	uBig := new(big.Int).SetBytes(u)
+	pBig := new(big.Int).SetBytes(p)
+
+	if nBig.Sign() <= 0 {
+		return nil, ErrInvalidAccumulator.Wrap("modulus must be positive")
+	}
+	if pBig.Sign() <= 0 {
+		return nil, ErrInvalidAccumulator.Wrap("prime representative must be positive")
+	}
+
+	e, b := new(big.Int), new(big.Int)
+	gcd := new(big.Int).GCD(e, b, pBig, uBig)
+	if gcd.Cmp(big.NewInt(1)) != 0 {
+		return nil, ErrCredentialIsRevoked
+	}
+
+	d := new(big.Int).Exp(gBig, e, nBig)
+	return &NonMembershipWitness{D: d.Bytes(), B: b}, nil
+}
+
+// VerifyNonRevocation checks that witness is valid Bezout-coefficient evidence that p (a
+// credential's hash-to-prime representative) was not folded into the accumulator a at the
+// epoch the witness was computed for: D^p * A^b = G (mod n), where D and b come from
+// witness and G, A, N identify the registry. b may be negative, which Exp handles via
+// modular inverse since n is the (implicitly odd, product-of-two-primes) RSA modulus.
+func VerifyNonRevocation(n, g, a []byte, witness *NonMembershipWitness, p []byte) bool {
+	if witness == nil || witness.B == nil {
+		return false
+	}
+	nBig := new(big.Int).SetBytes(n)
+	gBig := new(big.Int).SetBytes(g)
+	aBig := new(big.Int).SetBytes(a)
+	dBig := new(big.Int).SetBytes(witness.D)
+	pBig := new(big.Int).SetBytes(p)
+
+	if nBig.Sign() <= 0 {
+		return false
+	}
+
+	lhs := new(big.Int).Exp(dBig, pBig, nBig)
+	aToB := new(big.Int).Exp(aBig, witness.B, nBig)
+	if aToB == nil {
+		// Exp returns nil if b < 0 and a has no inverse mod n.
+		return false
+	}
+	lhs.Mul(lhs, aToB)
+	lhs.Mod(lhs, nBig)
+
+	return lhs.Cmp(new(big.Int).Mod(gBig, nBig)) == 0
+}
+
+// MsgCreateAccumulatorRegistry defines the message to register a new
+// accumulator-backed revocation list. N and G must be pre-generated from a trusted
+// setup -- see AccumulatorRegistry's doc comment -- this message does not generate them.
+type MsgCreateAccumulatorRegistry struct {
+	Id        string `json:"id"`
+	Issuer    string `json:"issuer"`
+	N         []byte `json:"n"`
+	G         []byte `json:"g"`
+	CircuitId string `json:"circuitId,omitempty"`
+}
+
+var _ sdk.Msg = &MsgCreateAccumulatorRegistry{}
+
+const TypeMsgCreateAccumulatorRegistry = "create_accumulator_registry"
+
+func (msg *MsgCreateAccumulatorRegistry) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgCreateAccumulatorRegistry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrUnauthorized.Wrap("invalid issuer address")
+	}
+	reg := AccumulatorRegistry{Id: msg.Id, Issuer: msg.Issuer, N: msg.N, G: msg.G}
+	return reg.Validate()
+}
+
+func (msg *MsgCreateAccumulatorRegistry) Type() string  { return TypeMsgCreateAccumulatorRegistry }
+func (msg *MsgCreateAccumulatorRegistry) Route() string { return RouterKey }
+func (msg *MsgCreateAccumulatorRegistry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgCreateAccumulatorRegistry) ProtoMessage()  {}
+func (m *MsgCreateAccumulatorRegistry) Reset()         { *m = MsgCreateAccumulatorRegistry{} }
+func (m *MsgCreateAccumulatorRegistry) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateAccumulatorRegistryResponse is the response for MsgCreateAccumulatorRegistry.
+type MsgCreateAccumulatorRegistryResponse struct{}
+
+func (m *MsgCreateAccumulatorRegistryResponse) ProtoMessage() {}
+func (m *MsgCreateAccumulatorRegistryResponse) Reset()        { *m = MsgCreateAccumulatorRegistryResponse{} }
+func (m *MsgCreateAccumulatorRegistryResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRevokeCredentialAccumulator folds credentialId's hash-to-prime representative into
+// RegistryId's accumulator, the accumulator-method counterpart to
+// MsgUpdateStatusListEntry for StatusList2021.
+type MsgRevokeCredentialAccumulator struct {
+	RegistryId   string `json:"registryId"`
+	CredentialId string `json:"credentialId"`
+	Issuer       string `json:"issuer"`
+}
+
+var _ sdk.Msg = &MsgRevokeCredentialAccumulator{}
+
+const TypeMsgRevokeCredentialAccumulator = "revoke_credential_accumulator"
+
+func (msg *MsgRevokeCredentialAccumulator) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgRevokeCredentialAccumulator) ValidateBasic() error {
+	if msg.RegistryId == "" {
+		return ErrInvalidAccumulator.Wrap("accumulator registry ID cannot be empty")
+	}
+	if msg.CredentialId == "" {
+		return ErrInvalidRevocation.Wrap("credential ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrUnauthorized.Wrap("invalid issuer address")
+	}
+	return nil
+}
+
+func (msg *MsgRevokeCredentialAccumulator) Type() string  { return TypeMsgRevokeCredentialAccumulator }
+func (msg *MsgRevokeCredentialAccumulator) Route() string { return RouterKey }
+func (msg *MsgRevokeCredentialAccumulator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRevokeCredentialAccumulator) ProtoMessage()  {}
+func (m *MsgRevokeCredentialAccumulator) Reset()         { *m = MsgRevokeCredentialAccumulator{} }
+func (m *MsgRevokeCredentialAccumulator) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeCredentialAccumulatorResponse is the response for
+// MsgRevokeCredentialAccumulator.
+type MsgRevokeCredentialAccumulatorResponse struct{}
+
+func (m *MsgRevokeCredentialAccumulatorResponse) ProtoMessage() {}
+func (m *MsgRevokeCredentialAccumulatorResponse) Reset() {
+	*m = MsgRevokeCredentialAccumulatorResponse{}
+}
+func (m *MsgRevokeCredentialAccumulatorResponse) String() string {
+	return proto.CompactTextString(m)
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestHashToPrime_DeterministicAndPrime(t *testing.T) {
+	p1, err := HashToPrime("credential-1")
+	if err != nil {
+		t.Fatalf("HashToPrime: %v", err)
+	}
+	p2, err := HashToPrime("credential-1")
+	if err != nil {
+		t.Fatalf("HashToPrime (second call): %v", err)
+	}
+	if p1.Cmp(p2) != 0 {
+		t.Fatal("HashToPrime is not deterministic for the same credential ID")
+	}
+	if !p1.ProbablyPrime(20) {
+		t.Fatalf("HashToPrime returned a non-prime value: %s", p1)
+	}
+	if p1.Bit(0) != 1 {
+		t.Fatal("HashToPrime returned an even candidate")
+	}
+
+	p3, err := HashToPrime("credential-2")
+	if err != nil {
+		t.Fatalf("HashToPrime: %v", err)
+	}
+	if p1.Cmp(p3) == 0 {
+		t.Fatal("two different credential IDs produced the same prime representative")
+	}
+}
+
+func TestVerifyMembershipWitness(t *testing.T) {
+	// A small worked example: n = 77 (= 7*11), g = 2 is a member of Z_n^*.
+	// Pick p = 5, compute A = g^p mod n, and a witness w = g (trivially g^p = A).
+	n := big.NewInt(77)
+	g := big.NewInt(2)
+	p := big.NewInt(5)
+	a := new(big.Int).Exp(g, p, n)
+
+	if !VerifyMembershipWitness(n.Bytes(), a.Bytes(), g.Bytes(), p.Bytes()) {
+		t.Fatal("expected VerifyMembershipWitness to accept a witness satisfying w^p = A (mod n)")
+	}
+
+	// A witness for the wrong exponent must be rejected.
+	wrongP := big.NewInt(7)
+	if VerifyMembershipWitness(n.Bytes(), a.Bytes(), g.Bytes(), wrongP.Bytes()) {
+		t.Fatal("expected VerifyMembershipWitness to reject a witness for the wrong exponent")
+	}
+
+	// A non-positive modulus must be rejected outright rather than panicking or
+	// dividing by zero.
+	if VerifyMembershipWitness(big.NewInt(0).Bytes(), a.Bytes(), g.Bytes(), p.Bytes()) {
+		t.Fatal("expected VerifyMembershipWitness to reject a zero modulus")
+	}
+}
+
+func TestComputeAndVerifyNonMembershipWitness(t *testing.T) {
+	// n = 77 (= 7*11), g = 2. u is the product of two revoked primes (3, 5); p_c = 13 is
+	// coprime to u, i.e. credential "13" was never revoked.
+	n := big.NewInt(77)
+	g := big.NewInt(2)
+	u := big.NewInt(15) // 3 * 5
+	a := new(big.Int).Exp(g, u, n)
+	pc := big.NewInt(13)
+
+	witness, err := ComputeNonMembershipWitness(n.Bytes(), g.Bytes(), u.Bytes(), pc.Bytes())
+	if err != nil {
+		t.Fatalf("ComputeNonMembershipWitness: %v", err)
+	}
+
+	if !VerifyNonRevocation(n.Bytes(), g.Bytes(), a.Bytes(), witness, pc.Bytes()) {
+		t.Fatal("expected VerifyNonRevocation to accept a valid non-membership witness")
+	}
+
+	// A witness computed for the wrong accumulator value must be rejected.
+	wrongA := new(big.Int).Exp(g, big.NewInt(21), n)
+	if VerifyNonRevocation(n.Bytes(), g.Bytes(), wrongA.Bytes(), witness, pc.Bytes()) {
+		t.Fatal("expected VerifyNonRevocation to reject a witness against the wrong accumulator value")
+	}
+
+	// A witness for the wrong credential must be rejected.
+	if VerifyNonRevocation(n.Bytes(), g.Bytes(), a.Bytes(), witness, big.NewInt(17).Bytes()) {
+		t.Fatal("expected VerifyNonRevocation to reject a witness presented for the wrong credential")
+	}
+}
+
+func TestComputeNonMembershipWitness_RejectsActuallyRevokedCredential(t *testing.T) {
+	// p_c = 5 divides u = 15 (= 3 * 5): this credential really was folded into the
+	// revoked set, so no non-membership witness can exist for it.
+	n := big.NewInt(77)
+	g := big.NewInt(2)
+	u := big.NewInt(15)
+	pc := big.NewInt(5)
+
+	if _, err := ComputeNonMembershipWitness(n.Bytes(), g.Bytes(), u.Bytes(), pc.Bytes()); !errors.Is(err, ErrCredentialIsRevoked) {
+		t.Fatalf("expected ErrCredentialIsRevoked, got %v", err)
+	}
+}
+
+func TestAccumulatorRegistry_Validate(t *testing.T) {
+	base := func() *AccumulatorRegistry {
+		return &AccumulatorRegistry{
+			Id:     "reg-1",
+			Issuer: "issuer-1",
+			N:      big.NewInt(77).Bytes(),
+			G:      big.NewInt(2).Bytes(),
+		}
+	}
+
+	if err := base().Validate(); err != nil {
+		t.Fatalf("expected a well-formed registry to validate, got %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		mutate func(*AccumulatorRegistry)
+	}{
+		{"empty id", func(r *AccumulatorRegistry) { r.Id = "" }},
+		{"empty issuer", func(r *AccumulatorRegistry) { r.Issuer = "" }},
+		{"N not greater than 1", func(r *AccumulatorRegistry) { r.N = big.NewInt(1).Bytes() }},
+		{"G equal to 1", func(r *AccumulatorRegistry) { r.G = big.NewInt(1).Bytes() }},
+		{"G greater than or equal to N", func(r *AccumulatorRegistry) { r.G = big.NewInt(77).Bytes() }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := base()
+			tc.mutate(r)
+			if err := r.Validate(); err == nil {
+				t.Fatalf("expected Validate to reject: %s", tc.name)
+			}
+		})
+	}
+}
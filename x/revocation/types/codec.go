@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the revocation module's types on the given LegacyAmino codec.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateStatusList{}, "revocation/CreateStatusList", nil)
+	cdc.RegisterConcrete(&MsgUpdateStatusListEntry{}, "revocation/UpdateStatusListEntry", nil)
+	cdc.RegisterConcrete(&MsgSubmitAppeal{}, "revocation/SubmitAppeal", nil)
+	cdc.RegisterConcrete(&MsgDecideAppeal{}, "revocation/DecideAppeal", nil)
+	cdc.RegisterConcrete(&MsgCreateAccumulatorRegistry{}, "revocation/CreateAccumulatorRegistry", nil)
+	cdc.RegisterConcrete(&MsgRevokeCredentialAccumulator{}, "revocation/RevokeCredentialAccumulator", nil)
+}
+
+// RegisterInterfaces registers the revocation module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateStatusList{},
+		&MsgUpdateStatusListEntry{},
+		&MsgSubmitAppeal{},
+		&MsgDecideAppeal{},
+		&MsgCreateAccumulatorRegistry{},
+		&MsgRevokeCredentialAccumulator{},
+	)
+
+	// Message service registration handled by generated proto code
+}
+
+// ModuleCdc references the global revocation module codec. Note, the codec should
+// ONLY be used in certain instances of tests and for JSON encoding as Amino is still
+// used for that purpose.
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterCodec(legacy.Cdc)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Revocation module error codes
+var (
+	ErrRevocationNotFound         = errorsmod.Register(ModuleName, 2, "revocation not found")
+	ErrInvalidRevocation          = errorsmod.Register(ModuleName, 3, "invalid revocation entry")
+	ErrAlreadyRevoked             = errorsmod.Register(ModuleName, 4, "credential already revoked")
+	ErrUnauthorized               = errorsmod.Register(ModuleName, 5, "unauthorized")
+	ErrInvalidAppeal              = errorsmod.Register(ModuleName, 6, "invalid appeal")
+	ErrInvalidStatusList          = errorsmod.Register(ModuleName, 7, "invalid status list")
+	ErrStatusListNotFound         = errorsmod.Register(ModuleName, 8, "status list not found")
+	ErrStatusIndexOutOfRange      = errorsmod.Register(ModuleName, 9, "status list index out of range")
+	ErrStatusListExists           = errorsmod.Register(ModuleName, 10, "status list already exists")
+	ErrStatusListFull             = errorsmod.Register(ModuleName, 11, "status list has no free index remaining")
+	ErrInvalidIBCVersion          = errorsmod.Register(ModuleName, 12, "invalid revocation-query IBC version")
+	ErrReviewerKeyNotFound        = errorsmod.Register(ModuleName, 13, "reviewer has no registered encryption key")
+	ErrReviewerDIDMismatch        = errorsmod.Register(ModuleName, 14, "reviewer DID does not match the assigned appeal reviewer")
+	ErrCiphertextMismatch         = errorsmod.Register(ModuleName, 15, "ciphertext hash does not match the appeal's encrypted payload")
+	ErrInvalidParams              = errorsmod.Register(ModuleName, 16, "invalid params")
+	ErrInvalidAccumulator         = errorsmod.Register(ModuleName, 17, "invalid accumulator registry")
+	ErrAccumulatorNotFound        = errorsmod.Register(ModuleName, 18, "accumulator registry not found")
+	ErrAccumulatorWitnessNotFound = errorsmod.Register(ModuleName, 19, "accumulator witness not found")
+	ErrCredentialIsRevoked        = errorsmod.Register(ModuleName, 20, "credential is a factor of the revoked-primes product")
+)
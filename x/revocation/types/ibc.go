@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/json"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// PortID is the default port id the revocation-query IBC application binds to.
+	PortID = "personarev-1"
+
+	// Version is the version string negotiated during the revocation-query channel
+	// handshake. OnChanOpenInit/Try reject any counterparty proposing a different one.
+	Version = "revocation-query-1"
+)
+
+// RevocationQueryPacketData is the packet payload a counterparty chain sends asking
+// this chain whether CredentialID is currently revoked, the same IsRevoked question
+// Keeper.IsRevoked answers for in-chain callers.
+type RevocationQueryPacketData struct {
+	CredentialID string `json:"credential_id"`
+}
+
+func (p *RevocationQueryPacketData) ProtoMessage()  {}
+func (p *RevocationQueryPacketData) Reset()         { *p = RevocationQueryPacketData{} }
+func (p *RevocationQueryPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p, the bytes a sender places in
+// channeltypes.Packet.Data.
+func (p RevocationQueryPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p RevocationQueryPacketData) ValidateBasic() error {
+	if p.CredentialID == "" {
+		return ErrInvalidRevocation.Wrap("credential_id must be set")
+	}
+	return nil
+}
+
+// RevocationQueryAcknowledgement is the successful acknowledgement this chain returns:
+// whether CredentialID was revoked as of Height. A query for a credential with no
+// revocation entry at all also acknowledges Revoked=false, the same not-found-means-
+// not-revoked answer Keeper.IsRevoked gives in-chain callers, rather than an error
+// acknowledgement -- only a malformed packet earns channeltypes.NewErrorAcknowledgement.
+type RevocationQueryAcknowledgement struct {
+	Revoked bool  `json:"revoked"`
+	Height  int64 `json:"height"`
+}
+
+func (a *RevocationQueryAcknowledgement) ProtoMessage()  {}
+func (a *RevocationQueryAcknowledgement) Reset()         { *a = RevocationQueryAcknowledgement{} }
+func (a *RevocationQueryAcknowledgement) String() string { return proto.CompactTextString(a) }
+
+// GetBytes returns the canonical JSON encoding of a, the payload wrapped in a
+// channeltypes.Acknowledgement's Result field.
+func (a RevocationQueryAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the revocation module name
+	ModuleName = "revocation"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_revocation"
+
+	// RouterKey is the message route for the revocation module
+	RouterKey = ModuleName
+)
+
+// Collections prefixes for the KVStoreService-backed schema keeper.go builds --
+// the replacement for the Legacy*Prefix raw-KVStore layout below, which MigrateStore
+// reads from to carry forward state written before this module adopted collections.
+var (
+	RevocationPrefix             = collections.NewPrefix(1)
+	RevocationByStatusPrefix     = collections.NewPrefix(2)
+	RevocationByCredentialPrefix = collections.NewPrefix(3)
+
+	StatusListPrefix                = collections.NewPrefix(10)
+	StatusListByIssuerPurposePrefix = collections.NewPrefix(11)
+	StatusListCounterPrefix         = collections.NewPrefix(12)
+	StatusListGenerationPrefix      = collections.NewPrefix(13)
+	CredentialStatusIndexPrefix     = collections.NewPrefix(14)
+	ParamsPrefix                    = collections.NewPrefix(15)
+
+	AccumulatorRegistryPrefix = collections.NewPrefix(20)
+	AccumulatorWitnessPrefix  = collections.NewPrefix(21)
+)
+
+// LegacyRevocationPrefix is the legacy string prefix for revocation entries.
+const LegacyRevocationPrefix = "revocation/"
+
+// RevocationKey creates a store key for a revocation entry by ID.
+func RevocationKey(id string) []byte {
+	return []byte(LegacyRevocationPrefix + id)
+}
+
+// LegacyStatusListPrefix is the legacy string prefix for StatusList2021 credentials.
+const LegacyStatusListPrefix = "revocation_status_list/"
+
+// StatusListKey creates a store key for a StatusList2021 credential by ID.
+func StatusListKey(id string) []byte {
+	return []byte(LegacyStatusListPrefix + id)
+}
+
+// LegacyStatusListIndexPrefix is the legacy string prefix indexing a status list ID by
+// the (issuer, purpose) pair that maintains it.
+const LegacyStatusListIndexPrefix = "revocation_status_list_by_issuer/"
+
+// StatusListByIssuerPurposeKey creates a store key mapping an issuer's status list for
+// a given purpose to its status list ID.
+func StatusListByIssuerPurposeKey(issuer string, purpose StatusPurpose) []byte {
+	return []byte(LegacyStatusListIndexPrefix + issuer + "/" + string(purpose))
+}
+
+// LegacyStatusListCounterPrefix is the legacy string prefix for a status list's
+// next-free-index counter.
+const LegacyStatusListCounterPrefix = "revocation_status_list_counter/"
+
+// StatusListCounterKey creates a store key for the next-free-index counter of a
+// status list by ID.
+func StatusListCounterKey(id string) []byte {
+	return []byte(LegacyStatusListCounterPrefix + id)
+}
+
+// LegacyStatusListGenerationPrefix tracks the current generation number for an
+// issuer/purpose's rolling sequence of status lists, so AllocateStatusListIndex knows
+// which list ID is current and which to roll to once it fills up.
+const LegacyStatusListGenerationPrefix = "revocation_status_list_generation/"
+
+// StatusListGenerationKey creates a store key for an issuer/purpose's current status
+// list generation number.
+func StatusListGenerationKey(issuer string, purpose StatusPurpose) []byte {
+	return []byte(LegacyStatusListGenerationPrefix + issuer + "/" + string(purpose))
+}
+
+// LegacyCredentialStatusIndexPrefix maps a credential ID to the (status list ID, bit
+// index) it was stamped with on issuance, so a credential's revocation bit can be
+// resolved in one lookup without the caller tracking which list it belongs to.
+const LegacyCredentialStatusIndexPrefix = "revocation_status_list_credential/"
+
+// CredentialStatusIndexKey creates a store key for a credential's CredentialStatusIndex.
+func CredentialStatusIndexKey(credentialID string) []byte {
+	return []byte(LegacyCredentialStatusIndexPrefix + credentialID)
+}
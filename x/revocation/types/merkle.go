@@ -0,0 +1,118 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// StatusListChunkSize is the chunk size, in bytes of the decompressed bitstring, that
+// StatusListMerkleRoot hashes leaves over. A light client can fetch a single chunk plus
+// a StatusListChunkProof instead of the full (potentially 16KB+) bitstring.
+const StatusListChunkSize = 4096
+
+// StatusListChunkProof is the Merkle inclusion proof for a single chunk of a status
+// list's decompressed bitstring, letting a light client verify a StatusListChunk
+// against StatusList's Merkle root without downloading the whole bitstring.
+type StatusListChunkProof struct {
+	ChunkIndex uint64     `json:"chunkIndex"`
+	Chunk      []byte     `json:"chunk"`
+	Siblings   [][32]byte `json:"siblings"`
+}
+
+// StatusListMerkleRoot computes a binary Merkle root over bits split into
+// StatusListChunkSize-byte chunks (the last chunk zero-padded, and the chunk count
+// padded up to a power of two by duplicating the last chunk) using SHA-256 leaf and
+// node hashes.
+func StatusListMerkleRoot(bits []byte) [32]byte {
+	levels := merkleLevels(chunkBitstring(bits))
+	return levels[len(levels)-1][0]
+}
+
+// ProveStatusListChunk returns chunkIndex's bytes and Merkle proof against bits's root.
+func ProveStatusListChunk(bits []byte, chunkIndex uint64) (*StatusListChunkProof, error) {
+	chunks := chunkBitstring(bits)
+	if chunkIndex >= uint64(len(chunks)) {
+		return nil, ErrStatusIndexOutOfRange.Wrapf("chunk %d out of range for %d chunks", chunkIndex, len(chunks))
+	}
+
+	levels := merkleLevels(chunks)
+	index := int(chunkIndex)
+	siblings := make([][32]byte, 0, len(levels)-1)
+	for _, level := range levels[:len(levels)-1] {
+		siblingIdx := index ^ 1
+		siblings = append(siblings, level[siblingIdx])
+		index /= 2
+	}
+
+	return &StatusListChunkProof{
+		ChunkIndex: chunkIndex,
+		Chunk:      chunks[chunkIndex],
+		Siblings:   siblings,
+	}, nil
+}
+
+// VerifyStatusListChunkProof reports whether proof's chunk is included under root.
+func VerifyStatusListChunkProof(root [32]byte, proof *StatusListChunkProof) bool {
+	hash := sha256.Sum256(proof.Chunk)
+	index := proof.ChunkIndex
+	for _, sibling := range proof.Siblings {
+		if index%2 == 0 {
+			hash = sha256.Sum256(append(append([]byte{}, hash[:]...), sibling[:]...))
+		} else {
+			hash = sha256.Sum256(append(append([]byte{}, sibling[:]...), hash[:]...))
+		}
+		index /= 2
+	}
+	return hash == root
+}
+
+// chunkBitstring splits bits into StatusListChunkSize-byte chunks (zero-padding the
+// last one), then pads the chunk count up to a power of two by duplicating the last
+// chunk, so every level of the Merkle tree built over it has an even width.
+func chunkBitstring(bits []byte) [][]byte {
+	var chunks [][]byte
+	if len(bits) == 0 {
+		chunks = [][]byte{make([]byte, StatusListChunkSize)}
+	} else {
+		for i := 0; i < len(bits); i += StatusListChunkSize {
+			end := i + StatusListChunkSize
+			if end > len(bits) {
+				end = len(bits)
+			}
+			chunk := make([]byte, StatusListChunkSize)
+			copy(chunk, bits[i:end])
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	for !isPowerOfTwo(len(chunks)) {
+		chunks = append(chunks, chunks[len(chunks)-1])
+	}
+	return chunks
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// merkleLevels returns every level of the binary Merkle tree built over chunks'
+// SHA-256 hashes, from leaves (levels[0]) up to the single-element root
+// (levels[len(levels)-1]).
+func merkleLevels(chunks [][]byte) [][][32]byte {
+	leaves := make([][32]byte, len(chunks))
+	for i, c := range chunks {
+		leaves[i] = sha256.Sum256(c)
+	}
+
+	levels := [][][32]byte{leaves}
+	level := leaves
+	for len(level) > 1 {
+		next := make([][32]byte, len(level)/2)
+		for i := range next {
+			combined := append(append([]byte{}, level[2*i][:]...), level[2*i+1][:]...)
+			next[i] = sha256.Sum256(combined)
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	TypeMsgSubmitAppeal = "submit_appeal"
+	TypeMsgDecideAppeal = "decide_appeal"
+)
+
+// MsgSubmitAppeal files an appeal against a revocation entry. Evidence carries
+// plaintext evidence; EncryptedPayload, if set, carries evidence sealed against the
+// assigned reviewer's x/e2ee registered key instead, so SubmitAppeal never has to
+// write sensitive evidence to state in plaintext.
+type MsgSubmitAppeal struct {
+	Appellant        string                  `json:"appellant"`
+	RevocationID     string                  `json:"revocationId"`
+	Reason           string                  `json:"reason"`
+	Evidence         string                  `json:"evidence,omitempty"`
+	ReviewerDID      string                  `json:"reviewerDid,omitempty"`
+	EncryptedPayload *EncryptedAppealPayload `json:"encryptedPayload,omitempty"`
+}
+
+var _ sdk.Msg = &MsgSubmitAppeal{}
+
+func (msg *MsgSubmitAppeal) GetSigners() []sdk.AccAddress {
+	appellant, err := sdk.AccAddressFromBech32(msg.Appellant)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{appellant}
+}
+
+func (msg *MsgSubmitAppeal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Appellant); err != nil {
+		return ErrUnauthorized.Wrap("invalid appellant address")
+	}
+	if msg.RevocationID == "" {
+		return ErrInvalidRevocation.Wrap("revocationId cannot be empty")
+	}
+	appeal := Appeal{
+		Appellant:        msg.Appellant,
+		Reason:           msg.Reason,
+		Evidence:         msg.Evidence,
+		ReviewerDID:      msg.ReviewerDID,
+		EncryptedPayload: msg.EncryptedPayload,
+	}
+	return appeal.Validate()
+}
+
+func (msg *MsgSubmitAppeal) Type() string  { return TypeMsgSubmitAppeal }
+func (msg *MsgSubmitAppeal) Route() string { return RouterKey }
+func (msg *MsgSubmitAppeal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgSubmitAppeal) ProtoMessage()  {}
+func (m *MsgSubmitAppeal) Reset()         { *m = MsgSubmitAppeal{} }
+func (m *MsgSubmitAppeal) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitAppealResponse is the response for MsgSubmitAppeal.
+type MsgSubmitAppealResponse struct{}
+
+func (m *MsgSubmitAppealResponse) ProtoMessage()  {}
+func (m *MsgSubmitAppealResponse) Reset()         { *m = MsgSubmitAppealResponse{} }
+func (m *MsgSubmitAppealResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgDecideAppeal records a reviewer's decision on an appeal. CiphertextHash must
+// match EncryptedAppealPayload.CiphertextHash() for the appeal being decided, binding
+// the decision to the specific envelope the reviewer actually reviewed without the
+// plaintext ever touching the chain; appeals filed with only plaintext Evidence leave
+// CiphertextHash empty.
+type MsgDecideAppeal struct {
+	Reviewer       string `json:"reviewer"`
+	RevocationID   string `json:"revocationId"`
+	AppealIndex    uint64 `json:"appealIndex"`
+	Upheld         bool   `json:"upheld"`
+	CiphertextHash []byte `json:"ciphertextHash,omitempty"`
+}
+
+var _ sdk.Msg = &MsgDecideAppeal{}
+
+func (msg *MsgDecideAppeal) GetSigners() []sdk.AccAddress {
+	reviewer, err := sdk.AccAddressFromBech32(msg.Reviewer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reviewer}
+}
+
+func (msg *MsgDecideAppeal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Reviewer); err != nil {
+		return ErrUnauthorized.Wrap("invalid reviewer address")
+	}
+	if msg.RevocationID == "" {
+		return ErrInvalidRevocation.Wrap("revocationId cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgDecideAppeal) Type() string  { return TypeMsgDecideAppeal }
+func (msg *MsgDecideAppeal) Route() string { return RouterKey }
+func (msg *MsgDecideAppeal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgDecideAppeal) ProtoMessage()  {}
+func (m *MsgDecideAppeal) Reset()         { *m = MsgDecideAppeal{} }
+func (m *MsgDecideAppeal) String() string { return proto.CompactTextString(m) }
+
+// MatchesCiphertext reports whether msg.CiphertextHash matches payload's own hash, or
+// trivially succeeds if the appeal being decided carries no EncryptedPayload.
+func (msg *MsgDecideAppeal) MatchesCiphertext(payload *EncryptedAppealPayload) bool {
+	if payload == nil {
+		return len(msg.CiphertextHash) == 0
+	}
+	return bytes.Equal(msg.CiphertextHash, payload.CiphertextHash())
+}
+
+// MsgDecideAppealResponse is the response for MsgDecideAppeal.
+type MsgDecideAppealResponse struct{}
+
+func (m *MsgDecideAppealResponse) ProtoMessage()  {}
+func (m *MsgDecideAppealResponse) Reset()         { *m = MsgDecideAppealResponse{} }
+func (m *MsgDecideAppealResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Params defines the revocation module's tunable parameters.
+type Params struct {
+	// StatusListSize is the number of bits (credential slots) AllocateStatusListIndex
+	// gives each newly created generation of a status list before rolling over to the
+	// next one (see statuslist.go's createGenerationStatusList). Defaults to
+	// DefaultStatusListSize; operators can raise or lower it via a param-change
+	// proposal to trade off per-list bitstring size against how often a high-volume
+	// issuer rolls to a new generation.
+	StatusListSize uint64 `json:"statusListSize"`
+}
+
+// DefaultParams returns the revocation module's default parameters.
+func DefaultParams() Params {
+	return Params{
+		StatusListSize: DefaultStatusListSize,
+	}
+}
+
+// Validate validates the revocation module's parameters.
+func (p Params) Validate() error {
+	if p.StatusListSize == 0 {
+		return ErrInvalidParams.Wrap("status list size cannot be zero")
+	}
+	return nil
+}
+
+func (m *Params) ProtoMessage()  {}
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
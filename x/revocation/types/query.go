@@ -0,0 +1,39 @@
+package types
+
+// QueryStatusListRequest asks for a chunk of an issuer's status list bitstring, plus
+// the Merkle proof needed to verify it against the list's root -- so a light client can
+// fetch a single 4KB chunk instead of the whole (potentially much larger) bitstring.
+type QueryStatusListRequest struct {
+	Issuer     string `json:"issuer"`
+	ListId     string `json:"listId"`
+	ChunkIndex uint64 `json:"chunkIndex"`
+}
+
+// QueryStatusListResponse returns one chunk of a status list's bitstring, its Merkle
+// inclusion proof, and the list's root so the proof can be checked without trusting the
+// node that served it.
+type QueryStatusListResponse struct {
+	MerkleRoot [32]byte              `json:"merkleRoot"`
+	Proof      *StatusListChunkProof `json:"proof"`
+}
+
+// QueryCredentialStatusRequest asks for a single credential's revocation/suspension
+// bit within a status list, by (issuer, listId, index) rather than by credential ID --
+// the shape a verifier who already has the credential's credentialStatus claim (which
+// carries exactly these three fields) can call directly, without a
+// CredentialStatusIndexes lookup first.
+type QueryCredentialStatusRequest struct {
+	Issuer string `json:"issuer"`
+	ListId string `json:"listId"`
+	Index  uint64 `json:"index"`
+}
+
+// QueryCredentialStatusResponse answers QueryCredentialStatusRequest with just the bit
+// value and a Merkle inclusion proof for the chunk containing it -- an O(1)-state-read
+// verification compared to QueryStatusListCredential's whole-bitstring response, per
+// w3c.github.io/vc-status-list-2021's "trusted status list providers ... O(1)" goal.
+type QueryCredentialStatusResponse struct {
+	Revoked    bool                  `json:"revoked"`
+	MerkleRoot [32]byte              `json:"merkleRoot"`
+	Proof      *StatusListChunkProof `json:"proof"`
+}
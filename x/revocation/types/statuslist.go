@@ -0,0 +1,339 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// StatusListEntryType is the credential status entry Type value used to reference a
+// StatusList2021 bit, per https://w3c.github.io/vc-status-list-2021/.
+const StatusListEntryType = "StatusList2021Entry"
+
+// StatusListCredentialType is the StatusListCredential.Type entry identifying the
+// credential as a StatusList2021 list.
+const StatusListCredentialType = "StatusList2021Credential"
+
+// DefaultStatusListSize is the default number of bits (credential slots) a newly
+// allocated status list holds: 131,072 bits, a 16KB gzip-compressed bitstring.
+const DefaultStatusListSize uint64 = 131072
+
+// StatusPurpose distinguishes what it means for a bit in a status list to be set.
+type StatusPurpose string
+
+const (
+	StatusPurposeRevocation StatusPurpose = "revocation"
+	StatusPurposeSuspension StatusPurpose = "suspension"
+)
+
+// StatusListID derives the rolling list ID for an issuer/purpose/generation, e.g.
+// "did:persona:abc#revocation#0". AllocateStatusListIndex rolls to generation+1 once
+// generation's list fills up, so a single issuer/purpose pair can outgrow one list's
+// DefaultStatusListSize without ever mutating an existing list's Size.
+func StatusListID(issuer string, purpose StatusPurpose, generation uint64) string {
+	return fmt.Sprintf("%s#%s#%d", issuer, purpose, generation)
+}
+
+// CredentialStatusIndex is the (status list ID, bit index) MsgIssueCredential
+// allocated for a credential, stored so a credential's revocation bit can be resolved
+// by ID alone via Keeper.IsRevokedByBit.
+type CredentialStatusIndex struct {
+	StatusListID string `json:"statusListId"`
+	Index        uint64 `json:"index"`
+}
+
+func (m *CredentialStatusIndex) ProtoMessage()  {}
+func (m *CredentialStatusIndex) Reset()         { *m = CredentialStatusIndex{} }
+func (m *CredentialStatusIndex) String() string { return proto.CompactTextString(m) }
+
+// StatusList is an on-chain StatusList2021 credential: a gzip-compressed, base64url
+// encoded bitstring in which bit i records the revocation/suspension state of the
+// credential that was issued with statusListIndex i against this list.
+type StatusList struct {
+	Id      string        `json:"id"`
+	Issuer  string        `json:"issuer"`
+	Purpose StatusPurpose `json:"purpose"`
+
+	// EncodedList is the gzip+base64url encoded bitstring, per the StatusList2021 spec.
+	EncodedList string `json:"encodedList"`
+
+	// Size is the number of bits (credential slots) the list holds.
+	Size uint64 `json:"size"`
+
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+func (m *StatusList) ProtoMessage()  {}
+func (m *StatusList) Reset()         { *m = StatusList{} }
+func (m *StatusList) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a StatusList.
+func (s *StatusList) Validate() error {
+	if s.Id == "" {
+		return ErrInvalidStatusList.Wrap("status list ID cannot be empty")
+	}
+	if s.Issuer == "" {
+		return ErrInvalidStatusList.Wrap("status list issuer cannot be empty")
+	}
+	if s.Purpose != StatusPurposeRevocation && s.Purpose != StatusPurposeSuspension {
+		return ErrInvalidStatusList.Wrapf("unsupported status purpose %q", s.Purpose)
+	}
+	if s.Size == 0 {
+		return ErrInvalidStatusList.Wrap("status list size cannot be zero")
+	}
+	return nil
+}
+
+// NewEmptyBitstring returns a gzip+base64url encoded bitstring of the given size with
+// every bit cleared, suitable as the EncodedList of a freshly created StatusList.
+func NewEmptyBitstring(size uint64) (string, error) {
+	return encodeBitstring(make([]byte, (size+7)/8))
+}
+
+// GetStatusBit reports whether bit index is set in a StatusList's EncodedList.
+func (s *StatusList) GetStatusBit(index uint64) (bool, error) {
+	if index >= s.Size {
+		return false, ErrStatusIndexOutOfRange.Wrapf("index %d out of range for size %d", index, s.Size)
+	}
+	bits, err := decodeBitstring(s.EncodedList)
+	if err != nil {
+		return false, err
+	}
+	byteIdx, bitOff := index/8, index%8
+	if byteIdx >= uint64(len(bits)) {
+		return false, nil
+	}
+	return bits[byteIdx]&(1<<bitOff) != 0, nil
+}
+
+// SetStatusBit returns a copy of the bitstring with bit index flipped to the given
+// value, re-encoded. It does not mutate the receiver's EncodedList.
+func (s *StatusList) SetStatusBit(index uint64, value bool) (string, error) {
+	if index >= s.Size {
+		return "", ErrStatusIndexOutOfRange.Wrapf("index %d out of range for size %d", index, s.Size)
+	}
+	bits, err := decodeBitstring(s.EncodedList)
+	if err != nil {
+		return "", err
+	}
+	byteIdx, bitOff := index/8, index%8
+	if byteIdx >= uint64(len(bits)) {
+		return "", ErrInvalidStatusList.Wrap("bitstring shorter than declared size")
+	}
+	if value {
+		bits[byteIdx] |= 1 << bitOff
+	} else {
+		bits[byteIdx] &^= 1 << bitOff
+	}
+	return encodeBitstring(bits)
+}
+
+// DecodedBits returns the decompressed bitstring underlying EncodedList, for callers
+// (e.g. the Merkle chunk/proof helpers in merkle.go) that need the raw bytes rather
+// than bit-at-a-time access.
+func (s *StatusList) DecodedBits() ([]byte, error) {
+	return decodeBitstring(s.EncodedList)
+}
+
+func encodeBitstring(bits []byte) (string, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(bits); err != nil {
+		return "", fmt.Errorf("compress status bitstring: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("compress status bitstring: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeBitstring(encoded string) ([]byte, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode status bitstring: %w", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompress status bitstring: %w", err)
+	}
+	defer gz.Close()
+	bits, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, fmt.Errorf("decompress status bitstring: %w", err)
+	}
+	return bits, nil
+}
+
+// StatusListCredential is the W3C JSON-LD verifiable credential encoding of a
+// StatusList, as returned by the revocation module's status list query endpoint so
+// verifiers can fetch and decode it over gRPC or REST.
+//
+// Proof is left unset (nil) by ToVerifiableCredential below: producing a real signature
+// over this credential would require a module-held signing key, and unlike
+// x/did/keeper/clientspec.go's VerifyClientSpecSignature (which verifies a signature
+// supplied by an externally-held key) or x/credential/keeper/verify.go's VerifyCredential
+// (same), there is no KMS or module-account-signing infrastructure anywhere in this tree
+// for a keeper to hold a private key and sign with it. The field is added now so the
+// JSON shape matches what a verifier polling GET /revocation/status-list/{id} expects
+// once that infrastructure exists, rather than requiring a breaking field addition later.
+type StatusListCredential struct {
+	Context           []string                    `json:"@context"`
+	ID                string                      `json:"id"`
+	Type              []string                    `json:"type"`
+	Issuer            string                      `json:"issuer"`
+	IssuanceDate      time.Time                   `json:"issuanceDate"`
+	CredentialSubject StatusListCredentialSubject `json:"credentialSubject"`
+	Proof             *Proof                      `json:"proof,omitempty"`
+}
+
+// StatusListCredentialSubject carries the encoded bitstring inside a StatusListCredential.
+type StatusListCredentialSubject struct {
+	ID            string        `json:"id"`
+	Type          string        `json:"type"`
+	StatusPurpose StatusPurpose `json:"statusPurpose"`
+	EncodedList   string        `json:"encodedList"`
+}
+
+func (m *StatusListCredential) ProtoMessage()  {}
+func (m *StatusListCredential) Reset()         { *m = StatusListCredential{} }
+func (m *StatusListCredential) String() string { return proto.CompactTextString(m) }
+
+// Proof is a W3C linked-data proof, mirroring x/credential/types.Proof's shape by
+// convention rather than importing that package directly -- x/revocation/types has no
+// other dependency on x/credential/types, and a StatusListCredential is its own
+// standalone VC, not a CredentialStatus reference into one.
+type Proof struct {
+	Type               string    `json:"type"`
+	Created            time.Time `json:"created"`
+	VerificationMethod string    `json:"verificationMethod"`
+	ProofPurpose       string    `json:"proofPurpose"`
+	ProofValue         string    `json:"proofValue"`
+}
+
+// ToVerifiableCredential encodes the StatusList as a W3C StatusList2021Credential. Proof
+// is left nil -- see StatusListCredential's doc comment for why no signature is produced.
+func (s *StatusList) ToVerifiableCredential() *StatusListCredential {
+	return &StatusListCredential{
+		Context: []string{
+			"https://www.w3.org/2018/credentials/v1",
+			"https://w3id.org/vc/status-list/2021/v1",
+		},
+		ID:           s.Id,
+		Type:         []string{"VerifiableCredential", StatusListCredentialType},
+		Issuer:       s.Issuer,
+		IssuanceDate: s.Created,
+		CredentialSubject: StatusListCredentialSubject{
+			ID:            s.Id + "#list",
+			Type:          StatusListCredentialType,
+			StatusPurpose: s.Purpose,
+			EncodedList:   s.EncodedList,
+		},
+	}
+}
+
+// MsgCreateStatusList defines the message to create a new StatusList2021 credential.
+type MsgCreateStatusList struct {
+	Id      string        `json:"id"`
+	Issuer  string        `json:"issuer"`
+	Purpose StatusPurpose `json:"purpose"`
+	Size    uint64        `json:"size"`
+}
+
+var _ sdk.Msg = &MsgCreateStatusList{}
+
+const TypeMsgCreateStatusList = "create_status_list"
+
+func (msg *MsgCreateStatusList) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgCreateStatusList) ValidateBasic() error {
+	if msg.Id == "" {
+		return ErrInvalidStatusList.Wrap("status list ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrUnauthorized.Wrap("invalid issuer address")
+	}
+	if msg.Purpose != StatusPurposeRevocation && msg.Purpose != StatusPurposeSuspension {
+		return ErrInvalidStatusList.Wrapf("unsupported status purpose %q", msg.Purpose)
+	}
+	if msg.Size == 0 {
+		return ErrInvalidStatusList.Wrap("status list size cannot be zero")
+	}
+	return nil
+}
+
+func (msg *MsgCreateStatusList) Type() string  { return TypeMsgCreateStatusList }
+func (msg *MsgCreateStatusList) Route() string { return RouterKey }
+func (msg *MsgCreateStatusList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgCreateStatusList) ProtoMessage()  {}
+func (m *MsgCreateStatusList) Reset()         { *m = MsgCreateStatusList{} }
+func (m *MsgCreateStatusList) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateStatusListResponse is the response for MsgCreateStatusList.
+type MsgCreateStatusListResponse struct{}
+
+func (m *MsgCreateStatusListResponse) ProtoMessage()  {}
+func (m *MsgCreateStatusListResponse) Reset()         { *m = MsgCreateStatusListResponse{} }
+func (m *MsgCreateStatusListResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateStatusListEntry flips the status bit for a credential within a status list.
+type MsgUpdateStatusListEntry struct {
+	StatusListId string `json:"statusListId"`
+	Index        uint64 `json:"index"`
+	Revoked      bool   `json:"revoked"`
+	Issuer       string `json:"issuer"`
+}
+
+var _ sdk.Msg = &MsgUpdateStatusListEntry{}
+
+const TypeMsgUpdateStatusListEntry = "update_status_list_entry"
+
+func (msg *MsgUpdateStatusListEntry) GetSigners() []sdk.AccAddress {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{issuer}
+}
+
+func (msg *MsgUpdateStatusListEntry) ValidateBasic() error {
+	if msg.StatusListId == "" {
+		return ErrInvalidStatusList.Wrap("status list ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return ErrUnauthorized.Wrap("invalid issuer address")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateStatusListEntry) Type() string  { return TypeMsgUpdateStatusListEntry }
+func (msg *MsgUpdateStatusListEntry) Route() string { return RouterKey }
+func (msg *MsgUpdateStatusListEntry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgUpdateStatusListEntry) ProtoMessage()  {}
+func (m *MsgUpdateStatusListEntry) Reset()         { *m = MsgUpdateStatusListEntry{} }
+func (m *MsgUpdateStatusListEntry) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateStatusListEntryResponse is the response for MsgUpdateStatusListEntry.
+type MsgUpdateStatusListEntryResponse struct{}
+
+func (m *MsgUpdateStatusListEntryResponse) ProtoMessage()  {}
+func (m *MsgUpdateStatusListEntryResponse) Reset()         { *m = MsgUpdateStatusListEntryResponse{} }
+func (m *MsgUpdateStatusListEntryResponse) String() string {
+	return proto.CompactTextString(m)
+}
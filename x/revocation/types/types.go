@@ -0,0 +1,172 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/crypto/chacha20poly1305"
+
+	e2eetypes "github.com/PersonaPass-ID/personachain/x/e2ee/types"
+)
+
+// RevocationStatus enumerates the lifecycle states of a RevocationEntry.
+type RevocationStatus string
+
+const (
+	StatusActive   RevocationStatus = "active"
+	StatusRevoked  RevocationStatus = "revoked"
+	StatusAppealed RevocationStatus = "appealed"
+	StatusReversed RevocationStatus = "reversed"
+)
+
+// RevocationEntry records a single credential revocation decision, including any
+// appeals filed against it.
+type RevocationEntry struct {
+	ID           string           `json:"id"`
+	CredentialID string           `json:"credentialId"`
+	Issuer       string           `json:"issuer"`
+	Reason       string           `json:"reason"`
+	Status       RevocationStatus `json:"status"`
+	Appeals      []Appeal         `json:"appeals,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+}
+
+func (m *RevocationEntry) ProtoMessage()  {}
+func (m *RevocationEntry) Reset()         { *m = RevocationEntry{} }
+func (m *RevocationEntry) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a RevocationEntry.
+func (e *RevocationEntry) Validate() error {
+	if e.ID == "" {
+		return ErrInvalidRevocation.Wrap("id cannot be empty")
+	}
+	if e.CredentialID == "" {
+		return ErrInvalidRevocation.Wrap("credentialId cannot be empty")
+	}
+	if e.Issuer == "" {
+		return ErrInvalidRevocation.Wrap("issuer cannot be empty")
+	}
+	switch e.Status {
+	case StatusActive, StatusRevoked, StatusAppealed, StatusReversed:
+	default:
+		return ErrInvalidRevocation.Wrapf("unknown status %q", e.Status)
+	}
+	return nil
+}
+
+// Appeal represents a holder-filed appeal against a revocation decision.
+type Appeal struct {
+	Appellant string    `json:"appellant"`
+	Reason    string    `json:"reason"`
+	Evidence  string    `json:"evidence,omitempty"`
+	FiledAt   time.Time `json:"filedAt"`
+	Resolved  bool      `json:"resolved"`
+	Upheld    bool      `json:"upheld"`
+
+	// ReviewerDID is the DID of the reviewer this appeal was assigned to, checked
+	// against didKeeper.GetDIDsByController(ReviewerKeyID) before an EncryptedPayload
+	// is accepted -- see EncryptedAppealPayload.
+	ReviewerDID string `json:"reviewerDid,omitempty"`
+
+	// EncryptedPayload carries sensitive appeal evidence (medical records, KYC docs)
+	// sealed off-chain instead of in Evidence, for appeals that shouldn't be stored in
+	// plaintext on chain. Nil means the appeal only uses Evidence.
+	EncryptedPayload *EncryptedAppealPayload `json:"encryptedPayload,omitempty"`
+}
+
+func (m *Appeal) ProtoMessage()  {}
+func (m *Appeal) Reset()         { *m = Appeal{} }
+func (m *Appeal) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of an Appeal.
+func (a *Appeal) Validate() error {
+	if a.Appellant == "" {
+		return ErrInvalidAppeal.Wrap("appellant cannot be empty")
+	}
+	if a.Reason == "" {
+		return ErrInvalidAppeal.Wrap("reason cannot be empty")
+	}
+	if a.EncryptedPayload != nil {
+		if err := a.EncryptedPayload.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncryptedAppealPayload is an ECIES envelope (X25519 ECDH -> HKDF-SHA256 ->
+// XChaCha20-Poly1305) sealing an appeal's sensitive evidence against the assigned
+// reviewer's x/e2ee RegisteredKey, the same envelope shape e2eetypes.SealedBlob uses
+// for credential delivery. Only this opaque envelope is ever written to state -- the
+// plaintext evidence never touches the chain.
+type EncryptedAppealPayload struct {
+	// Ciphertext is the sealed evidence payload.
+	Ciphertext []byte `json:"ciphertext"`
+
+	// EphemeralPubKey is the appellant's one-time X25519 public key used for ECDH.
+	EphemeralPubKey []byte `json:"ephemeralPubKey"`
+
+	// RecipientKeyID is the bech32 address the reviewer registered their encryption
+	// key under via e2ee's MsgRegisterEncryptionKey.
+	RecipientKeyID string `json:"recipientKeyId"`
+
+	// AEADTag is the XChaCha20-Poly1305 authentication tag.
+	AEADTag []byte `json:"aeadTag"`
+}
+
+func (m *EncryptedAppealPayload) ProtoMessage()  {}
+func (m *EncryptedAppealPayload) Reset()         { *m = EncryptedAppealPayload{} }
+func (m *EncryptedAppealPayload) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of an EncryptedAppealPayload.
+func (m *EncryptedAppealPayload) Validate() error {
+	if m.RecipientKeyID == "" {
+		return ErrInvalidAppeal.Wrap("encrypted payload recipientKeyId cannot be empty")
+	}
+	if len(m.EphemeralPubKey) != e2eetypes.X25519KeySize {
+		return ErrInvalidAppeal.Wrapf("ephemeral public key must be %d bytes, got %d", e2eetypes.X25519KeySize, len(m.EphemeralPubKey))
+	}
+	if len(m.Ciphertext) == 0 {
+		return ErrInvalidAppeal.Wrap("encrypted payload ciphertext cannot be empty")
+	}
+	if len(m.AEADTag) == 0 {
+		return ErrInvalidAppeal.Wrap("encrypted payload AEAD tag cannot be empty")
+	}
+	return nil
+}
+
+// NewEncryptedAppealPayload builds an EncryptedAppealPayload from a SealEnvelope
+// result sealed against the reviewer's e2ee registered key. e2eetypes.SealedBlob
+// appends the XChaCha20-Poly1305 tag to the end of Ciphertext (the standard AEAD.Seal
+// layout); this splits it back into the separate Ciphertext/AEADTag fields the request
+// asked EncryptedAppealPayload to carry.
+func NewEncryptedAppealPayload(recipientKeyID string, blob *e2eetypes.SealedBlob) (*EncryptedAppealPayload, error) {
+	if len(blob.Ciphertext) < chacha20poly1305.Overhead {
+		return nil, ErrInvalidAppeal.Wrap("sealed ciphertext shorter than the AEAD tag")
+	}
+	split := len(blob.Ciphertext) - chacha20poly1305.Overhead
+
+	payload := &EncryptedAppealPayload{
+		Ciphertext:      blob.Ciphertext[:split],
+		EphemeralPubKey: blob.EphemeralPublicKey,
+		RecipientKeyID:  recipientKeyID,
+		AEADTag:         blob.Ciphertext[split:],
+	}
+	return payload, payload.Validate()
+}
+
+// CiphertextHash returns the SHA-256 digest of Ciphertext, the value MsgDecideAppeal
+// references so a reviewer's decision is bound to the specific envelope they reviewed
+// without ever needing the plaintext.
+func (m *EncryptedAppealPayload) CiphertextHash() []byte {
+	sum := sha256.Sum256(m.Ciphertext)
+	return sum[:]
+}
+
+// RevocationQuery filters RevocationEntry lookups.
+type RevocationQuery struct {
+	Status       string `json:"status,omitempty"`
+	CredentialID string `json:"credentialId,omitempty"`
+}
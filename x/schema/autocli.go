@@ -0,0 +1,77 @@
+package schema
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions declares the schema module's tx/query commands for autocli, so
+// `personachaind tx schema` and `personachaind query schema` are generated without
+// hand-written cobra glue in x/schema/client/cli.
+//
+// x/identity does not exist in this tree -- the closest analogs to its
+// CreateIdentity/IssueCredential/VerifyCredential/RevokeCredential msgServer are spread
+// across x/credential (issuance) and x/revocation (revocation), neither of which has an
+// AppModule yet, so no identity-side descriptors are added here.
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "personahq.personachain.schema.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateSchema",
+					Use:       "create-schema [name] [version] [schema-type] [json-schema-file]",
+					Short:     "Publish a new content-addressed credential schema",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "version"},
+						{ProtoField: "schema_type"},
+						{ProtoField: "json_schema", FlagName: "from-file"},
+					},
+				},
+				{
+					RpcMethod: "DeprecateSchema",
+					Use:       "deprecate-schema [id] [superseded-by]",
+					Short:     "Deprecate an existing credential schema",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "superseded_by"},
+					},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "personahq.personachain.schema.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "GetSchema",
+					Use:       "get-schema [id]",
+					Short:     "Query a credential schema by its content-addressed ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ListSchemas",
+					Use:       "list-schemas",
+					Short:     "List every registered credential schema",
+				},
+				{
+					RpcMethod: "SchemasByAuthor",
+					Use:       "schemas-by-author [controller]",
+					Short:     "List credential schemas published by a controller",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "controller"},
+					},
+				},
+				{
+					RpcMethod: "SchemasByCompliance",
+					Use:       "schemas-by-compliance [schema-type]",
+					Short:     "List credential schemas declaring a given credential type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "schema_type"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -4,33 +4,56 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
-	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/log"
-	
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	
+
 	"github.com/PersonaPass-ID/personachain/x/schema/types"
 )
 
-// Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine. Migrated off a raw storeKey.KVStore(ctx) +
+// MustMarshal/MustUnmarshal layout onto a KVStoreService-backed collections schema, the
+// same direction x/revocation/keeper/keeper.go's Keeper already took (see that file's
+// doc comment for the full rationale: the old NewKeeper opened its one KVStore via
+// storeService.OpenKVStore(context.Background()) and stashed it as storeKey, a store
+// permanently bound to a background context rather than the live sdk.Context any
+// Msg/Query handler actually runs under). Every accessor below instead takes
+// context.Context and resolves its store fresh per call through storeService/Schema.
+//
+// Keeper deliberately does NOT also keep a raw storetypes.StoreKey field: the one
+// caller that still needs raw, historical-height store access --
+// SnapshotExtension/RestoreExtension in snapshot.go, for state-sync -- takes its own
+// storetypes.StoreKey as an explicit constructor parameter instead (see
+// NewSnapshotExtension), the same "legacy/raw store access is threaded as an explicit
+// parameter, not stored on Keeper" convention x/revocation/keeper/migrations.go's
+// MigrateStore already uses for its own legacyStoreKey. No other module in this tree
+// implements an ExtensionSnapshotter, so there was no in-tree precedent to follow here
+// beyond that convention.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
-	logger   log.Logger
-	
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+	Schema       collections.Schema
+
+	Schemas        collections.Map[string, types.CredentialSchema]
+	LatestPointers collections.Map[collections.Pair[string, string], string]
+	Params         collections.Item[types.Params]
+
 	// External keepers
 	authKeeper authkeeper.AccountKeeper
 	bankKeeper bankkeeper.Keeper
-	
+
 	// Authority is the module authority
 	authority string
 }
 
-// NewKeeper creates a new schema Keeper instance
+// NewKeeper creates a new schema Keeper instance backed by storeService.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
@@ -38,14 +61,28 @@ func NewKeeper(
 	authKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 ) *Keeper {
-	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeService.OpenKVStore(context.Background()),
-		logger:     log.NewNopLogger(),
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		authority:  authority,
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		logger:       log.NewNopLogger(),
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+		authority:    authority,
+
+		Schemas:        collections.NewMap(sb, types.SchemaPrefix, "schemas", collections.StringKey, codec.CollValue[types.CredentialSchema](cdc)),
+		LatestPointers: collections.NewMap(sb, types.LatestPointerPrefix, "latest_pointers", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		Params:         collections.NewItem(sb, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -58,70 +95,233 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// GetParams returns the module's current params, falling back to DefaultParams if none
+// have been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams(), nil
+	}
+	return params, nil
+}
+
+// SetParams validates and persists the module's params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, params)
+}
+
 // SetSchema stores a credential schema
-func (k Keeper) SetSchema(ctx sdk.Context, schema *types.CredentialSchema) error {
+func (k Keeper) SetSchema(ctx context.Context, schema *types.CredentialSchema) error {
+	if err := schema.Validate(); err != nil {
+		return err
+	}
+	return k.Schemas.Set(ctx, schema.ID, *schema)
+}
+
+// Schema dependency resolution below is deliberately scoped to what this module's
+// existing content-addressed, immutable design supports: Dependencies pins exact schema
+// IDs and ResolveDependencies walks that DAG. A full semver-range resolver (caret/tilde
+// ranges over a mutable (schemaID, major, minor, patch) store) and a migration-chain
+// engine (MsgAddMigration running a jq/jsonata-style transform, bounded to N hops) are
+// not implemented -- both would require keying schema storage by mutable version
+// triples, which conflicts with the one-ID-per-body invariant CredentialSchema.Validate
+// already enforces, and a jq/jsonata interpreter isn't vendored anywhere in this repo.
+// A real implementation of that part would need its own module-level design decision
+// rather than being bolted onto ResolveDependencies.
+
+// CreateSchema publishes a new content-addressed schema, refusing to overwrite an
+// existing one -- schemas are immutable once created and may only be deprecated. Its
+// Dependencies are resolved through ResolveDependencies (erroring on a cyclic,
+// missing, or deprecated dependency) before the schema is stored, and the
+// (controller, name) latest pointer is updated so ResolveLatest can find it.
+func (k Keeper) CreateSchema(ctx context.Context, schema *types.CredentialSchema) error {
 	if err := schema.Validate(); err != nil {
 		return err
 	}
-	
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(schema)
-	store.Set(types.SchemaKey(schema.ID), bz)
-	
+
+	exists, err := k.Schemas.Has(ctx, schema.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return types.ErrSchemaExists.Wrapf("schema %s already exists", schema.ID)
+	}
+
+	if _, err := k.ResolveDependencies(ctx, schema); err != nil {
+		return err
+	}
+
+	if err := k.Schemas.Set(ctx, schema.ID, *schema); err != nil {
+		return err
+	}
+	if err := k.LatestPointers.Set(ctx, collections.Join(schema.Controller, schema.Name), schema.ID); err != nil {
+		return err
+	}
+
+	types.EmitSchemaPublishedEvent(sdk.UnwrapSDKContext(ctx), schema)
+	return nil
+}
+
+// ResolveDependencies resolves every schema in schema.Dependencies (transitively),
+// returning them in DFS visit order. It rejects a missing dependency, a deprecated one
+// (MsgCreateCredential should never issue against a schema whose dependency chain has
+// been retired), and a cyclic graph -- walked via depth-first search, since dependencies
+// are pinned by exact content-addressed ID rather than a version range, the graph is a
+// plain DAG over schema IDs with no semver resolution step required.
+func (k Keeper) ResolveDependencies(ctx context.Context, schema *types.CredentialSchema) ([]*types.CredentialSchema, error) {
+	visiting := map[string]bool{schema.ID: true}
+	var resolved []*types.CredentialSchema
+
+	var visit func(id string) error
+	visit = func(id string) error {
+		dep, err := k.GetSchema(ctx, id)
+		if err != nil {
+			return types.ErrDependencyNotFound.Wrapf("dependency %s not found", id)
+		}
+		if dep.Deprecated {
+			return types.ErrDependencyDeprecated.Wrapf("dependency %s is deprecated, depend on %s instead", dep.ID, dep.SupersededBy)
+		}
+		for _, child := range dep.Dependencies {
+			if visiting[child] {
+				return types.ErrCyclicDependency.Wrapf("schema dependency cycle through %s", child)
+			}
+			visiting[child] = true
+			if err := visit(child); err != nil {
+				return err
+			}
+			delete(visiting, child)
+		}
+		resolved = append(resolved, dep)
+		return nil
+	}
+
+	for _, dep := range schema.Dependencies {
+		if visiting[dep] {
+			return nil, types.ErrCyclicDependency.Wrapf("schema %s cannot depend on itself transitively", schema.ID)
+		}
+		visiting[dep] = true
+		if err := visit(dep); err != nil {
+			return nil, err
+		}
+		delete(visiting, dep)
+	}
+
+	return resolved, nil
+}
+
+// ResolveLatest returns the most recently published schema controller has published
+// under name, i.e. the resolution a caller reaches for when it wants "the current
+// schema named X" rather than a specific content-addressed ID.
+func (k Keeper) ResolveLatest(ctx context.Context, controller, name string) (*types.CredentialSchema, error) {
+	id, err := k.LatestPointers.Get(ctx, collections.Join(controller, name))
+	if err != nil {
+		return nil, types.ErrSchemaNotFound.Wrapf("no schema named %q published by %s", name, controller)
+	}
+	return k.GetSchema(ctx, id)
+}
+
+// DeprecateSchema marks an existing schema as superseded by supersededBy, refusing any
+// other mutation of the record.
+func (k Keeper) DeprecateSchema(ctx context.Context, schemaID, supersededBy string) error {
+	schema, err := k.GetSchema(ctx, schemaID)
+	if err != nil {
+		return err
+	}
+	if schema.Deprecated {
+		return types.ErrSchemaDeprecated.Wrapf("schema %s is already deprecated", schemaID)
+	}
+
+	schema.Deprecate(supersededBy)
+	if err := k.Schemas.Set(ctx, schema.ID, *schema); err != nil {
+		return err
+	}
+
+	types.EmitSchemaDeprecatedEvent(sdk.UnwrapSDKContext(ctx), schemaID, supersededBy)
 	return nil
 }
 
+// ValidateCredential resolves the schema referenced by schemaID and checks that
+// credentialType declares the schema's type and that claims satisfy its JSON Schema
+// body. Intended to be called from the credential module's MsgCreateCredential handler
+// whenever CredentialMetadata.Schema is non-empty -- see
+// credentialtypes.VerifiableCredential.ValidateAgainstSchema, which wraps any error
+// returned here as credentialtypes.ErrInvalidCredential.
+func (k Keeper) ValidateCredential(ctx context.Context, schemaID string, credentialType []string, claims map[string]interface{}) error {
+	schema, err := k.GetSchema(ctx, schemaID)
+	if err != nil {
+		return err
+	}
+	if schema.Deprecated {
+		return types.ErrSchemaDeprecated.Wrapf("schema %s is deprecated, issue against %s instead", schema.ID, schema.SupersededBy)
+	}
+
+	declared := false
+	for _, t := range credentialType {
+		if t == schema.Schema.Type {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return types.ErrInvalidSchema.Wrapf("credential type must include the schema's declared type %q", schema.Schema.Type)
+	}
+
+	return types.ValidateClaims(schema.Schema.Raw, claims)
+}
+
 // GetSchema retrieves a credential schema
-func (k Keeper) GetSchema(ctx sdk.Context, schemaID string) (*types.CredentialSchema, error) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SchemaKey(schemaID))
-	
-	if bz == nil {
+func (k Keeper) GetSchema(ctx context.Context, schemaID string) (*types.CredentialSchema, error) {
+	schema, err := k.Schemas.Get(ctx, schemaID)
+	if err != nil {
 		return nil, types.ErrSchemaNotFound
 	}
-	
-	var schema types.CredentialSchema
-	k.cdc.MustUnmarshal(bz, &schema)
-	
 	return &schema, nil
 }
 
+// GetSchemaType returns schemaID's declared Schema.Type, satisfying
+// x/credential/types.SchemaKeeper's GetSchemaType -- a credential-application reviewer
+// uses this to learn what VerifiableCredential.Type to issue against a schema without
+// x/credential/types importing x/schema/types.CredentialSchema directly.
+func (k Keeper) GetSchemaType(ctx context.Context, schemaID string) (string, error) {
+	schema, err := k.GetSchema(ctx, schemaID)
+	if err != nil {
+		return "", err
+	}
+	return schema.Schema.Type, nil
+}
+
 // DeleteSchema removes a credential schema
-func (k Keeper) DeleteSchema(ctx sdk.Context, schemaID string) error {
-	store := ctx.KVStore(k.storeKey)
-	key := types.SchemaKey(schemaID)
-	
-	if !store.Has(key) {
+func (k Keeper) DeleteSchema(ctx context.Context, schemaID string) error {
+	exists, err := k.Schemas.Has(ctx, schemaID)
+	if err != nil {
+		return err
+	}
+	if !exists {
 		return types.ErrSchemaNotFound
 	}
-	
-	store.Delete(key)
-	return nil
+	return k.Schemas.Remove(ctx, schemaID)
 }
 
 // QuerySchemas returns schemas based on query parameters
-func (k Keeper) QuerySchemas(ctx sdk.Context, query types.SchemaQuery) ([]*types.CredentialSchema, error) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := store.Iterator(nil, nil)
-	defer iterator.Close()
-	
+func (k Keeper) QuerySchemas(ctx context.Context, query types.SchemaQuery) ([]*types.CredentialSchema, error) {
 	var schemas []*types.CredentialSchema
-	
-	for ; iterator.Valid(); iterator.Next() {
-		var schema types.CredentialSchema
-		k.cdc.MustUnmarshal(iterator.Value(), &schema)
-		
-		// Apply query filters
+
+	err := k.Schemas.Walk(ctx, nil, func(_ string, schema types.CredentialSchema) (bool, error) {
 		if query.SchemaType != "" && schema.Schema.Type != query.SchemaType {
-			continue
+			return false, nil
 		}
-		
 		if query.Version != "" && schema.Version != query.Version {
-			continue
+			return false, nil
 		}
-		
 		schemas = append(schemas, &schema)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	
+
 	return schemas, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/schema/types"
+)
+
+// MigrateStore moves every record written under the Legacy*Prefix string keys in
+// types/keys.go (LegacySchemaPrefix, LegacyLatestPointerPrefix) onto the
+// collections.NewPrefix(N) byte-prefixed schema keeper.go now builds, then deletes the
+// legacy entries. Modeled directly on x/revocation/keeper/migrations.go's MigrateStore:
+// it takes the legacy raw store key as an explicit parameter rather than routing
+// through Keeper, since by the time this is wired into an upgrade handler the legacy
+// storeKey won't be part of Keeper's collections schema anymore.
+//
+// ParamsKey is migrated too: the pre-collections keeper stored Params under the bare
+// "params/" key on the same raw KVStore as everything else, so it is read and
+// re-written through k.Params the same way SetSchema's records are through k.Schemas.
+func MigrateStore(ctx sdk.Context, legacyStoreKey storetypes.StoreKey, k Keeper) error {
+	store := ctx.KVStore(legacyStoreKey)
+
+	schemaIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacySchemaPrefix))
+	defer schemaIter.Close()
+	for ; schemaIter.Valid(); schemaIter.Next() {
+		var schema types.CredentialSchema
+		k.cdc.MustUnmarshal(schemaIter.Value(), &schema)
+		if err := k.Schemas.Set(ctx, schema.ID, schema); err != nil {
+			return err
+		}
+		store.Delete(schemaIter.Key())
+	}
+
+	pointerIter := storetypes.KVStorePrefixIterator(store, []byte(types.LegacyLatestPointerPrefix))
+	defer pointerIter.Close()
+	for ; pointerIter.Valid(); pointerIter.Next() {
+		controller, name := splitLatestPointerKey(pointerIter.Key())
+		if controller == "" || name == "" {
+			store.Delete(pointerIter.Key())
+			continue
+		}
+		if err := k.LatestPointers.Set(ctx, collections.Join(controller, name), string(pointerIter.Value())); err != nil {
+			return err
+		}
+		store.Delete(pointerIter.Key())
+	}
+
+	paramsKey := []byte(types.ParamsKey)
+	if bz := store.Get(paramsKey); bz != nil {
+		var params types.Params
+		k.cdc.MustUnmarshal(bz, &params)
+		if err := k.Params.Set(ctx, params); err != nil {
+			return err
+		}
+		store.Delete(paramsKey)
+	}
+
+	return nil
+}
+
+// splitLatestPointerKey splits a "<LegacyLatestPointerPrefix><controller>/<name>" legacy
+// key (see types/keys.go's LatestPointerKey) back into (controller, name), scanning for
+// the last '/' since a controller's own DID/address may itself contain '/'.
+func splitLatestPointerKey(key []byte) (controller, name string) {
+	suffix := string(key[len(types.LegacyLatestPointerPrefix):])
+	for i := len(suffix) - 1; i >= 0; i-- {
+		if suffix[i] == '/' {
+			return suffix[:i], suffix[i+1:]
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"io"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+
+	commonerrors "github.com/PersonaPass-ID/personachain/x/common/errors"
+	"github.com/PersonaPass-ID/personachain/x/schema/types"
+)
+
+// SnapshotExtensionName identifies the schema module's ExtensionSnapshotter to the
+// snapshot manager, so a multi-module state-sync snapshot routes payloads to the right
+// extension on restore.
+const SnapshotExtensionName = "schema"
+
+// snapshotChunkBytes caps how much a single ExtensionPayloadWriter call carries, so the
+// schema module's (potentially large) JSON Schema bodies don't get buffered into one
+// unbounded payload.
+const snapshotChunkBytes = 10 * 1024 * 1024 // 10MB
+
+// SnapshotExtension streams x/schema's CredentialSchema records out-of-band from
+// ordinary IAVL state-sync chunks, since schema bodies can be large enough to bloat
+// every chunk if left in the regular tree. x/schema keeps no secondary indexes --
+// QuerySchemas does a full-store scan rather than maintaining one -- so
+// RestoreExtension only has to replay the primary SchemaKey records; there is nothing
+// else to re-derive.
+//
+// This still reads through a raw storetypes.StoreKey rather than keeper.Schemas'
+// collections.Map: SnapshotExtension/RestoreExtension need a read of an arbitrary
+// historical height reconstructed from the committed MultiStore (see
+// SnapshotExtension below), and a KVStoreService's OpenKVStore(ctx) resolves against
+// whatever ctx it's handed rather than a specific past height -- see keeper.go's doc
+// comment on Keeper.rawStoreKey for the full rationale.
+type SnapshotExtension struct {
+	keeper      Keeper
+	rawStoreKey storetypes.StoreKey
+	cms         storetypes.MultiStore
+}
+
+// NewSnapshotExtension builds an ExtensionSnapshotter for x/schema. cms must be the
+// app's committed multistore, so SnapshotExtension/RestoreExtension can open a
+// read-only view of state at an arbitrary historical height. rawStoreKey is the same
+// storetypes.StoreKey passed to NewKeeper, threaded through here explicitly rather than
+// read off keeper, the same "legacy/raw store access is an explicit parameter, not a
+// Keeper field a caller might reach for by accident" convention
+// x/revocation/keeper/migrations.go's MigrateStore uses for its own legacyStoreKey.
+func NewSnapshotExtension(keeper Keeper, rawStoreKey storetypes.StoreKey, cms storetypes.MultiStore) *SnapshotExtension {
+	return &SnapshotExtension{keeper: keeper, rawStoreKey: rawStoreKey, cms: cms}
+}
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (s *SnapshotExtension) SnapshotName() string { return SnapshotExtensionName }
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (s *SnapshotExtension) SnapshotFormat() uint32 { return types.SnapshotFormatV1 }
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (s *SnapshotExtension) SupportedFormats() []uint32 { return []uint32{types.SnapshotFormatV1} }
+
+// SnapshotExtension writes every CredentialSchema at height as a length-prefixed
+// protobuf record, grouped into ~snapshotChunkBytes payloads each led by a format
+// version byte, so RestoreExtension can tell which record layout it's reading even
+// across a future SnapshotFormat bump.
+func (s *SnapshotExtension) SnapshotExtension(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	ctx := sdk.NewContext(s.cms, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	store := ctx.KVStore(s.rawStoreKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.LegacySchemaPrefix))
+	defer iterator.Close()
+
+	chunk := []byte{byte(types.SnapshotFormatV1)}
+	for ; iterator.Valid(); iterator.Next() {
+		chunk = appendLengthPrefixed(chunk, iterator.Value())
+		if len(chunk) >= snapshotChunkBytes {
+			if err := payloadWriter(chunk); err != nil {
+				return err
+			}
+			chunk = []byte{byte(types.SnapshotFormatV1)}
+		}
+	}
+	if len(chunk) > 1 {
+		return payloadWriter(chunk)
+	}
+	return nil
+}
+
+// RestoreExtension reassembles CredentialSchema records read from payloadReader and
+// re-stores each one under its SchemaKey.
+func (s *SnapshotExtension) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	if format != types.SnapshotFormatV1 {
+		return types.ErrInvalidSnapshot.Wrapf("unsupported snapshot format %d", format)
+	}
+
+	ctx := sdk.NewContext(s.cms, cmtproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+
+	for {
+		chunk, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return commonerrors.Wrap(types.ErrInvalidSnapshot, err)
+		}
+		if err := s.restoreChunk(ctx, chunk); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *SnapshotExtension) restoreChunk(ctx sdk.Context, chunk []byte) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+	if chunk[0] != byte(types.SnapshotFormatV1) {
+		return types.ErrInvalidSnapshot.Wrapf("unsupported snapshot record version %d", chunk[0])
+	}
+
+	buf := chunk[1:]
+	for len(buf) > 0 {
+		record, rest, err := readLengthPrefixed(buf)
+		if err != nil {
+			return err
+		}
+		buf = rest
+
+		var schema types.CredentialSchema
+		s.keeper.cdc.MustUnmarshal(record, &schema)
+		if err := s.keeper.SetSchema(ctx, &schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendLengthPrefixed(dst, record []byte) []byte {
+	var lenBz [4]byte
+	binary.BigEndian.PutUint32(lenBz[:], uint32(len(record)))
+	dst = append(dst, lenBz[:]...)
+	return append(dst, record...)
+}
+
+func readLengthPrefixed(buf []byte) (record, rest []byte, err error) {
+	if len(buf) < 4 {
+		return nil, nil, types.ErrInvalidSnapshot.Wrap("truncated snapshot record length")
+	}
+	n := binary.BigEndian.Uint32(buf[:4])
+	buf = buf[4:]
+	if uint32(len(buf)) < n {
+		return nil, nil, types.ErrInvalidSnapshot.Wrap("truncated snapshot record")
+	}
+	return buf[:n], buf[n:], nil
+}
@@ -0,0 +1,22 @@
+//go:build wasm
+
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/PersonaPass-ID/personachain/x/schema/wasmbinding"
+)
+
+// RegisterWasmBindings wires the PersonaMsg/PersonaQuery custom wasmbinding plugins into
+// wasmKeeper's options, so contracts can drive schema registration and validation
+// through the custom message/query surface instead of a native module. Only compiled
+// into binaries built with the `wasm` tag; see app.go.
+func (k Keeper) RegisterWasmBindings(wasmKeeper wasmkeeper.Keeper) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageHandlerDecorator(wasmbinding.CustomMessageDecorator(k)),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: wasmbinding.CustomQuerier(k),
+		}),
+	}
+}
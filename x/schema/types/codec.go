@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the schema module's types on the given LegacyAmino codec.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateSchema{}, "schema/CreateSchema", nil)
+	cdc.RegisterConcrete(&MsgDeprecateSchema{}, "schema/DeprecateSchema", nil)
+}
+
+// RegisterInterfaces registers the schema module's interface types.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateSchema{},
+		&MsgDeprecateSchema{},
+	)
+
+	// Message service registration handled by generated proto code
+}
+
+// ModuleCdc references the global schema module codec. Note, the codec should ONLY be
+// used in certain instances of tests and for JSON encoding as Amino is still used for
+// that purpose.
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	RegisterCodec(legacy.Cdc)
+}
+
+// MsgServer defines the schema module's message handling surface.
+type MsgServer interface {
+	CreateSchema(ctx context.Context, req *MsgCreateSchema) (*MsgCreateSchemaResponse, error)
+	DeprecateSchema(ctx context.Context, req *MsgDeprecateSchema) (*MsgDeprecateSchemaResponse, error)
+}
+
+// QueryServer defines the schema module's gRPC query surface.
+type QueryServer interface {
+	GetSchema(ctx context.Context, req *QuerySchemaRequest) (*QuerySchemaResponse, error)
+	ListSchemas(ctx context.Context, req *QueryListSchemasRequest) (*QueryListSchemasResponse, error)
+	SchemasByAuthor(ctx context.Context, req *QuerySchemasByAuthorRequest) (*QuerySchemasByAuthorResponse, error)
+	SchemasByCompliance(ctx context.Context, req *QuerySchemasByComplianceRequest) (*QuerySchemasByComplianceResponse, error)
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Schema module error codes
+var (
+	ErrInvalidSchema    = errorsmod.Register(ModuleName, 2, "invalid credential schema")
+	ErrSchemaNotFound   = errorsmod.Register(ModuleName, 3, "schema not found")
+	ErrSchemaExists     = errorsmod.Register(ModuleName, 4, "schema already exists")
+	ErrSchemaDeprecated = errorsmod.Register(ModuleName, 5, "schema is deprecated")
+	ErrUnauthorized     = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 7, "invalid params")
+	ErrCyclicDependency = errorsmod.Register(ModuleName, 8, "cyclic schema dependency")
+	ErrDependencyNotFound = errorsmod.Register(ModuleName, 9, "schema dependency not found")
+	ErrDependencyDeprecated = errorsmod.Register(ModuleName, 10, "schema dependency is deprecated")
+	ErrInvalidSnapshot      = errorsmod.Register(ModuleName, 11, "invalid schema state-sync snapshot")
+)
@@ -0,0 +1,17 @@
+package types
+
+// Event types emitted by x/schema.
+const (
+	EventTypeSchemaPublished  = "schema_published"
+	EventTypeSchemaDeprecated = "schema_deprecated"
+)
+
+// Event attribute keys emitted by x/schema.
+const (
+	AttributeKeySchemaID     = "schema_id"
+	AttributeKeyController   = "controller"
+	AttributeKeySchemaName   = "name"
+	AttributeKeySchemaVersion = "version"
+	AttributeKeyDependency   = "dependency"
+	AttributeKeySupersededBy = "superseded_by"
+)
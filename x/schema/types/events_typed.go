@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EventSchemaPublished is a typed, protobuf-style event emitted whenever a new
+// CredentialSchema is published, so indexers can decode it with sdk.ParseTypedEvent
+// instead of scraping loose string attributes.
+type EventSchemaPublished struct {
+	SchemaId     string   `json:"schema_id"`
+	Controller   string   `json:"controller"`
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+func (m *EventSchemaPublished) ProtoMessage()  {}
+func (m *EventSchemaPublished) Reset()         { *m = EventSchemaPublished{} }
+func (m *EventSchemaPublished) String() string { return proto.CompactTextString(m) }
+
+// EmitSchemaPublishedEvent emits the schema_published event for schema, plus its
+// EventSchemaPublished typed counterpart. Called once by Keeper.CreateSchema after its
+// dependency graph has been checked for cycles.
+func EmitSchemaPublishedEvent(ctx sdk.Context, schema *CredentialSchema) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeSchemaPublished,
+			sdk.NewAttribute(AttributeKeySchemaID, schema.ID),
+			sdk.NewAttribute(AttributeKeyController, schema.Controller),
+			sdk.NewAttribute(AttributeKeySchemaName, schema.Name),
+			sdk.NewAttribute(AttributeKeySchemaVersion, schema.Version),
+			sdk.NewAttribute(AttributeKeyDependency, strings.Join(schema.Dependencies, ",")),
+		),
+	})
+}
+
+// EmitSchemaDeprecatedEvent emits the schema_deprecated event for schemaID, recording
+// which schema it was superseded by.
+func EmitSchemaDeprecatedEvent(ctx sdk.Context, schemaID, supersededBy string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeSchemaDeprecated,
+			sdk.NewAttribute(AttributeKeySchemaID, schemaID),
+			sdk.NewAttribute(AttributeKeySupersededBy, supersededBy),
+		),
+	})
+}
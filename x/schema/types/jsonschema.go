@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// claimSchema is the practical subset of JSON Schema that ValidateClaims understands:
+// a flat object with typed, required properties. It excludes combinators (allOf/anyOf),
+// $ref, and format validators, which is sufficient for the flat claim maps credentials
+// carry today.
+type claimSchema struct {
+	Type       string                    `json:"type"`
+	Properties map[string]claimProperty  `json:"properties"`
+	Required   []string                  `json:"required"`
+}
+
+type claimProperty struct {
+	Type string `json:"type"`
+}
+
+// ValidateClaims validates a credential's claims against the given JSON Schema body.
+// It understands the "type"/"properties"/"required" subset described on claimSchema.
+func ValidateClaims(schemaBody []byte, claims map[string]interface{}) error {
+	var schema claimSchema
+	if err := json.Unmarshal(schemaBody, &schema); err != nil {
+		return ErrInvalidSchema.Wrapf("malformed schema: %s", err)
+	}
+
+	for _, name := range schema.Required {
+		if _, ok := claims[name]; !ok {
+			return ErrInvalidSchema.Wrapf("claims missing required property %q", name)
+		}
+	}
+
+	for name, prop := range schema.Properties {
+		value, ok := claims[name]
+		if !ok {
+			continue
+		}
+		if err := validateClaimType(name, prop.Type, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateClaimType(name, wantType string, value interface{}) error {
+	switch wantType {
+	case "", "any":
+		return nil
+	case "string":
+		if _, ok := value.(string); !ok {
+			return ErrInvalidSchema.Wrapf("claim %q must be a string", name)
+		}
+	case "number", "integer":
+		if _, ok := value.(float64); !ok {
+			return ErrInvalidSchema.Wrapf("claim %q must be a number", name)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return ErrInvalidSchema.Wrapf("claim %q must be a boolean", name)
+		}
+	case "object":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return ErrInvalidSchema.Wrapf("claim %q must be an object", name)
+		}
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return ErrInvalidSchema.Wrapf("claim %q must be an array", name)
+		}
+	default:
+		return ErrInvalidSchema.Wrapf("unsupported schema property type %q for claim %q", wantType, name)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the schema module name
+	ModuleName = "schema"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the schema module
+	RouterKey = ModuleName
+)
+
+// Collections prefixes for the KVStoreService-backed schema keeper.go builds -- the
+// replacement for the Legacy*Prefix raw-KVStore layout below, which MigrateStore reads
+// from to carry forward state written before this module adopted collections. Numbered
+// the same way x/revocation/types/keys.go numbers its own prefixes.
+var (
+	SchemaPrefix        = collections.NewPrefix(1)
+	LatestPointerPrefix = collections.NewPrefix(2)
+	ParamsPrefix        = collections.NewPrefix(3)
+)
+
+// LegacySchemaPrefix is the legacy string prefix for credential schemas.
+const LegacySchemaPrefix = "schema/"
+
+// SchemaKey creates a store key for a credential schema by its content-addressed ID.
+func SchemaKey(id string) []byte {
+	return []byte(LegacySchemaPrefix + id)
+}
+
+// ParamsKey stores the module's Params.
+const ParamsKey = "params/"
+
+// LegacyLatestPointerPrefix indexes the most recently published schema ID for a given
+// (controller, name) pair, so callers that only know "the current schema named X from
+// controller Y" (rather than a specific content-addressed ID) can resolve it via
+// Keeper.ResolveLatest.
+const LegacyLatestPointerPrefix = "schema_latest/"
+
+// LatestPointerKey creates a store key for controller's latest published schema ID
+// under name.
+func LatestPointerKey(controller, name string) []byte {
+	return []byte(LegacyLatestPointerPrefix + controller + "/" + name)
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// MsgCreateSchema publishes a new content-addressed CredentialSchema. The schema ID is
+// derived from the JSON Schema body, so it cannot be chosen by the controller.
+type MsgCreateSchema struct {
+	Controller string `json:"controller"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	SchemaType string `json:"schemaType"`
+	JSONSchema []byte `json:"jsonSchema"`
+}
+
+var _ sdk.Msg = &MsgCreateSchema{}
+
+const TypeMsgCreateSchema = "create_schema"
+
+func (msg *MsgCreateSchema) GetSigners() []sdk.AccAddress {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{controller}
+}
+
+func (msg *MsgCreateSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return ErrInvalidSchema.Wrap("invalid controller address")
+	}
+	if msg.Name == "" {
+		return ErrInvalidSchema.Wrap("name cannot be empty")
+	}
+	if msg.Version == "" {
+		return ErrInvalidSchema.Wrap("version cannot be empty")
+	}
+	if msg.SchemaType == "" {
+		return ErrInvalidSchema.Wrap("schema must declare a credential type")
+	}
+
+	schema := CredentialSchema{
+		ID:         SchemaID(msg.JSONSchema),
+		Controller: msg.Controller,
+		Name:       msg.Name,
+		Version:    msg.Version,
+		Schema:     SchemaDefinition{Type: msg.SchemaType, Raw: msg.JSONSchema},
+	}
+	return schema.Validate()
+}
+
+func (msg *MsgCreateSchema) Type() string  { return TypeMsgCreateSchema }
+func (msg *MsgCreateSchema) Route() string { return RouterKey }
+func (msg *MsgCreateSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgCreateSchema) ProtoMessage()  {}
+func (m *MsgCreateSchema) Reset()         { *m = MsgCreateSchema{} }
+func (m *MsgCreateSchema) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateSchemaResponse is the response for MsgCreateSchema.
+type MsgCreateSchemaResponse struct {
+	Id string `json:"id"`
+}
+
+func (m *MsgCreateSchemaResponse) ProtoMessage()  {}
+func (m *MsgCreateSchemaResponse) Reset()         { *m = MsgCreateSchemaResponse{} }
+func (m *MsgCreateSchemaResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgDeprecateSchema marks an existing schema as superseded. Schemas can never be
+// mutated in place; this is the only lifecycle transition besides creation.
+type MsgDeprecateSchema struct {
+	Controller   string `json:"controller"`
+	Id           string `json:"id"`
+	SupersededBy string `json:"supersededBy,omitempty"`
+}
+
+var _ sdk.Msg = &MsgDeprecateSchema{}
+
+const TypeMsgDeprecateSchema = "deprecate_schema"
+
+func (msg *MsgDeprecateSchema) GetSigners() []sdk.AccAddress {
+	controller, err := sdk.AccAddressFromBech32(msg.Controller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{controller}
+}
+
+func (msg *MsgDeprecateSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return ErrInvalidSchema.Wrap("invalid controller address")
+	}
+	if msg.Id == "" {
+		return ErrInvalidSchema.Wrap("id cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgDeprecateSchema) Type() string  { return TypeMsgDeprecateSchema }
+func (msg *MsgDeprecateSchema) Route() string { return RouterKey }
+func (msg *MsgDeprecateSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgDeprecateSchema) ProtoMessage()  {}
+func (m *MsgDeprecateSchema) Reset()         { *m = MsgDeprecateSchema{} }
+func (m *MsgDeprecateSchema) String() string { return proto.CompactTextString(m) }
+
+// MsgDeprecateSchemaResponse is the response for MsgDeprecateSchema.
+type MsgDeprecateSchemaResponse struct{}
+
+func (m *MsgDeprecateSchemaResponse) ProtoMessage()  {}
+func (m *MsgDeprecateSchemaResponse) Reset()         { *m = MsgDeprecateSchemaResponse{} }
+func (m *MsgDeprecateSchemaResponse) String() string { return proto.CompactTextString(m) }
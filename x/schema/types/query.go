@@ -0,0 +1,46 @@
+package types
+
+// SchemaQuery filters CredentialSchema lookups.
+type SchemaQuery struct {
+	SchemaType string `json:"schemaType,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
+
+// QuerySchemaRequest requests a single schema by its content-addressed ID.
+type QuerySchemaRequest struct {
+	Id string `json:"id"`
+}
+
+// QuerySchemaResponse returns the resolved schema.
+type QuerySchemaResponse struct {
+	Schema *CredentialSchema `json:"schema"`
+}
+
+// QueryListSchemasRequest lists every registered schema.
+type QueryListSchemasRequest struct{}
+
+// QueryListSchemasResponse returns every registered schema.
+type QueryListSchemasResponse struct {
+	Schemas []*CredentialSchema `json:"schemas"`
+}
+
+// QuerySchemasByAuthorRequest lists the schemas published by a given controller.
+type QuerySchemasByAuthorRequest struct {
+	Controller string `json:"controller"`
+}
+
+// QuerySchemasByAuthorResponse returns the matching schemas.
+type QuerySchemasByAuthorResponse struct {
+	Schemas []*CredentialSchema `json:"schemas"`
+}
+
+// QuerySchemasByComplianceRequest lists the schemas declaring a given credential type,
+// i.e. the schemas a credential of that type may be validated against.
+type QuerySchemasByComplianceRequest struct {
+	SchemaType string `json:"schemaType"`
+}
+
+// QuerySchemasByComplianceResponse returns the matching schemas.
+type QuerySchemasByComplianceResponse struct {
+	Schemas []*CredentialSchema `json:"schemas"`
+}
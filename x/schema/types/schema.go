@@ -0,0 +1,104 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// SchemaDefinition is the JSON Schema body attached to a CredentialSchema, together
+// with the VerifiableCredential.Type value it governs.
+type SchemaDefinition struct {
+	// Type is the VerifiableCredential.Type entry a credential must declare to be
+	// validated against this schema.
+	Type string `json:"type"`
+
+	// Raw is the JSON Schema document itself, checked by ValidateClaims.
+	Raw []byte `json:"raw"`
+}
+
+// CredentialSchema is a versioned, content-addressed JSON Schema that credential
+// issuance can be validated against. Schema IDs are deterministic
+// (schema:<sha256-of-Raw>), so a schema can never be mutated in place: publishing a
+// changed definition mints a new ID, and the old one can only be deprecated in favor of
+// the new one via MsgDeprecateSchema.
+type CredentialSchema struct {
+	ID           string           `json:"id"`
+	Controller   string           `json:"controller"`
+	Name         string           `json:"name"`
+	Version      string           `json:"version"`
+	Schema       SchemaDefinition `json:"schema"`
+	Created      time.Time        `json:"created"`
+	Deprecated   bool             `json:"deprecated,omitempty"`
+	SupersededBy string           `json:"supersededBy,omitempty"`
+
+	// Dependencies lists the IDs of other schemas this one's Schema.Raw body
+	// references (e.g. via JSON Schema $ref). Pinned by exact content-addressed ID
+	// rather than a semver range, consistent with this module's immutable,
+	// content-addressed schemas -- see Keeper.ResolveDependencies for cycle detection
+	// and Keeper.CreateSchema for where dependencies are checked at publish time.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+func (m *CredentialSchema) ProtoMessage()  {}
+func (m *CredentialSchema) Reset()         { *m = CredentialSchema{} }
+func (m *CredentialSchema) String() string { return proto.CompactTextString(m) }
+
+// SchemaID deterministically derives a content-addressed schema ID from a raw JSON
+// Schema body, so that publishing a changed schema always mints a new ID rather than
+// mutating an existing one.
+func SchemaID(raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return "schema:" + hex.EncodeToString(sum[:])
+}
+
+// Validate performs stateless validation of a CredentialSchema.
+func (s *CredentialSchema) Validate() error {
+	if s.ID == "" {
+		return ErrInvalidSchema.Wrap("id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Controller); err != nil {
+		return ErrInvalidSchema.Wrap("invalid controller address")
+	}
+	if s.Name == "" {
+		return ErrInvalidSchema.Wrap("name cannot be empty")
+	}
+	if s.Version == "" {
+		return ErrInvalidSchema.Wrap("version cannot be empty")
+	}
+	if s.Schema.Type == "" {
+		return ErrInvalidSchema.Wrap("schema must declare a credential type")
+	}
+	if len(s.Schema.Raw) == 0 {
+		return ErrInvalidSchema.Wrap("schema body cannot be empty")
+	}
+	if !json.Valid(s.Schema.Raw) {
+		return ErrInvalidSchema.Wrap("schema body is not valid JSON")
+	}
+	if s.ID != SchemaID(s.Schema.Raw) {
+		return ErrInvalidSchema.Wrap("schema id is not content-addressed to its body")
+	}
+	for _, dep := range s.Dependencies {
+		if dep == s.ID {
+			return ErrCyclicDependency.Wrapf("schema %s cannot depend on itself", s.ID)
+		}
+		if !strings.HasPrefix(dep, "schema:") {
+			return ErrInvalidSchema.Wrapf("dependency %q is not a schema ID", dep)
+		}
+	}
+	return nil
+}
+
+// Deprecate marks the schema as superseded by a newer schema ID. Deprecated schemas
+// remain resolvable for credentials already issued against them, but MsgCreateSchema's
+// content-addressing means a changed body always yields a new ID -- this is the only
+// mutation an existing schema record ever undergoes.
+func (s *CredentialSchema) Deprecate(supersededBy string) {
+	s.Deprecated = true
+	s.SupersededBy = supersededBy
+}
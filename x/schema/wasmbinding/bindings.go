@@ -0,0 +1,82 @@
+//go:build wasm
+
+// Package wasmbinding lets CosmWasm contracts drive schema-governed credential
+// issuance through a custom PersonaMsg/PersonaQuery surface, instead of needing a
+// native module of their own. It only compiles into binaries built with the `wasm`
+// build tag, so operators who don't run CosmWasm keep a lean binary.
+package wasmbinding
+
+// PersonaMsg is the custom CosmWasm message envelope PersonaChain registers with
+// wasmd's message router. A contract sends exactly one of the fields below per message.
+type PersonaMsg struct {
+	IssueCredential  *IssueCredentialMsg  `json:"issue_credential,omitempty"`
+	RevokeCredential *RevokeCredentialMsg `json:"revoke_credential,omitempty"`
+	RegisterSchema   *RegisterSchemaMsg   `json:"register_schema,omitempty"`
+}
+
+// IssueCredentialMsg asks x/credential to issue a credential on the contract's behalf.
+//
+// x/credential has no keeper in this tree yet -- see message_plugin.go -- so this
+// dispatches to a clear "not yet wired" error rather than pretending to call a keeper
+// method that does not exist.
+type IssueCredentialMsg struct {
+	SubjectDID     string         `json:"subject_did"`
+	CredentialType []string       `json:"credential_type"`
+	Claims         map[string]any `json:"claims"`
+	SchemaID       string         `json:"schema_id,omitempty"`
+}
+
+// RevokeCredentialMsg asks x/credential to revoke a previously issued credential.
+//
+// Same caveat as IssueCredentialMsg: no x/credential keeper exists yet to dispatch to.
+type RevokeCredentialMsg struct {
+	CredentialID string `json:"credential_id"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// RegisterSchemaMsg publishes a new CredentialSchema via x/schema.Keeper.CreateSchema.
+type RegisterSchemaMsg struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	SchemaType string `json:"schema_type"`
+	JSONSchema []byte `json:"json_schema"`
+}
+
+// PersonaQuery is the custom CosmWasm query envelope PersonaChain registers with
+// wasmd's query plugin router. A contract queries exactly one of the fields below.
+type PersonaQuery struct {
+	ResolveDID            *ResolveDIDQuery            `json:"resolve_did,omitempty"`
+	GetCredential         *GetCredentialQuery         `json:"get_credential,omitempty"`
+	ValidateAgainstSchema *ValidateAgainstSchemaQuery `json:"validate_against_schema,omitempty"`
+}
+
+// ResolveDIDQuery resolves a DID Document by ID.
+//
+// x/did has no keeper in this tree yet -- see query_plugin.go -- so this dispatches to
+// a clear "not yet wired" error rather than pretending to call a keeper method that does
+// not exist.
+type ResolveDIDQuery struct {
+	DID string `json:"did"`
+}
+
+// GetCredentialQuery fetches a previously issued credential by ID.
+//
+// Same caveat as ResolveDIDQuery: no x/credential keeper exists yet to dispatch to.
+type GetCredentialQuery struct {
+	CredentialID string `json:"credential_id"`
+}
+
+// ValidateAgainstSchemaQuery checks data_json's claims against schema_id's stored JSON
+// Schema body, entirely off the contract's gas meter's blind side -- the response's
+// ValidationTimeMs lets the contract account for the work it just had the chain do.
+type ValidateAgainstSchemaQuery struct {
+	SchemaID string `json:"schema_id"`
+	DataJSON []byte `json:"data_json"`
+}
+
+// ValidateAgainstSchemaResponse is the result of a ValidateAgainstSchemaQuery.
+type ValidateAgainstSchemaResponse struct {
+	Valid            bool     `json:"valid"`
+	Errors           []string `json:"errors,omitempty"`
+	ValidationTimeMs int64    `json:"validation_time_ms"`
+}
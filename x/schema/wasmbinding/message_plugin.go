@@ -0,0 +1,81 @@
+//go:build wasm
+
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	schemakeeper "github.com/PersonaPass-ID/personachain/x/schema/keeper"
+	schematypes "github.com/PersonaPass-ID/personachain/x/schema/types"
+)
+
+// CustomMessageDecorator wraps the next message handler in the chain with one that
+// intercepts wasmvmtypes.CosmosMsg.Custom payloads matching PersonaMsg and dispatches
+// them into the schema keeper, falling through to next for everything else. Registered
+// as wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(...)) in app.go.
+func CustomMessageDecorator(schemaKeeper schemakeeper.Keeper) func(next wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(next wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:      next,
+			schemaKeeper: schemaKeeper,
+		}
+	}
+}
+
+// CustomMessenger is the wasmkeeper.Messenger implementation CustomMessageDecorator
+// installs ahead of the default dispatcher.
+type CustomMessenger struct {
+	wrapped      wasmkeeper.Messenger
+	schemaKeeper schemakeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = CustomMessenger{}
+
+func (m CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]byte, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var personaMsg PersonaMsg
+	if err := json.Unmarshal(msg.Custom, &personaMsg); err != nil {
+		return nil, nil, nil, fmt.Errorf("unmarshal PersonaMsg: %w", err)
+	}
+
+	switch {
+	case personaMsg.RegisterSchema != nil:
+		return m.registerSchema(ctx, contractAddr, personaMsg.RegisterSchema)
+	case personaMsg.IssueCredential != nil:
+		return nil, nil, nil, fmt.Errorf("issue_credential: x/credential has no keeper in this tree yet, cannot dispatch")
+	case personaMsg.RevokeCredential != nil:
+		return nil, nil, nil, fmt.Errorf("revoke_credential: x/credential has no keeper in this tree yet, cannot dispatch")
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown PersonaMsg variant")
+	}
+}
+
+func (m CustomMessenger) registerSchema(ctx sdk.Context, contractAddr sdk.AccAddress, req *RegisterSchemaMsg) ([]sdk.Event, [][]byte, [][]byte, error) {
+	schema := &schematypes.CredentialSchema{
+		ID:         schematypes.SchemaID(req.JSONSchema),
+		Controller: contractAddr.String(),
+		Name:       req.Name,
+		Version:    req.Version,
+		Schema:     schematypes.SchemaDefinition{Type: req.SchemaType, Raw: req.JSONSchema},
+		Created:    ctx.BlockTime(),
+	}
+
+	if err := m.schemaKeeper.CreateSchema(ctx, schema); err != nil {
+		return nil, nil, nil, err
+	}
+
+	data, err := json.Marshal(schematypes.MsgCreateSchemaResponse{Id: schema.ID})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nil, [][]byte{data}, nil, nil
+}
@@ -0,0 +1,38 @@
+//go:build wasm
+
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	schemakeeper "github.com/PersonaPass-ID/personachain/x/schema/keeper"
+)
+
+// CustomQuerier answers PersonaQuery requests routed to it by wasmd's query plugin
+// dispatcher, registered in app.go as wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: CustomQuerier(schemaKeeper)}).
+func CustomQuerier(schemaKeeper schemakeeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query PersonaQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, fmt.Errorf("unmarshal PersonaQuery: %w", err)
+		}
+
+		switch {
+		case query.ValidateAgainstSchema != nil:
+			resp, err := ValidateAgainstSchema(ctx, schemaKeeper, query.ValidateAgainstSchema.SchemaID, query.ValidateAgainstSchema.DataJSON)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(resp)
+		case query.ResolveDID != nil:
+			return nil, fmt.Errorf("resolve_did: x/did has no keeper in this tree yet, cannot dispatch")
+		case query.GetCredential != nil:
+			return nil, fmt.Errorf("get_credential: x/credential has no keeper in this tree yet, cannot dispatch")
+		default:
+			return nil, fmt.Errorf("unknown PersonaQuery variant")
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//go:build wasm
+
+package wasmbinding
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	schemakeeper "github.com/PersonaPass-ID/personachain/x/schema/keeper"
+	schematypes "github.com/PersonaPass-ID/personachain/x/schema/types"
+)
+
+// compiledSchema is a claimSchema parsed once and reused across ValidateAgainstSchema
+// calls, keyed by schemaID+"@"+version so a deprecated-and-superseded schema ID (which
+// is immutable, but whose cache entry should never be reused across versions were the
+// compiler to change) never collides with a different version's compiled form.
+type compiledSchema struct {
+	raw []byte
+}
+
+var (
+	schemaCacheMu sync.RWMutex
+	schemaCache   = map[string]compiledSchema{}
+)
+
+func cacheKey(schemaID, version string) string {
+	return schemaID + "@" + version
+}
+
+// ValidateAgainstSchema resolves schemaID via the schema keeper, compiles (and caches)
+// its JSON Schema body, and validates dataJSON's claims against it. It returns a
+// ValidateAgainstSchemaResponse with a structured error list rather than failing fast,
+// so a contract can surface every violation to its caller in one round trip, plus the
+// wall-clock validation_time_ms so the contract can account for work it had the chain
+// do off its own gas meter.
+func ValidateAgainstSchema(ctx sdk.Context, keeper schemakeeper.Keeper, schemaID string, dataJSON []byte) (*ValidateAgainstSchemaResponse, error) {
+	start := time.Now()
+
+	schema, err := keeper.GetSchema(ctx, schemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := cacheKey(schema.ID, schema.Version)
+	schemaCacheMu.RLock()
+	compiled, ok := schemaCache[key]
+	schemaCacheMu.RUnlock()
+	if !ok {
+		compiled = compiledSchema{raw: schema.Schema.Raw}
+		schemaCacheMu.Lock()
+		schemaCache[key] = compiled
+		schemaCacheMu.Unlock()
+	}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(dataJSON, &claims); err != nil {
+		return &ValidateAgainstSchemaResponse{
+			Valid:            false,
+			Errors:           []string{"data_json must decode to a JSON object: " + err.Error()},
+			ValidationTimeMs: time.Since(start).Milliseconds(),
+		}, nil
+	}
+
+	var violations []string
+	if err := schematypes.ValidateClaims(compiled.raw, claims); err != nil {
+		violations = append(violations, err.Error())
+	}
+
+	return &ValidateAgainstSchemaResponse{
+		Valid:            len(violations) == 0,
+		Errors:           violations,
+		ValidationTimeMs: time.Since(start).Milliseconds(),
+	}, nil
+}
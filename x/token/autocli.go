@@ -0,0 +1,9 @@
+package token
+
+// AutoCLIOptions would declare the token module's tx/query commands for autocli --
+// params and token-config queries, per this chunk's request.
+//
+// It can't be written yet: x/token has no AppModule/module.go to hang the
+// autocli.HasAutoCLIConfig method on, and no Msg/Query service for a cobra command to
+// dispatch to -- see module/depinject.go's doc comment for the full state of that gap.
+// This file records the gap rather than fabricating one.
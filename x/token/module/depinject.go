@@ -0,0 +1,19 @@
+// Package module is where x/token's depinject wiring would live, following the same
+// per-module path x/oracle/module/depinject.go, x/registry/module/depinject.go, and
+// x/zkproof/module/depinject.go establish. It is a doc-only placeholder rather than a
+// working ModuleInputs/ModuleOutputs/ProvideModule: x/token is the deepest of this
+// request's four gaps. It has no concrete Keeper struct, no NewKeeper constructor, no
+// AppModule/module.go, and -- unlike x/zkproof, which at least has Msg types and a
+// keeper package with assumed fields -- no Msg types at all (see types/types.go's
+// PersonaToken, which is a plain supply-accounting struct with no handler wired to it
+// anywhere in this tree). types/expected_keepers.go does already declare this module's
+// BankKeeper (MintCoins/BurnCoins/SendCoinsFromModuleToAccount/
+// SendCoinsFromAccountToModule/GetSupply) and StakingKeeper (BondDenom/GetValidator/
+// GetDelegation) interfaces, satisfying this request's "expose expected_keepers.go
+// interfaces" ask for this module without any change needed here.
+//
+// Once a Keeper, Msg types, and AppModule exist for this module, ModuleInputs/
+// ModuleOutputs/ProvideModule here should follow x/oracle/module/depinject.go's exact
+// shape: Cdc/StoreService/Authority plus this module's BankKeeper/StakingKeeper
+// expected-keeper interfaces in, a Keeper and AppModule out.
+package module
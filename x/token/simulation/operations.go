@@ -0,0 +1,17 @@
+package simulation
+
+import (
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// WeightedOperations would return the token module's weighted simulation operations
+// for randomized mint/burn/transfer ops honoring types.TokenParams, the same shape
+// x/oracle/x/zkproof/x/credential's WeightedOperations in this chunk return.
+//
+// It can't be written yet: x/token has no Msg types, no MsgServer, and no Keeper for an
+// operation to dispatch against -- see module/depinject.go's doc comment for the full
+// state of that gap. Returns an empty set rather than fabricating a Msg surface and
+// keeper this module doesn't have.
+func WeightedOperations() simtypes.WeightedOperations {
+	return simtypes.WeightedOperations{}
+}
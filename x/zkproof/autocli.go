@@ -0,0 +1,17 @@
+package zkproof
+
+// AutoCLIOptions would declare the zkproof module's tx/query commands for autocli
+// against the persona.zk.v1.Msg/persona.zk.v1.Query services already drafted in
+// proto/persona/zk/v1/tx.proto and query.proto (submit-proof, register-circuit,
+// verify-proof, query proof, query proofs-by-prover, query proofs-by-circuit, query
+// proofs-by-status, per this chunk's request), the same shape x/oracle/autocli.go and
+// x/schema/autocli.go use.
+//
+// It can't be written yet: autocli.HasAutoCLIConfig is a method on an AppModule value
+// (`func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions`), and x/zkproof has no
+// AppModule/module.go anywhere in this tree to hang that method on -- see
+// keeper/msg_server_verify.go and ibc/ibc_module.go's doc comments for the same
+// standing gap. Defining AutoCLIOptions as a freestanding function instead (rather
+// than a method) wouldn't satisfy autocli.HasAutoCLIConfig, so there is nothing for a
+// real app wiring to discover even if this package declared one; this file records the
+// gap rather than fabricating a method on a type that doesn't exist.
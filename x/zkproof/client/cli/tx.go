@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// readVerificationKeyFile reads a circuit's compiled verifying key material off disk,
+// the same Parameters bytes Circuit stores and VerifyGroth16Proof requires.
+func readVerificationKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading verification key file %q: %w", path, err)
+	}
+	return data, nil
+}
+
+// GetTxCmd returns the transaction commands for the zkproof module, including the
+// governance proposal submission commands that curate which circuits this chain
+// trusts and discipline abusive provers.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSubmitRegisterCircuitProposal(),
+		CmdSubmitDeprecateCircuitProposal(),
+		CmdSubmitSlashProverProposal(),
+	)
+
+	return cmd
+}
+
+// CmdSubmitRegisterCircuitProposal submits a RegisterCircuitProposal wrapped in a
+// govv1beta1.MsgSubmitProposal.
+func CmdSubmitRegisterCircuitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-circuit-proposal [circuit-id] [circuit-type] [verification-key-file]",
+		Short: "Submit a proposal to whitelist a new zkproof verification circuit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			verificationKey, err := readVerificationKeyFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.RegisterCircuitProposal{
+				Title:           title,
+				Description:     description,
+				CircuitId:       args[0],
+				CircuitType:     types.CircuitType(args[1]),
+				VerificationKey: verificationKey,
+			}
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// CmdSubmitDeprecateCircuitProposal submits a DeprecateCircuitProposal wrapped in a
+// govv1beta1.MsgSubmitProposal.
+func CmdSubmitDeprecateCircuitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-circuit-proposal [circuit-id] [effective-height]",
+		Short: "Submit a proposal to deprecate a zkproof verification circuit after a given height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var effectiveHeight int64
+			if _, err := fmt.Sscanf(args[1], "%d", &effectiveHeight); err != nil {
+				return fmt.Errorf("invalid effective-height %q: %w", args[1], err)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.DeprecateCircuitProposal{
+				Title:           title,
+				Description:     description,
+				CircuitId:       args[0],
+				EffectiveHeight: effectiveHeight,
+			}
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// CmdSubmitSlashProverProposal submits a SlashProverProposal wrapped in a
+// govv1beta1.MsgSubmitProposal.
+func CmdSubmitSlashProverProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-prover-proposal [prover] [invalid-proof-count]",
+		Short: "Submit a proposal to burn a prover's bond and block the address after repeated invalid proofs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var invalidProofCount uint64
+			if _, err := fmt.Sscanf(args[1], "%d", &invalidProofCount); err != nil {
+				return fmt.Errorf("invalid invalid-proof-count %q: %w", args[1], err)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := &types.SlashProverProposal{
+				Title:             title,
+				Description:       description,
+				Prover:            args[0],
+				InvalidProofCount: invalidProofCount,
+			}
+
+			return submitProposal(cmd, clientCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags registers the shared --title/--description/--deposit flags every
+// gov proposal submission command in this file needs.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// submitProposal wraps content in a govv1beta1.MsgSubmitProposal using the --deposit
+// flag and the tx signer's address, then broadcasts it like any other tx command.
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govv1beta1.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,310 @@
+// Package gql exposes x/zkproof/keeper.QueryServer as a typed GraphQL schema, the
+// zkproof counterpart to x/credential/gql -- see that package's doc comment for why
+// there is no --gql-playground flag call site to mount NewPlaygroundHandler from yet
+// (this tree has no cmd/ or app/ HTTP server wiring at all).
+//
+// The request asking for this named it x/zk/gql; this module's real import path is
+// x/zkproof (see x/zkproof/router's own doc comments for the same x/zk vs x/zkproof
+// naming mismatch), so that's where this package lives instead.
+package gql
+
+import (
+	"context"
+	"net/http"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	zkproofkeeper "github.com/PersonaPass-ID/personachain/x/zkproof/keeper"
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// schema is the GraphQL SDL for the zkproof gateway. ProofConnection/ProofEdge/PageInfo
+// follow the Relay cursor connection spec, reusing types.CursorPageRequest/
+// CursorPageResponse (added for the gRPC side) as the connection's cursor.
+const schema = `
+	schema {
+		query: Query
+	}
+
+	type Query {
+		circuit(id: ID!): Circuit
+		queryProofs(prover: String, verifier: String, circuitId: String, status: String, first: Int, after: String): ProofConnection!
+		proofsByAttributes(attributes: [AttributePredicateInput!]!, first: Int, after: String): ProofConnection!
+		getStatus: Status!
+	}
+
+	# AttributePredicateInput is one {key, value} equality clause; predicates passed to
+	# proofsByAttributes are AND-intersected. Exactly one of the value fields should be
+	# set, mirroring types.AttributeValue's hand-rolled oneof.
+	input AttributePredicateInput {
+		key: String!
+		stringValue: String
+		intValue: Int
+		boolValue: Boolean
+	}
+
+	type Circuit {
+		id: ID!
+		name: String!
+		circuitType: String!
+		creator: String!
+		active: Boolean!
+	}
+
+	type Proof {
+		id: ID!
+		circuitId: String!
+		prover: String!
+		verifier: String!
+		proofType: String!
+		status: String!
+	}
+
+	type ProofConnection {
+		edges: [ProofEdge!]!
+		pageInfo: PageInfo!
+		totalCount: Int!
+	}
+
+	type ProofEdge {
+		node: Proof!
+		cursor: String!
+	}
+
+	type PageInfo {
+		endCursor: String
+		hasNextPage: Boolean!
+	}
+
+	type Status {
+		moduleName: String!
+	}
+`
+
+// Resolver backs the root Query type, translating GraphQL field arguments into
+// types.CircuitsQuery/types.ProofsQuery and delegating to the existing QueryServer
+// rather than re-implementing circuit/proof lookup against the keeper directly.
+type Resolver struct {
+	queryServer zkproofkeeper.QueryServer
+}
+
+// NewResolver returns a Resolver backed by queryServer.
+func NewResolver(queryServer zkproofkeeper.QueryServer) *Resolver {
+	return &Resolver{queryServer: queryServer}
+}
+
+// NewSchema parses schema and binds it to resolver.
+func NewSchema(resolver *Resolver) (*graphql.Schema, error) {
+	return graphql.ParseSchema(schema, resolver)
+}
+
+// NewPlaygroundHandler returns the graph-gophers relay playground handler for s.
+func NewPlaygroundHandler(s *graphql.Schema) http.Handler {
+	return &relay.Handler{Schema: s}
+}
+
+// Circuit resolves the circuit(id:) root field by looking the ID up directly against
+// r.queryServer.Circuits (the QueryServer's embedded Keeper), the same way
+// query_server.go's CircuitStats gets a single Circuit by ID rather than paginating.
+func (r *Resolver) Circuit(ctx context.Context, args struct{ ID graphql.ID }) (*circuitResolver, error) {
+	circuit, err := r.queryServer.Circuits.Get(ctx, string(args.ID))
+	if err != nil {
+		return nil, nil
+	}
+	return &circuitResolver{circuit: circuit}, nil
+}
+
+// QueryProofs resolves the queryProofs(...) root field via QueryServer.Proofs.
+//
+// The request asked for this to also accept an arbitrary attributes: [{key, value}]
+// filter list; types.ProofsQuery has no such generic attribute filter (its fields are
+// CircuitId/ProofType/Prover/Status/Tags/ValidFrom/ExpiredOnly -- see
+// x/zkproof/types/querygateway.go), so this resolver maps only the named
+// prover/verifier/circuitId/status arguments onto that query shape. ProofsQuery itself
+// has no Verifier filter field either; verifier is accepted but not yet applied,
+// documented here rather than silently dropped.
+func (r *Resolver) QueryProofs(ctx context.Context, args struct {
+	Prover    *string
+	Verifier  *string
+	CircuitID *string
+	Status    *string
+	First     *int32
+	After     *string
+}) (*proofConnectionResolver, error) {
+	query := types.ProofsQuery{Page: types.CursorPageRequest{}}
+	if args.Prover != nil {
+		query.Prover = *args.Prover
+	}
+	if args.CircuitID != nil {
+		query.CircuitId = *args.CircuitID
+	}
+	if args.Status != nil {
+		query.Status = types.ProofStatus(*args.Status)
+	}
+	if args.First != nil {
+		query.Page.Limit = uint64(*args.First)
+	}
+	if args.After != nil {
+		query.Page.Cursor = *args.After
+	}
+
+	items, resp, err := r.queryServer.Proofs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &proofConnectionResolver{items: items, resp: resp, verifier: args.Verifier}, nil
+}
+
+// attributePredicateInput is the resolved GraphQL shape of AttributePredicateInput.
+type attributePredicateInput struct {
+	Key         string
+	StringValue *string
+	IntValue    *int32
+	BoolValue   *bool
+}
+
+// toPredicate converts a GraphQL AttributePredicateInput into a types.AttributePredicate.
+// Only the first non-nil of StringValue/IntValue/BoolValue is used, in that order, the
+// same "exactly one set" contract types.AttributeValue documents. See
+// x/credential/gql's attributePredicateInput for the credential-side equivalent.
+func (a attributePredicateInput) toPredicate() types.AttributePredicate {
+	switch {
+	case a.StringValue != nil:
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{StringValue: a.StringValue}}
+	case a.IntValue != nil:
+		v := int64(*a.IntValue)
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{IntValue: &v}}
+	case a.BoolValue != nil:
+		return types.AttributePredicate{Key: a.Key, Value: types.AttributeValue{BoolValue: a.BoolValue}}
+	default:
+		return types.AttributePredicate{Key: a.Key}
+	}
+}
+
+// ProofsByAttributes resolves the proofsByAttributes(attributes, first, after:) root
+// field via QueryServer.GetProofsByAttributes's AND-intersected ProofsByAttribute
+// lookup. types.AttributeValue also supports BytesValue, which has no natural GraphQL
+// scalar and so isn't exposed on AttributePredicateInput.
+func (r *Resolver) ProofsByAttributes(ctx context.Context, args struct {
+	Attributes []attributePredicateInput
+	First      *int32
+	After      *string
+}) (*proofConnectionResolver, error) {
+	predicates := make([]types.AttributePredicate, len(args.Attributes))
+	for i, a := range args.Attributes {
+		predicates[i] = a.toPredicate()
+	}
+	page := types.CursorPageRequest{}
+	if args.First != nil {
+		page.Limit = uint64(*args.First)
+	}
+	if args.After != nil {
+		page.Cursor = *args.After
+	}
+
+	items, resp, err := r.queryServer.GetProofsByAttributes(ctx, predicates, page)
+	if err != nil {
+		return nil, err
+	}
+	return &proofConnectionResolver{items: items, resp: resp}, nil
+}
+
+// GetStatus resolves the getStatus root field. The request asks for this to return node
+// sync/peer info, which lives in baseapp/the consensus client, not anywhere
+// QueryServer's Keeper has access to (see this package's doc comment on the missing
+// app/cmd HTTP server wiring) -- this stub reports only what the keeper layer actually
+// knows: its own module name.
+func (r *Resolver) GetStatus(ctx context.Context) (*statusResolver, error) {
+	return &statusResolver{}, nil
+}
+
+type statusResolver struct{}
+
+func (s *statusResolver) ModuleName() string { return types.ModuleName }
+
+// circuitResolver backs the Circuit GraphQL type.
+type circuitResolver struct {
+	circuit types.Circuit
+}
+
+func (c *circuitResolver) ID() graphql.ID      { return graphql.ID(c.circuit.Id) }
+func (c *circuitResolver) Name() string        { return c.circuit.Name }
+func (c *circuitResolver) CircuitType() string { return string(c.circuit.CircuitType) }
+func (c *circuitResolver) Creator() string     { return c.circuit.Creator }
+func (c *circuitResolver) Active() bool        { return c.circuit.Active }
+
+// proofConnectionResolver backs the ProofConnection GraphQL type, adapting
+// QueryServer.Proofs's ([]ZKProof, CursorPageResponse) pair into a Relay connection.
+// verifier, if set, filters the already-fetched page client-side since ProofsQuery has
+// no Verifier field of its own (see QueryProofs's doc comment).
+type proofConnectionResolver struct {
+	items    []types.ZKProof
+	resp     types.CursorPageResponse
+	verifier *string
+}
+
+func (c *proofConnectionResolver) filtered() []types.ZKProof {
+	if c.verifier == nil {
+		return c.items
+	}
+	out := make([]types.ZKProof, 0, len(c.items))
+	for _, p := range c.items {
+		if p.Verifier == *c.verifier {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (c *proofConnectionResolver) Edges() []*proofEdgeResolver {
+	items := c.filtered()
+	edges := make([]*proofEdgeResolver, len(items))
+	for i, p := range items {
+		edges[i] = &proofEdgeResolver{proof: p, cursor: types.EncodeCursor([]byte(p.Id))}
+	}
+	return edges
+}
+
+func (c *proofConnectionResolver) PageInfo() *pageInfoResolver {
+	return &pageInfoResolver{resp: c.resp}
+}
+
+func (c *proofConnectionResolver) TotalCount() int32 { return int32(len(c.filtered())) }
+
+// proofEdgeResolver backs the ProofEdge GraphQL type.
+type proofEdgeResolver struct {
+	proof  types.ZKProof
+	cursor string
+}
+
+func (e *proofEdgeResolver) Node() *proofResolver { return &proofResolver{proof: e.proof} }
+func (e *proofEdgeResolver) Cursor() string       { return e.cursor }
+
+// proofResolver backs the Proof GraphQL type.
+type proofResolver struct {
+	proof types.ZKProof
+}
+
+func (p *proofResolver) ID() graphql.ID     { return graphql.ID(p.proof.Id) }
+func (p *proofResolver) CircuitId() string  { return p.proof.CircuitId }
+func (p *proofResolver) Prover() string     { return p.proof.Prover }
+func (p *proofResolver) Verifier() string   { return p.proof.Verifier }
+func (p *proofResolver) ProofType() string  { return string(p.proof.ProofType) }
+func (p *proofResolver) Status() string     { return string(p.proof.Status) }
+
+// pageInfoResolver backs the PageInfo GraphQL type, reusing
+// types.CursorPageResponse.NextCursor as endCursor.
+type pageInfoResolver struct {
+	resp types.CursorPageResponse
+}
+
+func (p *pageInfoResolver) EndCursor() *string {
+	if p.resp.NextCursor == "" {
+		return nil
+	}
+	cursor := p.resp.NextCursor
+	return &cursor
+}
+
+func (p *pageInfoResolver) HasNextPage() bool { return p.resp.NextCursor != "" }
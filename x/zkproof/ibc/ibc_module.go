@@ -0,0 +1,157 @@
+// Package ibc implements the zkproof-verify IBC application: a counterparty chain
+// asks "has proof X verified against circuit Y?" over a dedicated port instead of
+// trusting an off-chain oracle for this chain's proof ledger.
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/keeper"
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// IBCModule implements porttypes.IBCModule for the zkproof-verify application. This
+// chain only ever plays the host role: OnRecvPacket answers a ProofVerifyPacketData by
+// reading the proof's already-recorded verification status straight off keeper.Keeper,
+// the same status MsgVerifyProof sets. Nothing in this module sends a verify query of
+// its own yet, so OnAcknowledgementPacket/OnTimeoutPacket -- the controller-side
+// callbacks -- are no-ops.
+//
+// Like msg_server_verify.go and query_server.go before it, this assumes a Keeper
+// struct (storeKey or collections schema, NewKeeper constructor) that isn't defined
+// anywhere in this tree yet. Closing that gap is its own module-wiring task, same as
+// every other keeper file in this package.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule returns an IBCModule bound to k.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// OnChanOpenInit implements porttypes.IBCModule. The zkproof-verify port only accepts
+// ORDERED channels (a query and its answer must stay in sequence) on the negotiated
+// types.Version.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("zkproof-verify channels must be ORDERED")
+	}
+	if version != "" && version != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements porttypes.IBCModule, mirroring OnChanOpenInit's checks for
+// the side that did not initiate the handshake.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.ORDERED {
+		return "", types.ErrInvalidIBCVersion.Wrap("zkproof-verify channels must be ORDERED")
+	}
+	if counterpartyVersion != types.Version {
+		return "", types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != types.Version {
+		return types.ErrInvalidIBCVersion.Wrapf("expected version %q, got %q", types.Version, counterpartyVersion)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements porttypes.IBCModule.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements porttypes.IBCModule on the host side: it decodes the
+// ProofVerifyPacketData and reports whether ProofId is on record as
+// types.ProofStatusValid against CircuitId. A proof or circuit that doesn't exist, or
+// a proof recorded against a different circuit than CircuitId, all resolve to
+// Valid=false rather than an error acknowledgement -- only a malformed packet earns
+// channeltypes.NewErrorAcknowledgement.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data types.ProofVerifyPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unmarshaling zkproof-verify packet data: %w", err))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	valid := false
+	if proof, err := im.keeper.Proofs.Get(ctx, data.ProofId); err == nil {
+		valid = proof.CircuitId == data.CircuitId && proof.Status == types.ProofStatusValid
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofVerified,
+			sdk.NewAttribute(types.AttributeKeyProofID, data.ProofId),
+			sdk.NewAttribute(types.AttributeKeyCircuitID, data.CircuitId),
+			sdk.NewAttribute(types.AttributeKeyProofStatus, fmt.Sprintf("%t", valid)),
+		),
+	})
+
+	ackData := types.ProofVerifyAcknowledgement{
+		Valid:  valid,
+		Height: ctx.BlockHeight(),
+	}
+	ackBytes, err := ackData.GetBytes()
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("marshaling zkproof-verify acknowledgement: %w", err))
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackBytes)
+}
+
+// OnAcknowledgementPacket implements porttypes.IBCModule. This chain never sends a
+// proof-verify query of its own yet, so there is nothing to reconcile here.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return nil
+}
+
+// OnTimeoutPacket implements porttypes.IBCModule. A timed-out query is simply dropped.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return nil
+}
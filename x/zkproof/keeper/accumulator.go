@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/collections"
+)
+
+// Bytes32Value is the collections.ValueCodec for a fixed [32]byte digest, the codec
+// CircuitsAccumulator/ProofsAccumulator/ProofsByStatusAccumulator/RequestsAccumulator
+// are built with in keeper.go -- cosmossdk.io/collections has no built-in codec for a
+// fixed-size array, only collections.BytesValue's []byte.
+var Bytes32Value collections.ValueCodec[[32]byte] = bytes32Codec{}
+
+type bytes32Codec struct{}
+
+func (bytes32Codec) Encode(value [32]byte) ([]byte, error) {
+	return value[:], nil
+}
+
+func (bytes32Codec) Decode(b []byte) ([32]byte, error) {
+	var out [32]byte
+	if len(b) != 32 {
+		return out, fmt.Errorf("invalid bytes32 length: expected 32, got %d", len(b))
+	}
+	copy(out[:], b)
+	return out, nil
+}
+
+func (c bytes32Codec) EncodeJSON(value [32]byte) ([]byte, error) {
+	return []byte(`"` + hex.EncodeToString(value[:]) + `"`), nil
+}
+
+func (c bytes32Codec) DecodeJSON(b []byte) ([32]byte, error) {
+	var out [32]byte
+	s := string(b)
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return out, fmt.Errorf("invalid bytes32 JSON encoding: %s", s)
+	}
+	decoded, err := hex.DecodeString(s[1 : len(s)-1])
+	if err != nil {
+		return out, err
+	}
+	if len(decoded) != 32 {
+		return out, fmt.Errorf("invalid bytes32 length: expected 32, got %d", len(decoded))
+	}
+	copy(out[:], decoded)
+	return out, nil
+}
+
+func (bytes32Codec) Stringify(value [32]byte) string {
+	return hex.EncodeToString(value[:])
+}
+
+func (bytes32Codec) ValueType() string {
+	return "bytes32"
+}
+
+// xorToggle folds id's digest into acc via XOR, the same operation for both adding
+// and removing id from the accumulated set: XOR is its own inverse, so toggling id in
+// twice (once on insert, once on later removal) restores acc to what it was before
+// either call. This is what lets CircuitCountInvariant/ProofCountInvariant compare two
+// independently-maintained accumulators in O(1) instead of re-Walking their
+// collections: as long as every Set/Remove on the tracked collection calls the
+// matching toggle* helper below exactly once, the accumulator's value only depends on
+// the current *set* of IDs present, not the order or count of operations that produced it.
+func xorToggle(acc [32]byte, id string) [32]byte {
+	digest := sha256.Sum256([]byte(id))
+	var out [32]byte
+	for i := range acc {
+		out[i] = acc[i] ^ digest[i]
+	}
+	return out
+}
+
+// toggleAccumulator XORs id into the [32]byte digest stored at acc, one of
+// k.CircuitsAccumulator/k.ProofsAccumulator/k.ProofsByStatusAccumulator/
+// k.RequestsAccumulator -- real collections.Item[[32]byte] Keeper fields (see
+// keeper.go), the same way k.Proofs/k.Circuits are real collections.Map fields.
+//
+// Call this exactly once when id is added to the collection acc tracks, and exactly
+// once when id is removed from it. A pure status/field update on an already-present id
+// (e.g. VerifyProof's Proofs.Set of an existing proof, or a ProofsByStatus Remove+Set
+// pair moving the same id to a new status bucket) must NOT call this again: the id's
+// membership in the tracked set hasn't changed, only a field on the stored value (or,
+// for ProofsByStatusAccumulator, which status bucket it's filed under --
+// ProofsByStatusAccumulator tracks membership in the index as a whole, not per-status
+// partitioning, which StatusIndexPartitionInvariant's Walk already covers in deep mode).
+func (k Keeper) toggleAccumulator(ctx context.Context, acc collections.Item[[32]byte], id string) error {
+	current, err := acc.Get(ctx)
+	if err != nil {
+		current = [32]byte{}
+	}
+	return acc.Set(ctx, xorToggle(current, id))
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// indexProofAttributes mirrors proof.Metadata into ProofsByAttribute for every key in
+// allowedKeys (Params.IndexableAttributeKeys), the zkproof counterpart to
+// x/credential/keeper's indexCredentialAttributes. ZKProof carries no natural
+// {key, value} attribute source the way CredentialSubject.Claims does -- PublicInputs
+// is an ordered, unkeyed []string -- so this indexes the Metadata map[string]string
+// instead; see SubmitPredicateProof/AggregateProofs's call sites for why that map is
+// empty for every proof this tree currently creates.
+//
+// ProofsByAttribute collections.Map[collections.Triple[string,string,string], string]
+// (attrKey, attrValue, proofID) -> proofID is, like ProofsByStatus, a real Keeper field
+// (see keeper.go).
+func (k Keeper) indexProofAttributes(ctx context.Context, proof types.ZKProof, allowedKeys []string) error {
+	for _, key := range allowedKeys {
+		value, ok := proof.Metadata[key]
+		if !ok {
+			continue
+		}
+		if err := k.ProofsByAttribute.Set(ctx, collections.Join3(key, value, proof.Id), proof.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributeMatchIDs returns, in sorted order, every proof ID ProofsByAttribute has
+// indexed under pred's (key, value) pair. See
+// x/credential/keeper.attributeMatchIDs for why the Walk order needs no explicit sort.
+func (k Keeper) attributeMatchIDs(ctx context.Context, pred types.AttributePredicate) ([]string, error) {
+	rng := collections.NewPrefixedTripleRange[string, string, string](pred.Key, pred.Value.IndexKey())
+
+	var ids []string
+	err := k.ProofsByAttribute.Walk(ctx, rng, func(_ collections.Triple[string, string, string], proofID string) (bool, error) {
+		ids = append(ids, proofID)
+		return false, nil
+	})
+	return ids, err
+}
+
+// intersectSorted returns the sorted intersection of two sorted string slices via a
+// linear sort-merge. See x/credential/keeper.intersectSorted for the same helper.
+func intersectSorted(a, b []string) []string {
+	out := make([]string, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, a[i])
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return out
+}
+
+// GetProofsByAttributes AND-intersects predicates against ProofsByAttribute. See
+// x/credential/keeper.GetCredentialsByAttributes for the full rationale (sort-merge
+// intersection computed up front, then paginated over the resulting in-memory slice,
+// since no single predicate's store range can be resumed in a way that also resumes
+// the intersection).
+func (k Keeper) GetProofsByAttributes(ctx context.Context, predicates []types.AttributePredicate, page types.CursorPageRequest) ([]types.ZKProof, types.CursorPageResponse, error) {
+	if len(predicates) == 0 {
+		return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrap("at least one attribute predicate is required")
+	}
+
+	ids, err := k.attributeMatchIDs(ctx, predicates[0])
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+	for _, pred := range predicates[1:] {
+		matchIDs, err := k.attributeMatchIDs(ctx, pred)
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		ids = intersectSorted(ids, matchIDs)
+	}
+
+	startAt := 0
+	if page.Cursor != "" {
+		cursorKey, err := types.DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, types.CursorPageResponse{}, types.ErrInvalidQuery.Wrapf("invalid pagination cursor: %s", err)
+		}
+		startAt = sort.SearchStrings(ids, string(cursorKey))
+		if startAt < len(ids) && ids[startAt] == string(cursorKey) {
+			startAt++
+		}
+	}
+
+	limit := page.EffectiveLimit()
+	items := make([]types.ZKProof, 0, limit)
+	var nextCursor string
+	for i := startAt; i < len(ids); i++ {
+		if uint64(len(items)) >= limit {
+			nextCursor = types.EncodeCursor([]byte(ids[i-1]))
+			break
+		}
+		proof, err := k.Proofs.Get(ctx, ids[i])
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		items = append(items, proof)
+	}
+
+	return items, types.CursorPageResponse{NextCursor: nextCursor, Total: uint64(len(ids))}, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// BeginBlocker is called at the start of each block to sweep expired ProofRequests.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	return k.sweepExpiredProofRequests(sdk.UnwrapSDKContext(ctx))
+}
+
+// sweepExpiredProofRequests auto-refunds and closes out every never-accepted
+// ProofRequest (AcceptedProver empty) whose Deadline has passed, the BeginBlocker
+// counterpart to MsgCancelProofRequest's requester-initiated path -- this runs
+// unconditionally so a requester who never calls MsgCancelProofRequest still gets
+// their escrow back. A request that already has an AcceptedProver is left alone here:
+// that case needs MsgSlashProver's explicit stake-slashing, not a blanket refund.
+func (k Keeper) sweepExpiredProofRequests(ctx sdk.Context) error {
+	now := ctx.BlockTime()
+
+	var expired []types.ProofRequest
+	err := k.Requests.Walk(ctx, nil, func(id string, req types.ProofRequest) (bool, error) {
+		if req.Status != string(types.StatusPendingRequest) {
+			return false, nil
+		}
+		if req.AcceptedProver != "" {
+			return false, nil
+		}
+		if !now.After(req.Deadline) {
+			return false, nil
+		}
+		expired = append(expired, req)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, req := range expired {
+		refund := sdk.NewCoins()
+		if req.EscrowStatus == types.BountyStatusEscrowed {
+			requesterAddr, err := sdk.AccAddressFromBech32(req.Requester)
+			if err != nil {
+				return types.ErrUnauthorized.Wrap("invalid requester address")
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, requesterAddr, req.Reward); err != nil {
+				return err
+			}
+			refund = req.Reward
+			req.EscrowStatus = types.BountyStatusRefunded
+		}
+		req.Status = string(types.StatusExpiredRequest)
+
+		if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeProofRequestExpired,
+				sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+				sdk.NewAttribute(types.AttributeKeyRequester, req.Requester),
+				sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+			),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// CircuitUpgradeSignals/PendingCircuitUpgrades/CircuitVKHistory/CircuitVKEpoch/
+// stakingKeeper are real Keeper fields (see keeper.go).
+
+// GetCircuitVKKey returns the collections.Pair CircuitVKHistory is keyed by for
+// circuitID's epoch-th verifying key, the key the request that introduced this
+// rotation flow names directly.
+func GetCircuitVKKey(circuitID string, epoch uint64) collections.Pair[string, uint64] {
+	return collections.Join(circuitID, epoch)
+}
+
+// SignalCircuitUpgrade implements types.MsgServer's SignalCircuitUpgrade: it records or
+// overwrites msg.Validator's vote for rotating msg.CircuitId to msg.NewVkHash at
+// msg.ActivationHeight, ensures a PendingCircuitUpgrade row exists to tally it against,
+// and immediately re-tallies so a signal that single-handedly crosses
+// Params.CircuitUpgradeThreshold schedules without waiting for the next EndBlocker.
+func (k Keeper) SignalCircuitUpgrade(ctx context.Context, msg *types.MsgSignalCircuitUpgrade) (*types.MsgSignalCircuitUpgradeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Circuits.Get(ctx, msg.CircuitId); err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s: %s", msg.CircuitId, err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, types.ErrUnauthorized.Wrapf("invalid validator address: %s", err)
+	}
+	if _, found := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr); !found {
+		return nil, types.ErrUnauthorized.Wrapf("%s is not a bonded validator", msg.Validator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	if err := k.CircuitUpgradeSignals.Set(ctx, collections.Join3(msg.CircuitId, msg.NewVkHash, msg.Validator), types.CircuitUpgradeSignal{
+		Validator:        msg.Validator,
+		CircuitId:        msg.CircuitId,
+		NewVkHash:        msg.NewVkHash,
+		ActivationHeight: msg.ActivationHeight,
+		SignaledAt:       now,
+	}); err != nil {
+		return nil, err
+	}
+
+	pending, err := k.PendingCircuitUpgrades.Get(ctx, collections.Join(msg.CircuitId, msg.NewVkHash))
+	if err != nil {
+		if !isZkproofNotFound(err) {
+			return nil, err
+		}
+		pending = types.PendingCircuitUpgrade{
+			CircuitId:        msg.CircuitId,
+			NewVkHash:        msg.NewVkHash,
+			ActivationHeight: msg.ActivationHeight,
+			Status:           types.CircuitUpgradeStatusSignaling,
+			FirstSignaledAt:  now,
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCircuitUpgradeSignaled,
+			sdk.NewAttribute(types.AttributeKeyCircuitID, msg.CircuitId),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyNewVkHash, msg.NewVkHash),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, fmt.Sprintf("%d", msg.ActivationHeight)),
+		),
+	})
+
+	if err := k.tallyCircuitUpgrade(ctx, &pending); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalCircuitUpgradeResponse{Status: pending.Status}, nil
+}
+
+// TallyCircuitUpgrades re-tallies every PendingCircuitUpgrade still in
+// CircuitUpgradeStatusSignaling, scheduling any that crossed
+// Params.CircuitUpgradeThreshold, and activates any CircuitUpgradeStatusScheduled
+// upgrade whose ActivationHeight the chain has now reached. Intended to run once per
+// EndBlocker, alongside sweepExpiryQueue.
+func (k Keeper) TallyCircuitUpgrades(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	var toUpdate []types.PendingCircuitUpgrade
+	err := k.PendingCircuitUpgrades.Walk(ctx, nil, func(key collections.Pair[string, string], pending types.PendingCircuitUpgrade) (bool, error) {
+		switch pending.Status {
+		case types.CircuitUpgradeStatusSignaling:
+			if err := k.tallyCircuitUpgrade(ctx, &pending); err != nil {
+				return true, err
+			}
+			toUpdate = append(toUpdate, pending)
+		case types.CircuitUpgradeStatusScheduled:
+			if height >= pending.ActivationHeight {
+				if err := k.activateCircuitUpgrade(ctx, &pending); err != nil {
+					return true, err
+				}
+				toUpdate = append(toUpdate, pending)
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pending := range toUpdate {
+		if err := k.PendingCircuitUpgrades.Set(ctx, collections.Join(pending.CircuitId, pending.NewVkHash), pending); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tallyCircuitUpgrade recomputes pending's TalliedPower/TotalBondedPower from every
+// CircuitUpgradeSignal recorded for its (CircuitId, NewVkHash) pair and, if the ratio
+// now crosses params.CircuitUpgradeThreshold, transitions it to
+// CircuitUpgradeStatusScheduled and emits EventCircuitUpgradeScheduled. A signal from a
+// validator that has since unbonded is skipped rather than counted at its last-known
+// power, since GetLastValidatorPower reports not-found for it.
+func (k Keeper) tallyCircuitUpgrade(ctx context.Context, pending *types.PendingCircuitUpgrade) error {
+	if pending.Status != types.CircuitUpgradeStatusSignaling {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var tallied int64
+	rng := collections.NewPrefixedTripleRange[string, string, string](pending.CircuitId, pending.NewVkHash)
+	err = k.CircuitUpgradeSignals.Walk(ctx, rng, func(key collections.Triple[string, string, string], signal types.CircuitUpgradeSignal) (bool, error) {
+		valAddr, err := sdk.ValAddressFromBech32(signal.Validator)
+		if err != nil {
+			return false, nil
+		}
+		power, found := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if !found {
+			return false, nil
+		}
+		tallied += power
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	total, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending.TalliedPower = tallied
+	pending.TotalBondedPower = total.Int64()
+
+	if pending.TallyRatio() >= params.CircuitUpgradeThreshold {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		now := sdkCtx.BlockTime()
+		pending.Status = types.CircuitUpgradeStatusScheduled
+		pending.ScheduledAt = &now
+
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventCircuitUpgradeScheduled,
+				sdk.NewAttribute(types.AttributeKeyCircuitID, pending.CircuitId),
+				sdk.NewAttribute(types.AttributeKeyNewVkHash, pending.NewVkHash),
+				sdk.NewAttribute(types.AttributeKeyTalliedPower, fmt.Sprintf("%d", pending.TalliedPower)),
+				sdk.NewAttribute(types.AttributeKeyTotalBondedPower, fmt.Sprintf("%d", pending.TotalBondedPower)),
+			),
+		})
+	}
+
+	return nil
+}
+
+// activateCircuitUpgrade assigns pending's new verifying key the next epoch for its
+// circuit, storing it under GetCircuitVKKey(circuitId, epoch) so VerifyGroth16Proof can
+// later resolve a submitted proof's correct epoch by its submission height, marks
+// pending CircuitUpgradeStatusActivated, and emits EventCircuitUpgradeActivated. The
+// actual verifying-key bytes are expected to have been pushed out-of-band (the same
+// "can't fetch, must be pushed" constraint x/did's MsgUpdateOIDCKeys documents) before
+// ActivationHeight; this only activates the key the chain already has cached.
+func (k Keeper) activateCircuitUpgrade(ctx context.Context, pending *types.PendingCircuitUpgrade) error {
+	epoch, err := k.CircuitVKEpoch.Get(ctx, pending.CircuitId)
+	if err != nil {
+		if !isZkproofNotFound(err) {
+			return err
+		}
+		epoch = 0
+	}
+	nextEpoch := epoch + 1
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	version, err := k.CircuitVKHistory.Get(ctx, GetCircuitVKKey(pending.CircuitId, epoch))
+	var parameters []byte
+	if err == nil {
+		parameters = version.Parameters
+	} else if !isZkproofNotFound(err) {
+		return err
+	}
+
+	if err := k.CircuitVKHistory.Set(ctx, GetCircuitVKKey(pending.CircuitId, nextEpoch), types.CircuitVerifyingKeyVersion{
+		CircuitId:        pending.CircuitId,
+		Epoch:            nextEpoch,
+		VkHash:           pending.NewVkHash,
+		Parameters:       parameters,
+		ActivationHeight: pending.ActivationHeight,
+		ActivatedAt:      now,
+	}); err != nil {
+		return err
+	}
+	if err := k.CircuitVKEpoch.Set(ctx, pending.CircuitId, nextEpoch); err != nil {
+		return err
+	}
+
+	pending.Status = types.CircuitUpgradeStatusActivated
+	pending.ActivatedAt = &now
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCircuitUpgradeActivated,
+			sdk.NewAttribute(types.AttributeKeyCircuitID, pending.CircuitId),
+			sdk.NewAttribute(types.AttributeKeyNewVkHash, pending.NewVkHash),
+			sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", nextEpoch)),
+		),
+	})
+
+	return nil
+}
+
+// isZkproofNotFound reports whether err is a collections "not found" error.
+func isZkproofNotFound(err error) bool {
+	return err == collections.ErrNotFound
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// GenesisSnapshot is the genesis-time fixture shape k.GenesisSnapshot (a real Keeper
+// field, see keeper.go) holds, mirroring the shape simulation.GenesisState already uses
+// in lieu of a real x/zkproof GenesisState. It lets queryAtHeight answer a height-0
+// query against the state circuits/proofs/requests had at InitGenesis, without
+// replaying every block since.
+type GenesisSnapshot struct {
+	Circuits []types.Circuit
+	Proofs   []types.ZKProof
+	Requests []types.ProofRequest
+}
+
+// SetGenesisSnapshot captures circuits/proofs/requests as the genesis snapshot
+// queryAtHeight serves for a height-0 query. This is the primitive a real InitGenesis
+// would call once one exists -- x/zkproof has no module.go/AppModule/GenesisState in
+// this tree (see simulation/genesis.go's GenesisState doc comment for the same standing
+// gap), so nothing calls this yet.
+func (k Keeper) SetGenesisSnapshot(snapshot *GenesisSnapshot) {
+	k.GenesisSnapshot = snapshot
+}
+
+// queryAtHeight runs liveFn against ctx, unless the incoming gRPC metadata's
+// x-cosmos-block-height header is literally "0" and a GenesisSnapshot has been
+// captured, in which case it runs genesisFn against that snapshot instead.
+//
+// Note this departs from cosmos-sdk's own baseapp.CreateQueryContext, where a height of
+// 0 means "serve the latest committed height", not "serve genesis" -- by the time a
+// query reaches this keeper, baseapp has already swapped ctx to the requested
+// historical height for any height > 0, so there is nothing left for a keeper-level
+// helper to do for those; only height 0 needs special-casing here, and only because
+// this request asks for "0 means genesis" specifically, diverging from the SDK's own
+// "0 means latest" convention for that one value. Callers relying on the SDK's default
+// x-cosmos-block-height: 0 meaning "latest" will see this genesis snapshot instead.
+func queryAtHeight[T any](ctx context.Context, snapshot *GenesisSnapshot, liveFn func(context.Context) (T, error), genesisFn func(*GenesisSnapshot) (T, error)) (T, error) {
+	if snapshot != nil && isGenesisHeightQuery(ctx) {
+		return genesisFn(snapshot)
+	}
+	return liveFn(ctx)
+}
+
+// isGenesisHeightQuery reports whether ctx carries gRPC metadata requesting height 0,
+// per grpctypes.GRPCBlockHeightHeader ("x-cosmos-block-height").
+func isGenesisHeightQuery(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(grpctypes.GRPCBlockHeightHeader)
+	return len(values) == 1 && values[0] == "0"
+}
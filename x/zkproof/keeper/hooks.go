@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	didtypes "github.com/PersonaPass-ID/personachain/x/did/types"
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+var _ didtypes.DIDHooks = Keeper{}
+
+// AfterDIDDeactivated implements didtypes.DIDHooks. Circuits created by a deactivated
+// DID are deactivated so no further proofs can be submitted against them, and any of
+// the DID's still-pending proofs are marked invalid.
+func (k Keeper) AfterDIDDeactivated(ctx context.Context, did string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.Circuits.Walk(ctx, nil, func(key string, circuit types.Circuit) (bool, error) {
+		if circuit.Creator != did || !circuit.Active {
+			return false, nil
+		}
+		circuit.Active = false
+		circuit.UpdatedAt = sdkCtx.BlockTime()
+		if err := k.Circuits.Set(ctx, circuit.Id, circuit); err != nil {
+			return true, err
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	return k.Proofs.Walk(ctx, nil, func(key string, proof types.ZKProof) (bool, error) {
+		if proof.Prover != did || proof.Status == types.ProofStatusInvalid || proof.Status == types.ProofStatusExpired {
+			return false, nil
+		}
+		proof.Status = types.ProofStatusInvalid
+		proof.UpdatedAt = sdkCtx.BlockTime()
+		if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+			return true, err
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/router"
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the
+// various parts of the state machine. This struct was missing entirely until now:
+// msg_server_verify.go, invariants.go, attributes.go, accumulator.go,
+// circuit_upgrade.go, expiry_queue.go, genesis.go, genesis_stream.go,
+// oracle_binding.go, msg_server_predicate.go, and every other file in this package were
+// written against an assumed Keeper shape documented in each file's own doc comments
+// rather than a real type, which meant nothing in this package actually compiled. The
+// field set below is taken directly from those doc comments rather than redesigned
+// from scratch, so the methods written against the assumed shape need no further
+// changes.
+//
+// Modeled on x/credential/keeper/keeper.go and x/did/keeper/keeper.go's
+// KVStoreService-backed collections.Schema layout, the same newer-era convention this
+// package's methods already assume (k.Circuits.Get/Set/Walk rather than manual
+// byte-key construction).
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	logger       log.Logger
+	Schema       collections.Schema
+
+	Params collections.Item[types.Params]
+
+	Circuits   collections.Map[string, types.Circuit]
+	Proofs     collections.Map[string, types.ZKProof]
+	Requests   collections.Map[string, types.ProofRequest]
+	Ceremonies collections.Map[string, types.Ceremony]
+
+	ProofsByStatus    collections.Map[collections.Pair[string, string], string]
+	ProofsByStateRoot collections.Map[collections.Pair[string, string], string]
+	ProofsByAttribute collections.Map[collections.Triple[string, string, string], string]
+
+	// CircuitsAccumulator/ProofsAccumulator/ProofsByStatusAccumulator/
+	// RequestsAccumulator are each an XOR digest of every id currently a member of the
+	// collection they track -- see accumulator.go's toggleAccumulator.
+	CircuitsAccumulator       collections.Item[[32]byte]
+	ProofsAccumulator         collections.Item[[32]byte]
+	ProofsByStatusAccumulator collections.Item[[32]byte]
+	RequestsAccumulator       collections.Item[[32]byte]
+
+	// ExpiryQueue stores types.ExpiryQueueKey(proof.ValidTo, proof.Id) -> proof.Id --
+	// see expiry_queue.go.
+	ExpiryQueue collections.Map[[]byte, string]
+
+	CircuitUpgradeSignals  collections.Map[collections.Triple[string, string, string], types.CircuitUpgradeSignal]
+	PendingCircuitUpgrades collections.Map[collections.Pair[string, string], types.PendingCircuitUpgrade]
+	CircuitVKHistory       collections.Map[collections.Pair[string, uint64], types.CircuitVerifyingKeyVersion]
+	CircuitVKEpoch         collections.Map[string, uint64]
+
+	// VerifierRegistry resolves a proof type to the types.Verifier backend
+	// VerifyProof dispatches ProofData through -- see verifier_registry.go.
+	VerifierRegistry *VerifierRegistry
+
+	// PreMsgHandlers/PostMsgHandlers dispatch the RFC-006 pre/post message handler
+	// pattern around a zkproof Msg -- see x/zkproof/router.
+	PreMsgHandlers  *router.PreMsgHandlerRouter
+	PostMsgHandlers *router.PostMsgHandlerRouter
+
+	// GenesisSnapshot serves a height-0 query against InitGenesis's fixture -- see
+	// genesis.go's SetGenesisSnapshot/queryAtHeight. nil until set.
+	GenesisSnapshot *GenesisSnapshot
+
+	// External keepers, narrowed to this tree's expected_keepers.go interfaces rather
+	// than concrete x/auth, x/bank, x/staking, x/oracle, x/schema keeper types.
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	oracleKeeper  types.OracleKeeper
+	schemaKeeper  types.SchemaKeeper
+
+	// authority is the address capable of executing governance proposals
+	// (UpdateParams).
+	authority string
+}
+
+// NewKeeper creates a new zkproof Keeper instance backed by storeService.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	oracleKeeper types.OracleKeeper,
+	schemaKeeper types.SchemaKeeper,
+) *Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := &Keeper{
+		cdc:             cdc,
+		storeService:    storeService,
+		logger:          log.NewNopLogger(),
+		authority:       authority,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		stakingKeeper:   stakingKeeper,
+		oracleKeeper:    oracleKeeper,
+		schemaKeeper:    schemaKeeper,
+		PreMsgHandlers:  router.NewPreMsgHandlerRouter(),
+		PostMsgHandlers: router.NewPostMsgHandlerRouter(),
+
+		Params: collections.NewItem(sb, collections.NewPrefix(0), "params", codec.CollValue[types.Params](cdc)),
+
+		Circuits:   collections.NewMap(sb, collections.NewPrefix(1), "circuits", collections.StringKey, codec.CollValue[types.Circuit](cdc)),
+		Proofs:     collections.NewMap(sb, collections.NewPrefix(2), "proofs", collections.StringKey, codec.CollValue[types.ZKProof](cdc)),
+		Requests:   collections.NewMap(sb, collections.NewPrefix(3), "requests", collections.StringKey, codec.CollValue[types.ProofRequest](cdc)),
+		Ceremonies: collections.NewMap(sb, collections.NewPrefix(4), "ceremonies", collections.StringKey, codec.CollValue[types.Ceremony](cdc)),
+
+		ProofsByStatus:    collections.NewMap(sb, collections.NewPrefix(10), "proofs_by_status", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		ProofsByStateRoot: collections.NewMap(sb, collections.NewPrefix(11), "proofs_by_state_root", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.StringValue),
+		ProofsByAttribute: collections.NewMap(sb, collections.NewPrefix(12), "proofs_by_attribute", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), collections.StringValue),
+
+		CircuitsAccumulator:       collections.NewItem(sb, collections.NewPrefix(20), "circuits_accumulator", Bytes32Value),
+		ProofsAccumulator:         collections.NewItem(sb, collections.NewPrefix(21), "proofs_accumulator", Bytes32Value),
+		ProofsByStatusAccumulator: collections.NewItem(sb, collections.NewPrefix(22), "proofs_by_status_accumulator", Bytes32Value),
+		RequestsAccumulator:       collections.NewItem(sb, collections.NewPrefix(23), "requests_accumulator", Bytes32Value),
+
+		ExpiryQueue: collections.NewMap(sb, collections.NewPrefix(30), "expiry_queue", collections.BytesKey, collections.StringValue),
+
+		CircuitUpgradeSignals:  collections.NewMap(sb, collections.NewPrefix(40), "circuit_upgrade_signals", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), codec.CollValue[types.CircuitUpgradeSignal](cdc)),
+		PendingCircuitUpgrades: collections.NewMap(sb, collections.NewPrefix(41), "pending_circuit_upgrades", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.PendingCircuitUpgrade](cdc)),
+		CircuitVKHistory:       collections.NewMap(sb, collections.NewPrefix(42), "circuit_vk_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.CircuitVerifyingKeyVersion](cdc)),
+		CircuitVKEpoch:         collections.NewMap(sb, collections.NewPrefix(43), "circuit_vk_epoch", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	k.VerifierRegistry = DefaultVerifierRegistry(NewZKVerificationService(k))
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the module's current params, falling back to DefaultParams if none
+// have been set yet.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams(), nil
+	}
+	return params, nil
+}
+
+// SetParams persists the module's params.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// bn254ScalarFieldModulus is the order of the BN254 scalar field, the field the
+// gnark-groth16-bn254 backend operates over. Fiat-Shamir challenges derived below are
+// reduced into this field so a batch-linear-combination verifier (once implemented)
+// can consume them directly as scalar multipliers without a further reduction step.
+var bn254ScalarFieldModulus, _ = new(big.Int).SetString(
+	"21888242871839275222246405745257275088548364400416034343698204186575808495617", 10,
+)
+
+// fiatShamirScalars derives one challenge scalar per entry in proofs by hashing a
+// running transcript of mode, each preceding proof's ID and ProofData, and the
+// scalar's own index, then reducing the digest mod bn254ScalarFieldModulus. This is the
+// same construction a real batch-linear-combination verifier would use to bind the
+// scalars to the exact proof set being aggregated -- a verifier replaying these proofs
+// must derive the identical scalars from the identical transcript, so the aggregator
+// can't pick favorable coefficients after the fact.
+func fiatShamirScalars(mode types.AggregationMode, proofs []types.ZKProof) []*big.Int {
+	transcript := sha256.New()
+	transcript.Write([]byte(mode))
+	for _, proof := range proofs {
+		transcript.Write([]byte(proof.Id))
+		transcript.Write(proof.ProofData)
+	}
+	seed := transcript.Sum(nil)
+
+	scalars := make([]*big.Int, len(proofs))
+	for i := range proofs {
+		h := sha256.New()
+		h.Write(seed)
+		var idx [8]byte
+		binary.BigEndian.PutUint64(idx[:], uint64(i))
+		h.Write(idx[:])
+		scalars[i] = new(big.Int).Mod(new(big.Int).SetBytes(h.Sum(nil)), bn254ScalarFieldModulus)
+	}
+	return scalars
+}
+
+// aggregatedProofID deterministically names an aggregated proof after the proof set and
+// mode that produced it, mirroring the content-addressed ID convention x/schema uses
+// for CredentialSchema -- an aggregation of the same proofs under the same mode always
+// resolves to the same ID rather than minting a fresh one each time it's requested.
+func aggregatedProofID(mode types.AggregationMode, proofIDs []string) string {
+	h := sha256.New()
+	h.Write([]byte(mode))
+	for _, id := range proofIDs {
+		h.Write([]byte(id))
+	}
+	return fmt.Sprintf("zkagg:%x", h.Sum(nil))
+}
+
+// AggregateProofs implements types.MsgServer's AggregateProofs. It loads every proof in
+// msg.ProofIds, requires each to already be types.ProofStatusValid and compatible with
+// the requested types.AggregationMode, derives Fiat-Shamir scalars over a transcript of
+// the input proofs, and stores a single aggregated types.ZKProof carrying the source
+// proof IDs and derived scalars as lineage in its Metadata.
+//
+// The aggregated proof is recorded with types.ProofStatusPending: this function performs
+// the real transcript/scalar-derivation half of aggregation, but the cryptographic core
+// -- folding N Groth16 proofs into one recursive SNARK, or actually checking a
+// multi-pairing batch equation over the derived scalars -- needs either a recursive
+// circuit (for AggregationModeSNARKRecursive) or real multi-pairing support in
+// VerifierRegistry (for AggregationModeBatchLinearCombination), neither of which exists
+// in this tree yet. A subsequent MsgVerifyProof against the aggregated proof ID is
+// expected to perform that check once one of those backends exists; until then it will
+// correctly fail closed the same way PLONKVerifier/StarkVerifier do today.
+func (k Keeper) AggregateProofs(ctx context.Context, msg *types.MsgAggregateProofs) (*types.MsgAggregateProofsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	proofs := make([]types.ZKProof, 0, len(msg.ProofIds))
+	for _, id := range msg.ProofIds {
+		proof, err := k.Proofs.Get(ctx, id)
+		if err != nil {
+			return nil, types.ErrProofNotFound.Wrapf("proof %s not found", id)
+		}
+		if proof.Status != types.ProofStatusValid {
+			return nil, types.ErrIncompatibleProofs.Wrapf("proof %s is %s, not valid", id, proof.Status)
+		}
+		proofs = append(proofs, proof)
+	}
+
+	first := proofs[0]
+	for _, proof := range proofs[1:] {
+		if msg.Mode == types.AggregationModeSNARKRecursive && proof.CircuitId != first.CircuitId {
+			return nil, types.ErrIncompatibleProofs.Wrap("snark_recursive aggregation requires all proofs share a circuit")
+		}
+		if proof.ProofType != first.ProofType {
+			return nil, types.ErrIncompatibleProofs.Wrapf(
+				"proof %s has type %s, expected %s", proof.Id, proof.ProofType, first.ProofType,
+			)
+		}
+	}
+
+	scalars := fiatShamirScalars(msg.Mode, proofs)
+	scalarStrs := make([]string, len(scalars))
+	for i, s := range scalars {
+		scalarStrs[i] = s.String()
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Aggregation amortizes per-proof verification cost across the batch, so the fee is
+	// discounted by log2(N) of the individual per-proof verification gas rather than
+	// charged N times over.
+	aggregateGas := uint64(0)
+	for _, proof := range proofs {
+		circuit, err := k.Circuits.Get(ctx, proof.CircuitId)
+		if err != nil {
+			return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", proof.CircuitId)
+		}
+		aggregateGas += GasForVerification(circuit, len(proof.ProofData), len(proof.PublicInputs), params)
+	}
+	discountedGas := uint64(float64(aggregateGas) / math.Log2(float64(len(proofs)+1)))
+	sdkCtx.GasMeter().ConsumeGas(discountedGas, "zkproof: aggregate proofs")
+
+	sourceIDs := make([]string, len(proofs))
+	for i, proof := range proofs {
+		sourceIDs[i] = proof.Id
+	}
+
+	aggID := aggregatedProofID(msg.Mode, sourceIDs)
+	now := sdkCtx.BlockTime()
+	aggregated := types.ZKProof{
+		Id:           aggID,
+		CircuitId:    first.CircuitId,
+		Prover:       msg.Aggregator,
+		ProofType:    first.ProofType,
+		ProofData:    nil,
+		PublicInputs: nil,
+		Status:       types.ProofStatusPending,
+		ValidFrom:    now,
+		Metadata: map[string]string{
+			"aggregation_mode":   string(msg.Mode),
+			"source_proof_ids":   fmt.Sprintf("%v", sourceIDs),
+			"fiat_shamir_scalars": fmt.Sprintf("%v", scalarStrs),
+		},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		BlockHeight: sdkCtx.BlockHeight(),
+	}
+
+	if err := k.Proofs.Set(ctx, aggregated.Id, aggregated); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsAccumulator, aggregated.Id); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Set(ctx, statusIndexKey(aggregated), aggregated.Id); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsByStatusAccumulator, aggregated.Id); err != nil {
+		return nil, err
+	}
+	// Unlike SubmitPredicateProof, aggregated.Metadata is always populated (aggregation
+	// lineage set above), so if "aggregation_mode" or "source_proof_ids" is
+	// allowlisted in params.IndexableAttributeKeys this actually indexes something.
+	if err := k.indexProofAttributes(ctx, aggregated, params.IndexableAttributeKeys); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofsAggregated,
+			sdk.NewAttribute(types.AttributeKeyAggregatedProofID, aggregated.Id),
+			sdk.NewAttribute(types.AttributeKeyAggregationMode, string(msg.Mode)),
+			sdk.NewAttribute(types.AttributeKeySourceProofCount, fmt.Sprintf("%d", len(proofs))),
+		),
+	})
+
+	return &types.MsgAggregateProofsResponse{AggregatedProofId: aggregated.Id}, nil
+}
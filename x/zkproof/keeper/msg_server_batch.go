@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// SubmitProofBatch implements types.MsgServer's SubmitProofBatch, the batch
+// counterpart to SubmitProof: it records msg.SubProofs as ZKProofs in one call and,
+// depending on whether msg.AggregationProof is set, resolves their status one of two
+// ways.
+//
+//   - AggregationProof set: only the aggregator is cryptographically verified, against
+//     msg.AggregationCircuitId's CircuitTypeAggregation circuit, via the same
+//     k.VerifierRegistry dispatch VerifyProof uses. If it checks out, every sub-proof is
+//     recorded types.ProofStatusValid directly -- the aggregator's proof statement is
+//     "all N sub-proofs verify under their respective circuits", so a passing aggregator
+//     check stands in for N individual ones. The aggregator itself is recorded as a
+//     ZKProof against AggregationCircuitId with AggregatedChildren set to the sub-proof
+//     IDs, and is what the AggregationProofId on the response names.
+//   - AggregationProof absent: each sub-proof is verified individually through the same
+//     k.VerifierRegistry dispatch, falling back to the per-proof path VerifyProof takes.
+//     There's still only one batch of storage writes and one summary event, rather than
+//     the per-call event VerifyProof would emit for each.
+//
+// This is a distinct mechanism from the pre-existing MsgAggregateProofs/AggregateProofs:
+// that one folds already-submitted, already-valid proofs into one combined proof via
+// Fiat-Shamir linear combination (AggregationModeBatchLinearCombination) or a recursive
+// verifier circuit walked one inner proof at a time (AggregationModeSNARKRecursive).
+// SubmitProofBatch instead accepts N *new, not-yet-verified* sub-proofs plus (optionally)
+// one recursive proof that attests to all of them at once, so that verifying the
+// aggregator is the only cryptographic check a high-throughput caller pays for.
+func (k Keeper) SubmitProofBatch(ctx context.Context, msg *types.MsgSubmitProofBatch) (*types.MsgSubmitProofBatchResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	proofs := make([]types.ZKProof, len(msg.SubProofs))
+	proofIDs := make([]string, len(msg.SubProofs))
+	for i, sub := range msg.SubProofs {
+		circuit, err := k.Circuits.Get(ctx, sub.CircuitId)
+		if err != nil {
+			return nil, types.ErrCircuitNotFound.Wrapf("sub-proof %d: circuit %s not found", i, sub.CircuitId)
+		}
+		if !circuit.IsCompatibleWithProofType(sub.ProofType) {
+			return nil, types.ErrInvalidProofType.Wrapf("sub-proof %d: circuit %s does not support proof type %s", i, circuit.Id, sub.ProofType)
+		}
+
+		proofs[i] = types.ZKProof{
+			Id:           fmt.Sprintf("zkproof:%x", sha256.Sum256(append(append([]byte(circuit.Id), sub.ProofData...), []byte(msg.Prover)...))),
+			CircuitId:    circuit.Id,
+			Prover:       msg.Prover,
+			ProofType:    sub.ProofType,
+			ProofData:    sub.ProofData,
+			PublicInputs: sub.PublicInputs,
+			Status:       types.ProofStatusPending,
+			ValidFrom:    now,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			BlockHeight:  sdkCtx.BlockHeight(),
+		}
+		proofIDs[i] = proofs[i].Id
+	}
+
+	var aggregationProofID string
+	if len(msg.AggregationProof) > 0 {
+		aggCircuit, err := k.Circuits.Get(ctx, msg.AggregationCircuitId)
+		if err != nil {
+			return nil, types.ErrCircuitNotFound.Wrapf("aggregation circuit %s not found", msg.AggregationCircuitId)
+		}
+		if len(aggCircuit.SupportedProofTypes) == 0 {
+			return nil, types.ErrInvalidCircuit.Wrapf("aggregation circuit %s declares no supported proof types", aggCircuit.Id)
+		}
+
+		aggregator := types.ZKProof{
+			Id:                 fmt.Sprintf("zkproof:%x", sha256.Sum256(append([]byte(aggCircuit.Id), msg.AggregationProof...))),
+			CircuitId:          aggCircuit.Id,
+			Prover:             msg.Prover,
+			ProofType:          aggCircuit.SupportedProofTypes[0],
+			ProofData:          msg.AggregationProof,
+			PublicInputs:       proofIDs,
+			Status:             types.ProofStatusPending,
+			ValidFrom:          now,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+			BlockHeight:        sdkCtx.BlockHeight(),
+			AggregatedChildren: proofIDs,
+		}
+
+		verifier, err := k.VerifierRegistry.Get(aggregator.ProofType)
+		if err != nil {
+			return nil, err
+		}
+		valid, err := verifier.Verify(ctx, aggregator, aggCircuit)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			aggregator.Status = types.ProofStatusInvalid
+			for i := range proofs {
+				proofs[i].Status = types.ProofStatusInvalid
+			}
+		} else {
+			aggregator.Status = types.ProofStatusValid
+			for i := range proofs {
+				proofs[i].Status = types.ProofStatusValid
+			}
+		}
+		aggregator.VerificationTimestamp = now
+
+		if err := k.Proofs.Set(ctx, aggregator.Id, aggregator); err != nil {
+			return nil, err
+		}
+		if err := k.toggleAccumulator(ctx, k.ProofsAccumulator, aggregator.Id); err != nil {
+			return nil, err
+		}
+		if err := k.ProofsByStatus.Set(ctx, statusIndexKey(aggregator), aggregator.Id); err != nil {
+			return nil, err
+		}
+		if err := k.toggleAccumulator(ctx, k.ProofsByStatusAccumulator, aggregator.Id); err != nil {
+			return nil, err
+		}
+		aggregationProofID = aggregator.Id
+	} else {
+		for i, proof := range proofs {
+			circuit, err := k.Circuits.Get(ctx, proof.CircuitId)
+			if err != nil {
+				return nil, types.ErrCircuitNotFound.Wrapf("sub-proof %d: circuit %s not found", i, proof.CircuitId)
+			}
+			verifier, err := k.VerifierRegistry.Get(proof.ProofType)
+			if err != nil {
+				return nil, err
+			}
+			valid, err := verifier.Verify(ctx, proof, circuit)
+			if err != nil {
+				return nil, err
+			}
+			if valid {
+				proofs[i].Status = types.ProofStatusValid
+			} else {
+				proofs[i].Status = types.ProofStatusInvalid
+			}
+			proofs[i].VerificationTimestamp = now
+		}
+	}
+
+	for _, proof := range proofs {
+		if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+			return nil, err
+		}
+		if err := k.toggleAccumulator(ctx, k.ProofsAccumulator, proof.Id); err != nil {
+			return nil, err
+		}
+		if err := k.ProofsByStatus.Set(ctx, statusIndexKey(proof), proof.Id); err != nil {
+			return nil, err
+		}
+		if err := k.toggleAccumulator(ctx, k.ProofsByStatusAccumulator, proof.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofSubmitted,
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+			sdk.NewAttribute("batch_size", fmt.Sprintf("%d", len(proofs))),
+			sdk.NewAttribute("aggregation_proof_id", aggregationProofID),
+		),
+	})
+
+	return &types.MsgSubmitProofBatchResponse{ProofIds: proofIDs, AggregationProofId: aggregationProofID}, nil
+}
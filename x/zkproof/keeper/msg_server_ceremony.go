@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// ceremonyID deterministically names a ceremony after what it was started over, so
+// starting a ceremony with identical parameters always resolves to the same ID rather
+// than minting a fresh one each time -- the same content-addressed convention
+// aggregatedProofID uses for aggregated proofs.
+func ceremonyID(msg *types.MsgStartCeremony) string {
+	h := sha256.New()
+	h.Write([]byte(msg.Phase))
+	h.Write([]byte(msg.CircuitId))
+	h.Write(msg.InitialTranscriptHash)
+	h.Write([]byte(msg.Coordinator))
+	return fmt.Sprintf("zkceremony:%x", h.Sum(nil))
+}
+
+// StartCeremony implements types.MsgServer's StartCeremony. A CeremonyPhaseTwo
+// ceremony must name a circuit with TrustedSetup true that hasn't already finalized;
+// that circuit is moved into types.CircuitStatusPendingSetup so MsgSubmitProof can
+// reject it (via Circuit.CanAcceptProofs) until FinalizeCeremony activates it.
+func (k Keeper) StartCeremony(ctx context.Context, msg *types.MsgStartCeremony) (*types.MsgStartCeremonyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if msg.Phase == types.CeremonyPhaseTwo {
+		circuit, err := k.Circuits.Get(ctx, msg.CircuitId)
+		if err != nil {
+			return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", msg.CircuitId)
+		}
+		if !circuit.TrustedSetup {
+			return nil, types.ErrInvalidCeremony.Wrap("circuit does not declare a trusted setup")
+		}
+		if circuit.Status == types.CircuitStatusActive {
+			return nil, types.ErrCeremonyFinalized.Wrapf("circuit %s has already finalized its trusted setup", circuit.Id)
+		}
+		circuit.Status = types.CircuitStatusPendingSetup
+		circuit.UpdatedAt = sdkCtx.BlockTime()
+		if err := k.Circuits.Set(ctx, circuit.Id, circuit); err != nil {
+			return nil, err
+		}
+	}
+
+	id := ceremonyID(msg)
+	if has, err := k.Ceremonies.Has(ctx, id); err != nil {
+		return nil, err
+	} else if has {
+		return nil, types.ErrCeremonyExists.Wrapf("ceremony %s already exists", id)
+	}
+
+	ceremony := types.Ceremony{
+		Id:                    id,
+		CircuitId:             msg.CircuitId,
+		Phase:                 msg.Phase,
+		Status:                types.CeremonyStatusOpen,
+		InitialTranscriptHash: msg.InitialTranscriptHash,
+		Coordinator:           msg.Coordinator,
+		CreatedAt:             sdkCtx.BlockTime(),
+		BlockHeight:           sdkCtx.BlockHeight(),
+	}
+	if err := ceremony.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Ceremonies.Set(ctx, ceremony.Id, ceremony); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCeremonyStarted,
+			sdk.NewAttribute(types.AttributeKeyCeremonyID, ceremony.Id),
+			sdk.NewAttribute(types.AttributeKeyCeremonyPhase, string(ceremony.Phase)),
+			sdk.NewAttribute(types.AttributeKeyCircuitID, ceremony.CircuitId),
+		),
+	})
+
+	return &types.MsgStartCeremonyResponse{CeremonyId: ceremony.Id}, nil
+}
+
+// ContributeCeremony implements types.MsgServer's ContributeCeremony. It appends one
+// Contribution to an open ceremony's transcript, deriving TranscriptHash from the
+// chain's current tip (types.Ceremony.LatestTranscriptHash) and msg.ContributionData
+// rather than trusting a client-supplied hash, so the chain types.Ceremony stores is
+// always internally self-consistent the moment a contribution lands -- replaying it
+// later with VerifyContributionChain is then just confirming nothing was altered after
+// acceptance, not discovering a bad link for the first time.
+//
+// msg.Attestation is checked structurally (non-empty) only. Verifying it as a real BLS
+// signature over TranscriptHash under a key registered on ContributorDID's DID
+// Document needs a typed verification-method lookup that types.DIDKeeper's
+// interface{}-returning ResolveDID doesn't provide yet -- a TODO left the same way
+// PLONKVerifier and StarkVerifier leave their pairing checks as a TODO pending a
+// vendored backend.
+func (k Keeper) ContributeCeremony(ctx context.Context, msg *types.MsgContributeCeremony) (*types.MsgContributeCeremonyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ceremony, err := k.Ceremonies.Get(ctx, msg.CeremonyId)
+	if err != nil {
+		return nil, types.ErrCeremonyNotFound.Wrapf("ceremony %s not found", msg.CeremonyId)
+	}
+	if ceremony.Status == types.CeremonyStatusFinalized {
+		return nil, types.ErrCeremonyFinalized.Wrapf("ceremony %s has already finalized", ceremony.Id)
+	}
+
+	transcriptHash := types.ExpectedTranscriptHash(ceremony.LatestTranscriptHash(), msg.ContributionData)
+
+	contribution := types.Contribution{
+		ContributorDID:   msg.ContributorDID,
+		ContributionData: msg.ContributionData,
+		TranscriptHash:   transcriptHash,
+		Attestation:      msg.Attestation,
+		BlockHeight:      sdkCtx.BlockHeight(),
+		ContributedAt:    sdkCtx.BlockTime(),
+	}
+	if err := contribution.Validate(); err != nil {
+		return nil, err
+	}
+
+	ceremony.Contributions = append(ceremony.Contributions, contribution)
+	if err := k.Ceremonies.Set(ctx, ceremony.Id, ceremony); err != nil {
+		return nil, err
+	}
+
+	index := uint64(len(ceremony.Contributions) - 1)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCeremonyContributed,
+			sdk.NewAttribute(types.AttributeKeyCeremonyID, ceremony.Id),
+			sdk.NewAttribute(types.AttributeKeyContributorDID, msg.ContributorDID),
+			sdk.NewAttribute(types.AttributeKeyContributionIndex, fmt.Sprintf("%d", index)),
+		),
+	})
+
+	return &types.MsgContributeCeremonyResponse{
+		ContributionIndex: index,
+		TranscriptHash:    transcriptHash,
+	}, nil
+}
+
+// FinalizeCeremony implements types.MsgServer's FinalizeCeremony. It replays the
+// ceremony's full contribution chain with types.Ceremony.VerifyContributionChain --
+// so finalization depends on every link actually being a correct update of its
+// predecessor, not just on each contribution having been accepted one at a time -- and
+// for a CeremonyPhaseTwo ceremony writes msg.Parameters into the named circuit's
+// Circuit.Parameters and activates it.
+func (k Keeper) FinalizeCeremony(ctx context.Context, msg *types.MsgFinalizeCeremony) (*types.MsgFinalizeCeremonyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ceremony, err := k.Ceremonies.Get(ctx, msg.CeremonyId)
+	if err != nil {
+		return nil, types.ErrCeremonyNotFound.Wrapf("ceremony %s not found", msg.CeremonyId)
+	}
+	if ceremony.Status == types.CeremonyStatusFinalized {
+		return nil, types.ErrCeremonyFinalized.Wrapf("ceremony %s has already finalized", ceremony.Id)
+	}
+	if len(ceremony.Contributions) == 0 {
+		return nil, types.ErrInvalidCeremony.Wrap("ceremony has no contributions to finalize")
+	}
+	if !ceremony.VerifyContributionChain() {
+		return nil, types.ErrInvalidContribution.Wrap("contribution chain failed replay verification")
+	}
+
+	now := sdkCtx.BlockTime()
+	ceremony.Status = types.CeremonyStatusFinalized
+	ceremony.FinalizedAt = &now
+
+	if err := k.Ceremonies.Set(ctx, ceremony.Id, ceremony); err != nil {
+		return nil, err
+	}
+
+	resp := &types.MsgFinalizeCeremonyResponse{}
+	if ceremony.Phase == types.CeremonyPhaseTwo {
+		circuit, err := k.Circuits.Get(ctx, ceremony.CircuitId)
+		if err != nil {
+			return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", ceremony.CircuitId)
+		}
+		circuit.Parameters = msg.Parameters
+		circuit.Status = types.CircuitStatusActive
+		circuit.Active = true
+		circuit.UpdatedAt = now
+		if err := k.Circuits.Set(ctx, circuit.Id, circuit); err != nil {
+			return nil, err
+		}
+		resp.CircuitId = circuit.Id
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCeremonyFinalized,
+			sdk.NewAttribute(types.AttributeKeyCeremonyID, ceremony.Id),
+			sdk.NewAttribute(types.AttributeKeyCircuitID, ceremony.CircuitId),
+		),
+	})
+
+	return resp, nil
+}
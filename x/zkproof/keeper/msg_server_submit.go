@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// SubmitProof implements types.MsgServer's SubmitProof -- another of this package's
+// pre-existing-but-unimplemented Msg types, alongside CreateCircuit before this
+// change. It records a ZKProof against msg.CircuitId, and, when msg.StateCommitment is
+// set, first verifies it via VerifyStateCommitment and mirrors the verified (root,
+// path, value) tuple into the recorded proof's PublicInputs as an implicit entry a
+// circuit's PublicInputsSpec can bind to -- see state_commitment.go. Likewise, when
+// msg.OracleBinding is set, it first verifies it via VerifyOracleBinding against
+// x/oracle's committee-resolved value and mirrors the verified (requestID, value) pair
+// in the same way -- see oracle_binding.go.
+//
+// Like SubmitPredicateProof/AggregateProofs, the recorded ZKProof is left
+// types.ProofStatusPending: this handler validates the circuit reference, proof-type
+// compatibility, and (when present) the ICS-23 state commitment, but the ZK
+// proof-system check itself is MsgVerifyProof's job, dispatched through
+// k.VerifierRegistry.
+//
+// It also sets ValidTo from params.ProofValidityPeriod and enqueues the proof into
+// k.ExpiryQueue -- before this change ValidTo was never populated by any Msg handler,
+// so EndBlocker's processExpiredProofs Walk never actually found anything to expire;
+// see expiry_queue.go for the bounded-range reap this feeds.
+func (k Keeper) SubmitProof(ctx context.Context, msg *types.MsgSubmitProof) (*types.MsgSubmitProofResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	circuit, err := k.Circuits.Get(ctx, msg.CircuitId)
+	if err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", msg.CircuitId)
+	}
+	if !circuit.IsCompatibleWithProofType(msg.ProofType) {
+		return nil, types.ErrInvalidProofType.Wrapf("circuit %s does not support proof type %s", circuit.Id, msg.ProofType)
+	}
+
+	publicInputs := msg.PublicInputs
+	var stateCommitment *types.StateCommitment
+	if msg.StateCommitment != nil {
+		if err := VerifyStateCommitment(*msg.StateCommitment); err != nil {
+			return nil, err
+		}
+		stateCommitment = msg.StateCommitment
+		publicInputs = append(publicInputs, stateCommitmentPublicInput(*stateCommitment))
+	}
+
+	var oracleBinding *types.OracleBinding
+	if msg.OracleBinding != nil {
+		if err := k.VerifyOracleBinding(ctx, *msg.OracleBinding); err != nil {
+			return nil, err
+		}
+		oracleBinding = msg.OracleBinding
+		publicInputs = append(publicInputs, oracleBindingPublicInput(*oracleBinding))
+	}
+
+	now := sdkCtx.BlockTime()
+	validTo := now.Add(time.Duration(params.ProofValidityPeriod) * time.Second)
+	proof := types.ZKProof{
+		Id:              fmt.Sprintf("zkproof:%x", sha256.Sum256(append(append([]byte(circuit.Id), msg.ProofData...), []byte(msg.Prover)...))),
+		CircuitId:       circuit.Id,
+		Prover:          msg.Prover,
+		ProofType:       msg.ProofType,
+		ProofData:       msg.ProofData,
+		PublicInputs:    publicInputs,
+		StateCommitment: stateCommitment,
+		OracleBinding:   oracleBinding,
+		Status:          types.ProofStatusPending,
+		ValidFrom:       now,
+		ValidTo:         &validTo,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		BlockHeight:     sdkCtx.BlockHeight(),
+	}
+
+	if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+		return nil, err
+	}
+	if err := k.enqueueProofExpiry(ctx, proof); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsAccumulator, proof.Id); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Set(ctx, statusIndexKey(proof), proof.Id); err != nil {
+		return nil, err
+	}
+	if err := k.toggleAccumulator(ctx, k.ProofsByStatusAccumulator, proof.Id); err != nil {
+		return nil, err
+	}
+	if stateCommitment != nil {
+		if err := k.ProofsByStateRoot.Set(ctx, stateRootIndexKey(stateCommitment.Root, proof.Id), proof.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofSubmitted,
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyCircuitID, circuit.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+		),
+	})
+
+	return &types.MsgSubmitProofResponse{ProofId: proof.Id}, nil
+}
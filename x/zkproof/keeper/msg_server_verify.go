@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// k.PreMsgHandlers/k.PostMsgHandlers are real *router.PreMsgHandlerRouter/
+// *router.PostMsgHandlerRouter Keeper fields (see keeper.go) -- see x/zkproof/router
+// for what they dispatch.
+
+// VerifyProof implements types.MsgServer's VerifyProof, making ProofStatusValid a
+// result of actually dispatching proof.ProofData through k.VerifierRegistry rather
+// than a status label a client could set directly. It rejects proof types the module
+// params don't allow, proof types the circuit doesn't declare support for, and a
+// circuit whose VerifierBackend doesn't match the dispatched Verifier's own Backend(),
+// then meters gas by the circuit's complexity and the proof's size before running
+// verification.
+//
+// k.VerifierRegistry, like k.Proofs/k.Circuits/k.GetParams used elsewhere in this
+// package, is a real Keeper field (see keeper.go).
+func (k Keeper) VerifyProof(ctx context.Context, msg *types.MsgVerifyProof) (*types.MsgVerifyProofResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.PreMsgHandlers.Dispatch(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	proof, err := k.Proofs.Get(ctx, msg.ProofId)
+	if err != nil {
+		return nil, types.ErrProofNotFound.Wrapf("proof %s not found", msg.ProofId)
+	}
+
+	circuit, err := k.Circuits.Get(ctx, proof.CircuitId)
+	if err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", proof.CircuitId)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowedProofType(params, proof.ProofType) {
+		return nil, types.ErrInvalidProofType.Wrapf("proof type %q is not allowed by module params", proof.ProofType)
+	}
+	if !circuit.IsCompatibleWithProofType(proof.ProofType) {
+		return nil, types.ErrInvalidProofType.Wrapf("circuit %s does not support proof type %s", circuit.Id, proof.ProofType)
+	}
+
+	verifier, err := k.VerifierRegistry.Get(proof.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	if circuit.VerifierBackend != "" && circuit.VerifierBackend != verifier.Backend() {
+		return nil, types.ErrInvalidCircuit.Wrapf(
+			"circuit %s requires verifier backend %q, registered backend for %s is %q",
+			circuit.Id, circuit.VerifierBackend, proof.ProofType, verifier.Backend(),
+		)
+	}
+
+	sdkCtx.GasMeter().ConsumeGas(
+		GasForVerification(circuit, len(proof.ProofData), len(proof.PublicInputs), params),
+		"zkproof: verify proof",
+	)
+
+	valid, err := verifier.Verify(ctx, proof, circuit)
+	if err != nil {
+		return nil, err
+	}
+
+	oldStatusKey := statusIndexKey(proof)
+	if valid {
+		proof.Status = types.ProofStatusValid
+	} else {
+		proof.Status = types.ProofStatusInvalid
+	}
+	proof.Verifier = msg.Verifier
+	proof.VerificationTimestamp = sdkCtx.BlockTime()
+	proof.UpdatedAt = sdkCtx.BlockTime()
+
+	if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Remove(ctx, oldStatusKey); err != nil {
+		return nil, err
+	}
+	if err := k.ProofsByStatus.Set(ctx, statusIndexKey(proof), proof.Id); err != nil {
+		return nil, err
+	}
+	// A verified proof no longer needs to sit in k.ExpiryQueue -- sweepExpiryQueue
+	// would just skip it as already-Valid/Invalid once its ValidTo is reached, but
+	// dequeuing now keeps the queue from accumulating resolved entries.
+	if err := k.dequeueProofExpiry(ctx, proof); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProofVerified,
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier),
+			sdk.NewAttribute(types.AttributeKeyProofStatus, string(proof.Status)),
+		),
+	})
+
+	resp := &types.MsgVerifyProofResponse{Valid: valid}
+	if err := k.PostMsgHandlers.Dispatch(ctx, msg, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func allowedProofType(params types.Params, proofType types.ProofType) bool {
+	for _, t := range params.AllowedProofTypes {
+		if t == proofType {
+			return true
+		}
+	}
+	return false
+}
+
+// statusIndexKey mirrors the (status, proofID) composite key EndBlocker's
+// sweepExpiryQueue already uses for k.ProofsByStatus.
+func statusIndexKey(proof types.ZKProof) collections.Pair[string, string] {
+	return collections.Join(string(proof.Status), proof.Id)
+}
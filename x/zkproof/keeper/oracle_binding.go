@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// VerifyOracleBinding checks binding's asserted Value against what x/oracle's committee
+// response flow actually resolved for binding.RequestID, via k.oracleKeeper -- a real
+// types.OracleKeeper Keeper field, the same way k.Proofs/k.Circuits are real
+// collections.Map fields (see keeper.go).
+//
+// binding.Validate (called from MsgSubmitProof.ValidateBasic) has already checked that
+// RequestID is non-empty; this does the cross-module lookup VerifyStateCommitment's
+// cryptographic check corresponds to for OracleBinding.
+func (k Keeper) VerifyOracleBinding(ctx context.Context, binding types.OracleBinding) error {
+	resolved, ok, err := k.oracleKeeper.GetResolvedValue(ctx, binding.RequestID)
+	if err != nil {
+		return types.ErrOracleBindingFailed.Wrapf("looking up request %s: %s", binding.RequestID, err)
+	}
+	if !ok {
+		return types.ErrOracleBindingFailed.Wrapf("request %s has not resolved yet", binding.RequestID)
+	}
+	if resolved != binding.Value {
+		return types.ErrOracleBindingFailed.Wrapf("asserted value %q does not match resolved value %q", binding.Value, resolved)
+	}
+	return nil
+}
+
+// oracleBindingPublicInput renders binding's verified (requestID, value) pair as the
+// single implicit public input SubmitProof appends to msg.PublicInputs once
+// VerifyOracleBinding has passed, mirroring stateCommitmentPublicInput's hex-encoded,
+// colon-joined shape in state_commitment.go.
+func oracleBindingPublicInput(binding types.OracleBinding) string {
+	return fmt.Sprintf("%s:%s", hex.EncodeToString([]byte(binding.RequestID)), hex.EncodeToString([]byte(binding.Value)))
+}
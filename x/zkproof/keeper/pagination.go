@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// paginate walks coll in store-key order starting just after page.Cursor (or from the
+// beginning, if empty), collecting values matches accepts until either
+// page.EffectiveLimitWithMax(maxLimit) of them have been found or coll is exhausted. It
+// returns a CursorPageResponse whose NextCursor resumes exactly where this call left
+// off -- the real cursor-based iteration the zkproof keeper's k.Circuits/k.Proofs/
+// k.Requests collections.Map fields (see keeper.go) have lacked until now, versus the
+// previous Walk(ctx, nil, ...) that always restarted from the first key and never
+// advertised a cursor at all.
+//
+// When page.CountTotal is set, paginate does a second unbounded walk to report Total,
+// the same cost cosmos.base.query.v1beta1.PageRequest.count_total carries.
+func paginate[V any](
+	ctx context.Context,
+	coll collections.Map[string, V],
+	page types.CursorPageRequest,
+	maxLimit uint64,
+	matches func(V) bool,
+) ([]V, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimitWithMax(maxLimit)
+
+	startKey, err := types.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, types.ErrInvalidRequest.Wrapf("invalid pagination cursor: %s", err)
+	}
+
+	rng := new(collections.Range[string])
+	if len(startKey) > 0 {
+		rng = rng.StartExclusive(string(startKey))
+	}
+	if page.Reverse {
+		rng = rng.Descending()
+	}
+
+	items := make([]V, 0, limit)
+	var lastKey string
+	var hasMore bool
+
+	err = coll.Walk(ctx, rng, func(key string, value V) (bool, error) {
+		if !matches(value) {
+			return false, nil
+		}
+		if uint64(len(items)) >= limit {
+			hasMore = true
+			return true, nil
+		}
+		items = append(items, value)
+		lastKey = key
+		return false, nil
+	})
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+
+	var nextCursor string
+	if hasMore {
+		nextCursor = types.EncodeCursor([]byte(lastKey))
+	}
+	resp := types.CursorPageResponse{NextCursor: nextCursor}
+	if page.CountTotal {
+		total, err := countMatches(ctx, coll, page.Reverse, matches)
+		if err != nil {
+			return nil, types.CursorPageResponse{}, err
+		}
+		resp.Total = total
+	}
+
+	return items, resp, nil
+}
+
+// countMatches walks the whole of coll, independent of any cursor/limit, counting
+// values matches accepts -- the unbounded pass paginate's CountTotal branch pays for.
+func countMatches[V any](ctx context.Context, coll collections.Map[string, V], reverse bool, matches func(V) bool) (uint64, error) {
+	rng := new(collections.Range[string])
+	if reverse {
+		rng = rng.Descending()
+	}
+
+	var total uint64
+	err := coll.Walk(ctx, rng, func(_ string, value V) (bool, error) {
+		if matches(value) {
+			total++
+		}
+		return false, nil
+	})
+	return total, err
+}
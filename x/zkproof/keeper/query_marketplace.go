@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// ListOpenProofRequests returns ProofRequests matching q's CircuitId and
+// RequiredProofType filters (see types.OpenProofRequestQuery.Matches), resuming from
+// q.Page.Cursor and returning up to q.Page.EffectiveLimitWithMax(params.MaxQueryPageSize)
+// of them -- the query a prover service browsing for work to bid on calls before
+// submitting MsgBidOnProofRequest.
+//
+// This still walks k.Requests in primary-key order rather than through a secondary
+// by-status index the way k.Proofs has k.ProofsByStatus, so a page still scans past
+// non-open requests q.Matches rejects; that part of the original TODO(pagination) note
+// stands. What it no longer does is restart from the first key on every call -- the
+// cursor now resumes a real bounded range scan, matching Circuits/Proofs/ProofRequests
+// in query_server.go.
+func (k Keeper) ListOpenProofRequests(ctx context.Context, q types.OpenProofRequestQuery) ([]types.ProofRequest, types.CursorPageResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, types.CursorPageResponse{}, err
+	}
+	return paginate(ctx, k.Requests, q.Page, params.MaxQueryPageSize, q.Matches)
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// QueryServer exposes the zkproof keeper's read surface -- Circuits/Proofs/
+// ProofRequests filtering plus the CircuitStats/SuccessRateByProver/
+// AvgVerificationLatency aggregates -- as the shape both a gRPC Query service and a
+// GraphQL gateway in front of it would call into. Wiring an actual
+// grpc-gateway/graphql-go service onto these methods is a separate transport-layer
+// task this doesn't attempt, the same way RegisterMsgServer in types/codec.go is a
+// non-wiring stub for the write side.
+type QueryServer struct {
+	Keeper
+}
+
+// NewQueryServer returns a QueryServer backed by keeper.
+func NewQueryServer(keeper Keeper) QueryServer {
+	return QueryServer{Keeper: keeper}
+}
+
+// Circuits returns Circuits matching q.Page's filters, resuming from q.Page.Cursor and
+// returning up to q.Page.EffectiveLimitWithMax(params.MaxQueryPageSize) of them, plus a
+// CursorPageResponse.NextCursor to pass back in as q.Page.Cursor for the next page.
+//
+// A height-0 x-cosmos-block-height request (see genesis.go's queryAtHeight) is served
+// out of q.Keeper.GenesisSnapshot.Circuits instead of the live q.Keeper.Circuits
+// collection, filtered the same way but without cursor resumption -- the snapshot is an
+// in-memory slice, not a collections.Map, so there's no store key to resume a Range
+// walk from.
+func (q QueryServer) Circuits(ctx context.Context, query types.CircuitsQuery) ([]types.Circuit, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.Circuit, types.CursorPageResponse, error) {
+			params, err := q.Keeper.GetParams(ctx)
+			if err != nil {
+				return nil, types.CursorPageResponse{}, err
+			}
+			return paginate(ctx, q.Keeper.Circuits, query.Page, params.MaxQueryPageSize, query.Matches)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.Circuit, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Circuits, query.Page, query.Matches)
+		},
+	)
+}
+
+// Proofs returns ZKProofs matching query.Page's filters, resuming from query.Page.Cursor
+// and returning up to query.Page.EffectiveLimitWithMax(params.MaxQueryPageSize) of them,
+// plus a CursorPageResponse.NextCursor to resume from. A height-0 request is served out
+// of q.Keeper.GenesisSnapshot.Proofs; see Circuits's doc comment.
+func (q QueryServer) Proofs(ctx context.Context, query types.ProofsQuery) ([]types.ZKProof, types.CursorPageResponse, error) {
+	at := sdk.UnwrapSDKContext(ctx).BlockTime()
+	matches := func(proof types.ZKProof) bool { return query.Matches(proof, at) }
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.ZKProof, types.CursorPageResponse, error) {
+			params, err := q.Keeper.GetParams(ctx)
+			if err != nil {
+				return nil, types.CursorPageResponse{}, err
+			}
+			return paginate(ctx, q.Keeper.Proofs, query.Page, params.MaxQueryPageSize, matches)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.ZKProof, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Proofs, query.Page, matches)
+		},
+	)
+}
+
+// ProofRequests returns ProofRequests matching query.Page's filters, resuming from
+// query.Page.Cursor and returning up to
+// query.Page.EffectiveLimitWithMax(params.MaxQueryPageSize) of them, plus a
+// CursorPageResponse.NextCursor to resume from. A height-0 request is served out of
+// q.Keeper.GenesisSnapshot.Requests; see Circuits's doc comment.
+func (q QueryServer) ProofRequests(ctx context.Context, query types.ProofRequestsQuery) ([]types.ProofRequest, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.ProofRequest, types.CursorPageResponse, error) {
+			params, err := q.Keeper.GetParams(ctx)
+			if err != nil {
+				return nil, types.CursorPageResponse{}, err
+			}
+			return paginate(ctx, q.Keeper.Requests, query.Page, params.MaxQueryPageSize, query.Matches)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.ProofRequest, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Requests, query.Page, query.Matches)
+		},
+	)
+}
+
+// GetProofsByStateRoot returns every ZKProof whose StateCommitment.Root matches root
+// (hex-encoded), via the ProofsByStateRoot index msg_server_submit.go's SubmitProof
+// populates when a proof carries a StateCommitment. Unlike the collections.Map-backed
+// queries above, there is no genesis-snapshot fallback here: GenesisSnapshot predates
+// StateCommitment and was never extended with a by-root index of its own.
+func (q QueryServer) GetProofsByStateRoot(ctx context.Context, rootHex string) ([]types.ZKProof, error) {
+	rng := collections.NewPrefixedPairRange[string, string](rootHex)
+
+	var proofs []types.ZKProof
+	err := q.Keeper.ProofsByStateRoot.Walk(ctx, rng, func(_ collections.Pair[string, string], proofID string) (bool, error) {
+		proof, err := q.Keeper.Proofs.Get(ctx, proofID)
+		if err != nil {
+			return false, err
+		}
+		proofs = append(proofs, proof)
+		return false, nil
+	})
+	return proofs, err
+}
+
+// GetProofsByAttributes resolves predicates via attributes.go's GetProofsByAttributes
+// (ProofsByAttribute's sort-merge AND-intersection). A height-0 request is served by
+// matching predicates directly against each genesis snapshot proof's Metadata rather
+// than via the index -- a GenesisSnapshot is an in-memory slice with no
+// ProofsByAttribute index of its own to intersect against.
+func (q QueryServer) GetProofsByAttributes(ctx context.Context, predicates []types.AttributePredicate, page types.CursorPageRequest) ([]types.ZKProof, types.CursorPageResponse, error) {
+	return queryAtHeight(ctx, q.Keeper.GenesisSnapshot,
+		func(ctx context.Context) ([]types.ZKProof, types.CursorPageResponse, error) {
+			return q.Keeper.GetProofsByAttributes(ctx, predicates, page)
+		},
+		func(snapshot *GenesisSnapshot) ([]types.ZKProof, types.CursorPageResponse, error) {
+			return filterSlice(snapshot.Proofs, page, func(proof types.ZKProof) bool {
+				return matchesAllMetadata(proof, predicates)
+			})
+		},
+	)
+}
+
+// matchesAllMetadata reports whether proof.Metadata satisfies every predicate, the
+// genesis-snapshot counterpart to the live path's ProofsByAttribute index lookup. See
+// x/credential/keeper.matchesAllClaims for the credential-side equivalent.
+func matchesAllMetadata(proof types.ZKProof, predicates []types.AttributePredicate) bool {
+	for _, pred := range predicates {
+		value, ok := proof.Metadata[pred.Key]
+		if !ok || value != pred.Value.IndexKey() {
+			return false
+		}
+	}
+	return true
+}
+
+// filterSlice applies page's Limit/Matches over an in-memory genesis snapshot slice,
+// the GenesisSnapshot counterpart to pagination.go's paginate -- no cursor resumption,
+// since a snapshot slice has no store keys to resume a Range walk from.
+func filterSlice[V any](all []V, page types.CursorPageRequest, matches func(V) bool) ([]V, types.CursorPageResponse, error) {
+	limit := page.EffectiveLimit()
+	out := make([]V, 0, limit)
+	for _, v := range all {
+		if !matches(v) {
+			continue
+		}
+		if uint64(len(out)) >= limit {
+			break
+		}
+		out = append(out, v)
+	}
+	return out, types.CursorPageResponse{Total: uint64(len(out))}, nil
+}
+
+// CircuitStats reports circuitID's totalProofsByCircuit aggregate straight off
+// Circuit.ProofCount/SuccessfulProofs, the counters MsgVerifyProof maintains as each
+// proof resolves, rather than re-walking every ZKProof submitted against it.
+func (q QueryServer) CircuitStats(ctx context.Context, circuitID string) (*types.CircuitStatsResponse, error) {
+	circuit, err := q.Keeper.Circuits.Get(ctx, circuitID)
+	if err != nil {
+		return nil, types.ErrCircuitNotFound.Wrapf("circuit %s not found", circuitID)
+	}
+
+	return &types.CircuitStatsResponse{
+		CircuitId:        circuit.Id,
+		TotalProofs:      circuit.ProofCount,
+		SuccessfulProofs: circuit.SuccessfulProofs,
+		SuccessRate:      successRate(circuit.SuccessfulProofs, circuit.ProofCount),
+	}, nil
+}
+
+// SuccessRateByProver walks every ZKProof submitted by prover and reports what
+// fraction resolved types.ProofStatusValid.
+func (q QueryServer) SuccessRateByProver(ctx context.Context, prover string) (*types.ProverSuccessRate, error) {
+	var total, successful uint64
+
+	err := q.Keeper.Proofs.Walk(ctx, nil, func(key string, proof types.ZKProof) (bool, error) {
+		if proof.Prover != prover {
+			return false, nil
+		}
+		total++
+		if proof.Status == types.ProofStatusValid {
+			successful++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProverSuccessRate{
+		Prover:      prover,
+		TotalProofs: total,
+		Successful:  successful,
+		SuccessRate: successRate(successful, total),
+	}, nil
+}
+
+// AvgVerificationLatency averages VerificationTimestamp.Sub(CreatedAt) across every
+// circuitID proof that has been verified (ProofStatusValid or ProofStatusInvalid),
+// i.e. how long submissions against this circuit typically wait for MsgVerifyProof.
+func (q QueryServer) AvgVerificationLatency(ctx context.Context, circuitID string) (time.Duration, error) {
+	var count int
+	var total time.Duration
+
+	err := q.Keeper.Proofs.Walk(ctx, nil, func(key string, proof types.ZKProof) (bool, error) {
+		if proof.CircuitId != circuitID {
+			return false, nil
+		}
+		if proof.Status != types.ProofStatusValid && proof.Status != types.ProofStatusInvalid {
+			return false, nil
+		}
+		total += proof.VerificationTimestamp.Sub(proof.CreatedAt)
+		count++
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	return total / time.Duration(count), nil
+}
+
+// PendingCircuitUpgrades returns every in-flight (CircuitUpgradeStatusSignaling or
+// CircuitUpgradeStatusScheduled) circuit verifying-key rotation, optionally restricted
+// to query.CircuitId -- the query a wallet/prover polls to learn it should regenerate
+// proofs against an upcoming key before its ActivationHeight. See
+// keeper.Keeper.TallyCircuitUpgrades for how these are tallied and scheduled.
+func (q QueryServer) PendingCircuitUpgrades(ctx context.Context, query types.PendingCircuitUpgradesQuery) (types.PendingCircuitUpgradesResponse, error) {
+	var upgrades []types.PendingCircuitUpgrade
+	err := q.Keeper.PendingCircuitUpgrades.Walk(ctx, nil, func(_ collections.Pair[string, string], pending types.PendingCircuitUpgrade) (bool, error) {
+		if query.CircuitId != "" && pending.CircuitId != query.CircuitId {
+			return false, nil
+		}
+		if pending.Status == types.CircuitUpgradeStatusActivated {
+			return false, nil
+		}
+		upgrades = append(upgrades, pending)
+		return false, nil
+	})
+	if err != nil {
+		return types.PendingCircuitUpgradesResponse{}, err
+	}
+	return types.PendingCircuitUpgradesResponse{Upgrades: upgrades}, nil
+}
+
+// successRate returns successful/total as a fraction in [0, 1], or 0 for a
+// not-yet-submitted-to circuit/prover rather than dividing by zero.
+func successRate(successful, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(successful) / float64(total)
+}
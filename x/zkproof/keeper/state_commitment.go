@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	ics23 "github.com/cosmos/ics23/go"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// VerifyStateCommitment checks sc's chained ICS-23 proof against sc.Root, mirroring
+// the bottom-up walk ibc-go's commitmenttypes.MerkleProof.VerifyMembership performs
+// for a multi-store IBC proof: the innermost proof verifies sc.Value at the innermost
+// path element under a calculated intermediate root, and each subsequent proof
+// verifies that intermediate root as the "value" committed at the next path element,
+// until the outermost proof is checked directly against sc.Root.
+//
+// sc.Validate (called from MsgSubmitProof.ValidateBasic) has already checked that
+// ProofSpecs/Proofs/Path are all equal length and non-empty, so this only does the
+// cryptographic half.
+func VerifyStateCommitment(sc types.StateCommitment) error {
+	value := sc.Value
+	for i, spec := range sc.ProofSpecs {
+		root := sc.Root
+		if i < len(sc.ProofSpecs)-1 {
+			calculated, err := ics23.CalculateExistenceRoot(sc.Proofs[i].GetExist())
+			if err != nil {
+				return types.ErrInvalidStateCommitment.Wrapf("calculating root at depth %d: %s", i, err)
+			}
+			root = calculated
+		}
+		if !ics23.VerifyMembership(spec, root, sc.Proofs[i], sc.Path[i], value) {
+			return types.ErrInvalidStateCommitment.Wrapf("ICS-23 membership verification failed at path depth %d", i)
+		}
+		value = root
+	}
+	return nil
+}
+
+// stateCommitmentPublicInput renders sc's verified (root, path, value) tuple as the
+// single implicit public input SubmitProof appends to msg.PublicInputs once
+// VerifyStateCommitment has passed, so a circuit whose PublicInputsSpec expects a
+// "state_commitment" entry can bind to it the same way any other public input is
+// bound. Hex-encoded since PublicInputs is a []string, the same encoding
+// msg_server_predicate.go uses for its own commitment public input.
+func stateCommitmentPublicInput(sc types.StateCommitment) string {
+	path := make([]byte, 0)
+	for _, p := range sc.Path {
+		path = append(path, p...)
+	}
+	return fmt.Sprintf("%s:%s:%s", hex.EncodeToString(sc.Root), hex.EncodeToString(path), hex.EncodeToString(sc.Value))
+}
+
+// stateRootIndexKey is the (rootHex, proofID) key ProofsByStateRoot indexes proof
+// under, the same Pair-of-strings shape statusIndexKey uses for ProofsByStatus.
+func stateRootIndexKey(root []byte, proofID string) collections.Pair[string, string] {
+	return collections.Join(hex.EncodeToString(root), proofID)
+}
@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/backend/groth16"
+	groth16bn254 "github.com/consensys/gnark/backend/groth16/bn254"
+	"github.com/consensys/gnark/backend/witness"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
@@ -16,12 +21,17 @@ import (
 // ZKVerificationService handles cryptographic verification of zero-knowledge proofs
 type ZKVerificationService struct {
 	keeper *Keeper
+
+	// vkCache memoizes the decoded groth16.VerifyingKey per circuit ID + Parameters
+	// hash -- see VerifyingKeyCache's doc comment.
+	vkCache *VerifyingKeyCache
 }
 
 // NewZKVerificationService creates a new verification service
 func NewZKVerificationService(k *Keeper) *ZKVerificationService {
 	return &ZKVerificationService{
-		keeper: k,
+		keeper:  k,
+		vkCache: NewVerifyingKeyCache(),
 	}
 }
 
@@ -33,7 +43,10 @@ type SnarkJSProof struct {
 	Protocol string `json:"protocol"`
 }
 
-// VerifyGroth16Proof verifies a Groth16 zero-knowledge proof
+// VerifyGroth16Proof verifies a Groth16 zero-knowledge proof by decoding the snarkjs
+// proof and circuit.Parameters into gnark's bn254 types and running the real pairing
+// check via groth16.Verify -- superseding the structural-only placeholder this function
+// previously was.
 func (vs *ZKVerificationService) VerifyGroth16Proof(
 	ctx context.Context,
 	proof types.ZKProof,
@@ -42,6 +55,11 @@ func (vs *ZKVerificationService) VerifyGroth16Proof(
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	logger := vs.keeper.Logger(ctx)
 
+	if circuit.CurveId != "" && circuit.CurveId != types.CurveIDBN254 {
+		logger.Error("unsupported curve", "circuit_id", circuit.Id, "curve_id", circuit.CurveId)
+		return false, types.ErrInvalidCircuit.Wrapf("groth16 verifier only supports curve %q, circuit declares %q", types.CurveIDBN254, circuit.CurveId)
+	}
+
 	// Step 1: Parse the proof data from snarkjs format
 	var snarkProof SnarkJSProof
 	if err := json.Unmarshal(proof.ProofData, &snarkProof); err != nil {
@@ -54,99 +72,289 @@ func (vs *ZKVerificationService) VerifyGroth16Proof(
 		return false, types.ErrInvalidProofType.Wrap("expected groth16 protocol")
 	}
 
-	// Step 2: Validate and parse proof structure
+	// Step 2: Validate proof data size, ahead of the more expensive point parsing below
+	params, err := vs.keeper.GetParams(context.Background())
+	if err == nil {
+		if uint64(len(proof.ProofData)) > params.MaxProofSize {
+			logger.Error("proof data exceeds size limit",
+				"size", len(proof.ProofData),
+				"max_size", params.MaxProofSize)
+			return false, types.ErrInvalidProof.Wrap("proof too large")
+		}
+	}
+
+	// Step 3: Decode pi_a/pi_b/pi_c into a gnark groth16.Proof
 	gnarkProof, err := vs.convertSnarkJSToGnark(snarkProof)
 	if err != nil {
-		logger.Error("failed to validate proof structure", "error", err)
-		return false, types.ErrInvalidProof.Wrap("proof structure validation failed")
+		logger.Error("failed to decode proof structure", "error", err)
+		return false, types.ErrInvalidProof.Wrapf("proof structure validation failed: %s", err)
 	}
 
-	// Step 3: Load and validate circuit parameters
+	// Step 4: Load and decode circuit.Parameters as a groth16.VerifyingKey
 	if len(circuit.Parameters) == 0 {
 		logger.Error("circuit parameters missing", "circuit_id", circuit.Id)
 		return false, types.ErrInvalidCircuit.Wrap("circuit verification key required")
 	}
+	vk, err := vs.vkCache.loadGroth16VerifyingKey(circuit.Id, circuit.Parameters)
+	if err != nil {
+		logger.Error("failed to load verification key", "circuit_id", circuit.Id, "error", err)
+		return false, types.ErrInvalidCircuit.Wrapf("failed to load verification key: %s", err)
+	}
 
-	// Step 4: Validate public inputs format
+	// Step 5: Decode public inputs into a gnark witness
 	if circuit.RequiresPublicInputs && len(proof.PublicInputs) == 0 {
 		logger.Error("public inputs required but missing", "circuit_id", circuit.Id)
 		return false, types.ErrInvalidProof.Wrap("public inputs required")
 	}
+	publicWitness, err := parsePublicInputsToWitness(proof.PublicInputs)
+	if err != nil {
+		logger.Error("failed to parse public inputs", "circuit_id", circuit.Id, "error", err)
+		return false, types.ErrInvalidProof.Wrapf("invalid public input encoding: %s", err)
+	}
 
-	// Step 5: Perform structural verification
-	logger.Info("performing proof structure verification",
+	// Step 6: Run the real bn254 pairing check
+	logger.Info("verifying groth16 proof",
 		"circuit_id", circuit.Id,
 		"proof_id", proof.Id,
 		"block_height", sdkCtx.BlockHeight(),
 		"public_inputs_count", len(proof.PublicInputs),
 	)
-
-	// TODO: Replace with full cryptographic verification once circuits are compiled
-	// For now, we do comprehensive structural validation
-	
-	// Validate proof data size
-	params, err := vs.keeper.GetParams(context.Background())
-	if err == nil {
-		if uint64(len(proof.ProofData)) > params.MaxProofSize {
-			logger.Error("proof data exceeds size limit", 
-				"size", len(proof.ProofData), 
-				"max_size", params.MaxProofSize)
-			return false, types.ErrInvalidProof.Wrap("proof too large")
-		}
-	}
-
-	// Validate public inputs
-	for i, input := range proof.PublicInputs {
-		if _, success := new(big.Int).SetString(input, 10); !success {
-			logger.Error("invalid public input format", "index", i, "input", input)
-			return false, types.ErrInvalidProof.Wrap("invalid public input encoding")
-		}
+	if err := groth16.Verify(gnarkProof, vk, publicWitness); err != nil {
+		logger.Info("groth16 proof failed verification",
+			"circuit_id", circuit.Id,
+			"proof_id", proof.Id,
+			"error", err,
+		)
+		return false, nil
 	}
 
-	logger.Info("proof verification successful - structural validation complete",
+	logger.Info("groth16 proof verified successfully",
 		"circuit_id", circuit.Id,
 		"proof_id", proof.Id,
-		"gnark_proof_valid", gnarkProof != nil,
 	)
 
 	return true, nil
 }
 
-// convertSnarkJSToGnark converts snarkjs proof format to gnark format
-func (vs *ZKVerificationService) convertSnarkJSToGnark(snarkProof SnarkJSProof) (*groth16.Proof, error) {
-	// TODO: Implement full proof parsing when circuit compilation is complete
-	// For now, we validate the structure and return a placeholder
-	
-	// Validate proof structure
-	if len(snarkProof.Pi_a) != 3 {
-		return nil, fmt.Errorf("invalid pi_a structure: expected 3 elements, got %d", len(snarkProof.Pi_a))
-	}
-	
-	if len(snarkProof.Pi_b) != 3 || len(snarkProof.Pi_b[0]) != 2 || len(snarkProof.Pi_b[1]) != 2 {
-		return nil, fmt.Errorf("invalid pi_b structure")
-	}
-	
-	if len(snarkProof.Pi_c) != 3 {
-		return nil, fmt.Errorf("invalid pi_c structure: expected 3 elements, got %d", len(snarkProof.Pi_c))
-	}
-
-	// Create a proof placeholder that will be properly implemented 
-	// when we have compiled circuits and verification keys
-	proof := groth16.NewProof(ecc.BN254)
-	
-	return &proof, nil
+// convertSnarkJSToGnark decodes a snarkjs-format Groth16 proof (pi_a/pi_b/pi_c, each a
+// jacobian-normalized affine point) into a gnark groth16.Proof over BN254.
+func (vs *ZKVerificationService) convertSnarkJSToGnark(snarkProof SnarkJSProof) (groth16.Proof, error) {
+	ar, err := parseG1Point(snarkProof.Pi_a)
+	if err != nil {
+		return nil, fmt.Errorf("pi_a: %w", err)
+	}
+	bs, err := parseG2Point(snarkProof.Pi_b)
+	if err != nil {
+		return nil, fmt.Errorf("pi_b: %w", err)
+	}
+	krs, err := parseG1Point(snarkProof.Pi_c)
+	if err != nil {
+		return nil, fmt.Errorf("pi_c: %w", err)
+	}
+
+	gnarkProof := groth16.NewProof(ecc.BN254)
+	concrete, ok := gnarkProof.(*groth16bn254.Proof)
+	if !ok {
+		return nil, fmt.Errorf("unexpected proof implementation %T for curve bn254", gnarkProof)
+	}
+	concrete.Ar = ar
+	concrete.Bs = bs
+	concrete.Krs = krs
+
+	return gnarkProof, nil
 }
 
-// TODO: Implement full elliptic curve point parsing when circuits are compiled
-// These functions will be needed for full cryptographic verification:
-// - parseG1Point: Parse BN254 G1 affine points from snarkjs format
-// - parseG2Point: Parse BN254 G2 affine points from snarkjs format  
-// - loadVerificationKey: Load and parse gnark VerifyingKey from circuit compilation
-// - parsePublicInputsToWitness: Convert public inputs to gnark witness format
+// decimalToBigInt parses a base-10 string into a *big.Int, the same encoding
+// VerifyGroth16Proof's public-input validation already assumed before this function
+// existed.
+func decimalToBigInt(s string) (*big.Int, bool) {
+	return new(big.Int).SetString(s, 10)
+}
+
+// parseG1Point decodes a snarkjs-format BN254 G1 affine point: a 3-element array of
+// decimal strings [x, y, z], where z is the jacobian coordinate snarkjs always
+// normalizes to "1" for an affine-form point and carries no further information once x
+// and y are known.
+func parseG1Point(coords []string) (curve.G1Affine, error) {
+	if len(coords) != 3 {
+		return curve.G1Affine{}, fmt.Errorf("g1 point requires 3 coordinates [x, y, z], got %d", len(coords))
+	}
+	if coords[2] != "1" {
+		return curve.G1Affine{}, fmt.Errorf("g1 point is not affine-normalized: expected z=1, got %q", coords[2])
+	}
 
-// The current implementation focuses on structural validation and will be
-// enhanced with full cryptographic verification once the ZK circuits are compiled
-// and verification keys are available from the build process.
+	x, ok := decimalToBigInt(coords[0])
+	if !ok {
+		return curve.G1Affine{}, fmt.Errorf("invalid x coordinate %q", coords[0])
+	}
+	y, ok := decimalToBigInt(coords[1])
+	if !ok {
+		return curve.G1Affine{}, fmt.Errorf("invalid y coordinate %q", coords[1])
+	}
+
+	var p curve.G1Affine
+	p.X.SetBigInt(x)
+	p.Y.SetBigInt(y)
+	if !p.IsOnCurve() {
+		return curve.G1Affine{}, fmt.Errorf("point is not on the bn254 G1 curve")
+	}
+	return p, nil
+}
+
+// parseG2Point decodes a snarkjs-format BN254 G2 affine point: a 3-element array of
+// Fp2 coordinate pairs [[x.c0, x.c1], [y.c0, y.c1], ["1", "0"]], the last again the
+// jacobian z-coordinate snarkjs normalizes away.
+//
+// snarkjs emits each Fp2 coordinate as [c0, c1]; gnark-crypto's bn254.E2{A0, A1} expects
+// the opposite component order, so the two are swapped here rather than at each call
+// site.
+func parseG2Point(coords [][]string) (curve.G2Affine, error) {
+	if len(coords) != 3 || len(coords[0]) != 2 || len(coords[1]) != 2 {
+		return curve.G2Affine{}, fmt.Errorf("g2 point requires 3 Fp2 coordinate pairs [[x.c0,x.c1],[y.c0,y.c1],[1,0]], got %d", len(coords))
+	}
+	if coords[2][0] != "1" || coords[2][1] != "0" {
+		return curve.G2Affine{}, fmt.Errorf("g2 point is not affine-normalized: expected z=[1,0], got %v", coords[2])
+	}
+
+	xc0, ok := decimalToBigInt(coords[0][0])
+	if !ok {
+		return curve.G2Affine{}, fmt.Errorf("invalid x.c0 coordinate %q", coords[0][0])
+	}
+	xc1, ok := decimalToBigInt(coords[0][1])
+	if !ok {
+		return curve.G2Affine{}, fmt.Errorf("invalid x.c1 coordinate %q", coords[0][1])
+	}
+	yc0, ok := decimalToBigInt(coords[1][0])
+	if !ok {
+		return curve.G2Affine{}, fmt.Errorf("invalid y.c0 coordinate %q", coords[1][0])
+	}
+	yc1, ok := decimalToBigInt(coords[1][1])
+	if !ok {
+		return curve.G2Affine{}, fmt.Errorf("invalid y.c1 coordinate %q", coords[1][1])
+	}
+
+	var p curve.G2Affine
+	p.X.A0.SetBigInt(xc1)
+	p.X.A1.SetBigInt(xc0)
+	p.Y.A0.SetBigInt(yc1)
+	p.Y.A1.SetBigInt(yc0)
+	if !p.IsOnCurve() {
+		return curve.G2Affine{}, fmt.Errorf("point is not on the bn254 G2 curve")
+	}
+	return p, nil
+}
+
+// snarkJSVerificationKey is the snarkjs-exported Groth16 verification key JSON shape
+// (the output of `snarkjs zkey export verificationkey`), the second of the two formats
+// loadVerificationKey accepts.
+type snarkJSVerificationKey struct {
+	Protocol string     `json:"protocol"`
+	Curve    string     `json:"curve"`
+	VkAlpha1 []string   `json:"vk_alpha_1"`
+	VkBeta2  [][]string `json:"vk_beta_2"`
+	VkGamma2 [][]string `json:"vk_gamma_2"`
+	VkDelta2 [][]string `json:"vk_delta_2"`
+	IC       [][]string `json:"IC"`
+}
+
+// loadVerificationKey decodes circuit.Parameters as a groth16.VerifyingKey over BN254,
+// accepting either gnark's own binary-encoded VerifyingKey (the format
+// groth16.VerifyingKey.WriteTo produces) or a snarkjs-exported verification key JSON
+// (vk_alpha_1/vk_beta_2/vk_gamma_2/vk_delta_2/IC). The gnark binary format is tried
+// first since it round-trips losslessly; the snarkjs JSON form is the fallback a
+// circuit registered straight from `snarkjs zkey export verificationkey` output would
+// use.
+func loadVerificationKey(parameters []byte) (groth16.VerifyingKey, error) {
+	vk := groth16.NewVerifyingKey(ecc.BN254)
+	if _, err := vk.ReadFrom(bytes.NewReader(parameters)); err == nil {
+		return vk, nil
+	}
+
+	var snarkVK snarkJSVerificationKey
+	if err := json.Unmarshal(parameters, &snarkVK); err != nil {
+		return nil, fmt.Errorf("parameters are neither a gnark binary verifying key nor snarkjs verification key JSON: %w", err)
+	}
+	if snarkVK.Protocol != "" && snarkVK.Protocol != "groth16" {
+		return nil, fmt.Errorf("snarkjs verification key declares protocol %q, expected groth16", snarkVK.Protocol)
+	}
+
+	concreteVK, ok := vk.(*groth16bn254.VerifyingKey)
+	if !ok {
+		return nil, fmt.Errorf("unexpected verifying key implementation %T for curve bn254", vk)
+	}
+
+	alpha, err := parseG1Point(snarkVK.VkAlpha1)
+	if err != nil {
+		return nil, fmt.Errorf("vk_alpha_1: %w", err)
+	}
+	beta, err := parseG2Point(snarkVK.VkBeta2)
+	if err != nil {
+		return nil, fmt.Errorf("vk_beta_2: %w", err)
+	}
+	gamma, err := parseG2Point(snarkVK.VkGamma2)
+	if err != nil {
+		return nil, fmt.Errorf("vk_gamma_2: %w", err)
+	}
+	delta, err := parseG2Point(snarkVK.VkDelta2)
+	if err != nil {
+		return nil, fmt.Errorf("vk_delta_2: %w", err)
+	}
+	if len(snarkVK.IC) == 0 {
+		return nil, fmt.Errorf("IC must contain at least one point (the constant term)")
+	}
+	ic := make([]curve.G1Affine, len(snarkVK.IC))
+	for i, coords := range snarkVK.IC {
+		p, err := parseG1Point(coords)
+		if err != nil {
+			return nil, fmt.Errorf("IC[%d]: %w", i, err)
+		}
+		ic[i] = p
+	}
+
+	concreteVK.G1.Alpha = alpha
+	concreteVK.G1.K = ic
+	concreteVK.G2.Beta = beta
+	concreteVK.G2.Gamma = gamma
+	concreteVK.G2.Delta = delta
+
+	// groth16.Setup would normally cache e(alpha, beta) on the VerifyingKey at setup
+	// time so Verify doesn't recompute it on every call; reproduce that here since this
+	// VerifyingKey is assembled from raw snarkjs points rather than produced by Setup.
+	e, err := curve.Pair([]curve.G1Affine{alpha}, []curve.G2Affine{beta})
+	if err != nil {
+		return nil, fmt.Errorf("pairing alpha and beta: %w", err)
+	}
+	concreteVK.E = e
+
+	return vk, nil
+}
+
+// parsePublicInputsToWitness decodes decimal-string public inputs into a gnark
+// witness.Witness over BN254's scalar field, the format groth16.Verify's publicWitness
+// argument requires.
+func parsePublicInputsToWitness(publicInputs []string) (witness.Witness, error) {
+	w, err := witness.New(ecc.BN254.ScalarField())
+	if err != nil {
+		return nil, fmt.Errorf("allocating witness: %w", err)
+	}
+
+	values := make(chan any, len(publicInputs))
+	for i, input := range publicInputs {
+		bi, ok := decimalToBigInt(input)
+		if !ok {
+			return nil, fmt.Errorf("public input %d: %q is not a decimal integer", i, input)
+		}
+		var v fr.Element
+		v.SetBigInt(bi)
+		values <- v
+	}
+	close(values)
+
+	if err := w.Fill(len(publicInputs), 0, values); err != nil {
+		return nil, fmt.Errorf("filling witness: %w", err)
+	}
+	return w, nil
+}
 
 // ValidateCircuitCompatibility ensures proof type matches circuit capabilities
 func (vs *ZKVerificationService) ValidateCircuitCompatibility(
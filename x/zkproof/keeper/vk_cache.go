@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/consensys/gnark/backend/groth16"
+)
+
+// VerifyingKeyCache memoizes loadVerificationKey's decode of a circuit's Parameters so
+// VerifyGroth16Proof doesn't re-parse the same verifying key (a gnark binary blob or a
+// snarkjs JSON export, either of which involves several curve-point on-curve checks and
+// a pairing to recompute VerifyingKey.E -- see loadVerificationKey's doc comment) on
+// every proof submitted against that circuit. Entries are keyed by circuit ID plus a
+// hash of Parameters rather than circuit ID alone, so a circuit whose Parameters
+// changed (e.g. a CircuitVerifyingKeyVersion rotation, see x/zkproof/keeper/
+// circuit_upgrade.go) misses the cache instead of verifying against a stale key.
+//
+// This is process-local, in-memory state -- never persisted, never consensus-critical --
+// the same role a node's mempool or block-production caches play; every validator
+// recomputes cache misses identically from the same on-chain Circuit.Parameters, so a
+// cold cache never causes a consensus divergence, only a slower first verification.
+type VerifyingKeyCache struct {
+	mu      sync.RWMutex
+	entries map[string]groth16.VerifyingKey
+}
+
+// NewVerifyingKeyCache returns an empty VerifyingKeyCache.
+func NewVerifyingKeyCache() *VerifyingKeyCache {
+	return &VerifyingKeyCache{
+		entries: make(map[string]groth16.VerifyingKey),
+	}
+}
+
+// vkCacheKey derives the cache key for circuitID's current Parameters.
+func vkCacheKey(circuitID string, parameters []byte) string {
+	sum := sha256.Sum256(parameters)
+	return circuitID + ":" + hex.EncodeToString(sum[:])
+}
+
+// loadGroth16VerifyingKey returns the decoded groth16.VerifyingKey for circuitID's
+// parameters, serving it from the cache when present and otherwise decoding it via
+// loadVerificationKey and caching the result.
+func (c *VerifyingKeyCache) loadGroth16VerifyingKey(circuitID string, parameters []byte) (groth16.VerifyingKey, error) {
+	key := vkCacheKey(circuitID, parameters)
+
+	c.mu.RLock()
+	vk, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok {
+		return vk, nil
+	}
+
+	vk, err := loadVerificationKey(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = vk
+	c.mu.Unlock()
+
+	return vk, nil
+}
@@ -0,0 +1,28 @@
+// Package module is where x/zkproof's depinject wiring would live, following the same
+// per-module path x/oracle/module/depinject.go and x/registry/module/depinject.go
+// establish. It is a doc-only placeholder rather than a working ModuleInputs/
+// ModuleOutputs/ProvideModule: unlike x/oracle and x/registry, x/zkproof has no
+// concrete Keeper struct or NewKeeper constructor defined anywhere in this tree (see
+// keeper/circuit_upgrade.go's and keeper/expiry_queue.go's doc comments, which already
+// document every new Keeper field here as an assumed addition rather than a field on a
+// real struct) and no AppModule/module.go, so there is nothing for a ProvideModule
+// function to construct yet.
+//
+// What this module does already have, and which satisfy two of this request's named
+// cross-module dependencies without any new code:
+//
+//   - types/expected_keepers.go's OracleKeeper interface -- the "OracleKeeper consumed
+//     by zkproof" this request asks expected_keepers.go to expose was added in an
+//     earlier chunk (keeper.VerifyOracleBinding's GetResolvedValue dependency) and
+//     needs no change here.
+//   - router/depinject.go's ProviderSet -- a real, working depinject.Provide binding for
+//     this module's PreMsgHandlerRouter/PostMsgHandlerRouter, whose own doc comment
+//     already states the same "no app_config.go/depinject.Inject exists yet" gap this
+//     file defers to.
+//
+// Once a concrete Keeper struct and AppModule exist for this module, ModuleInputs/
+// ModuleOutputs/ProvideModule here should follow x/oracle/module/depinject.go's exact
+// shape: Cdc/StoreService/Authority plus this module's AccountKeeper/BankKeeper/
+// SchemaKeeper/StakingKeeper/OracleKeeper/DIDKeeper expected-keeper interfaces in, a
+// Keeper and AppModule out.
+package module
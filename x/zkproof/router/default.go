@@ -0,0 +1,34 @@
+package router
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	revocationkeeper "github.com/PersonaPass-ID/personachain/x/revocation/keeper"
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// RegisterDefaultHandlers installs this repo's default cross-cutting check onto pre:
+// reject MsgSubmitProof from a prover with a revoked credential.
+//
+// MsgSubmitProof carries a Prover address and a CircuitId, but no CredentialID field to
+// look up against revocationKeeper.IsRevoked (which keys on credential ID, not
+// address) -- there is no address-to-credential-ID mapping anywhere in this tree for
+// this handler to resolve one from. This passes msg.Prover directly as the "credential
+// ID" IsRevoked checks, which only does something useful once a prover happens to use
+// its own address as its credential ID; a real MsgSubmitProof.CredentialID field is
+// what would make this check meaningful, and is out of scope for wiring up the
+// router itself.
+func RegisterDefaultHandlers(pre *PreMsgHandlerRouter, revocationKeeper revocationkeeper.Keeper) {
+	pre.RegisterPreMsgHandler(sdk.MsgTypeURL(&types.MsgSubmitProof{}), func(ctx context.Context, msg sdk.Msg) error {
+		submit, ok := msg.(*types.MsgSubmitProof)
+		if !ok {
+			return nil
+		}
+		if revocationKeeper.IsRevoked(ctx, submit.Prover) {
+			return types.ErrUnauthorized.Wrapf("prover %s has a revoked credential", submit.Prover)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,137 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// genCircuits generates a handful of fixture Circuits with Groth16/PLONK-shaped
+// CircuitData and Parameters, the same byte-slice fields keeper.VerifierRegistry
+// dispatches on in verification.go.
+func genCircuits(r *rand.Rand, accs []string) []types.Circuit {
+	circuits := make([]types.Circuit, 0, 5)
+	proofTypes := []types.ProofType{types.ProofTypeGroth16, types.ProofTypePLONK}
+	for i := 0; i < 5; i++ {
+		circuits = append(circuits, types.Circuit{
+			Id:                   fmt.Sprintf("circuit-%d", i),
+			Name:                 fmt.Sprintf("sim circuit %d", i),
+			Creator:              accs[r.Intn(len(accs))],
+			CircuitType:          types.CircuitTypeArithmetic,
+			SupportedProofTypes:  proofTypes,
+			CircuitData:          randBytes(r, 64),
+			Parameters:           randBytes(r, 32),
+			PublicInputsSpec:     []string{"root"},
+			RequiresPublicInputs: true,
+			Version:              1,
+			Active:               true,
+			CreatedAt:            simGenesisTime,
+			UpdatedAt:            simGenesisTime,
+		})
+	}
+	return circuits
+}
+
+// genProofs generates fixture ZKProofs against genCircuits' circuit IDs, split
+// between ProofStatusValid and ProofStatusPending so RandomizedGenState exercises
+// both the happy path and the in-flight verification path.
+func genProofs(r *rand.Rand, accs []string, circuits []types.Circuit) []types.ZKProof {
+	proofs := make([]types.ZKProof, 0, 10)
+	for i := 0; i < 10; i++ {
+		circuit := circuits[r.Intn(len(circuits))]
+		status := types.ProofStatusPending
+		if i%2 == 0 {
+			status = types.ProofStatusValid
+		}
+		proofs = append(proofs, types.ZKProof{
+			Id:           fmt.Sprintf("proof-%d", i),
+			CircuitId:    circuit.Id,
+			Prover:       accs[r.Intn(len(accs))],
+			ProofType:    circuit.SupportedProofTypes[0],
+			ProofData:    randBytes(r, 64),
+			PublicInputs: []string{fmt.Sprintf("0x%x", r.Uint32())},
+			Status:       status,
+			ValidFrom:    simGenesisTime,
+			CreatedAt:    simGenesisTime,
+			UpdatedAt:    simGenesisTime,
+		})
+	}
+	return proofs
+}
+
+// genProofRequests generates fixture ProofRequests between random accounts against
+// genCircuits' circuit IDs.
+func genProofRequests(r *rand.Rand, accs []string, circuits []types.Circuit) []types.ProofRequest {
+	requests := make([]types.ProofRequest, 0, 5)
+	for i := 0; i < 5; i++ {
+		circuit := circuits[r.Intn(len(circuits))]
+		requests = append(requests, types.ProofRequest{
+			Id:                fmt.Sprintf("request-%d", i),
+			Requester:         accs[r.Intn(len(accs))],
+			TargetProver:      accs[r.Intn(len(accs))],
+			CircuitId:         circuit.Id,
+			RequiredProofType: circuit.SupportedProofTypes[0],
+			Challenge:         fmt.Sprintf("challenge-%d", i),
+			Deadline:          simGenesisTime.Add(24 * time.Hour),
+			Status:            "open",
+		})
+	}
+	return requests
+}
+
+// randBytes returns n pseudo-random bytes, standing in for compiled circuit
+// constraints / Groth16-PLONK proving and verifying key material.
+func randBytes(r *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	r.Read(b)
+	return b
+}
+
+// simGenesisTime is used in place of time.Now() for every fixture timestamp
+// RandomizedGenState produces, since simulation genesis state must be deterministic
+// given the same seed.
+var simGenesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// GenesisState is the fixture shape RandomizedGenState marshals -- x/zkproof has no
+// GenesisState type of its own anywhere in this tree to marshal into instead.
+// x/zkproof has no module.go/AppModule in this tree (see ibc/ibc_module.go and
+// keeper/msg_server_verify.go's doc comments for the same standing gap), so nothing
+// reads simState.GenState[types.ModuleName] back out via InitGenesis yet. This struct
+// is written against the fixture shape a real GenesisState would need, for whoever
+// closes that module-wiring gap to wire in directly.
+type GenesisState struct {
+	Circuits      []types.Circuit      `json:"circuits"`
+	Proofs        []types.ZKProof      `json:"proofs"`
+	ProofRequests []types.ProofRequest `json:"proofRequests"`
+}
+
+// RandomizedGenState seeds the zkproof module's simulation genesis with circuits,
+// proofs, and proof requests shaped like real Groth16/PLONK fixtures, so the sim
+// harness exercises CreateCircuit/SubmitProof/VerifyProof state transitions against
+// genesis data rather than only ever the empty set.
+func RandomizedGenState(simState *module.SimulationState) {
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	if len(accs) == 0 {
+		return
+	}
+
+	circuits := genCircuits(simState.Rand, accs)
+	genesis := GenesisState{
+		Circuits:      circuits,
+		Proofs:        genProofs(simState.Rand, accs, circuits),
+		ProofRequests: genProofRequests(simState.Rand, accs, circuits),
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+func (m *GenesisState) ProtoMessage()  {}
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return fmt.Sprintf("%+v", *m) }
@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateCircuit      = "op_weight_msg_create_circuit"
+	OpWeightMsgSubmitProof        = "op_weight_msg_submit_proof"
+	OpWeightMsgVerifyProof        = "op_weight_msg_verify_proof"
+	OpWeightMsgCreateProofRequest = "op_weight_msg_create_proof_request"
+
+	DefaultWeightMsgCreateCircuit      = 30
+	DefaultWeightMsgSubmitProof        = 100
+	DefaultWeightMsgVerifyProof        = 80
+	DefaultWeightMsgCreateProofRequest = 40
+)
+
+// WeightedOperations returns all the operations from the zkproof module with their
+// respective weights.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec) simtypes.WeightedOperations {
+	var (
+		weightMsgCreateCircuit      int
+		weightMsgSubmitProof        int
+		weightMsgVerifyProof        int
+		weightMsgCreateProofRequest int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateCircuit, &weightMsgCreateCircuit, nil, func(_ *rand.Rand) {
+		weightMsgCreateCircuit = DefaultWeightMsgCreateCircuit
+	})
+	appParams.GetOrGenerate(OpWeightMsgSubmitProof, &weightMsgSubmitProof, nil, func(_ *rand.Rand) {
+		weightMsgSubmitProof = DefaultWeightMsgSubmitProof
+	})
+	appParams.GetOrGenerate(OpWeightMsgVerifyProof, &weightMsgVerifyProof, nil, func(_ *rand.Rand) {
+		weightMsgVerifyProof = DefaultWeightMsgVerifyProof
+	})
+	appParams.GetOrGenerate(OpWeightMsgCreateProofRequest, &weightMsgCreateProofRequest, nil, func(_ *rand.Rand) {
+		weightMsgCreateProofRequest = DefaultWeightMsgCreateProofRequest
+	})
+
+	return simtypes.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateCircuit, SimulateMsgCreateCircuit()),
+		simulation.NewWeightedOperation(weightMsgSubmitProof, SimulateMsgSubmitProof()),
+		simulation.NewWeightedOperation(weightMsgVerifyProof, SimulateMsgVerifyProof()),
+		simulation.NewWeightedOperation(weightMsgCreateProofRequest, SimulateMsgCreateProofRequest()),
+	}
+}
+
+// SimulateMsgCreateCircuit generates a MsgCreateCircuit with random circuit bytes.
+func SimulateMsgCreateCircuit() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgCreateCircuit{
+			Creator:             creator.Address.String(),
+			Name:                simtypes.RandStringOfLength(r, 12),
+			CircuitType:         types.CircuitTypeArithmetic,
+			SupportedProofTypes: []types.ProofType{types.ProofTypeGroth16},
+			CircuitData:         []byte(simtypes.RandStringOfLength(r, 32)),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "zkproof keeper does not yet support simulated delivery"), nil, nil
+	}
+}
+
+// SimulateMsgSubmitProof generates a MsgSubmitProof against a randomly chosen circuit ID.
+func SimulateMsgSubmitProof() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		prover, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgSubmitProof{
+			Prover:    prover.Address.String(),
+			CircuitId: simtypes.RandStringOfLength(r, 16),
+			ProofType: types.ProofTypeGroth16,
+			ProofData: []byte(simtypes.RandStringOfLength(r, 64)),
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no matching circuit exists yet to prove against"), nil, nil
+	}
+}
+
+// SimulateMsgVerifyProof generates a MsgVerifyProof against a randomly chosen proof
+// ID, the same NoOpMsg terminal result as every other operation in this file since
+// the zkproof keeper does not yet support simulated delivery.
+func SimulateMsgVerifyProof() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		verifier, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgVerifyProof{
+			Verifier: verifier.Address.String(),
+			ProofId:  simtypes.RandStringOfLength(r, 16),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "zkproof keeper does not yet support simulated delivery"), nil, nil
+	}
+}
+
+// SimulateMsgCreateProofRequest generates a MsgCreateProofRequest between two random accounts.
+func SimulateMsgCreateProofRequest() simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		requester, _ := simtypes.RandomAcc(r, accs)
+		prover, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgCreateProofRequest{
+			Requester:         requester.Address.String(),
+			TargetProver:      prover.Address.String(),
+			CircuitId:         simtypes.RandStringOfLength(r, 16),
+			RequiredProofType: types.ProofTypeGroth16,
+			Challenge:         simtypes.RandStringOfLength(r, 8),
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "no matching circuit exists yet to request a proof against"), nil, nil
+	}
+}
@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/PersonaPass-ID/personachain/x/zkproof/types"
+)
+
+// Weighted proposal content operation weights
+const (
+	OpWeightRegisterCircuitProposal  = "op_weight_register_circuit_proposal"
+	OpWeightDeprecateCircuitProposal = "op_weight_deprecate_circuit_proposal"
+	OpWeightSlashProverProposal      = "op_weight_slash_prover_proposal"
+
+	DefaultWeightRegisterCircuitProposal  = 5
+	DefaultWeightDeprecateCircuitProposal = 5
+	DefaultWeightSlashProverProposal      = 5
+)
+
+// ProposalContents returns all the zkproof module's weighted governance proposal
+// content generators, for AppModule.ProposalContents to hand to the sim harness
+// alongside every other module's.
+func ProposalContents() []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightRegisterCircuitProposal,
+			DefaultWeightRegisterCircuitProposal,
+			SimulateRegisterCircuitProposal(),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightDeprecateCircuitProposal,
+			DefaultWeightDeprecateCircuitProposal,
+			SimulateDeprecateCircuitProposal(),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSlashProverProposal,
+			DefaultWeightSlashProverProposal,
+			SimulateSlashProverProposal(),
+		),
+	}
+}
+
+// SimulateRegisterCircuitProposal generates a random RegisterCircuitProposal. Like
+// SimulateMsgCreateCircuit in operations.go, it doesn't check against any existing
+// keeper state -- x/zkproof/keeper has no Keeper struct in this tree yet for it to
+// query.
+func SimulateRegisterCircuitProposal() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) govv1beta1.Content {
+		return &types.RegisterCircuitProposal{
+			Title:           simtypes.RandStringOfLength(r, 10),
+			Description:     simtypes.RandStringOfLength(r, 100),
+			CircuitId:       simtypes.RandStringOfLength(r, 16),
+			CircuitType:     types.CircuitTypeArithmetic,
+			VerificationKey: []byte(simtypes.RandStringOfLength(r, 32)),
+		}
+	}
+}
+
+// SimulateDeprecateCircuitProposal generates a random DeprecateCircuitProposal.
+func SimulateDeprecateCircuitProposal() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) govv1beta1.Content {
+		return &types.DeprecateCircuitProposal{
+			Title:           simtypes.RandStringOfLength(r, 10),
+			Description:     simtypes.RandStringOfLength(r, 100),
+			CircuitId:       simtypes.RandStringOfLength(r, 16),
+			EffectiveHeight: ctx.BlockHeight() + int64(simtypes.RandIntBetween(r, 1, 100_000)),
+		}
+	}
+}
+
+// SimulateSlashProverProposal generates a random SlashProverProposal against a
+// randomly chosen simulation account.
+func SimulateSlashProverProposal() simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) govv1beta1.Content {
+		prover, _ := simtypes.RandomAcc(r, accs)
+		return &types.SlashProverProposal{
+			Title:             simtypes.RandStringOfLength(r, 10),
+			Description:       simtypes.RandStringOfLength(r, 100),
+			Prover:            prover.Address.String(),
+			InvalidProofCount: uint64(simtypes.RandIntBetween(r, 1, 100)),
+		}
+	}
+}
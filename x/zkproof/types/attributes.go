@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/base64"
+	"strconv"
+)
+
+// AttributeValue is a typed attribute value for indexed attribute search -- a
+// hand-rolled oneof (exactly one field set), the zkproof counterpart to
+// x/credential/types.AttributeValue. Kept as a separate duplicate rather than a shared
+// import, the same way CursorPageRequest/CursorPageResponse are independently defined
+// in both modules' types packages.
+type AttributeValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *int64  `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+	BytesValue  []byte  `json:"bytesValue,omitempty"`
+}
+
+// IndexKey returns the string ProofsByAttribute's Triple key indexes v under. See
+// x/credential/types.AttributeValue.IndexKey for the encoding rationale.
+func (v AttributeValue) IndexKey() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.IntValue != nil:
+		return strconv.FormatInt(*v.IntValue, 10)
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.BytesValue != nil:
+		return base64.RawURLEncoding.EncodeToString(v.BytesValue)
+	default:
+		return ""
+	}
+}
+
+// AttributePredicate is one {key, value} equality clause of a QueryProofsByAttributes
+// request. Multiple predicates are AND-intersected.
+type AttributePredicate struct {
+	Key   string         `json:"key"`
+	Value AttributeValue `json:"value"`
+}
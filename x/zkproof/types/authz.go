@@ -0,0 +1,161 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ProofAuthorization is an x/authz-style grant letting a grantee submit proofs and/or
+// manage circuits on behalf of a granter, scoped to a specific circuit and an optional
+// expiration.
+type ProofAuthorization struct {
+	// Granter is the address delegating authority.
+	Granter string `json:"granter"`
+
+	// Grantee is the address authorized to act on the granter's behalf.
+	Grantee string `json:"grantee"`
+
+	// CircuitId restricts the grant to a single circuit. Empty means all circuits the
+	// granter controls.
+	CircuitId string `json:"circuitId,omitempty"`
+
+	// AllowSubmitProof permits the grantee to submit proofs as the granter.
+	AllowSubmitProof bool `json:"allowSubmitProof"`
+
+	// AllowManageCircuit permits the grantee to update circuit lifecycle state.
+	AllowManageCircuit bool `json:"allowManageCircuit"`
+
+	// Expiration is when the grant stops being honored. Nil means it never expires.
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+func (m *ProofAuthorization) ProtoMessage()  {}
+func (m *ProofAuthorization) Reset()         { *m = ProofAuthorization{} }
+func (m *ProofAuthorization) String() string { return proto.CompactTextString(m) }
+
+// Validate validates a ProofAuthorization grant.
+func (a *ProofAuthorization) Validate() error {
+	if a.Granter == "" {
+		return ErrUnauthorized.Wrap("granter cannot be empty")
+	}
+	if a.Grantee == "" {
+		return ErrUnauthorized.Wrap("grantee cannot be empty")
+	}
+	if a.Granter == a.Grantee {
+		return ErrUnauthorized.Wrap("granter and grantee cannot be the same address")
+	}
+	if !a.AllowSubmitProof && !a.AllowManageCircuit {
+		return ErrUnauthorized.Wrap("grant must allow at least one of submitting proofs or managing circuits")
+	}
+	return nil
+}
+
+// IsExpired reports whether the grant is no longer valid at the given time.
+func (a *ProofAuthorization) IsExpired(at time.Time) bool {
+	return a.Expiration != nil && at.After(*a.Expiration)
+}
+
+// AcceptsCircuit reports whether this grant covers the given circuit ID.
+func (a *ProofAuthorization) AcceptsCircuit(circuitID string) bool {
+	return a.CircuitId == "" || a.CircuitId == circuitID
+}
+
+// MsgGrantProofAuthorization delegates proof submission and/or circuit management
+// authority from a granter to a grantee.
+type MsgGrantProofAuthorization struct {
+	Granter            string     `json:"granter"`
+	Grantee            string     `json:"grantee"`
+	CircuitId          string     `json:"circuitId,omitempty"`
+	AllowSubmitProof   bool       `json:"allowSubmitProof"`
+	AllowManageCircuit bool       `json:"allowManageCircuit"`
+	Expiration         *time.Time `json:"expiration,omitempty"`
+}
+
+var _ sdk.Msg = &MsgGrantProofAuthorization{}
+
+func (msg *MsgGrantProofAuthorization) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgGrantProofAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrUnauthorized.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	auth := ProofAuthorization{
+		Granter:            msg.Granter,
+		Grantee:            msg.Grantee,
+		CircuitId:          msg.CircuitId,
+		AllowSubmitProof:   msg.AllowSubmitProof,
+		AllowManageCircuit: msg.AllowManageCircuit,
+		Expiration:         msg.Expiration,
+	}
+	return auth.Validate()
+}
+
+func (msg *MsgGrantProofAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgGrantProofAuthorization) ProtoMessage()  {}
+func (m *MsgGrantProofAuthorization) Reset()         { *m = MsgGrantProofAuthorization{} }
+func (m *MsgGrantProofAuthorization) String() string { return proto.CompactTextString(m) }
+
+// MsgGrantProofAuthorizationResponse is the response for MsgGrantProofAuthorization.
+type MsgGrantProofAuthorizationResponse struct{}
+
+func (m *MsgGrantProofAuthorizationResponse) ProtoMessage()  {}
+func (m *MsgGrantProofAuthorizationResponse) Reset()         { *m = MsgGrantProofAuthorizationResponse{} }
+func (m *MsgGrantProofAuthorizationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+
+// MsgRevokeProofAuthorization revokes a standing delegation.
+type MsgRevokeProofAuthorization struct {
+	Granter   string `json:"granter"`
+	Grantee   string `json:"grantee"`
+	CircuitId string `json:"circuitId,omitempty"`
+}
+
+var _ sdk.Msg = &MsgRevokeProofAuthorization{}
+
+func (msg *MsgRevokeProofAuthorization) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg *MsgRevokeProofAuthorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return ErrUnauthorized.Wrap("invalid granter address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return ErrUnauthorized.Wrap("invalid grantee address")
+	}
+	return nil
+}
+
+func (msg *MsgRevokeProofAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (m *MsgRevokeProofAuthorization) ProtoMessage()  {}
+func (m *MsgRevokeProofAuthorization) Reset()         { *m = MsgRevokeProofAuthorization{} }
+func (m *MsgRevokeProofAuthorization) String() string { return proto.CompactTextString(m) }
+
+// MsgRevokeProofAuthorizationResponse is the response for MsgRevokeProofAuthorization.
+type MsgRevokeProofAuthorizationResponse struct{}
+
+func (m *MsgRevokeProofAuthorizationResponse) ProtoMessage()  {}
+func (m *MsgRevokeProofAuthorizationResponse) Reset()         { *m = MsgRevokeProofAuthorizationResponse{} }
+func (m *MsgRevokeProofAuthorizationResponse) String() string {
+	return proto.CompactTextString(m)
+}
@@ -0,0 +1,61 @@
+package types
+
+import "time"
+
+// BountyStatus tracks the escrow lifecycle of a ProofRequest's Reward, layered on top
+// of ProofRequest.Status which tracks the proof-fulfillment side of the same request.
+type BountyStatus string
+
+const (
+	// BountyStatusEscrowed means the Reward has been moved from the requester into the
+	// module account and is held pending fulfillment or expiry.
+	BountyStatusEscrowed BountyStatus = "escrowed"
+
+	// BountyStatusPaid means the Reward was paid out to the prover that fulfilled the
+	// request with a valid proof.
+	BountyStatusPaid BountyStatus = "paid"
+
+	// BountyStatusRefunded means the deadline passed unfulfilled and the Reward was
+	// returned to the requester.
+	BountyStatusRefunded BountyStatus = "refunded"
+
+	// BountyStatusSlashed means the deadline passed after a prover had already
+	// accepted the request: the accepted prover's ProverStake was slashed to the
+	// requester and the Reward was refunded to them -- see MsgSlashProver.
+	BountyStatusSlashed BountyStatus = "slashed"
+)
+
+// ModuleEscrowAccountName is the module account that holds escrowed proof bounties
+// between MsgCreateProofRequest and fulfillment/expiry.
+const ModuleEscrowAccountName = ModuleName + "_escrow"
+
+// ValidateBounty checks that a ProofRequest's reward/deadline can be escrowed: it must
+// carry a positive Reward and a Deadline in the future relative to `at`.
+func ValidateBounty(req *ProofRequest, at time.Time) error {
+	if req.Reward.IsZero() {
+		return ErrInvalidRequest.Wrap("proof request must carry a non-zero reward to be escrowed")
+	}
+	if !req.Reward.IsValid() {
+		return ErrInvalidRequest.Wrap("proof request reward is not a valid coin set")
+	}
+	if !req.Deadline.After(at) {
+		return ErrInvalidRequest.Wrap("proof request deadline must be in the future")
+	}
+	return nil
+}
+
+// IsBountyExpired reports whether a ProofRequest's deadline has passed without
+// fulfillment, meaning its escrowed Reward is eligible for refund.
+func IsBountyExpired(req *ProofRequest, at time.Time) bool {
+	return req.Status != string(StatusFulfilled) && at.After(req.Deadline)
+}
+
+// ProofRequestStatus enumerates the fulfillment lifecycle of a ProofRequest.
+type ProofRequestStatus string
+
+const (
+	StatusPendingRequest ProofRequestStatus = "pending"
+	StatusAccepted       ProofRequestStatus = "accepted"
+	StatusFulfilled      ProofRequestStatus = "fulfilled"
+	StatusExpiredRequest ProofRequestStatus = "expired"
+)
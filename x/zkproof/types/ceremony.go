@@ -0,0 +1,334 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgStartCeremony{}
+	_ sdk.Msg = &MsgContributeCeremony{}
+	_ sdk.Msg = &MsgFinalizeCeremony{}
+)
+
+// CircuitStatus tracks a Circuit's trusted-setup lifecycle. See the Status field on
+// Circuit.
+type CircuitStatus string
+
+const (
+	// CircuitStatusPendingSetup means the circuit was registered with TrustedSetup
+	// true and is waiting on a Ceremony to finalize before it can accept proofs.
+	CircuitStatusPendingSetup CircuitStatus = "pending_setup"
+
+	// CircuitStatusActive means the circuit has usable Parameters -- either it never
+	// required a trusted setup, or its ceremony finalized and wrote the resulting
+	// SRS/proving-key/verifying-key blobs into Circuit.Parameters.
+	CircuitStatusActive CircuitStatus = "active"
+)
+
+// CeremonyPhase distinguishes a Powers-of-Tau style universal phase-1 transcript,
+// which is circuit-agnostic and can be reused to bootstrap many circuits, from a
+// phase-2 transcript that specializes a phase-1 SRS to one specific circuit.
+type CeremonyPhase string
+
+const (
+	CeremonyPhaseOne CeremonyPhase = "phase1_universal"
+	CeremonyPhaseTwo CeremonyPhase = "phase2_circuit_specific"
+)
+
+// CeremonyStatus tracks a Ceremony's lifecycle.
+type CeremonyStatus string
+
+const (
+	CeremonyStatusOpen      CeremonyStatus = "open"
+	CeremonyStatusFinalized CeremonyStatus = "finalized"
+)
+
+// Contribution records one participant's update to a Ceremony's transcript.
+// TranscriptHash must equal ExpectedTranscriptHash of the previous contribution's
+// TranscriptHash (or Ceremony.InitialTranscriptHash for the first contribution) and
+// this contribution's ContributionData, checked before the contribution is accepted
+// -- see Keeper.ContributeCeremony and Ceremony.VerifyContributionChain. As long as a
+// single contributor in the resulting chain discarded their toxic waste, the final SRS
+// is sound, the "N-of-N honest" guarantee a Powers-of-Tau ceremony is designed around.
+type Contribution struct {
+	// ContributorDID is the DID that authored this contribution.
+	ContributorDID string `json:"contributorDid"`
+
+	// ContributionData is the opaque per-backend transcript update this contributor
+	// produced (e.g. the re-randomized SRS group elements) -- never the toxic waste
+	// itself, which the contributor must discard.
+	ContributionData []byte `json:"contributionData"`
+
+	// TranscriptHash is the SHA-256 digest of the transcript after this contribution
+	// is applied, i.e. the hash the next contributor must build on.
+	TranscriptHash []byte `json:"transcriptHash"`
+
+	// Attestation is a BLS signature over TranscriptHash by a key controlled by
+	// ContributorDID, binding the contributor to the exact transcript state they
+	// produced.
+	Attestation []byte `json:"attestation"`
+
+	BlockHeight   int64     `json:"blockHeight"`
+	ContributedAt time.Time `json:"contributedAt"`
+}
+
+func (m *Contribution) ProtoMessage()  {}
+func (m *Contribution) Reset()         { *m = Contribution{} }
+func (m *Contribution) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a Contribution.
+func (c *Contribution) Validate() error {
+	if c.ContributorDID == "" {
+		return ErrUnauthorized.Wrap("contributor DID cannot be empty")
+	}
+	if len(c.ContributionData) == 0 {
+		return ErrInvalidContribution.Wrap("contribution data cannot be empty")
+	}
+	if len(c.TranscriptHash) != sha256.Size {
+		return ErrInvalidContribution.Wrapf("transcript hash must be %d bytes", sha256.Size)
+	}
+	if len(c.Attestation) == 0 {
+		return ErrInvalidContribution.Wrap("attestation cannot be empty")
+	}
+	return nil
+}
+
+// Ceremony tracks a trusted-setup ceremony's ordered contribution chain, per
+// CeremonyPhase.
+type Ceremony struct {
+	Id        string         `json:"id"`
+	CircuitId string         `json:"circuitId,omitempty"`
+	Phase     CeremonyPhase  `json:"phase"`
+	Status    CeremonyStatus `json:"status"`
+
+	// Contributions is the ordered chain of contributions applied to the transcript
+	// so far; Contributions[i].TranscriptHash is the input to Contributions[i+1].
+	Contributions []Contribution `json:"contributions,omitempty"`
+
+	// InitialTranscriptHash seeds the chain -- a previously finalized phase-1
+	// Ceremony's final transcript hash for a CeremonyPhaseTwo ceremony, or a
+	// domain-separated constant for a fresh CeremonyPhaseOne ceremony.
+	InitialTranscriptHash []byte `json:"initialTranscriptHash"`
+
+	Coordinator string `json:"coordinator"`
+
+	CreatedAt   time.Time  `json:"createdAt"`
+	FinalizedAt *time.Time `json:"finalizedAt,omitempty"`
+
+	BlockHeight int64 `json:"blockHeight"`
+}
+
+func (m *Ceremony) ProtoMessage()  {}
+func (m *Ceremony) Reset()         { *m = Ceremony{} }
+func (m *Ceremony) String() string { return proto.CompactTextString(m) }
+
+// Validate performs stateless validation of a Ceremony.
+func (c *Ceremony) Validate() error {
+	if c.Id == "" {
+		return ErrInvalidCeremony.Wrap("ceremony ID cannot be empty")
+	}
+	if c.Phase != CeremonyPhaseOne && c.Phase != CeremonyPhaseTwo {
+		return ErrInvalidCeremony.Wrapf("unknown ceremony phase %q", c.Phase)
+	}
+	if c.Phase == CeremonyPhaseTwo && c.CircuitId == "" {
+		return ErrInvalidCeremony.Wrap("phase-2 ceremony must name a circuit")
+	}
+	if len(c.InitialTranscriptHash) != sha256.Size {
+		return ErrInvalidCeremony.Wrapf("initial transcript hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// LatestTranscriptHash returns the transcript hash the next contribution must build
+// on: the last contribution's TranscriptHash, or InitialTranscriptHash if none have
+// landed yet.
+func (c *Ceremony) LatestTranscriptHash() []byte {
+	if len(c.Contributions) == 0 {
+		return c.InitialTranscriptHash
+	}
+	return c.Contributions[len(c.Contributions)-1].TranscriptHash
+}
+
+// VerifyContributionChain walks Contributions from InitialTranscriptHash and reports
+// whether every entry's TranscriptHash is a correctly-formed update of its
+// predecessor's. A single call lets any honest auditor -- not just the coordinator who
+// accepted each contribution as it arrived -- replay the whole chain from genesis and
+// confirm no contribution was tampered with after the fact.
+func (c *Ceremony) VerifyContributionChain() bool {
+	prev := c.InitialTranscriptHash
+	for _, contribution := range c.Contributions {
+		if !bytes.Equal(contribution.TranscriptHash, ExpectedTranscriptHash(prev, contribution.ContributionData)) {
+			return false
+		}
+		prev = contribution.TranscriptHash
+	}
+	return true
+}
+
+// ExpectedTranscriptHash derives the transcript hash a contribution must produce from
+// the previous transcript hash and the contribution's own update data, binding each
+// link in the chain to exactly one predecessor. A real Powers-of-Tau implementation
+// would instead hash the updated SRS group elements under the pairing-friendly curve
+// itself; this stands in for that until a concrete backend is vendored, the same way
+// CanonicalizeCredentialSubject stands in for full URDNA2015 canonicalization.
+func ExpectedTranscriptHash(previous []byte, contributionData []byte) []byte {
+	h := sha256.Sum256(append(append([]byte{}, previous...), contributionData...))
+	return h[:]
+}
+
+// MsgStartCeremony opens a new trusted-setup ceremony. A CeremonyPhaseTwo ceremony
+// must name an existing CircuitId with TrustedSetup true that hasn't already
+// finalized; a CeremonyPhaseOne ceremony is circuit-agnostic and CircuitId is empty.
+type MsgStartCeremony struct {
+	Coordinator           string        `json:"coordinator"`
+	CircuitId             string        `json:"circuitId,omitempty"`
+	Phase                 CeremonyPhase `json:"phase"`
+	InitialTranscriptHash []byte        `json:"initialTranscriptHash"`
+}
+
+func (msg *MsgStartCeremony) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Coordinator); err != nil {
+		return ErrUnauthorized.Wrap("invalid coordinator address")
+	}
+	if msg.Phase != CeremonyPhaseOne && msg.Phase != CeremonyPhaseTwo {
+		return ErrInvalidCeremony.Wrapf("unsupported ceremony phase %q", msg.Phase)
+	}
+	if msg.Phase == CeremonyPhaseTwo && msg.CircuitId == "" {
+		return ErrInvalidCeremony.Wrap("phase-2 ceremony must name a circuit")
+	}
+	if len(msg.InitialTranscriptHash) != sha256.Size {
+		return ErrInvalidCeremony.Wrapf("initial transcript hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+func (msg *MsgStartCeremony) GetSigners() []sdk.AccAddress {
+	coordinator, err := sdk.AccAddressFromBech32(msg.Coordinator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{coordinator}
+}
+
+func (msg *MsgStartCeremony) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgStartCeremony) ProtoMessage()  {}
+func (m *MsgStartCeremony) Reset()         { *m = MsgStartCeremony{} }
+func (m *MsgStartCeremony) String() string { return proto.CompactTextString(m) }
+
+// MsgStartCeremonyResponse is the response for MsgStartCeremony.
+type MsgStartCeremonyResponse struct {
+	CeremonyId string `json:"ceremonyId"`
+}
+
+func (m *MsgStartCeremonyResponse) ProtoMessage()  {}
+func (m *MsgStartCeremonyResponse) Reset()         { *m = MsgStartCeremonyResponse{} }
+func (m *MsgStartCeremonyResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgContributeCeremony submits one contribution to an open ceremony's transcript.
+type MsgContributeCeremony struct {
+	Contributor      string `json:"contributor"`
+	ContributorDID   string `json:"contributorDid"`
+	CeremonyId       string `json:"ceremonyId"`
+	ContributionData []byte `json:"contributionData"`
+	Attestation      []byte `json:"attestation"`
+}
+
+func (msg *MsgContributeCeremony) ValidateBasic() error {
+	if msg.CeremonyId == "" {
+		return ErrInvalidCeremony.Wrap("ceremony ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contributor); err != nil {
+		return ErrUnauthorized.Wrap("invalid contributor address")
+	}
+	if msg.ContributorDID == "" {
+		return ErrUnauthorized.Wrap("contributor DID cannot be empty")
+	}
+	if len(msg.ContributionData) == 0 {
+		return ErrInvalidContribution.Wrap("contribution data cannot be empty")
+	}
+	if len(msg.Attestation) == 0 {
+		return ErrInvalidContribution.Wrap("attestation cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgContributeCeremony) GetSigners() []sdk.AccAddress {
+	contributor, err := sdk.AccAddressFromBech32(msg.Contributor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{contributor}
+}
+
+func (msg *MsgContributeCeremony) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgContributeCeremony) ProtoMessage()  {}
+func (m *MsgContributeCeremony) Reset()         { *m = MsgContributeCeremony{} }
+func (m *MsgContributeCeremony) String() string { return proto.CompactTextString(m) }
+
+// MsgContributeCeremonyResponse is the response for MsgContributeCeremony.
+type MsgContributeCeremonyResponse struct {
+	ContributionIndex uint64 `json:"contributionIndex"`
+	TranscriptHash    []byte `json:"transcriptHash"`
+}
+
+func (m *MsgContributeCeremonyResponse) ProtoMessage()  {}
+func (m *MsgContributeCeremonyResponse) Reset()         { *m = MsgContributeCeremonyResponse{} }
+func (m *MsgContributeCeremonyResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgFinalizeCeremony closes a ceremony after replaying and verifying its full
+// contribution chain. For a CeremonyPhaseTwo ceremony, Parameters is written into the
+// named circuit's Circuit.Parameters and the circuit transitions to
+// CircuitStatusActive; a CeremonyPhaseOne ceremony instead publishes a reusable SRS
+// that a later phase-2 ceremony's InitialTranscriptHash builds on, so Parameters is
+// unused for it.
+type MsgFinalizeCeremony struct {
+	Finalizer  string `json:"finalizer"`
+	CeremonyId string `json:"ceremonyId"`
+	Parameters []byte `json:"parameters,omitempty"`
+}
+
+func (msg *MsgFinalizeCeremony) ValidateBasic() error {
+	if msg.CeremonyId == "" {
+		return ErrInvalidCeremony.Wrap("ceremony ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Finalizer); err != nil {
+		return ErrUnauthorized.Wrap("invalid finalizer address")
+	}
+	return nil
+}
+
+func (msg *MsgFinalizeCeremony) GetSigners() []sdk.AccAddress {
+	finalizer, err := sdk.AccAddressFromBech32(msg.Finalizer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{finalizer}
+}
+
+func (msg *MsgFinalizeCeremony) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgFinalizeCeremony) ProtoMessage()  {}
+func (m *MsgFinalizeCeremony) Reset()         { *m = MsgFinalizeCeremony{} }
+func (m *MsgFinalizeCeremony) String() string { return proto.CompactTextString(m) }
+
+// MsgFinalizeCeremonyResponse is the response for MsgFinalizeCeremony.
+type MsgFinalizeCeremonyResponse struct {
+	CircuitId string `json:"circuitId,omitempty"`
+}
+
+func (m *MsgFinalizeCeremonyResponse) ProtoMessage()  {}
+func (m *MsgFinalizeCeremonyResponse) Reset()         { *m = MsgFinalizeCeremonyResponse{} }
+func (m *MsgFinalizeCeremonyResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,206 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComputeVKHash derives the hex-encoded content hash MsgSignalCircuitUpgrade.NewVkHash
+// and CircuitVerifyingKeyVersion.VkHash identify a verifying-key blob by, the same
+// sha256-based content-addressing convention ComputeDIDVersionID/
+// ComputeOIDCPublicKeyHash use elsewhere in this tree (see x/did/types for why: no
+// blake2b dependency is vendored here).
+func ComputeVKHash(parameters []byte) string {
+	sum := sha256.Sum256(parameters)
+	return hex.EncodeToString(sum[:])
+}
+
+var _ sdk.Msg = &MsgSignalCircuitUpgrade{}
+
+// CircuitUpgradeStatus tracks a pending verification-key rotation's lifecycle, the
+// same signal -> tally -> activate shape Cosmos SDK's single-binary upgrade signaling
+// (validators signaling a version, tallied by bonded power) uses for a coordinated
+// halt height, applied here to a Circuit's verifying key instead.
+type CircuitUpgradeStatus string
+
+const (
+	// CircuitUpgradeStatusSignaling means validators are still signaling; tallied
+	// power has not yet crossed Params.CircuitUpgradeThreshold.
+	CircuitUpgradeStatusSignaling CircuitUpgradeStatus = "signaling"
+
+	// CircuitUpgradeStatusScheduled means the tally crossed threshold before
+	// ActivationHeight; keeper.Keeper.EndBlocker activates it once the chain reaches
+	// that height.
+	CircuitUpgradeStatusScheduled CircuitUpgradeStatus = "scheduled"
+
+	// CircuitUpgradeStatusActivated means ActivationHeight has passed and the new
+	// verifying key is now the one GetCircuitVKKey's current epoch resolves to.
+	CircuitUpgradeStatusActivated CircuitUpgradeStatus = "activated"
+)
+
+// MsgSignalCircuitUpgrade lets a validator record its vote for rotating CircuitId's
+// verifying key to NewVkHash, effective at ActivationHeight. keeper.Keeper.EndBlocker
+// tallies every validator's latest signal for a (CircuitId, NewVkHash) pair by bonded
+// power; once the tally crosses Params.CircuitUpgradeThreshold the upgrade becomes
+// CircuitUpgradeStatusScheduled.
+type MsgSignalCircuitUpgrade struct {
+	// Validator is the bech32 validator operator address (sdk.ValAddress) casting this
+	// signal -- the same address GetSigners derives its required-signer AccAddress
+	// from, mirroring how a validator's operator key authorizes its own staking
+	// messages.
+	Validator string `json:"validator"`
+
+	CircuitId string `json:"circuitId"`
+
+	// NewVkHash is a hex-encoded content hash (see ComputeVKHash) of the new
+	// Circuit.Parameters blob this signal proposes rotating to -- never the key
+	// material itself, which is submitted once out-of-band and only referenced here so
+	// a signal can be small and every validator's votes can be compared for equality.
+	NewVkHash string `json:"newVkHash"`
+
+	// ActivationHeight is the block height the rotation takes effect at, once
+	// scheduled. Must be in the future relative to the signaling block.
+	ActivationHeight int64 `json:"activationHeight"`
+}
+
+func (msg *MsgSignalCircuitUpgrade) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return ErrUnauthorized.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit id cannot be empty")
+	}
+	if msg.NewVkHash == "" {
+		return ErrInvalidCircuit.Wrap("new vk hash cannot be empty")
+	}
+	if msg.ActivationHeight <= 0 {
+		return ErrInvalidCircuit.Wrap("activation height must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgSignalCircuitUpgrade) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.Validator)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg *MsgSignalCircuitUpgrade) GetSignBytes() []byte {
+	bz, _ := json.Marshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSignalCircuitUpgrade) ProtoMessage()  {}
+func (m *MsgSignalCircuitUpgrade) Reset()         { *m = MsgSignalCircuitUpgrade{} }
+func (m *MsgSignalCircuitUpgrade) String() string { return proto.CompactTextString(m) }
+
+// MsgSignalCircuitUpgradeResponse is MsgSignalCircuitUpgrade's response.
+type MsgSignalCircuitUpgradeResponse struct {
+	// Status is the (CircuitId, NewVkHash) upgrade's status immediately after this
+	// signal was recorded -- still CircuitUpgradeStatusSignaling unless this signal
+	// itself crossed the threshold.
+	Status CircuitUpgradeStatus `json:"status"`
+}
+
+func (m *MsgSignalCircuitUpgradeResponse) ProtoMessage()  {}
+func (m *MsgSignalCircuitUpgradeResponse) Reset()         { *m = MsgSignalCircuitUpgradeResponse{} }
+func (m *MsgSignalCircuitUpgradeResponse) String() string { return proto.CompactTextString(m) }
+
+// CircuitUpgradeSignal is one validator's latest vote for a (CircuitId, NewVkHash)
+// rotation, stored under (CircuitId, NewVkHash, Validator) so a later
+// MsgSignalCircuitUpgrade from the same validator for the same pair overwrites rather
+// than double-counts, and a validator switching its vote to a different NewVkHash for
+// the same circuit is tracked as a separate entry the tally for its old vote no longer
+// sees once Keeper.SignalCircuitUpgrade removes it.
+type CircuitUpgradeSignal struct {
+	Validator        string    `json:"validator"`
+	CircuitId        string    `json:"circuitId"`
+	NewVkHash        string    `json:"newVkHash"`
+	ActivationHeight int64     `json:"activationHeight"`
+	SignaledAt       time.Time `json:"signaledAt"`
+}
+
+func (m *CircuitUpgradeSignal) ProtoMessage()  {}
+func (m *CircuitUpgradeSignal) Reset()         { *m = CircuitUpgradeSignal{} }
+func (m *CircuitUpgradeSignal) String() string { return proto.CompactTextString(m) }
+
+// PendingCircuitUpgrade is the tallied state of one (CircuitId, NewVkHash) rotation
+// proposal, stored under PendingCircuitUpgradeKey(CircuitId, NewVkHash) and returned by
+// keeper.QueryServer.PendingCircuitUpgrades.
+type PendingCircuitUpgrade struct {
+	CircuitId        string               `json:"circuitId"`
+	NewVkHash        string               `json:"newVkHash"`
+	ActivationHeight int64                `json:"activationHeight"`
+	Status           CircuitUpgradeStatus `json:"status"`
+
+	// TalliedPower is the sum of bonded voting power behind every validator's latest
+	// signal for this (CircuitId, NewVkHash) pair, recomputed each EndBlocker until the
+	// upgrade is Scheduled.
+	TalliedPower int64 `json:"talliedPower"`
+
+	// TotalBondedPower is the chain's total bonded power as of the last tally, the
+	// denominator TalliedPower/TotalBondedPower is compared to
+	// Params.CircuitUpgradeThreshold against.
+	TotalBondedPower int64 `json:"totalBondedPower"`
+
+	FirstSignaledAt time.Time  `json:"firstSignaledAt"`
+	ScheduledAt     *time.Time `json:"scheduledAt,omitempty"`
+	ActivatedAt     *time.Time `json:"activatedAt,omitempty"`
+}
+
+func (m *PendingCircuitUpgrade) ProtoMessage()  {}
+func (m *PendingCircuitUpgrade) Reset()         { *m = PendingCircuitUpgrade{} }
+func (m *PendingCircuitUpgrade) String() string { return proto.CompactTextString(m) }
+
+// TallyRatio returns TalliedPower/TotalBondedPower, or 0 if no power has been recorded
+// yet, the value EndBlocker compares against Params.CircuitUpgradeThreshold.
+func (p *PendingCircuitUpgrade) TallyRatio() float64 {
+	if p.TotalBondedPower == 0 {
+		return 0
+	}
+	return float64(p.TalliedPower) / float64(p.TotalBondedPower)
+}
+
+// CircuitVerifyingKeyVersion is one verifying-key epoch for a circuit, stored under
+// GetCircuitVKKey(CircuitId, Epoch). Keeping both the current and pending epoch's key
+// material addressable by epoch (rather than overwriting Circuit.Parameters in place)
+// is what gives in-flight proofs their grace window: a proof whose submission_height
+// falls before ActivationHeight is checked against the epoch active at that height, not
+// whatever is newest.
+type CircuitVerifyingKeyVersion struct {
+	CircuitId string `json:"circuitId"`
+	Epoch     uint64 `json:"epoch"`
+
+	// VkHash is NewVkHash from the MsgSignalCircuitUpgrade that scheduled this epoch
+	// (or the circuit's original VerifyGroth16Proof-style hash for epoch 0).
+	VkHash string `json:"vkHash"`
+
+	// Parameters is the verifying-key blob this epoch's VkHash was computed over --
+	// submitted out-of-band by MsgUpdateCircuitVK (modeled after MsgUpdateOIDCKeys's
+	// governance/relayer-pushed pattern in x/did) once a signaled rotation schedules,
+	// since MsgSignalCircuitUpgrade itself only carries a hash, not the key material.
+	Parameters []byte `json:"parameters"`
+
+	ActivationHeight int64     `json:"activationHeight"`
+	ActivatedAt      time.Time `json:"activatedAt"`
+}
+
+func (m *CircuitVerifyingKeyVersion) ProtoMessage()  {}
+func (m *CircuitVerifyingKeyVersion) Reset()         { *m = CircuitVerifyingKeyVersion{} }
+func (m *CircuitVerifyingKeyVersion) String() string { return proto.CompactTextString(m) }
+
+// PendingCircuitUpgradesQuery lists in-flight (Signaling or Scheduled) upgrades,
+// optionally restricted to one CircuitId -- the query wallets/provers poll so they know
+// to regenerate proofs against an upcoming verifying key before its ActivationHeight.
+type PendingCircuitUpgradesQuery struct {
+	CircuitId string `json:"circuitId,omitempty"`
+}
+
+// PendingCircuitUpgradesResponse is PendingCircuitUpgradesQuery's response.
+type PendingCircuitUpgradesResponse struct {
+	Upgrades []PendingCircuitUpgrade `json:"upgrades"`
+}
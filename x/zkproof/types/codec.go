@@ -1,10 +1,13 @@
 package types
 
 import (
+	"context"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
@@ -19,6 +22,20 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVerifyProof{}, "zkproof/VerifyProof", nil)
 	cdc.RegisterConcrete(&MsgCreateProofRequest{}, "zkproof/CreateProofRequest", nil)
 	cdc.RegisterConcrete(&MsgUpdateCircuit{}, "zkproof/UpdateCircuit", nil)
+	cdc.RegisterConcrete(&MsgGrantProofAuthorization{}, "zkproof/GrantProofAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRevokeProofAuthorization{}, "zkproof/RevokeProofAuthorization", nil)
+	cdc.RegisterConcrete(&MsgStartCeremony{}, "zkproof/StartCeremony", nil)
+	cdc.RegisterConcrete(&MsgContributeCeremony{}, "zkproof/ContributeCeremony", nil)
+	cdc.RegisterConcrete(&MsgFinalizeCeremony{}, "zkproof/FinalizeCeremony", nil)
+	cdc.RegisterConcrete(&MsgBidOnProofRequest{}, "zkproof/BidOnProofRequest", nil)
+	cdc.RegisterConcrete(&MsgAcceptBid{}, "zkproof/AcceptBid", nil)
+	cdc.RegisterConcrete(&MsgClaimReward{}, "zkproof/ClaimReward", nil)
+	cdc.RegisterConcrete(&MsgSlashProver{}, "zkproof/SlashProver", nil)
+	cdc.RegisterConcrete(&MsgSubmitPredicateProof{}, "zkproof/SubmitPredicateProof", nil)
+	cdc.RegisterConcrete(&MsgSubmitProofBatch{}, "zkproof/SubmitProofBatch", nil)
+	cdc.RegisterConcrete(&MsgFulfillProofRequest{}, "zkproof/FulfillProofRequest", nil)
+	cdc.RegisterConcrete(&MsgCancelProofRequest{}, "zkproof/CancelProofRequest", nil)
+	cdc.RegisterConcrete(&MsgSignalCircuitUpgrade{}, "zkproof/SignalCircuitUpgrade", nil)
 }
 
 // RegisterInterfaces registers the zkproof module's interface types.
@@ -29,6 +46,26 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVerifyProof{},
 		&MsgCreateProofRequest{},
 		&MsgUpdateCircuit{},
+		&MsgGrantProofAuthorization{},
+		&MsgRevokeProofAuthorization{},
+		&MsgStartCeremony{},
+		&MsgContributeCeremony{},
+		&MsgFinalizeCeremony{},
+		&MsgBidOnProofRequest{},
+		&MsgAcceptBid{},
+		&MsgClaimReward{},
+		&MsgSlashProver{},
+		&MsgSubmitPredicateProof{},
+		&MsgSubmitProofBatch{},
+		&MsgFulfillProofRequest{},
+		&MsgCancelProofRequest{},
+		&MsgSignalCircuitUpgrade{},
+	)
+
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&RegisterCircuitProposal{},
+		&DeprecateCircuitProposal{},
+		&SlashProverProposal{},
 	)
 
 	// Message service registration handled by generated proto code
@@ -51,11 +88,34 @@ func init() {
 	RegisterCodec(legacy.Cdc)
 }
 
-// RegisterMsgServer registers the server implementation with the server configurator
-func RegisterMsgServer(s cdctypes.InterfaceRegistry, srv MsgServer) {
-	// This will be implemented when we have proper proto generation
-	// For now, we'll use the manual implementation
+// MsgServer defines the gRPC Msg service implemented by the zkproof keeper. Msg types
+// in this package no longer implement Route()/Type() (the legacy sdk.LegacyMsg
+// interface) now that dispatch goes through this generated-style MsgServer rather than
+// the legacy baseapp router.
+type MsgServer interface {
+	CreateCircuit(ctx context.Context, msg *MsgCreateCircuit) (*MsgCreateCircuitResponse, error)
+	UpdateCircuit(ctx context.Context, msg *MsgUpdateCircuit) (*MsgUpdateCircuitResponse, error)
+	SubmitProof(ctx context.Context, msg *MsgSubmitProof) (*MsgSubmitProofResponse, error)
+	VerifyProof(ctx context.Context, msg *MsgVerifyProof) (*MsgVerifyProofResponse, error)
+	CreateProofRequest(ctx context.Context, msg *MsgCreateProofRequest) (*MsgCreateProofRequestResponse, error)
+	GrantProofAuthorization(ctx context.Context, msg *MsgGrantProofAuthorization) (*MsgGrantProofAuthorizationResponse, error)
+	RevokeProofAuthorization(ctx context.Context, msg *MsgRevokeProofAuthorization) (*MsgRevokeProofAuthorizationResponse, error)
+	StartCeremony(ctx context.Context, msg *MsgStartCeremony) (*MsgStartCeremonyResponse, error)
+	ContributeCeremony(ctx context.Context, msg *MsgContributeCeremony) (*MsgContributeCeremonyResponse, error)
+	FinalizeCeremony(ctx context.Context, msg *MsgFinalizeCeremony) (*MsgFinalizeCeremonyResponse, error)
+	BidOnProofRequest(ctx context.Context, msg *MsgBidOnProofRequest) (*MsgBidOnProofRequestResponse, error)
+	AcceptBid(ctx context.Context, msg *MsgAcceptBid) (*MsgAcceptBidResponse, error)
+	ClaimReward(ctx context.Context, msg *MsgClaimReward) (*MsgClaimRewardResponse, error)
+	SlashProver(ctx context.Context, msg *MsgSlashProver) (*MsgSlashProverResponse, error)
+	SubmitPredicateProof(ctx context.Context, msg *MsgSubmitPredicateProof) (*MsgSubmitPredicateProofResponse, error)
+	SubmitProofBatch(ctx context.Context, msg *MsgSubmitProofBatch) (*MsgSubmitProofBatchResponse, error)
+	FulfillProofRequest(ctx context.Context, msg *MsgFulfillProofRequest) (*MsgFulfillProofRequestResponse, error)
+	CancelProofRequest(ctx context.Context, msg *MsgCancelProofRequest) (*MsgCancelProofRequestResponse, error)
+	SignalCircuitUpgrade(ctx context.Context, msg *MsgSignalCircuitUpgrade) (*MsgSignalCircuitUpgradeResponse, error)
 }
 
-// _Msg_serviceDesc is a service descriptor for the Msg service
-var _Msg_serviceDesc = struct{}{}
\ No newline at end of file
+// RegisterMsgServer registers the server implementation with the server configurator.
+func RegisterMsgServer(server interface{}, impl MsgServer) {
+	// For compatibility with SDK message server registration; in a full proto
+	// implementation this would register impl with the gRPC service registrar.
+}
\ No newline at end of file
@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// zkproof module error codes
+var (
+	ErrInvalidCircuit    = errorsmod.Register(ModuleName, 2, "invalid circuit")
+	ErrCircuitNotFound   = errorsmod.Register(ModuleName, 3, "circuit not found")
+	ErrCircuitExists     = errorsmod.Register(ModuleName, 4, "circuit already exists")
+	ErrInvalidProof      = errorsmod.Register(ModuleName, 5, "invalid proof")
+	ErrInvalidProofType  = errorsmod.Register(ModuleName, 6, "invalid proof type")
+	ErrProofNotFound     = errorsmod.Register(ModuleName, 7, "proof not found")
+	ErrProofExists       = errorsmod.Register(ModuleName, 8, "proof already exists")
+	ErrProofExpired      = errorsmod.Register(ModuleName, 9, "proof expired")
+	ErrInvalidRequest    = errorsmod.Register(ModuleName, 10, "invalid proof request")
+	ErrRequestNotFound   = errorsmod.Register(ModuleName, 11, "proof request not found")
+	ErrUnauthorized      = errorsmod.Register(ModuleName, 12, "unauthorized")
+	ErrInsufficientFunds = errorsmod.Register(ModuleName, 13, "insufficient funds")
+	ErrIncompatibleProofs  = errorsmod.Register(ModuleName, 14, "proofs are not compatible for aggregation")
+	ErrInvalidCeremony     = errorsmod.Register(ModuleName, 15, "invalid ceremony")
+	ErrCeremonyNotFound    = errorsmod.Register(ModuleName, 16, "ceremony not found")
+	ErrCeremonyExists      = errorsmod.Register(ModuleName, 17, "ceremony already exists")
+	ErrCeremonyFinalized   = errorsmod.Register(ModuleName, 18, "ceremony already finalized")
+	ErrInvalidContribution = errorsmod.Register(ModuleName, 19, "invalid ceremony contribution")
+	ErrBidNotFound         = errorsmod.Register(ModuleName, 20, "bid not found")
+	ErrRequestNotAccepted  = errorsmod.Register(ModuleName, 21, "proof request has no accepted prover")
+	ErrDeadlineNotPassed   = errorsmod.Register(ModuleName, 22, "proof request deadline has not passed")
+	ErrInvalidPredicate    = errorsmod.Register(ModuleName, 23, "invalid predicate expression")
+	ErrSchemaFieldNotFound = errorsmod.Register(ModuleName, 24, "predicate field not found on schema")
+	ErrInvalidIBCVersion   = errorsmod.Register(ModuleName, 25, "invalid zkproof-verify IBC version")
+	ErrInvalidQuery        = errorsmod.Register(ModuleName, 26, "invalid query")
+	ErrInvalidStateCommitment = errorsmod.Register(ModuleName, 27, "invalid ICS-23 state commitment")
+	ErrRequestExpired         = errorsmod.Register(ModuleName, 28, "proof request deadline has passed")
+	ErrCircuitUpgradeNotFound = errorsmod.Register(ModuleName, 29, "pending circuit upgrade not found")
+	ErrOracleBindingFailed    = errorsmod.Register(ModuleName, 30, "oracle binding value does not match the oracle's resolved value")
+)
@@ -7,6 +7,22 @@ const (
 	EventTypeProofSubmitted      = "proof_submitted"
 	EventTypeProofVerified       = "proof_verified"
 	EventTypeProofRequestCreated = "proof_request_created"
+	EventTypeProofsAggregated    = "proofs_aggregated"
+	EventTypeCeremonyStarted     = "ceremony_started"
+	EventTypeCeremonyContributed = "ceremony_contributed"
+	EventTypeCeremonyFinalized   = "ceremony_finalized"
+	EventTypeBidSubmitted        = "bid_submitted"
+	EventTypeBidAccepted         = "bid_accepted"
+	EventTypeRewardClaimed       = "reward_claimed"
+	EventTypeProverSlashed       = "prover_slashed"
+	EventTypePredicateProofSubmitted = "predicate_proof_submitted"
+	EventTypeProofRequestFulfilled   = "proof_request_fulfilled"
+	EventTypeProofRequestCancelled   = "proof_request_cancelled"
+	EventTypeProofRequestExpired     = "proof_request_expired"
+
+	EventCircuitUpgradeSignaled  = "circuit_upgrade_signaled"
+	EventCircuitUpgradeScheduled = "circuit_upgrade_scheduled"
+	EventCircuitUpgradeActivated = "circuit_upgrade_activated"
 )
 
 // Attribute keys for events
@@ -22,4 +38,20 @@ const (
 	AttributeKeyProofRequestID  = "proof_request_id"
 	AttributeKeyRequester       = "requester"
 	AttributeKeyTargetProver    = "target_prover"
+	AttributeKeyAggregatedProofID = "aggregated_proof_id"
+	AttributeKeyAggregationMode   = "aggregation_mode"
+	AttributeKeySourceProofCount  = "source_proof_count"
+	AttributeKeyCeremonyID        = "ceremony_id"
+	AttributeKeyCeremonyPhase     = "ceremony_phase"
+	AttributeKeyContributorDID    = "contributor_did"
+	AttributeKeyContributionIndex = "contribution_index"
+	AttributeKeyAmount            = "amount"
+	AttributeKeySchemaID          = "schema_id"
+
+	AttributeKeyNewVkHash          = "new_vk_hash"
+	AttributeKeyActivationHeight   = "activation_height"
+	AttributeKeyValidator          = "validator"
+	AttributeKeyTalliedPower       = "tallied_power"
+	AttributeKeyTotalBondedPower   = "total_bonded_power"
+	AttributeKeyEpoch              = "epoch"
 )
\ No newline at end of file
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +21,42 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// SchemaKeeper defines the expected interface for the Schema module, letting
+// CompilePredicateCircuit validate a PredicateExpr's fields against the credential
+// schema it targets without x/zkproof importing x/schema/types directly.
+type SchemaKeeper interface {
+	// GetSchemaBody returns the raw JSON Schema body (CredentialSchema.Schema.Raw)
+	// registered under schemaID.
+	GetSchemaBody(ctx context.Context, schemaID string) ([]byte, error)
+}
+
+// StakingKeeper defines the expected interface for the staking module, letting
+// keeper.Keeper.TallyCircuitUpgrades weigh each validator's MsgSignalCircuitUpgrade by
+// its bonded power rather than counting one validator as one vote.
+type StakingKeeper interface {
+	// GetLastValidatorPower returns valAddr's bonded power as of the last power update
+	// (the same snapshot x/slashing and x/gov tally against), or found=false if
+	// valAddr isn't a bonded validator.
+	GetLastValidatorPower(ctx context.Context, valAddr sdk.ValAddress) (power int64, found bool)
+
+	// GetLastTotalPower returns the chain's total bonded power as of the last power
+	// update, the denominator PendingCircuitUpgrade.TallyRatio divides by.
+	GetLastTotalPower(ctx context.Context) (math.Int, error)
+}
+
+// OracleKeeper defines the expected interface for the Oracle module, letting
+// keeper.VerifyOracleBinding check an OracleBinding's asserted Value against what
+// x/oracle's committee response flow actually resolved for a request, without
+// x/zkproof importing x/oracle/types directly.
+type OracleKeeper interface {
+	// GetResolvedValue returns the aggregate value x/oracle's
+	// CommitteeResponseEndBlocker (or an immediate MinResponses resolution) recorded
+	// for requestID, rendered as a string the same way OracleBinding.Value is -- and
+	// resolved=false if requestID's committee response window hasn't resolved yet.
+	GetResolvedValue(ctx context.Context, requestID string) (value string, resolved bool, err error)
 }
 
 // DIDKeeper defines the expected interface for the DID module.
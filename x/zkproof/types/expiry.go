@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// ExpiryQueuePrefix is the collections prefix for the time-ordered expiry queue used
+// by EndBlocker to reap expired proofs without a full-table scan.
+const ExpiryQueuePrefix = "zkproof_expiry_queue/"
+
+// ExpiryQueueKey builds a lexicographically time-ordered key so that EndBlocker can
+// iterate the queue up to the current block time and stop as soon as it sees an entry
+// whose expiry is still in the future.
+func ExpiryQueueKey(expiresAt time.Time, proofID string) []byte {
+	key := make([]byte, 0, len(ExpiryQueuePrefix)+8+len(proofID)+1)
+	key = append(key, []byte(ExpiryQueuePrefix)...)
+	key = append(key, expiryTimeBytes(expiresAt)...)
+	key = append(key, ':')
+	key = append(key, []byte(proofID)...)
+	return key
+}
+
+// ExpiryQueuePrefixUntil returns the prefix covering every entry whose expiry is at or
+// before the given time, suitable for a bounded range iteration in EndBlocker.
+func ExpiryQueuePrefixUntil(at time.Time) []byte {
+	key := make([]byte, 0, len(ExpiryQueuePrefix)+8)
+	key = append(key, []byte(ExpiryQueuePrefix)...)
+	key = append(key, expiryTimeBytes(at)...)
+	return key
+}
+
+// expiryTimeBytes encodes a time as a sortable big-endian uint64 of unix seconds.
+func expiryTimeBytes(t time.Time) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(t.Unix()))
+	return bz
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// Governance proposal routes and types for curating which circuits this module trusts
+// and for disciplining provers who keep submitting invalid proofs, rather than leaving
+// either decision to whoever calls MsgCreateCircuit/MsgSubmitProof.
+const (
+	ProposalRouteRegisterCircuit   = "zkproof/RegisterCircuit"
+	ProposalTypeRegisterCircuit    = "RegisterCircuit"
+	ProposalRouteDeprecateCircuit  = "zkproof/DeprecateCircuit"
+	ProposalTypeDeprecateCircuit   = "DeprecateCircuit"
+	ProposalRouteSlashProver       = "zkproof/SlashProver"
+	ProposalTypeSlashProver        = "SlashProver"
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeRegisterCircuit)
+	govv1beta1.RegisterProposalType(ProposalTypeDeprecateCircuit)
+	govv1beta1.RegisterProposalType(ProposalTypeSlashProver)
+}
+
+var (
+	_ govv1beta1.Content = &RegisterCircuitProposal{}
+	_ govv1beta1.Content = &DeprecateCircuitProposal{}
+	_ govv1beta1.Content = &SlashProverProposal{}
+)
+
+// RegisterCircuitProposal whitelists a new verification circuit by submitting its
+// verification key and metadata through governance, rather than letting whoever calls
+// MsgCreateCircuit decide unilaterally which circuits this chain trusts.
+type RegisterCircuitProposal struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	CircuitId   string      `json:"circuit_id"`
+	CircuitType CircuitType `json:"circuit_type"`
+	// VerificationKey is the circuit's compiled verifying key material, the same
+	// Parameters field Circuit stores and VerifyGroth16Proof requires to be non-empty.
+	VerificationKey []byte `json:"verification_key"`
+}
+
+func (p *RegisterCircuitProposal) ProtoMessage()  {}
+func (p *RegisterCircuitProposal) Reset()         { *p = RegisterCircuitProposal{} }
+func (p *RegisterCircuitProposal) String() string {
+	return fmt.Sprintf("RegisterCircuitProposal{CircuitId: %s, CircuitType: %s}", p.CircuitId, p.CircuitType)
+}
+
+func (p *RegisterCircuitProposal) GetTitle() string       { return p.Title }
+func (p *RegisterCircuitProposal) GetDescription() string { return p.Description }
+func (p *RegisterCircuitProposal) ProposalRoute() string  { return ProposalRouteRegisterCircuit }
+func (p *RegisterCircuitProposal) ProposalType() string   { return ProposalTypeRegisterCircuit }
+
+func (p *RegisterCircuitProposal) ValidateBasic() error {
+	if p.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit_id cannot be empty")
+	}
+	if len(p.VerificationKey) == 0 {
+		return ErrInvalidCircuit.Wrap("verification_key cannot be empty")
+	}
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// DeprecateCircuitProposal marks a previously registered circuit non-verifiable after
+// EffectiveHeight, while leaving every proof already recorded against it untouched --
+// historical proofs stay valid, only new MsgSubmitProof/MsgVerifyProof calls against
+// the circuit are rejected from that height on.
+type DeprecateCircuitProposal struct {
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	CircuitId       string `json:"circuit_id"`
+	EffectiveHeight int64  `json:"effective_height"`
+}
+
+func (p *DeprecateCircuitProposal) ProtoMessage()  {}
+func (p *DeprecateCircuitProposal) Reset()         { *p = DeprecateCircuitProposal{} }
+func (p *DeprecateCircuitProposal) String() string {
+	return fmt.Sprintf("DeprecateCircuitProposal{CircuitId: %s, EffectiveHeight: %d}", p.CircuitId, p.EffectiveHeight)
+}
+
+func (p *DeprecateCircuitProposal) GetTitle() string       { return p.Title }
+func (p *DeprecateCircuitProposal) GetDescription() string { return p.Description }
+func (p *DeprecateCircuitProposal) ProposalRoute() string  { return ProposalRouteDeprecateCircuit }
+func (p *DeprecateCircuitProposal) ProposalType() string   { return ProposalTypeDeprecateCircuit }
+
+func (p *DeprecateCircuitProposal) ValidateBasic() error {
+	if p.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit_id cannot be empty")
+	}
+	if p.EffectiveHeight <= 0 {
+		return ErrInvalidCircuit.Wrap("effective_height must be positive")
+	}
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// SlashProverProposal burns Prover's bond and blocks the address from submitting
+// further proofs once governance has decided InvalidProofCount invalid proofs from
+// them is no longer tolerable, the disciplinary counterpart to MsgSlashProver's
+// per-request slashing in marketplace.go.
+type SlashProverProposal struct {
+	Title            string `json:"title"`
+	Description      string `json:"description"`
+	Prover           string `json:"prover"`
+	InvalidProofCount uint64 `json:"invalid_proof_count"`
+}
+
+func (p *SlashProverProposal) ProtoMessage()  {}
+func (p *SlashProverProposal) Reset()         { *p = SlashProverProposal{} }
+func (p *SlashProverProposal) String() string {
+	return fmt.Sprintf("SlashProverProposal{Prover: %s, InvalidProofCount: %d}", p.Prover, p.InvalidProofCount)
+}
+
+func (p *SlashProverProposal) GetTitle() string       { return p.Title }
+func (p *SlashProverProposal) GetDescription() string { return p.Description }
+func (p *SlashProverProposal) ProposalRoute() string  { return ProposalRouteSlashProver }
+func (p *SlashProverProposal) ProposalType() string   { return ProposalTypeSlashProver }
+
+func (p *SlashProverProposal) ValidateBasic() error {
+	if p.Prover == "" {
+		return ErrUnauthorized.Wrap("prover cannot be empty")
+	}
+	if p.InvalidProofCount == 0 {
+		return ErrInvalidRequest.Wrap("invalid_proof_count must be positive")
+	}
+	return govv1beta1.ValidateAbstract(p)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// PortID is the default port id the zkproof-verify IBC application binds to.
+	PortID = "personazk-1"
+
+	// Version is the version string negotiated during the zkproof-verify channel
+	// handshake. OnChanOpenInit/Try reject any counterparty proposing a different one.
+	Version = "zkproof-verify-1"
+)
+
+// ProofVerifyPacketData is the packet payload a counterparty chain sends asking this
+// chain to report whether ProofId has verified successfully against CircuitId, the
+// same question MsgVerifyProof answers for in-chain callers.
+type ProofVerifyPacketData struct {
+	ProofId   string `json:"proof_id"`
+	CircuitId string `json:"circuit_id"`
+}
+
+func (p *ProofVerifyPacketData) ProtoMessage()  {}
+func (p *ProofVerifyPacketData) Reset()         { *p = ProofVerifyPacketData{} }
+func (p *ProofVerifyPacketData) String() string { return proto.CompactTextString(p) }
+
+// GetBytes returns the canonical JSON encoding of p, the bytes a sender places in
+// channeltypes.Packet.Data.
+func (p ProofVerifyPacketData) GetBytes() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// ValidateBasic checks that p is well-formed before it is packed into a packet.
+func (p ProofVerifyPacketData) ValidateBasic() error {
+	if p.ProofId == "" {
+		return ErrInvalidProof.Wrap("proof_id must be set")
+	}
+	if p.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit_id must be set")
+	}
+	return nil
+}
+
+// ProofVerifyAcknowledgement is the successful acknowledgement this chain returns:
+// whether ProofId's recorded Status is ProofStatusValid for CircuitId as of Height. A
+// proof or circuit that doesn't exist, or a circuit mismatch, is still reported as
+// Valid=false rather than as an error acknowledgement -- only a malformed packet earns
+// channeltypes.NewErrorAcknowledgement.
+type ProofVerifyAcknowledgement struct {
+	Valid  bool  `json:"valid"`
+	Height int64 `json:"height"`
+}
+
+func (a *ProofVerifyAcknowledgement) ProtoMessage()  {}
+func (a *ProofVerifyAcknowledgement) Reset()         { *a = ProofVerifyAcknowledgement{} }
+func (a *ProofVerifyAcknowledgement) String() string { return proto.CompactTextString(a) }
+
+// GetBytes returns the canonical JSON encoding of a, the payload wrapped in a
+// channeltypes.Acknowledgement's Result field.
+func (a ProofVerifyAcknowledgement) GetBytes() ([]byte, error) {
+	return json.Marshal(a)
+}
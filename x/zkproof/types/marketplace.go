@@ -0,0 +1,284 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var (
+	_ sdk.Msg = &MsgBidOnProofRequest{}
+	_ sdk.Msg = &MsgAcceptBid{}
+	_ sdk.Msg = &MsgClaimReward{}
+	_ sdk.Msg = &MsgSlashProver{}
+)
+
+// Bid is one prover's offer to fulfill a ProofRequest, carrying the stake they commit
+// to lock into ModuleEscrowAccountName if accepted.
+type Bid struct {
+	Prover      string    `json:"prover"`
+	Stake       sdk.Coins `json:"stake"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+func (m *Bid) ProtoMessage()  {}
+func (m *Bid) Reset()         { *m = Bid{} }
+func (m *Bid) String() string { return proto.CompactTextString(m) }
+
+// Validate checks that a Bid carries a valid address and a stake meeting minStake.
+func (b *Bid) Validate(minStake sdk.Coins) error {
+	if _, err := sdk.AccAddressFromBech32(b.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if !b.Stake.IsValid() {
+		return ErrInvalidRequest.Wrap("bid stake is not a valid coin set")
+	}
+	for _, required := range minStake {
+		if b.Stake.AmountOf(required.Denom).LT(required.Amount) {
+			return ErrInvalidRequest.Wrap("bid stake is below the required minimum circuit stake")
+		}
+	}
+	return nil
+}
+
+// IsOpenTarget reports whether req has no fixed TargetProver and is instead resolved
+// by a reverse Dutch auction over DutchAuctionPrice -- the first bid accepted at its
+// current decayed price wins, rather than the requester picking among competing bids.
+func (req *ProofRequest) IsOpenTarget() bool {
+	return req.TargetProver == ""
+}
+
+// AuctionDecayBlocks is how many blocks an open-target ProofRequest's reward takes to
+// decay from Reward down to zero in a reverse Dutch auction.
+const AuctionDecayBlocks = 1000
+
+// DutchAuctionPrice returns the reward an open-target request is offering
+// blocksElapsed blocks after it was created: Reward decayed linearly to zero over
+// AuctionDecayBlocks, floored at zero once fully decayed. A request that isn't
+// IsOpenTarget always offers the full Reward regardless of elapsed blocks, since only
+// open-target requests run a Dutch auction.
+func DutchAuctionPrice(req *ProofRequest, blocksElapsed int64) sdk.Coins {
+	if !req.IsOpenTarget() || blocksElapsed <= 0 {
+		return req.Reward
+	}
+	if blocksElapsed >= AuctionDecayBlocks {
+		return sdk.NewCoins()
+	}
+	decayed := make(sdk.Coins, 0, len(req.Reward))
+	for _, coin := range req.Reward {
+		remaining := coin.Amount.MulRaw(AuctionDecayBlocks - blocksElapsed).QuoRaw(AuctionDecayBlocks)
+		if remaining.IsPositive() {
+			decayed = append(decayed, sdk.NewCoin(coin.Denom, remaining))
+		}
+	}
+	return decayed
+}
+
+// MsgBidOnProofRequest submits a prover's offer to fulfill a ProofRequest. For a
+// targeted request this only registers the bid for the requester's MsgAcceptBid to
+// consider; for an open-target request it is evaluated immediately against the
+// request's current DutchAuctionPrice and accepted on the spot if the bidder's Stake
+// still meets the circuit's minimum stake -- see Keeper.BidOnProofRequest.
+type MsgBidOnProofRequest struct {
+	Prover         string    `json:"prover"`
+	ProofRequestId string    `json:"proofRequestId"`
+	Stake          sdk.Coins `json:"stake"`
+}
+
+func (msg *MsgBidOnProofRequest) ValidateBasic() error {
+	if msg.ProofRequestId == "" {
+		return ErrInvalidRequest.Wrap("proof request ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if !msg.Stake.IsValid() || msg.Stake.IsZero() {
+		return ErrInvalidRequest.Wrap("bid stake must be a non-zero valid coin set")
+	}
+	return nil
+}
+
+func (msg *MsgBidOnProofRequest) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgBidOnProofRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgBidOnProofRequest) ProtoMessage()  {}
+func (m *MsgBidOnProofRequest) Reset()         { *m = MsgBidOnProofRequest{} }
+func (m *MsgBidOnProofRequest) String() string { return proto.CompactTextString(m) }
+
+// MsgBidOnProofRequestResponse is the response for MsgBidOnProofRequest.
+type MsgBidOnProofRequestResponse struct {
+	// Accepted reports whether the bid was accepted immediately, which only happens
+	// for an open-target request's Dutch auction.
+	Accepted bool `json:"accepted"`
+}
+
+func (m *MsgBidOnProofRequestResponse) ProtoMessage()  {}
+func (m *MsgBidOnProofRequestResponse) Reset()         { *m = MsgBidOnProofRequestResponse{} }
+func (m *MsgBidOnProofRequestResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgAcceptBid lets the requester behind a targeted ProofRequest pick one of the bids
+// submitted against it, escrowing both Reward and the chosen bid's Stake.
+type MsgAcceptBid struct {
+	Requester      string `json:"requester"`
+	ProofRequestId string `json:"proofRequestId"`
+	Prover         string `json:"prover"`
+}
+
+func (msg *MsgAcceptBid) ValidateBasic() error {
+	if msg.ProofRequestId == "" {
+		return ErrInvalidRequest.Wrap("proof request ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return ErrUnauthorized.Wrap("invalid requester address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	return nil
+}
+
+func (msg *MsgAcceptBid) GetSigners() []sdk.AccAddress {
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{requester}
+}
+
+func (msg *MsgAcceptBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgAcceptBid) ProtoMessage()  {}
+func (m *MsgAcceptBid) Reset()         { *m = MsgAcceptBid{} }
+func (m *MsgAcceptBid) String() string { return proto.CompactTextString(m) }
+
+// MsgAcceptBidResponse is the response for MsgAcceptBid.
+type MsgAcceptBidResponse struct{}
+
+func (m *MsgAcceptBidResponse) ProtoMessage()  {}
+func (m *MsgAcceptBidResponse) Reset()         { *m = MsgAcceptBidResponse{} }
+func (m *MsgAcceptBidResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgClaimReward lets the accepted prover claim Reward after referencing a ProofId
+// that verified successfully against the request's CircuitId, paying out Reward and
+// refunding ProverStake.
+type MsgClaimReward struct {
+	Prover         string `json:"prover"`
+	ProofRequestId string `json:"proofRequestId"`
+	ProofId        string `json:"proofId"`
+}
+
+func (msg *MsgClaimReward) ValidateBasic() error {
+	if msg.ProofRequestId == "" {
+		return ErrInvalidRequest.Wrap("proof request ID cannot be empty")
+	}
+	if msg.ProofId == "" {
+		return ErrInvalidProof.Wrap("proof ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	return nil
+}
+
+func (msg *MsgClaimReward) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgClaimReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgClaimReward) ProtoMessage()  {}
+func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
+func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
+
+// MsgClaimRewardResponse is the response for MsgClaimReward.
+type MsgClaimRewardResponse struct {
+	AmountPaid sdk.Coins `json:"amountPaid"`
+}
+
+func (m *MsgClaimRewardResponse) ProtoMessage()  {}
+func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
+func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSlashProver is submitted by anyone once a request's Deadline has passed without a
+// valid ZKProof referencing it: the accepted prover's ProverStake is slashed to the
+// requester and Reward is refunded to them.
+type MsgSlashProver struct {
+	Caller         string `json:"caller"`
+	ProofRequestId string `json:"proofRequestId"`
+}
+
+func (msg *MsgSlashProver) ValidateBasic() error {
+	if msg.ProofRequestId == "" {
+		return ErrInvalidRequest.Wrap("proof request ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Caller); err != nil {
+		return ErrUnauthorized.Wrap("invalid caller address")
+	}
+	return nil
+}
+
+func (msg *MsgSlashProver) GetSigners() []sdk.AccAddress {
+	caller, err := sdk.AccAddressFromBech32(msg.Caller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{caller}
+}
+
+func (msg *MsgSlashProver) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgSlashProver) ProtoMessage()  {}
+func (m *MsgSlashProver) Reset()         { *m = MsgSlashProver{} }
+func (m *MsgSlashProver) String() string { return proto.CompactTextString(m) }
+
+// MsgSlashProverResponse is the response for MsgSlashProver.
+type MsgSlashProverResponse struct {
+	SlashedAmount sdk.Coins `json:"slashedAmount"`
+}
+
+func (m *MsgSlashProverResponse) ProtoMessage()  {}
+func (m *MsgSlashProverResponse) Reset()         { *m = MsgSlashProverResponse{} }
+func (m *MsgSlashProverResponse) String() string { return proto.CompactTextString(m) }
+
+// OpenProofRequestQuery filters the marketplace listing of open (StatusPendingRequest)
+// proof requests, e.g. for a prover service browsing what it can bid on.
+type OpenProofRequestQuery struct {
+	CircuitId         string            `json:"circuitId,omitempty"`
+	RequiredProofType ProofType         `json:"requiredProofType,omitempty"`
+	Page              CursorPageRequest `json:"page,omitempty"`
+}
+
+// Matches reports whether req satisfies q's (optional) CircuitId/RequiredProofType
+// filters and is still open for bidding.
+func (q OpenProofRequestQuery) Matches(req ProofRequest) bool {
+	if req.Status != string(StatusPendingRequest) {
+		return false
+	}
+	if q.CircuitId != "" && req.CircuitId != q.CircuitId {
+		return false
+	}
+	if q.RequiredProofType != "" && req.RequiredProofType != q.RequiredProofType {
+		return false
+	}
+	return true
+}
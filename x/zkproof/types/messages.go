@@ -0,0 +1,510 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateCircuit{}
+	_ sdk.Msg = &MsgUpdateCircuit{}
+	_ sdk.Msg = &MsgSubmitProof{}
+	_ sdk.Msg = &MsgVerifyProof{}
+	_ sdk.Msg = &MsgCreateProofRequest{}
+	_ sdk.Msg = &MsgAggregateProofs{}
+)
+
+// MsgCreateCircuit defines the message to register a new ZK circuit
+type MsgCreateCircuit struct {
+	Creator              string      `json:"creator"`
+	Name                 string      `json:"name"`
+	Description          string      `json:"description"`
+	CircuitType          CircuitType `json:"circuitType"`
+	SupportedProofTypes  []ProofType `json:"supportedProofTypes"`
+	CircuitData          []byte      `json:"circuitData"`
+	Parameters           []byte      `json:"parameters"`
+	RequiresPublicInputs bool        `json:"requiresPublicInputs"`
+
+	// CurveId names the curve Parameters (the verifying key) is defined over, the same
+	// field Circuit.CurveId carries forward -- see CreateCircuit and
+	// types.ResolveProvingSystem. Empty defaults to CurveIDBN254.
+	CurveId CurveID `json:"curveId,omitempty"`
+}
+
+func (msg *MsgCreateCircuit) ValidateBasic() error {
+	if msg.Creator == "" {
+		return ErrUnauthorized.Wrap("creator cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized.Wrap("invalid creator address")
+	}
+	if msg.Name == "" {
+		return ErrInvalidCircuit.Wrap("circuit name cannot be empty")
+	}
+	if len(msg.CircuitData) == 0 {
+		return ErrInvalidCircuit.Wrap("circuit data cannot be empty")
+	}
+	if len(msg.SupportedProofTypes) == 0 {
+		return ErrInvalidCircuit.Wrap("circuit must support at least one proof type")
+	}
+	return nil
+}
+
+func (msg *MsgCreateCircuit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgCreateCircuit) ProtoMessage()  {}
+func (m *MsgCreateCircuit) Reset()         { *m = MsgCreateCircuit{} }
+func (m *MsgCreateCircuit) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateCircuitResponse is the response for MsgCreateCircuit
+type MsgCreateCircuitResponse struct {
+	CircuitId string `json:"circuitId"`
+}
+
+func (m *MsgCreateCircuitResponse) ProtoMessage()  {}
+func (m *MsgCreateCircuitResponse) Reset()         { *m = MsgCreateCircuitResponse{} }
+func (m *MsgCreateCircuitResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateCircuit defines the message to update an existing circuit's lifecycle state
+type MsgUpdateCircuit struct {
+	Creator   string `json:"creator"`
+	CircuitId string `json:"circuitId"`
+	Active    bool   `json:"active"`
+}
+
+func (msg *MsgUpdateCircuit) ValidateBasic() error {
+	if msg.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return ErrUnauthorized.Wrap("invalid creator address")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateCircuit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgUpdateCircuit) ProtoMessage()  {}
+func (m *MsgUpdateCircuit) Reset()         { *m = MsgUpdateCircuit{} }
+func (m *MsgUpdateCircuit) String() string { return proto.CompactTextString(m) }
+
+// MsgUpdateCircuitResponse is the response for MsgUpdateCircuit
+type MsgUpdateCircuitResponse struct{}
+
+func (m *MsgUpdateCircuitResponse) ProtoMessage()  {}
+func (m *MsgUpdateCircuitResponse) Reset()         { *m = MsgUpdateCircuitResponse{} }
+func (m *MsgUpdateCircuitResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitProof defines the message to submit a zero-knowledge proof
+type MsgSubmitProof struct {
+	Prover       string    `json:"prover"`
+	CircuitId    string    `json:"circuitId"`
+	ProofType    ProofType `json:"proofType"`
+	ProofData    []byte    `json:"proofData"`
+	PublicInputs []string  `json:"publicInputs"`
+
+	// StateCommitment, when set, asks SubmitProof to verify an ICS-23 membership proof
+	// against an external state root before recording the ZKProof, and to mirror the
+	// verified (root, path, value) tuple into the recorded proof's PublicInputs. See
+	// x/zkproof/types.StateCommitment.
+	StateCommitment *StateCommitment `json:"stateCommitment,omitempty"`
+
+	// OracleBinding, when set, asks SubmitProof to verify this proof's asserted value
+	// against x/oracle's committee-resolved value for a request before recording the
+	// ZKProof, and to mirror the verified value into the recorded proof's PublicInputs.
+	// See x/zkproof/types.OracleBinding.
+	OracleBinding *OracleBinding `json:"oracleBinding,omitempty"`
+}
+
+func (msg *MsgSubmitProof) ValidateBasic() error {
+	if msg.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if len(msg.ProofData) == 0 {
+		return ErrInvalidProof.Wrap("proof data cannot be empty")
+	}
+	if msg.ProofType == "" {
+		return ErrInvalidProofType.Wrap("proof type cannot be empty")
+	}
+	if msg.StateCommitment != nil {
+		if err := msg.StateCommitment.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.OracleBinding != nil {
+		if err := msg.OracleBinding.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg *MsgSubmitProof) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgSubmitProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgSubmitProof) ProtoMessage()  {}
+func (m *MsgSubmitProof) Reset()         { *m = MsgSubmitProof{} }
+func (m *MsgSubmitProof) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitProofResponse is the response for MsgSubmitProof
+type MsgSubmitProofResponse struct {
+	ProofId string `json:"proofId"`
+}
+
+func (m *MsgSubmitProofResponse) ProtoMessage()  {}
+func (m *MsgSubmitProofResponse) Reset()         { *m = MsgSubmitProofResponse{} }
+func (m *MsgSubmitProofResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgVerifyProof defines the message requesting on-chain verification of a submitted proof
+type MsgVerifyProof struct {
+	Verifier string `json:"verifier"`
+	ProofId  string `json:"proofId"`
+}
+
+func (msg *MsgVerifyProof) ValidateBasic() error {
+	if msg.ProofId == "" {
+		return ErrInvalidProof.Wrap("proof ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Verifier); err != nil {
+		return ErrUnauthorized.Wrap("invalid verifier address")
+	}
+	return nil
+}
+
+func (msg *MsgVerifyProof) GetSigners() []sdk.AccAddress {
+	verifier, err := sdk.AccAddressFromBech32(msg.Verifier)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{verifier}
+}
+
+func (msg *MsgVerifyProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgVerifyProof) ProtoMessage()  {}
+func (m *MsgVerifyProof) Reset()         { *m = MsgVerifyProof{} }
+func (m *MsgVerifyProof) String() string { return proto.CompactTextString(m) }
+
+// MsgVerifyProofResponse is the response for MsgVerifyProof
+type MsgVerifyProofResponse struct {
+	Valid bool `json:"valid"`
+}
+
+func (m *MsgVerifyProofResponse) ProtoMessage()  {}
+func (m *MsgVerifyProofResponse) Reset()         { *m = MsgVerifyProofResponse{} }
+func (m *MsgVerifyProofResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateProofRequest defines the message to request a proof from another prover
+type MsgCreateProofRequest struct {
+	Requester         string    `json:"requester"`
+	TargetProver      string    `json:"targetProver"`
+	CircuitId         string    `json:"circuitId"`
+	RequiredProofType ProofType `json:"requiredProofType"`
+	Challenge         string    `json:"challenge"`
+	Reward            sdk.Coins `json:"reward"`
+}
+
+func (msg *MsgCreateProofRequest) ValidateBasic() error {
+	if msg.CircuitId == "" {
+		return ErrInvalidCircuit.Wrap("circuit ID cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return ErrUnauthorized.Wrap("invalid requester address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TargetProver); err != nil {
+		return ErrUnauthorized.Wrap("invalid target prover address")
+	}
+	if msg.RequiredProofType == "" {
+		return ErrInvalidProofType.Wrap("required proof type cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgCreateProofRequest) GetSigners() []sdk.AccAddress {
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{requester}
+}
+
+func (msg *MsgCreateProofRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgCreateProofRequest) ProtoMessage()  {}
+func (m *MsgCreateProofRequest) Reset()         { *m = MsgCreateProofRequest{} }
+func (m *MsgCreateProofRequest) String() string { return proto.CompactTextString(m) }
+
+// MsgCreateProofRequestResponse is the response for MsgCreateProofRequest
+type MsgCreateProofRequestResponse struct {
+	RequestId string `json:"requestId"`
+}
+
+func (m *MsgCreateProofRequestResponse) ProtoMessage()  {}
+func (m *MsgCreateProofRequestResponse) Reset()         { *m = MsgCreateProofRequestResponse{} }
+func (m *MsgCreateProofRequestResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgFulfillProofRequest binds an already-submitted ZKProof to a pending ProofRequest:
+// the targeted prover names the proof they submitted against ProofRequest.CircuitId,
+// the keeper dispatches it through k.VerifierRegistry the same way MsgVerifyProof
+// does, and on a passing verification pays the escrowed Reward straight to Prover. This
+// is the direct-target fast path alongside the existing Bid/AcceptBid -> ClaimReward
+// flow: ClaimReward assumes a proof already verified valid by a separate MsgVerifyProof
+// call and requires an AcceptedProver set by MsgAcceptBid/BidOnProofRequest first;
+// MsgFulfillProofRequest does the verification itself in the same call, for a targeted
+// request (TargetProver set) that was never put up for bidding at all.
+type MsgFulfillProofRequest struct {
+	Prover         string `json:"prover"`
+	ProofRequestId string `json:"proofRequestId"`
+	ProofId        string `json:"proofId"`
+}
+
+func (msg *MsgFulfillProofRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if msg.ProofRequestId == "" {
+		return ErrRequestNotFound.Wrap("proof request ID cannot be empty")
+	}
+	if msg.ProofId == "" {
+		return ErrProofNotFound.Wrap("proof ID cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgFulfillProofRequest) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgFulfillProofRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgFulfillProofRequest) ProtoMessage()  {}
+func (m *MsgFulfillProofRequest) Reset()         { *m = MsgFulfillProofRequest{} }
+func (m *MsgFulfillProofRequest) String() string { return proto.CompactTextString(m) }
+
+// MsgFulfillProofRequestResponse is the response for MsgFulfillProofRequest
+type MsgFulfillProofRequestResponse struct {
+	Valid      bool      `json:"valid"`
+	AmountPaid sdk.Coins `json:"amountPaid"`
+}
+
+func (m *MsgFulfillProofRequestResponse) ProtoMessage()  {}
+func (m *MsgFulfillProofRequestResponse) Reset()         { *m = MsgFulfillProofRequestResponse{} }
+func (m *MsgFulfillProofRequestResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgCancelProofRequest lets Requester reclaim an escrowed Reward once Deadline has
+// passed on a request nobody ever accepted (AcceptedProver empty) -- the
+// never-accepted counterpart to MsgSlashProver, which instead handles a deadline
+// passing after a prover already staked into the request.
+type MsgCancelProofRequest struct {
+	Requester      string `json:"requester"`
+	ProofRequestId string `json:"proofRequestId"`
+}
+
+func (msg *MsgCancelProofRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Requester); err != nil {
+		return ErrUnauthorized.Wrap("invalid requester address")
+	}
+	if msg.ProofRequestId == "" {
+		return ErrRequestNotFound.Wrap("proof request ID cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgCancelProofRequest) GetSigners() []sdk.AccAddress {
+	requester, err := sdk.AccAddressFromBech32(msg.Requester)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{requester}
+}
+
+func (msg *MsgCancelProofRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgCancelProofRequest) ProtoMessage()  {}
+func (m *MsgCancelProofRequest) Reset()         { *m = MsgCancelProofRequest{} }
+func (m *MsgCancelProofRequest) String() string { return proto.CompactTextString(m) }
+
+// MsgCancelProofRequestResponse is the response for MsgCancelProofRequest
+type MsgCancelProofRequestResponse struct {
+	RefundedAmount sdk.Coins `json:"refundedAmount"`
+}
+
+func (m *MsgCancelProofRequestResponse) ProtoMessage()  {}
+func (m *MsgCancelProofRequestResponse) Reset()         { *m = MsgCancelProofRequestResponse{} }
+func (m *MsgCancelProofRequestResponse) String() string { return proto.CompactTextString(m) }
+
+// MsgAggregateProofs defines the message to fold several already-submitted proofs into
+// a single aggregated proof, per Mode -- see keeper.Keeper.AggregateProofs.
+type MsgAggregateProofs struct {
+	Aggregator string          `json:"aggregator"`
+	ProofIds   []string        `json:"proofIds"`
+	Mode       AggregationMode `json:"mode"`
+}
+
+func (msg *MsgAggregateProofs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Aggregator); err != nil {
+		return ErrUnauthorized.Wrap("invalid aggregator address")
+	}
+	if len(msg.ProofIds) < 2 {
+		return ErrInvalidProof.Wrap("aggregation requires at least two proofs")
+	}
+	seen := make(map[string]bool, len(msg.ProofIds))
+	for _, id := range msg.ProofIds {
+		if id == "" {
+			return ErrInvalidProof.Wrap("proof ID cannot be empty")
+		}
+		if seen[id] {
+			return ErrInvalidProof.Wrapf("proof %s listed more than once", id)
+		}
+		seen[id] = true
+	}
+	switch msg.Mode {
+	case AggregationModeSNARKRecursive, AggregationModeBatchLinearCombination:
+	default:
+		return ErrInvalidProofType.Wrapf("unsupported aggregation mode %q", msg.Mode)
+	}
+	return nil
+}
+
+func (msg *MsgAggregateProofs) GetSigners() []sdk.AccAddress {
+	aggregator, err := sdk.AccAddressFromBech32(msg.Aggregator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{aggregator}
+}
+
+func (msg *MsgAggregateProofs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgAggregateProofs) ProtoMessage()  {}
+func (m *MsgAggregateProofs) Reset()         { *m = MsgAggregateProofs{} }
+func (m *MsgAggregateProofs) String() string { return proto.CompactTextString(m) }
+
+// MsgAggregateProofsResponse is the response for MsgAggregateProofs
+type MsgAggregateProofsResponse struct {
+	AggregatedProofId string `json:"aggregatedProofId"`
+}
+
+func (m *MsgAggregateProofsResponse) ProtoMessage()  {}
+func (m *MsgAggregateProofsResponse) Reset()         { *m = MsgAggregateProofsResponse{} }
+func (m *MsgAggregateProofsResponse) String() string { return proto.CompactTextString(m) }
+
+// SubProofSubmission is one sub-proof of a MsgSubmitProofBatch -- the batch
+// counterpart to MsgSubmitProof's own Prover/CircuitId/ProofType/ProofData/
+// PublicInputs fields, minus Prover since the whole batch shares msg.Prover.
+type SubProofSubmission struct {
+	CircuitId    string    `json:"circuitId"`
+	ProofType    ProofType `json:"proofType"`
+	ProofData    []byte    `json:"proofData"`
+	PublicInputs []string  `json:"publicInputs"`
+}
+
+// MsgSubmitProofBatch submits N sub-proofs in one message, optionally accompanied by
+// an AggregationProof under AggregationCircuitId attesting "all N sub-proofs verify
+// under their respective circuits" -- see keeper.Keeper.SubmitProofBatch. When
+// AggregationProof is set, only that recursive proof is cryptographically verified and
+// every sub-proof is recorded types.ProofStatusValid directly; when it's empty, each
+// sub-proof is verified individually (the same per-proof path MsgVerifyProof takes),
+// just with batched storage writes and a single summary event instead of one per proof.
+type MsgSubmitProofBatch struct {
+	Prover               string               `json:"prover"`
+	SubProofs            []SubProofSubmission `json:"subProofs"`
+	AggregationCircuitId string               `json:"aggregationCircuitId,omitempty"`
+	AggregationProof     []byte               `json:"aggregationProof,omitempty"`
+}
+
+func (msg *MsgSubmitProofBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if len(msg.SubProofs) == 0 {
+		return ErrInvalidProof.Wrap("proof batch requires at least one sub-proof")
+	}
+	for i, sub := range msg.SubProofs {
+		if sub.CircuitId == "" {
+			return ErrInvalidCircuit.Wrapf("sub-proof %d: circuit ID cannot be empty", i)
+		}
+		if len(sub.ProofData) == 0 {
+			return ErrInvalidProof.Wrapf("sub-proof %d: proof data cannot be empty", i)
+		}
+		if sub.ProofType == "" {
+			return ErrInvalidProofType.Wrapf("sub-proof %d: proof type cannot be empty", i)
+		}
+	}
+	if len(msg.AggregationProof) > 0 && msg.AggregationCircuitId == "" {
+		return ErrInvalidCircuit.Wrap("aggregation proof requires an aggregation circuit ID")
+	}
+	return nil
+}
+
+func (msg *MsgSubmitProofBatch) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgSubmitProofBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgSubmitProofBatch) ProtoMessage()  {}
+func (m *MsgSubmitProofBatch) Reset()         { *m = MsgSubmitProofBatch{} }
+func (m *MsgSubmitProofBatch) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitProofBatchResponse is the response for MsgSubmitProofBatch
+type MsgSubmitProofBatchResponse struct {
+	ProofIds           []string `json:"proofIds"`
+	AggregationProofId string   `json:"aggregationProofId,omitempty"`
+}
+
+func (m *MsgSubmitProofBatchResponse) ProtoMessage()  {}
+func (m *MsgSubmitProofBatchResponse) Reset()         { *m = MsgSubmitProofBatchResponse{} }
+func (m *MsgSubmitProofBatchResponse) String() string { return proto.CompactTextString(m) }
@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+// OracleBinding, when set on MsgSubmitProof/ZKProof, declares that one of the circuit's
+// public inputs is backed by an x/oracle committee-resolved value (see
+// x/oracle/keeper/committee_response.go's CommitteeResponseEndBlocker) rather than a
+// value the prover merely asserts. keeper.VerifyOracleBinding checks it via
+// OracleKeeper.GetResolvedValue before SubmitProof records the proof, and mirrors the
+// verified value into the proof's PublicInputs -- the same "verify an external binding,
+// then mirror it into PublicInputs" shape StateCommitment already uses (see
+// state_commitment.go, keeper/state_commitment.go).
+type OracleBinding struct {
+	// RequestID names the x/oracle OracleRequest whose committee-resolved value backs
+	// this proof's declared public input.
+	RequestID string `json:"request_id"`
+
+	// Value is the value the prover asserts x/oracle resolved for RequestID.
+	// VerifyOracleBinding checks this against OracleKeeper.GetResolvedValue(RequestID)
+	// rather than trusting the prover's assertion -- analogous to how
+	// VerifyStateCommitment cryptographically checks StateCommitment.Value instead of
+	// accepting it unchecked.
+	Value string `json:"value"`
+}
+
+// Validate checks OracleBinding's own shape -- that it actually names a request to bind
+// against -- not whether RequestID's committee-resolved value matches Value
+// (VerifyOracleBinding's job).
+func (b *OracleBinding) Validate() error {
+	if b.RequestID == "" {
+		return fmt.Errorf("oracle binding request ID cannot be empty")
+	}
+	return nil
+}
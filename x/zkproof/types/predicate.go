@@ -0,0 +1,280 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+var _ sdk.Msg = &MsgSubmitPredicateProof{}
+
+// PredicateOp enumerates the comparison a PredicateConstraint checks a credential claim
+// with. Constraints are always AND-ed together by PredicateExpr -- there is no OR or NOT,
+// which keeps every constraint compilable into an independent gadget in
+// CompilePredicateCircuit.
+type PredicateOp string
+
+const (
+	PredicateOpEQ      PredicateOp = "=="
+	PredicateOpGTE     PredicateOp = ">="
+	PredicateOpLTE     PredicateOp = "<="
+	PredicateOpGT      PredicateOp = ">"
+	PredicateOpLT      PredicateOp = "<"
+	PredicateOpIn      PredicateOp = "IN"
+	PredicateOpBetween PredicateOp = "BETWEEN"
+)
+
+// PredicateConstraint is a single AND-ed clause of a PredicateExpr, e.g. the
+// `age >= 18` or `country IN {"US","CA"}` clauses of
+// `age >= 18 AND country IN {"US","CA"} AND income BETWEEN 50000 AND 200000`. Field
+// names a property of the credential schema PredicateExpr.SchemaId points at.
+type PredicateConstraint struct {
+	Field string      `json:"field"`
+	Op    PredicateOp `json:"op"`
+
+	// Value is the operand for PredicateOpEQ/GTE/LTE/GT/LT.
+	Value string `json:"value,omitempty"`
+
+	// Set is the operand for PredicateOpIn.
+	Set []string `json:"set,omitempty"`
+
+	// Low and High are the operands for PredicateOpBetween.
+	Low  string `json:"low,omitempty"`
+	High string `json:"high,omitempty"`
+}
+
+// Validate checks c is structurally well-formed for its Op, without yet knowing whether
+// Field exists on any particular schema -- that cross-check happens in
+// CompilePredicateCircuit, which has the schema body in hand.
+func (c PredicateConstraint) Validate() error {
+	if c.Field == "" {
+		return ErrInvalidPredicate.Wrap("constraint field cannot be empty")
+	}
+	switch c.Op {
+	case PredicateOpEQ, PredicateOpGTE, PredicateOpLTE, PredicateOpGT, PredicateOpLT:
+		if c.Value == "" {
+			return ErrInvalidPredicate.Wrapf("constraint on %q needs a value", c.Field)
+		}
+	case PredicateOpIn:
+		if len(c.Set) == 0 {
+			return ErrInvalidPredicate.Wrapf("constraint on %q needs a non-empty set", c.Field)
+		}
+	case PredicateOpBetween:
+		if c.Low == "" || c.High == "" {
+			return ErrInvalidPredicate.Wrapf("constraint on %q needs both a low and high bound", c.Field)
+		}
+	default:
+		return ErrInvalidPredicate.Wrapf("unsupported predicate op %q", c.Op)
+	}
+	return nil
+}
+
+// PredicateExpr is the structured form a client compiles source text like
+// `age >= 18 AND country IN {"US","CA"} AND income BETWEEN 50000 AND 200000` into
+// before calling MsgSubmitPredicateProof -- the module itself only ever sees
+// Constraints, never the source text, the same way a SQL driver never sees the query
+// string once it's been planned.
+type PredicateExpr struct {
+	Constraints []PredicateConstraint `json:"constraints"`
+}
+
+// Validate checks every constraint in e is individually well-formed and that e names at
+// least one.
+func (e PredicateExpr) Validate() error {
+	if len(e.Constraints) == 0 {
+		return ErrInvalidPredicate.Wrap("predicate must have at least one constraint")
+	}
+	for _, c := range e.Constraints {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RangeGadget is the compiled form of a GTE/LTE/GT/LT/BETWEEN constraint: a range proof
+// obligation the circuit's prover must satisfy for Field without revealing its value.
+// Low and/or High is empty when the constraint is one-sided (GT/GTE only sets Low;
+// LT/LTE only sets High).
+type RangeGadget struct {
+	Field string `json:"field"`
+	Low   string `json:"low,omitempty"`
+	High  string `json:"high,omitempty"`
+}
+
+// SetMembershipGadget is the compiled form of an IN constraint: Field's hidden value
+// must be one of the leaves committed under SetRoot, proved by Merkle inclusion rather
+// than by revealing which member it is.
+type SetMembershipGadget struct {
+	Field   string   `json:"field"`
+	SetRoot [32]byte `json:"setRoot"`
+}
+
+// predicateSetRoot computes a binary Merkle root over set's SHA-256-hashed, sorted-as-given
+// members, the same leaf/node hash construction x/revocation/types.StatusListMerkleRoot
+// uses for status-list chunks. Unlike that function this doesn't pad to a power of two
+// with duplicated leaves when set has an odd width at some level, since
+// SetMembershipGadget never needs an inclusion proof against an individual member on
+// chain -- only the root, which a prover's off-chain Merkle-path witness is checked
+// against inside the (not yet implemented) circuit itself.
+func predicateSetRoot(set []string) [32]byte {
+	leaves := make([][32]byte, len(set))
+	for i, member := range set {
+		leaves[i] = sha256Sum([]byte(member))
+	}
+	for len(leaves) > 1 {
+		next := make([][32]byte, 0, (len(leaves)+1)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			if i+1 == len(leaves) {
+				next = append(next, leaves[i])
+				continue
+			}
+			next = append(next, sha256Sum(append(append([]byte{}, leaves[i][:]...), leaves[i+1][:]...)))
+		}
+		leaves = next
+	}
+	return leaves[0]
+}
+
+// PoseidonHashPlaceholder stands in for a real field-native Poseidon hash over the
+// BN254 scalar field, which every other gadget in this file would otherwise use to bind
+// a credential subject's commitment into the circuit cheaply in-circuit. No Poseidon
+// implementation is vendored in this tree, so this falls back to SHA-256 the same way
+// x/credential/types.CanonicalizeCredentialSubject stands in for full URDNA2015
+// canonicalization -- structurally correct (deterministic, collision-resistant, binds
+// every input), but NOT what a real arithmetic circuit would constrain efficiently.
+//
+// TODO: replace with a real Poseidon permutation once a BN254-compatible
+// implementation is vendored.
+func PoseidonHashPlaceholder(inputs ...[]byte) [32]byte {
+	var acc []byte
+	for _, in := range inputs {
+		acc = append(acc, in...)
+	}
+	return sha256Sum(acc)
+}
+
+// PredicateCircuitData is the compiled form of a PredicateExpr against a credential
+// schema, serialized into Circuit.CircuitData for CircuitTypePredicate circuits.
+// Compiling the same (SchemaId, Expr) pair always yields byte-identical
+// PredicateCircuitData, which is what lets a verifier reconstruct the exact circuit a
+// prover targeted from nothing but the on-chain predicate and schema, rather than
+// trusting a circuit blob the prover supplied.
+type PredicateCircuitData struct {
+	SchemaId string                `json:"schemaId"`
+	Expr     PredicateExpr         `json:"expr"`
+	Ranges   []RangeGadget         `json:"ranges,omitempty"`
+	Sets     []SetMembershipGadget `json:"sets,omitempty"`
+}
+
+// CompilePredicateCircuit validates expr's fields against schemaBody (a JSON Schema
+// body in the flat "type"/"properties"/"required" subset x/schema/types.ValidateClaims
+// understands) and lowers each constraint into a RangeGadget or SetMembershipGadget.
+// EQ/GTE/LTE/GT/LT/BETWEEN constraints all compile to a RangeGadget (EQ as the
+// degenerate Low==High case); IN constraints compile to a SetMembershipGadget over
+// predicateSetRoot(constraint.Set).
+//
+// This doesn't import x/schema/types directly -- x/zkproof only needs a schema's raw
+// JSON Schema body, fetched through the SchemaKeeper expected-keeper interface, the
+// same arm's-length relationship x/credential/types keeps with x/schema's schema
+// format.
+func CompilePredicateCircuit(schemaID string, schemaBody []byte, expr PredicateExpr) (*PredicateCircuitData, error) {
+	if err := expr.Validate(); err != nil {
+		return nil, err
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(schemaBody, &schema); err != nil {
+		return nil, ErrInvalidPredicate.Wrapf("malformed schema body: %s", err)
+	}
+
+	compiled := &PredicateCircuitData{SchemaId: schemaID, Expr: expr}
+	for _, c := range expr.Constraints {
+		if _, ok := schema.Properties[c.Field]; !ok {
+			return nil, ErrSchemaFieldNotFound.Wrapf("schema %s has no property %q", schemaID, c.Field)
+		}
+
+		switch c.Op {
+		case PredicateOpEQ:
+			compiled.Ranges = append(compiled.Ranges, RangeGadget{Field: c.Field, Low: c.Value, High: c.Value})
+		case PredicateOpGTE, PredicateOpGT:
+			compiled.Ranges = append(compiled.Ranges, RangeGadget{Field: c.Field, Low: c.Value})
+		case PredicateOpLTE, PredicateOpLT:
+			compiled.Ranges = append(compiled.Ranges, RangeGadget{Field: c.Field, High: c.Value})
+		case PredicateOpBetween:
+			compiled.Ranges = append(compiled.Ranges, RangeGadget{Field: c.Field, Low: c.Low, High: c.High})
+		case PredicateOpIn:
+			compiled.Sets = append(compiled.Sets, SetMembershipGadget{Field: c.Field, SetRoot: predicateSetRoot(c.Set)})
+		}
+	}
+	return compiled, nil
+}
+
+// MsgSubmitPredicateProof submits a zero-knowledge proof that a verifiable credential's
+// hidden claims satisfy Predicate as compiled against SchemaId by
+// CompilePredicateCircuit, without revealing the claim values themselves. VcCommitment
+// binds the proof to one specific credential (see PoseidonHashPlaceholder), so the same
+// predicate can't be satisfied by swapping in a different holder's credential after the
+// fact.
+type MsgSubmitPredicateProof struct {
+	Prover       string        `json:"prover"`
+	SchemaId     string        `json:"schemaId"`
+	Predicate    PredicateExpr `json:"predicate"`
+	VcCommitment []byte        `json:"vcCommitment"`
+	ProofData    []byte        `json:"proofData"`
+}
+
+func (msg *MsgSubmitPredicateProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return ErrUnauthorized.Wrap("invalid prover address")
+	}
+	if msg.SchemaId == "" {
+		return ErrInvalidPredicate.Wrap("schema id cannot be empty")
+	}
+	if err := msg.Predicate.Validate(); err != nil {
+		return err
+	}
+	if len(msg.VcCommitment) == 0 {
+		return ErrInvalidPredicate.Wrap("VC commitment cannot be empty")
+	}
+	if len(msg.ProofData) == 0 {
+		return ErrInvalidProof.Wrap("proof data cannot be empty")
+	}
+	return nil
+}
+
+func (msg *MsgSubmitPredicateProof) GetSigners() []sdk.AccAddress {
+	prover, err := sdk.AccAddressFromBech32(msg.Prover)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{prover}
+}
+
+func (msg *MsgSubmitPredicateProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (m *MsgSubmitPredicateProof) ProtoMessage()  {}
+func (m *MsgSubmitPredicateProof) Reset()         { *m = MsgSubmitPredicateProof{} }
+func (m *MsgSubmitPredicateProof) String() string { return proto.CompactTextString(m) }
+
+// MsgSubmitPredicateProofResponse is the response for MsgSubmitPredicateProof.
+type MsgSubmitPredicateProofResponse struct {
+	ProofId   string `json:"proofId"`
+	CircuitId string `json:"circuitId"`
+}
+
+func (m *MsgSubmitPredicateProofResponse) ProtoMessage()  {}
+func (m *MsgSubmitPredicateProofResponse) Reset()         { *m = MsgSubmitPredicateProofResponse{} }
+func (m *MsgSubmitPredicateProofResponse) String() string { return proto.CompactTextString(m) }
+
+func sha256Sum(b []byte) [32]byte {
+	return sha256.Sum256(b)
+}
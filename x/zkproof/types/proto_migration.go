@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// This file tracks the module's move from the JSON-tagged, hand-rolled ProtoMessage()/
+// Reset()/String() types found throughout this package (Circuit, ZKProof, ProofRequest,
+// Params, and every Msg*/Msg*Response pair) onto real protobuf types generated from
+// proto/persona/zk/v1/{circuit,proof,request,params,genesis,tx,query}.proto.
+//
+// Those .proto files are the canonical schema now: Marshal/Unmarshal generated from them
+// would give every field a stable wire tag, make sdk.Coins and time.Time round-trip as
+// cosmos.base.v1beta1.Coin/google.protobuf.Timestamp instead of JSON's
+// locale-and-precision-dependent text encodings, and turn ProofType/CircuitType/
+// ProofStatus into real protobuf enums IBC packets and non-Go clients can decode without
+// depending on this package's exact string constants.
+//
+// Generating that code requires protoc (or buf) wired into this repo's build, which
+// isn't set up in this tree yet -- the same kind of pre-existing gap
+// msg_server_verify.go documents for the missing Keeper struct. Once it is, keeper
+// state (k.Circuits/k.Proofs/k.Requests) and the Msg/Query servers should construct
+// their collections.Map/collections.Pair value codecs from the generated types via
+// codec.CollValue[T], the same as any other Cosmos SDK module migrated onto
+// codec.BinaryCodec, and RegisterInterfaces in codec.go should register the generated
+// Msg types instead of (or alongside, during the migration window) the hand-rolled ones
+// above.
+
+// MigrateJSONCircuit reads a Circuit previously stored as JSON (this package's current
+// on-disk encoding) and re-encodes it through cdc as protobuf, for a state-migration
+// handler run once at the upgrade height that introduces codec.BinaryCodec-backed
+// storage. It validates that legacyJSON round-trips into something CircuitData-shaped
+// before attempting to re-encode it, the same defensive decode-then-validate structure
+// ValidateClaims uses against a credential schema body.
+//
+// The protobuf re-encode half can't be implemented yet: Circuit doesn't generate a
+// Marshal/Unmarshal pair until proto/persona/zk/v1/circuit.proto is compiled, so cdc
+// (expected to be the app's ProtoCodec once that exists) has nothing registered to
+// marshal Circuit through. This returns an error rather than silently falling back to
+// JSON, so a migration handler invoking this fails loudly instead of writing
+// mis-encoded state.
+func MigrateJSONCircuit(cdc codec.BinaryCodec, legacyJSON []byte) ([]byte, error) {
+	var legacy Circuit
+	if err := json.Unmarshal(legacyJSON, &legacy); err != nil {
+		return nil, fmt.Errorf("migrate circuit: legacy value is not valid JSON: %w", err)
+	}
+	if err := legacy.Validate(); err != nil {
+		return nil, fmt.Errorf("migrate circuit: legacy value failed validation: %w", err)
+	}
+
+	// TODO: once proto/persona/zk/v1/circuit.proto is compiled into a generated Circuit
+	// type, copy legacy's fields into it field-by-field (sdk.Coins -> repeated
+	// cosmos.base.v1beta1.Coin, time.Time -> google.protobuf.Timestamp,
+	// CircuitType/ProofType/CircuitStatus -> their generated enums) and return
+	// cdc.Marshal(&generated) here instead of this error.
+	return nil, fmt.Errorf("protobuf re-encoding not yet implemented: circuit.proto has no generated Go types in this tree")
+}
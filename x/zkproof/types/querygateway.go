@@ -0,0 +1,154 @@
+package types
+
+import "time"
+
+// TimeRange bounds a time-window filter shared by the query-gateway's CircuitsQuery,
+// ProofsQuery, and ProofRequestsQuery.
+type TimeRange struct {
+	From time.Time `json:"from,omitempty"`
+	To   time.Time `json:"to,omitempty"`
+}
+
+// Contains reports whether t falls within the range. A zero From or To is unbounded
+// on that side, so the zero-value TimeRange matches every t.
+func (r TimeRange) Contains(t time.Time) bool {
+	if !r.From.IsZero() && t.Before(r.From) {
+		return false
+	}
+	if !r.To.IsZero() && t.After(r.To) {
+		return false
+	}
+	return true
+}
+
+// CircuitsQuery filters the query-gateway's Circuits endpoint.
+type CircuitsQuery struct {
+	CircuitType CircuitType       `json:"circuitType,omitempty"`
+	Creator     string            `json:"creator,omitempty"`
+	Active      *bool             `json:"active,omitempty"`
+	CreatedAt   TimeRange         `json:"createdAt,omitempty"`
+	Page        CursorPageRequest `json:"page,omitempty"`
+}
+
+// Matches reports whether c satisfies every filter field set on q.
+func (q CircuitsQuery) Matches(c Circuit) bool {
+	if q.CircuitType != "" && c.CircuitType != q.CircuitType {
+		return false
+	}
+	if q.Creator != "" && c.Creator != q.Creator {
+		return false
+	}
+	if q.Active != nil && c.Active != *q.Active {
+		return false
+	}
+	if !q.CreatedAt.Contains(c.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+// ProofsQuery filters the query-gateway's Proofs endpoint.
+type ProofsQuery struct {
+	CircuitId   string            `json:"circuitId,omitempty"`
+	ProofType   ProofType         `json:"proofType,omitempty"`
+	Prover      string            `json:"prover,omitempty"`
+	Status      ProofStatus       `json:"status,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	ValidFrom   TimeRange         `json:"validFrom,omitempty"`
+	ExpiredOnly bool              `json:"expiredOnly,omitempty"`
+	Page        CursorPageRequest `json:"page,omitempty"`
+}
+
+// Matches reports whether p satisfies every filter field set on q, evaluating
+// ExpiredOnly/IsExpired relative to at (the caller's current block time).
+func (q ProofsQuery) Matches(p ZKProof, at time.Time) bool {
+	if q.CircuitId != "" && p.CircuitId != q.CircuitId {
+		return false
+	}
+	if q.ProofType != "" && p.ProofType != q.ProofType {
+		return false
+	}
+	if q.Prover != "" && p.Prover != q.Prover {
+		return false
+	}
+	if q.Status != "" && p.Status != q.Status {
+		return false
+	}
+	if !q.ValidFrom.Contains(p.ValidFrom) {
+		return false
+	}
+	if q.ExpiredOnly && !(p.ValidTo != nil && at.After(*p.ValidTo)) {
+		return false
+	}
+	if len(q.Tags) > 0 && !hasAllTags(p.Tags, q.Tags) {
+		return false
+	}
+	return true
+}
+
+// hasAllTags reports whether every tag in want is present in have.
+func hasAllTags(have, want []string) bool {
+	set := make(map[string]bool, len(have))
+	for _, t := range have {
+		set[t] = true
+	}
+	for _, t := range want {
+		if !set[t] {
+			return false
+		}
+	}
+	return true
+}
+
+// ProofRequestsQuery filters the query-gateway's ProofRequests endpoint across every
+// request status, the broader counterpart to OpenProofRequestQuery's
+// StatusPendingRequest-only marketplace listing.
+type ProofRequestsQuery struct {
+	CircuitId         string            `json:"circuitId,omitempty"`
+	RequiredProofType ProofType         `json:"requiredProofType,omitempty"`
+	Requester         string            `json:"requester,omitempty"`
+	Status            string            `json:"status,omitempty"`
+	Deadline          TimeRange         `json:"deadline,omitempty"`
+	Page              CursorPageRequest `json:"page,omitempty"`
+}
+
+// Matches reports whether r satisfies every filter field set on q.
+func (q ProofRequestsQuery) Matches(r ProofRequest) bool {
+	if q.CircuitId != "" && r.CircuitId != q.CircuitId {
+		return false
+	}
+	if q.RequiredProofType != "" && r.RequiredProofType != q.RequiredProofType {
+		return false
+	}
+	if q.Requester != "" && r.Requester != q.Requester {
+		return false
+	}
+	if q.Status != "" && r.Status != q.Status {
+		return false
+	}
+	if !q.Deadline.Contains(r.Deadline) {
+		return false
+	}
+	return true
+}
+
+// CircuitStatsResponse aggregates a circuit's proof-lineage counters for
+// indexers/explorers rendering proof-lineage graphs, computed from Circuit's running
+// ProofCount/SuccessfulProofs counters rather than re-walking every ZKProof submitted
+// against it.
+type CircuitStatsResponse struct {
+	CircuitId        string  `json:"circuitId"`
+	TotalProofs      uint64  `json:"totalProofs"`
+	SuccessfulProofs uint64  `json:"successfulProofs"`
+	SuccessRate      float64 `json:"successRate"`
+}
+
+// ProverSuccessRate reports one prover's verification track record across every proof
+// they've submitted, filtered down by the caller first if a narrower scope (a single
+// circuit, a time window) is wanted.
+type ProverSuccessRate struct {
+	Prover      string  `json:"prover"`
+	TotalProofs uint64  `json:"totalProofs"`
+	Successful  uint64  `json:"successful"`
+	SuccessRate float64 `json:"successRate"`
+}
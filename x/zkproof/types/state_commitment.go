@@ -0,0 +1,60 @@
+package types
+
+import (
+	ics23 "github.com/cosmos/ics23/go"
+)
+
+// StateCommitment binds a ZKProof to a value an external chain (or this chain's own
+// IBC counterparty) has merkle-committed to at Path under Root -- e.g. "I know a
+// preimage for the credential whose hash sits at ICS-23 path P in counterparty root
+// R", verified alongside the ZK proof itself rather than trusted on the prover's say-
+// so. Modeled on the ordered []*ics23.ProofSpec + chained CommitmentProof shape
+// ibc-go's 23-commitment MerkleProof.VerifyMembership walks for a multi-store IBC
+// proof, reused here without importing ibc-go's commitment types package directly so
+// x/zkproof doesn't pick up an IBC client dependency just for this.
+type StateCommitment struct {
+	// Root is the merkle root the ordered Proofs chain is ultimately checked against.
+	Root []byte `json:"root"`
+
+	// ProofSpecs orders the ics23.ProofSpec each entry of Proofs is checked under,
+	// innermost (leaf store) first. Its length is the proof's claimed depth and must
+	// match len(Proofs) and len(Path).
+	ProofSpecs []*ics23.ProofSpec `json:"proofSpecs"`
+
+	// Proofs are the chained ics23.CommitmentProof bytes, one per ProofSpecs entry,
+	// innermost first -- Proofs[0] proves Value at Path[0], and (for depth > 1) each
+	// subsequent proof proves the previous layer's calculated root as the value
+	// committed at the next Path element, until the outermost proof is checked
+	// directly against Root.
+	Proofs []*ics23.CommitmentProof `json:"proofs"`
+
+	// Path is the ordered list of key path elements, innermost first, matching
+	// ProofSpecs/Proofs one-for-one.
+	Path [][]byte `json:"path"`
+
+	// Value is the leaf value committed to at Path under Root.
+	Value []byte `json:"value"`
+}
+
+// Validate checks StateCommitment's own shape -- that it actually describes a proof
+// chain rather than cryptographically verifying it (VerifyStateCommitment in
+// x/zkproof/keeper does that, since it needs no keeper state but lives alongside the
+// rest of the verification-dispatch logic).
+func (sc StateCommitment) Validate() error {
+	if len(sc.Root) == 0 {
+		return ErrInvalidProof.Wrap("state commitment root cannot be empty")
+	}
+	if len(sc.ProofSpecs) == 0 {
+		return ErrInvalidProof.Wrap("state commitment proof specs cannot be empty")
+	}
+	if len(sc.ProofSpecs) != len(sc.Proofs) || len(sc.ProofSpecs) != len(sc.Path) {
+		return ErrInvalidProof.Wrapf(
+			"state commitment proof depth mismatch: %d specs, %d proofs, %d path elements",
+			len(sc.ProofSpecs), len(sc.Proofs), len(sc.Path),
+		)
+	}
+	if len(sc.Value) == 0 {
+		return ErrInvalidProof.Wrap("state commitment value cannot be empty")
+	}
+	return nil
+}
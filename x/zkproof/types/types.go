@@ -41,8 +41,78 @@ const (
 	CircuitTypeMembership CircuitType = "membership"
 	CircuitTypeRange      CircuitType = "range"
 	CircuitTypeCustom     CircuitType = "custom"
+	CircuitTypeRecursive  CircuitType = "recursive"
+
+	// CircuitTypePredicate names a circuit compiled by CompilePredicateCircuit from a
+	// PredicateExpr against a credential schema, rather than hand-authored CircuitData --
+	// see predicate.go.
+	CircuitTypePredicate CircuitType = "predicate"
+
+	// CircuitTypeAggregation names the recursive verifier circuit keeper.SubmitProofBatch
+	// checks a batch's AggregationProof against. Its PublicInputsSpec enumerates each
+	// child circuit ID paired with that child's expected public-input digest, e.g.
+	// "circuit:<childCircuitId>" / "digest:<childCircuitId>" entries in order -- distinct
+	// from CircuitTypeRecursive, which is the per-proof recursive-verifier circuit
+	// AggregationModeSNARKRecursive's MsgAggregateProofs checks individual sub-proofs
+	// against one at a time rather than verifying a whole pre-submitted batch at once.
+	CircuitTypeAggregation CircuitType = "aggregation"
 )
 
+// AggregationMode selects how MsgAggregateProofs folds multiple ZKProofs into one --
+// see keeper.Keeper.AggregateProofs.
+type AggregationMode string
+
+const (
+	// AggregationModeSNARKRecursive verifies each inner proof inside a recursive
+	// verifier circuit (CircuitTypeRecursive) and outputs a single proof of that.
+	AggregationModeSNARKRecursive AggregationMode = "snark_recursive"
+
+	// AggregationModeBatchLinearCombination draws Fiat-Shamir scalars from a
+	// transcript of the input proofs and verifies their linear combination in one
+	// multi-pairing check, without a recursive circuit.
+	AggregationModeBatchLinearCombination AggregationMode = "batch_linear_combination"
+)
+
+// CurveID names the elliptic curve a circuit's proving/verifying key material is
+// defined over. Separate from ProofType (which names the proof *system* --
+// groth16/plonk/stark/bulletproof): the same proof system can be instantiated over more
+// than one curve, and a verifier needs both to know which pairing-friendly arithmetic to
+// run.
+type CurveID string
+
+const (
+	// CurveIDBN254 is the curve Groth16Verifier's gnark-crypto integration implements.
+	CurveIDBN254 CurveID = "bn254"
+
+	// CurveIDBLS12381 is reserved for a future BLS12-381-backed Groth16Verifier;
+	// no such backend exists in this tree yet.
+	CurveIDBLS12381 CurveID = "bls12_381"
+)
+
+// ProvingSystem values name a ProofType+CurveID combination, the identifier
+// Circuit.ProvingSystem is set to and keeper.VerifyingKeyCache keys its cached,
+// decoded verifying keys by (alongside circuit ID). Only ProvingSystemGroth16BN254 has
+// a working Verifier (Groth16Verifier, gnark-crypto's bn254 pairing); the
+// BLS12-381 and PLONK entries exist so CreateCircuit/ResolveProvingSystem can name a
+// circuit's intended system ahead of a backend for it existing -- the same
+// documented-gap convention PLONKVerifier/StarkVerifier/BulletproofVerifier already
+// use for "structural validation only, no backend yet".
+const (
+	ProvingSystemGroth16BN254    = "groth16-bn254"
+	ProvingSystemGroth16BLS12381 = "groth16-bls12-381"
+	ProvingSystemPLONKBN254      = "plonk-bn254"
+)
+
+// ResolveProvingSystem names the ProvingSystem a circuit declaring proofType and curve
+// verifies against. An empty curve defaults to CurveIDBN254, the same default
+// VerifyGroth16Proof's CurveId check already applies.
+func ResolveProvingSystem(proofType ProofType, curve CurveID) string {
+	if curve == "" {
+		curve = CurveIDBN254
+	}
+	return fmt.Sprintf("%s-%s", proofType, curve)
+}
+
 // Circuit represents a zero-knowledge proof circuit
 type Circuit struct {
 	// Unique identifier for the circuit
@@ -90,6 +160,40 @@ type Circuit struct {
 	VerificationMethod string `json:"verificationMethod"`
 	TrustedSetup       bool   `json:"trustedSetup"`
 
+	// Status tracks the circuit's trusted-setup lifecycle. Zero value is
+	// CircuitStatusActive's equivalent for a circuit that never required a ceremony
+	// (TrustedSetup false); circuits created with TrustedSetup true start in
+	// CircuitStatusPendingSetup and only accept proofs once a ceremony under
+	// x/zkproof/types.Ceremony finalizes and activates them -- see
+	// keeper.Keeper.FinalizeCeremony.
+	Status CircuitStatus `json:"status,omitempty"`
+
+	// VerifierBackend names the specific Verifier implementation this circuit expects
+	// to be checked with, e.g. "gnark-groth16-bn254" -- distinct from ProofType, which
+	// only names the proof *system* (groth16/plonk/stark/bulletproof) and can be
+	// served by more than one backend. keeper.VerifierRegistry currently dispatches on
+	// ProofType alone; a circuit declaring a VerifierBackend its registered Verifier
+	// doesn't implement is rejected by keeper.Keeper.VerifyProof.
+	VerifierBackend string `json:"verifierBackend,omitempty"`
+
+	// CurveId names the elliptic curve this circuit's Parameters (proving/verifying
+	// key material) are defined over. Empty is treated as CurveIDBN254, the only curve
+	// Groth16Verifier actually implements today; a circuit declaring any other CurveId
+	// is rejected by VerifyGroth16Proof rather than silently verified against the
+	// wrong curve's pairing. Reserved for CurveIDBLS12381 once a BLS12-381 backend
+	// exists -- see CurveID's doc comment.
+	CurveId CurveID `json:"curveId,omitempty"`
+
+	// ProvingSystem names the ProofType+CurveId combination this circuit verifies
+	// against, e.g. ProvingSystemGroth16BN254 -- the same pair VerifierBackend and
+	// CurveId already carry separately, collapsed into the single string
+	// keeper.VerifyingKeyCache keys its decoded-verifying-key entries by alongside the
+	// circuit ID. CreateCircuit derives it from SupportedProofTypes[0] and CurveId
+	// rather than accepting it from MsgCreateCircuit directly, the same way it already
+	// derives NumConstraints/NumVariables/VerificationMethod -- see CreateCircuit's doc
+	// comment.
+	ProvingSystem string `json:"provingSystem,omitempty"`
+
 	// Lifecycle
 	Version   uint64    `json:"version"`
 	Active    bool      `json:"active"`
@@ -158,6 +262,24 @@ type ZKProof struct {
 
 	// Fee and payment information
 	ProofFee sdk.Coins `json:"proofFee"`
+
+	// StateCommitment, when set, binds this proof to a value ICS-23-committed at a
+	// path under an external merkle root -- verified by keeper.VerifyStateCommitment
+	// before the ZK proof itself is recorded. See msg_server_submit.go's SubmitProof.
+	StateCommitment *StateCommitment `json:"stateCommitment,omitempty"`
+
+	// OracleBinding, when set, binds this proof to a value x/oracle's committee response
+	// flow resolved for a request -- verified by keeper.VerifyOracleBinding before the ZK
+	// proof itself is recorded. See msg_server_submit.go's SubmitProof.
+	OracleBinding *OracleBinding `json:"oracleBinding,omitempty"`
+
+	// AggregatedChildren lists the ZKProof IDs this proof recursively attests to, set
+	// only on the aggregator proof recorded by keeper.Keeper.SubmitProofBatch against a
+	// CircuitTypeAggregation circuit. Distinct from MsgAggregateProofs's own lineage,
+	// which is recorded as a "source_proof_ids" entry inside Metadata rather than as a
+	// typed field -- AggregatedChildren exists so ProofCircuitReferentialIntegrityInvariant
+	// can walk it directly instead of parsing Metadata strings.
+	AggregatedChildren []string `json:"aggregatedChildren,omitempty"`
 }
 
 // ProofRequest represents a request for a zero-knowledge proof
@@ -192,6 +314,26 @@ type ProofRequest struct {
 	// Request status
 	Status string `json:"status"`
 
+	// Bids is the marketplace's record of every prover offer submitted via
+	// MsgBidOnProofRequest. For a targeted request (TargetProver set) the requester
+	// picks among these with MsgAcceptBid; for an open-target request the first bid
+	// accepted at its current decayed DutchAuctionReward price wins instead -- see
+	// keeper.Keeper.BidOnProofRequest.
+	Bids []Bid `json:"bids,omitempty"`
+
+	// AcceptedProver is the prover MsgAcceptBid (or an open-target auction accept)
+	// committed to fulfilling this request, empty until a bid is accepted.
+	AcceptedProver string `json:"acceptedProver,omitempty"`
+
+	// ProverStake is the accepted prover's stake, locked into
+	// ModuleEscrowAccountName alongside Reward until MsgClaimReward or
+	// MsgSlashProver resolves it.
+	ProverStake sdk.Coins `json:"proverStake,omitempty"`
+
+	// EscrowStatus tracks Reward/ProverStake's lifecycle in ModuleEscrowAccountName,
+	// independent of Status which tracks proof fulfillment.
+	EscrowStatus BountyStatus `json:"escrowStatus,omitempty"`
+
 	// Associated proof ID (when completed)
 	ProofId string `json:"proofId,omitempty"`
 
@@ -238,6 +380,42 @@ type Params struct {
 
 	// Minimum stake required for circuit creation
 	MinCircuitStake sdk.Coins `json:"minCircuitStake"`
+
+	// GasPerConstraint meters verification cost by circuit complexity, charged per
+	// Circuit.NumConstraints in keeper.Keeper.VerifyProof.
+	GasPerConstraint uint64 `json:"gasPerConstraint"`
+
+	// GasPerProofByte meters verification cost by submitted proof size, charged per
+	// byte of ZKProof.ProofData in keeper.Keeper.VerifyProof.
+	GasPerProofByte uint64 `json:"gasPerProofByte"`
+
+	// GasPerPublicInput meters verification cost by the number of ZKProof.PublicInputs
+	// entries, charged in keeper.GasForVerification alongside GasPerConstraint/
+	// GasPerProofByte -- each public input costs its own scalar-field decode plus one
+	// more term in the multi-scalar multiplication VerifyGroth16Proof's witness
+	// construction performs.
+	GasPerPublicInput uint64 `json:"gasPerPublicInput"`
+
+	// MaxQueryPageSize caps CursorPageRequest.Limit for QueryServer's Circuits/Proofs/
+	// ProofRequests methods, in place of the fixed MaxQueryLimit constant, so page size
+	// can be tightened or relaxed by governance without a binary upgrade.
+	MaxQueryPageSize uint64 `json:"maxQueryPageSize"`
+
+	// IndexableAttributeKeys allowlists which ZKProof.Metadata keys get mirrored into
+	// ProofsByAttribute. Empty by default, the same state-bloat guard
+	// x/credential.Params.IndexableAttributeKeys documents.
+	IndexableAttributeKeys []string `json:"indexableAttributeKeys,omitempty"`
+
+	// ProofRequestTTL is how long (seconds) a MsgCreateProofRequest's escrowed Reward
+	// stays outstanding before keeper.Keeper.BeginBlocker sweeps it as expired --
+	// mirrors ProofValidityPeriod's role for ZKProof.ValidTo, but for ProofRequest.Deadline.
+	ProofRequestTTL uint64 `json:"proofRequestTTL"`
+
+	// CircuitUpgradeThreshold is the fraction of bonded voting power that must signal
+	// the same (circuit_id, new_vk_hash) pair via MsgSignalCircuitUpgrade before
+	// keeper.Keeper.TallyCircuitUpgrades schedules it, mirroring the supermajority
+	// single-binary upgrade signaling uses to decide a halt height is safe to commit to.
+	CircuitUpgradeThreshold float64 `json:"circuitUpgradeThreshold"`
 }
 
 // DefaultParams returns default parameters
@@ -254,6 +432,12 @@ func DefaultParams() Params {
 		AllowedProofTypes:    []ProofType{ProofTypeGroth16, ProofTypePLONK, ProofTypeSTARK, ProofTypeBulletproof},
 		AllowedCircuitTypes:  []CircuitType{CircuitTypeArithmetic, CircuitTypeBoolean, CircuitTypeHash, CircuitTypeSignature, CircuitTypeMembership, CircuitTypeRange, CircuitTypeCustom},
 		MinCircuitStake:      sdk.NewCoins(sdk.NewCoin("upersona", math.NewInt(50000))),
+		GasPerConstraint:     10,
+		GasPerProofByte:      1,
+		GasPerPublicInput:    50,
+		MaxQueryPageSize:     MaxQueryLimit,
+		ProofRequestTTL:      86400 * 7, // 7 days
+		CircuitUpgradeThreshold: 5.0 / 6.0,
 	}
 }
 
@@ -276,9 +460,22 @@ func (c *Circuit) Validate() error {
 	if len(c.SupportedProofTypes) == 0 {
 		return fmt.Errorf("circuit must support at least one proof type")
 	}
+	if c.TrustedSetup && c.Status == CircuitStatusPendingSetup && c.Active {
+		return fmt.Errorf("circuit cannot be active while its trusted setup is still pending")
+	}
 	return nil
 }
 
+// CanAcceptProofs reports whether the circuit is ready for MsgSubmitProof: it must be
+// Active, and if it has a trusted setup, that setup must have finalized
+// (CircuitStatusActive) rather than still being pending.
+func (c *Circuit) CanAcceptProofs() bool {
+	if !c.Active {
+		return false
+	}
+	return !c.TrustedSetup || c.Status == CircuitStatusActive
+}
+
 // Validate validates a zero-knowledge proof
 func (p *ZKProof) Validate() error {
 	if p.Id == "" {
@@ -342,6 +539,12 @@ func (p Params) Validate() error {
 	if len(p.AllowedCircuitTypes) == 0 {
 		return fmt.Errorf("must allow at least one circuit type")
 	}
+	if p.MaxQueryPageSize == 0 {
+		return fmt.Errorf("max query page size cannot be zero")
+	}
+	if p.CircuitUpgradeThreshold <= 0 || p.CircuitUpgradeThreshold > 1 {
+		return fmt.Errorf("circuit upgrade threshold must be in (0, 1]")
+	}
 	return nil
 }
 